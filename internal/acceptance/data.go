@@ -11,6 +11,8 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -55,6 +57,12 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder records (or replays) the requests sent to Azure for this test, when enabled
+	recorder *common.Recorder
+
+	// random is the source used for random values when recording or replaying, so that these are consistent
+	random *rand.Rand
 }
 
 // BuildTestData generates some test data for the given resource
@@ -85,6 +93,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		Secondary: os.Getenv("ARM_TEST_SUBSCRIPTION_ID_ALT"),
 	}
 
+	if testclient.Recording() || testclient.Replaying() {
+		testData.configureRecording(t)
+	}
+
 	return testData
 }
 
@@ -123,6 +135,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.random != nil {
+		return randStringFromSource(td.random, len, charSetAlphaNum)
+	}
+
 	return randString(len)
 }
 
@@ -140,3 +156,13 @@ func randStringFromCharSet(strlen int, charSet string) string {
 	}
 	return string(result)
 }
+
+// randStringFromSource generates a random string by selecting characters from the charset provided, using the
+// specified source so that the string is consistent when a test is replayed
+func randStringFromSource(source *rand.Rand, strlen int, charSet string) string {
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[source.Intn(len(charSet))]
+	}
+	return string(result)
+}
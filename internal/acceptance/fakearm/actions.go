@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ActionFunc performs a POST action on the resource using the body of the request, returning the body of the
+// response - or nil when the action doesn't return a body
+type ActionFunc func(resource map[string]interface{}, input map[string]interface{}) (map[string]interface{}, error)
+
+type action struct {
+	fn          ActionFunc
+	longRunning bool
+}
+
+// RegisterAction registers the POST action `name` (for example `listKeys`) for all resources, which completes
+// immediately - or when `longRunning` is true, is performed as a long-running operation where the body is returned
+// once the operation completes
+func (s *Server) RegisterAction(name string, longRunning bool, fn ActionFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.actions[strings.ToLower(name)] = action{
+		fn:          fn,
+		longRunning: longRunning,
+	}
+}
+
+func (s *Server) postAction(w http.ResponseWriter, r *http.Request, path string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	id, name := splitResourceId(path)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	action, ok := s.actions[strings.ToLower(name)]
+	if !ok {
+		writeError(w, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("the action %q is not supported by the fake server for %q", name, id))
+		return
+	}
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	result, err := action.fn(copyResource(resource), body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "BadRequest", err.Error())
+		return
+	}
+
+	if action.longRunning {
+		op := s.startOperation("", "")
+		op.result = result
+		op.writeHeaders(w, s.URL())
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if result == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceHarness runs the Create, Read, Update and Delete functions of a typed Resource using a Client, such as
+// the Client for the fake server returned from Server.Client
+type ResourceHarness struct {
+	t        *testing.T
+	client   *clients.Client
+	resource *pluginsdk.Resource
+}
+
+func NewResourceHarness(t *testing.T, client *clients.Client, resource sdk.Resource) ResourceHarness {
+	wrapper := sdk.NewResourceWrapper(resource)
+	r, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building %q: %+v", resource.ResourceType(), err)
+	}
+
+	return ResourceHarness{
+		t:        t,
+		client:   client,
+		resource: r,
+	}
+}
+
+// Create runs the Create function (and then the Read function) for the configuration, returning the ResourceData
+func (h ResourceHarness) Create(ctx context.Context, config map[string]interface{}) (*pluginsdk.ResourceData, error) {
+	d := schema.TestResourceDataRaw(h.t, h.resource.Schema, config)
+	if err := h.run(ctx, h.resource.CreateContext, d, h.resource.Timeouts.Create); err != nil {
+		return d, fmt.Errorf("creating: %+v", err)
+	}
+	return d, nil
+}
+
+// Read runs the Read function for the resource with the specified ID, returning the ResourceData - where the ID of
+// the ResourceData is empty when the resource no longer exists
+func (h ResourceHarness) Read(ctx context.Context, id string) (*pluginsdk.ResourceData, error) {
+	d := h.resource.TestResourceData()
+	d.SetId(id)
+	if err := h.run(ctx, h.resource.ReadContext, d, h.resource.Timeouts.Read); err != nil {
+		return d, fmt.Errorf("reading: %+v", err)
+	}
+	return d, nil
+}
+
+// Update runs the Update function (and then the Read function) for the resource with the specified ID - since
+// there's no prior state, each field within the configuration is considered to have changed
+func (h ResourceHarness) Update(ctx context.Context, id string, config map[string]interface{}) (*pluginsdk.ResourceData, error) {
+	if h.resource.UpdateContext == nil {
+		return nil, fmt.Errorf("the resource doesn't support being updated")
+	}
+
+	d := schema.TestResourceDataRaw(h.t, h.resource.Schema, config)
+	d.SetId(id)
+	if err := h.run(ctx, h.resource.UpdateContext, d, h.resource.Timeouts.Update); err != nil {
+		return d, fmt.Errorf("updating: %+v", err)
+	}
+	return d, nil
+}
+
+// Delete runs the Delete function for the resource with the specified ID
+func (h ResourceHarness) Delete(ctx context.Context, id string) error {
+	d := h.resource.TestResourceData()
+	d.SetId(id)
+	if err := h.run(ctx, h.resource.DeleteContext, d, h.resource.Timeouts.Delete); err != nil {
+		return fmt.Errorf("deleting: %+v", err)
+	}
+	return nil
+}
+
+// Refresh runs the Read function for an existing ResourceData (for example one returned from Create), which is needed
+// for resources which depend on the existing state (rather than only the ID) to be read
+func (h ResourceHarness) Refresh(ctx context.Context, d *pluginsdk.ResourceData) error {
+	if err := h.run(ctx, h.resource.ReadContext, d, h.resource.Timeouts.Read); err != nil {
+		return fmt.Errorf("reading: %+v", err)
+	}
+	return nil
+}
+
+// Destroy runs the Delete function for an existing ResourceData, which is needed for resources which depend on the
+// existing state (rather than only the ID) to be deleted
+func (h ResourceHarness) Destroy(ctx context.Context, d *pluginsdk.ResourceData) error {
+	if err := h.run(ctx, h.resource.DeleteContext, d, h.resource.Timeouts.Delete); err != nil {
+		return fmt.Errorf("deleting: %+v", err)
+	}
+	return nil
+}
+
+// run runs the function with the timeout for the operation, since polling long-running operations requires a
+// deadline - as would be set by Terraform
+func (h ResourceHarness) run(ctx context.Context, fn func(context.Context, *pluginsdk.ResourceData, interface{}) diag.Diagnostics, d *pluginsdk.ResourceData, timeout *time.Duration) error {
+	if timeout != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	return diagnosticsError(fn(ctx, d, h.client))
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+
+	messages := make([]string, 0)
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		message := d.Summary
+		if d.Detail != "" {
+			message = fmt.Sprintf("%s: %s", message, d.Detail)
+		}
+		messages = append(messages, message)
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "\n"))
+}
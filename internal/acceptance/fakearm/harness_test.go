@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
+)
+
+func TestResourceHarnessTypedResource(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakearm.NewServer(fakearm.Options{})
+	defer server.Close()
+
+	client, err := server.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	if client.Account.SubscriptionId != fakearm.SubscriptionId || client.Account.ObjectId != fakearm.ObjectId {
+		t.Fatalf("expected the account to be for the fake server but got %+v", client.Account)
+	}
+
+	harness := fakearm.NewResourceHarness(t, client, resource.ResourceManagementPrivateLinkResource{})
+	config := map[string]interface{}{
+		"name":                "example",
+		"resource_group_name": "example-resources",
+		"location":            "West Europe",
+	}
+
+	if _, err := harness.Create(ctx, config); err == nil {
+		t.Fatal("expected an error when the Resource Group doesn't exist")
+	}
+
+	server.PutResource("/subscriptions/"+fakearm.SubscriptionId+"/resourceGroups/example-resources", map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	d, err := harness.Create(ctx, config)
+	if err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+	id := d.Id()
+	if _, ok := server.GetResource(id); !ok {
+		t.Fatalf("expected %q to exist on the fake server", id)
+	}
+	if v := d.Get("location").(string); v != "westeurope" {
+		t.Fatalf("expected the `location` to be read back as %q but got %q", "westeurope", v)
+	}
+
+	if _, err := harness.Create(ctx, config); err == nil {
+		t.Fatal("expected an error requiring the existing resource to be imported")
+	}
+
+	if err := harness.Delete(ctx, id); err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+
+	d, err = harness.Read(ctx, id)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected the resource to be removed from the state once deleted but got %q", d.Id())
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// operationsPath is the path used for long-running operations, which is outside of the paths used for
+	// resources so that these can't conflict
+	operationsPath = "/fakearm/operations/"
+
+	// operationsApiVersion is the API version used when polling long-running operations, since all requests to
+	// Azure Resource Manager require one
+	operationsApiVersion = "2020-01-01"
+
+	operationStatusInProgress = "InProgress"
+	operationStatusSucceeded  = "Succeeded"
+)
+
+// operation is a long-running operation, which is reported as `InProgress` until it's been polled the number of
+// times specified in Options.PollsUntilComplete
+type operation struct {
+	id        string
+	startTime time.Time
+
+	// resourceKey is the (lower-cased) ID of the resource which is returned once the operation completes, which is
+	// empty when the resource has been deleted
+	resourceKey string
+
+	// inProgressState is the provisioningState of the resource whilst the operation is in progress
+	inProgressState string
+
+	// result is the body returned once the operation completes for a long-running action, in place of the resource
+	result map[string]interface{}
+
+	remainingPolls int
+}
+
+// startOperation starts a long-running operation for the resource, which must be called with the lock held
+func (s *Server) startOperation(resourceKey, inProgressState string) *operation {
+	op := &operation{
+		id:              fmt.Sprintf("operation-%d", len(s.operations)+1),
+		startTime:       time.Now().UTC(),
+		resourceKey:     resourceKey,
+		inProgressState: inProgressState,
+		remainingPolls:  s.options.PollsUntilComplete,
+	}
+	s.operations[op.id] = op
+	return op
+}
+
+func (o *operation) completed() bool {
+	return o.remainingPolls <= 0
+}
+
+// provisioningState returns the provisioningState of the resource whilst this operation is running
+func (o *operation) provisioningState() string {
+	if o.completed() {
+		return provisioningStateSucceeded
+	}
+	return o.inProgressState
+}
+
+// writeHeaders writes the headers used to poll the operation - both the `Azure-AsyncOperation` and `Location`
+// headers are returned, and `Retry-After` is set to `0` so that there's no delay between polling the operation
+func (o *operation) writeHeaders(w http.ResponseWriter, baseUrl string) {
+	w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("%s%s%s?api-version=%s", baseUrl, operationsPath, o.id, operationsApiVersion))
+	w.Header().Set("Location", fmt.Sprintf("%s%s%s/result?api-version=%s", baseUrl, operationsPath, o.id, operationsApiVersion))
+	w.Header().Set("Retry-After", "0")
+}
+
+// serveOperation returns the status of a long-running operation from `/fakearm/operations/{id}`, or the result of
+// the operation from `/fakearm/operations/{id}/result` - as referenced by the `Azure-AsyncOperation` and `Location`
+// headers respectively
+func (s *Server) serveOperation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for operations", r.Method))
+		return
+	}
+
+	path := strings.Trim(r.URL.Path[len(operationsPath):], "/")
+	id, suffix, _ := strings.Cut(path, "/")
+	result := strings.EqualFold(suffix, "result")
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	op, ok := s.operations[id]
+	if !ok || (suffix != "" && !result) {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The operation %q was not found.", id))
+		return
+	}
+
+	// the operation is reported as in progress until it's been polled the configured number of times
+	inProgress := !op.completed()
+	if inProgress {
+		op.remainingPolls--
+	} else if resource, ok := s.resources[op.resourceKey]; ok {
+		resource["properties"].(map[string]interface{})["provisioningState"] = provisioningStateSucceeded
+	}
+
+	w.Header().Set("Retry-After", "0")
+
+	if result {
+		if inProgress {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		if op.result != nil {
+			writeJSON(w, http.StatusOK, op.result)
+			return
+		}
+		if resource, ok := s.resources[op.resourceKey]; ok {
+			writeJSON(w, http.StatusOK, resource)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	status := operationStatusSucceeded
+	if inProgress {
+		status = operationStatusInProgress
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":        fmt.Sprintf("%s%s", operationsPath, op.id),
+		"name":      op.id,
+		"status":    status,
+		"startTime": op.startTime.Format(time.RFC3339),
+	})
+}
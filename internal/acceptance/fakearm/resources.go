@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	provisioningStateSucceeded = "Succeeded"
+	provisioningStateCreating  = "Creating"
+	provisioningStateUpdating  = "Updating"
+	provisioningStateDeleting  = "Deleting"
+)
+
+func (s *Server) getResource(w http.ResponseWriter, id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if resource, ok := s.resources[strings.ToLower(id)]; ok {
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	if isCollection(id) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": s.listResources(id),
+		})
+		return
+	}
+
+	writeNotFound(w, id)
+}
+
+// listResources returns the resources within the collection, for example the Storage Accounts within
+// `/subscriptions/{id}/resourceGroups/{name}/providers/Microsoft.Storage/storageAccounts`
+func (s *Server) listResources(collectionId string) []interface{} {
+	ids := make([]string, 0)
+	for key := range s.resources {
+		if parent, _ := splitResourceId(key); parent == strings.ToLower(collectionId) {
+			ids = append(ids, key)
+		}
+	}
+	sort.Strings(ids)
+
+	out := make([]interface{}, 0, len(ids))
+	for _, key := range ids {
+		out = append(out, s.resources[key])
+	}
+	return out
+}
+
+func (s *Server) putResource(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.parentExists(id) {
+		writeParentNotFound(w, id)
+		return
+	}
+
+	key := strings.ToLower(id)
+	existing, exists := s.resources[key]
+	if exists {
+		// the casing of the ID is retained from when the resource was created, as in Azure
+		id = existing["id"].(string)
+	}
+
+	provisioningState := provisioningStateCreating
+	statusCode := http.StatusCreated
+	if exists {
+		provisioningState = provisioningStateUpdating
+		statusCode = http.StatusOK
+	}
+
+	op := s.startOperation(key, provisioningState)
+	resource := normalizeResource(id, body, op.provisioningState())
+	s.resources[key] = resource
+
+	op.writeHeaders(w, s.URL())
+	writeJSON(w, statusCode, resource)
+}
+
+func (s *Server) patchResource(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := strings.ToLower(id)
+	existing, ok := s.resources[key]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	op := s.startOperation(key, provisioningStateUpdating)
+	resource := normalizeResource(existing["id"].(string), mergePatch(copyResource(existing), body).(map[string]interface{}), op.provisioningState())
+	s.resources[key] = resource
+
+	op.writeHeaders(w, s.URL())
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, _ *http.Request, id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := strings.ToLower(id)
+	if _, ok := s.resources[key]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a resource also deletes any resources nested within it, for example the resources within a
+	// Resource Group - the resource is removed immediately, with the operation reporting the progress
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+
+	op := s.startOperation("", provisioningStateDeleting)
+	if op.completed() {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	op.writeHeaders(w, s.URL())
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// parentExists returns whether the resource which the resource is nested within exists - resources directly within
+// a Subscription (or the Tenant) are assumed to have a parent which exists
+func (s *Server) parentExists(id string) bool {
+	parentId := parentResourceId(id)
+	if segments := strings.Split(strings.Trim(parentId, "/"), "/"); len(segments) <= 2 {
+		return true
+	}
+
+	_, ok := s.resources[strings.ToLower(parentId)]
+	return ok
+}
+
+// parentResourceId returns the ID of the resource which the resource is nested within, for example the Resource
+// Group containing a Storage Account, or the resource which an extension resource (such as a Role Assignment) is
+// scoped to
+func parentResourceId(id string) string {
+	collection, _ := splitResourceId(id)
+	parentId, _ := splitResourceId(collection)
+
+	// omit the Resource Provider namespace, e.g. `/providers/Microsoft.Storage`
+	if index := strings.LastIndex(strings.ToLower(parentId), "/providers/"); index != -1 && strings.Count(parentId[index+1:], "/") == 1 {
+		parentId = parentId[:index]
+	}
+	return parentId
+}
+
+// splitResourceId returns the ID of the collection containing the resource, and the name of the resource
+func splitResourceId(id string) (collection string, name string) {
+	id = strings.TrimSuffix(id, "/")
+	index := strings.LastIndex(id, "/")
+	if index == -1 {
+		return "", id
+	}
+	return id[:index], id[index+1:]
+}
+
+func lastSegment(id string) string {
+	_, name := splitResourceId(id)
+	return name
+}
+
+// isCollection returns whether the path refers to a collection of resources (such as `.../storageAccounts`) rather
+// than a resource (such as `.../storageAccounts/example`) - within a Resource ID each type is followed by a name,
+// except for `providers` which is followed by the Resource Provider namespace
+func isCollection(id string) bool {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	i := 0
+	for i < len(segments) {
+		if strings.EqualFold(segments[i], "providers") {
+			i += 2
+			continue
+		}
+		if i+1 >= len(segments) {
+			return true
+		}
+		i += 2
+	}
+	return false
+}
+
+// resourceType returns the type of the resource, for example `Microsoft.Storage/storageAccounts` or
+// `Microsoft.Storage/storageAccounts/blobServices`
+func resourceType(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	providersIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+1 < len(segments) {
+			providersIndex = i
+		}
+	}
+	if providersIndex == -1 {
+		if len(segments) >= 2 {
+			return fmt.Sprintf("Microsoft.Resources/%s", segments[len(segments)-2])
+		}
+		return ""
+	}
+
+	types := []string{segments[providersIndex+1]}
+	for i := providersIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// normalizeResource returns the resource with the `id`, `name` and `type` populated and the `provisioningState`
+// set, as returned by Azure Resource Manager
+func normalizeResource(id string, body map[string]interface{}, provisioningState string) map[string]interface{} {
+	resource := copyResource(body)
+	resource["id"] = id
+	resource["name"] = lastSegment(id)
+	resource["type"] = resourceType(id)
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = map[string]interface{}{}
+	}
+	properties["provisioningState"] = provisioningState
+	resource["properties"] = properties
+
+	return resource
+}
+
+func decodeBody(r *http.Request) (map[string]interface{}, error) {
+	body := map[string]interface{}{}
+	if r.Body == nil {
+		return body, nil
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		if errors.Is(err, io.EOF) {
+			return map[string]interface{}{}, nil
+		}
+		return nil, fmt.Errorf("the request content was invalid and could not be deserialized: %+v", err)
+	}
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	return body, nil
+}
+
+// mergePatch applies the patch to the target as a JSON Merge Patch (RFC 7396), where `null` values remove fields
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for k, v := range patchObject {
+		if v == nil {
+			delete(targetObject, k)
+			continue
+		}
+		targetObject[k] = mergePatch(targetObject[k], v)
+	}
+	return targetObject
+}
+
+// copyResource returns a deep copy of the resource, so that changes to the copy aren't reflected on the server
+func copyResource(input map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		out[k] = copyValue(v)
+	}
+	return out
+}
+
+func copyValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		return copyResource(v)
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = copyValue(item)
+		}
+		return out
+	}
+	return input
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	if strings.EqualFold(resourceType(id), "Microsoft.Resources/resourceGroups") {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", lastSegment(id)))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", id))
+}
+
+func writeParentNotFound(w http.ResponseWriter, id string) {
+	parentId := parentResourceId(id)
+	if strings.EqualFold(resourceType(parentId), "Microsoft.Resources/resourceGroups") {
+		writeNotFound(w, parentId)
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", parentId))
+}
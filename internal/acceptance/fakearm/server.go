@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// The identifiers used for the Subscription and the authenticated principal by the fake server, which are
+// available within the Client returned from Server.Client
+const (
+	SubscriptionId = "11111111-1111-1111-1111-111111111111"
+	TenantId       = "22222222-2222-2222-2222-222222222222"
+	ClientId       = "33333333-3333-3333-3333-333333333333"
+	ObjectId       = "44444444-4444-4444-4444-444444444444"
+)
+
+// Options configures the behaviour of the fake server
+type Options struct {
+	// PollsUntilComplete is the number of times a long-running operation is reported as `InProgress` when polled
+	// before it completes - by default operations complete the first time they're polled
+	PollsUntilComplete int
+}
+
+// Server is an in-memory implementation of Azure Resource Manager, which supports the generic semantics for
+// creating, retrieving, updating and deleting resources (including long-running operations) - allowing the
+// Create, Read, Update and Delete functions for resources to be tested without an Azure Subscription.
+//
+// Requests for Resource Manager and the token endpoint are served by this server - requests for other APIs (such
+// as data plane APIs) are also sent to this server, and fail.
+type Server struct {
+	options Options
+	server  *httptest.Server
+
+	lock       sync.Mutex
+	resources  map[string]map[string]interface{}
+	operations map[string]*operation
+	actions    map[string]action
+	requests   []string
+}
+
+// NewServer starts a fake server listening on a local port, which should be closed by calling Close once finished
+func NewServer(options Options) *Server {
+	s := &Server{
+		options:    options,
+		resources:  map[string]map[string]interface{}{},
+		operations: map[string]*operation{},
+		actions:    map[string]action{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts down the fake server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL for the fake server, which is used as the Resource Manager endpoint
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Environment returns an environment where the login endpoint and every API defined by an endpoint are served by
+// the fake server. Data plane APIs (such as Storage) build their URIs from a domain suffix rather than an endpoint,
+// so retain the Azure Public domain suffixes - these aren't implemented by the fake server and so can't be used.
+func (s *Server) Environment() *environments.Environment {
+	env := environments.AzurePublic()
+	env.Name = "FakeARM"
+	env.Authorization.Audiences = []string{s.URL()}
+	env.Authorization.LoginEndpoint = s.URL()
+
+	apiType := reflect.TypeOf((*environments.Api)(nil)).Elem()
+	v := reflect.ValueOf(env).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Type() != apiType {
+			continue
+		}
+
+		name := v.Type().Field(i).Name
+		var appId *string
+		if api, ok := field.Interface().(environments.Api); ok && api != nil {
+			if _, ok := api.DomainSuffix(); ok {
+				// data plane clients build their hosts from the domain suffix rather than the endpoint, so are left as-is
+				continue
+			}
+			name = api.Name()
+			appId, _ = api.AppId()
+		}
+		field.Set(reflect.ValueOf(environments.NewApiEndpoint(name, s.URL(), appId).WithResourceIdentifier(s.URL())))
+	}
+
+	env.ResourceManager = environments.ResourceManagerAPI(s.URL())
+	return env
+}
+
+// AuthConfig returns credentials which authenticate against the fake server
+func (s *Server) AuthConfig() *auth.Credentials {
+	return &auth.Credentials{
+		Environment:                           *s.Environment(),
+		TenantID:                              TenantId,
+		ClientID:                              ClientId,
+		ClientSecret:                          "fake-secret",
+		EnableAuthenticatingUsingClientSecret: true,
+	}
+}
+
+// Client builds the Provider's Client configured to send requests to the fake server
+func (s *Server) Client(ctx context.Context) (*clients.Client, error) {
+	builder := clients.ClientBuilder{
+		AuthConfig:                s.AuthConfig(),
+		DisableTerraformPartnerID: true,
+		Features:                  features.Default(),
+		SubscriptionID:            SubscriptionId,
+		TerraformVersion:          "1.0.0",
+	}
+
+	client, err := clients.Build(ctx, builder)
+	if err != nil {
+		return nil, fmt.Errorf("building client for the fake server: %+v", err)
+	}
+
+	return client, nil
+}
+
+// PutResource stores a resource on the fake server, for example a Resource Group which is referenced by a test
+func (s *Server) PutResource(id string, body map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.resources[strings.ToLower(id)] = normalizeResource(id, body, provisioningStateSucceeded)
+}
+
+// GetResource returns a copy of the resource stored on the fake server with the specified ID
+func (s *Server) GetResource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		return nil, false
+	}
+	return copyResource(resource), true
+}
+
+// ResourceIds returns the IDs of the resources stored on the fake server
+func (s *Server) ResourceIds() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ids := make([]string, 0, len(s.resources))
+	for _, resource := range s.resources {
+		ids = append(ids, resource["id"].(string))
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Requests returns the method and path of each request sent to the fake server, in the order they were received
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	s.lock.Unlock()
+
+	if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/oauth2/v2.0/token") {
+		s.serveToken(w)
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "AuthenticationFailed", "Authentication failed. The 'Authorization' header is missing.")
+		return
+	}
+
+	if r.URL.Query().Get("api-version") == "" {
+		writeError(w, http.StatusBadRequest, "MissingApiVersionParameter", "The api-version query parameter (?api-version=) is required for all requests.")
+		return
+	}
+
+	if strings.HasPrefix(strings.ToLower(r.URL.Path), operationsPath) {
+		s.serveOperation(w, r)
+		return
+	}
+
+	id := strings.TrimSuffix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodGet:
+		s.getResource(w, id)
+	case http.MethodPut:
+		s.putResource(w, r, id)
+	case http.MethodPatch:
+		s.patchResource(w, r, id)
+	case http.MethodDelete:
+		s.deleteResource(w, r, id)
+	case http.MethodPost:
+		s.postAction(w, r, id)
+	default:
+		writeError(w, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("the method %q is not supported by the fake server for %q", r.Method, id))
+	}
+}
+
+// serveToken returns an access token for any client credentials, containing the claims used by the Provider to
+// determine the authenticated principal
+func (s *Server) serveToken(w http.ResponseWriter) {
+	now := time.Now()
+	header, _ := json.Marshal(map[string]string{
+		"alg": "none",
+		"typ": "JWT",
+	})
+	claims, _ := json.Marshal(map[string]interface{}{
+		"aud":   s.URL(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"iat":   now.Unix(),
+		"iss":   fmt.Sprintf("%s/%s/", s.URL(), TenantId),
+		"appid": ClientId,
+		"oid":   ObjectId,
+		"sub":   ObjectId,
+		"tid":   TenantId,
+	})
+	token := fmt.Sprintf("%s.%s.fake", base64.RawURLEncoding.EncodeToString(header), base64.RawURLEncoding.EncodeToString(claims))
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+// writeError writes an error in the format returned by Azure Resource Manager
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]string{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+const testResourceGroupId = "/subscriptions/" + SubscriptionId + "/resourceGroups/example"
+
+func sendRequest(t *testing.T, server *Server, method, path, body string) (*http.Response, map[string]interface{}) {
+	t.Helper()
+
+	uri := path
+	if !strings.HasPrefix(uri, "http") {
+		uri = fmt.Sprintf("%s%s?api-version=2022-09-01", server.URL(), path)
+	}
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	request, err := http.NewRequest(method, uri, reader)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	request.Header.Set("Authorization", "Bearer token")
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer response.Body.Close()
+
+	contents, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("reading response: %+v", err)
+	}
+
+	var out map[string]interface{}
+	if len(contents) > 0 {
+		if err := json.Unmarshal(contents, &out); err != nil {
+			t.Fatalf("parsing response %q: %+v", string(contents), err)
+		}
+	}
+	return response, out
+}
+
+func errorCode(body map[string]interface{}) string {
+	if e, ok := body["error"].(map[string]interface{}); ok {
+		return e["code"].(string)
+	}
+	return ""
+}
+
+func provisioningStateOf(body map[string]interface{}) string {
+	if properties, ok := body["properties"].(map[string]interface{}); ok {
+		return properties["provisioningState"].(string)
+	}
+	return ""
+}
+
+func TestServerResourceLifecycle(t *testing.T) {
+	server := NewServer(Options{})
+	defer server.Close()
+
+	accountId := testResourceGroupId + "/providers/Microsoft.Storage/storageAccounts/example"
+
+	response, body := sendRequest(t, server, http.MethodGet, testResourceGroupId, "")
+	if response.StatusCode != http.StatusNotFound || errorCode(body) != "ResourceGroupNotFound" {
+		t.Fatalf("expected a 404 with the code `ResourceGroupNotFound` but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodPut, accountId, `{"location": "westeurope"}`)
+	if response.StatusCode != http.StatusNotFound || errorCode(body) != "ResourceGroupNotFound" {
+		t.Fatalf("expected a 404 when the Resource Group doesn't exist but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodPut, testResourceGroupId, `{"location": "westeurope"}`)
+	if response.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 when creating but got %d: %+v", response.StatusCode, body)
+	}
+	if body["type"] != "Microsoft.Resources/resourceGroups" || body["name"] != "example" {
+		t.Fatalf("expected the `type` and `name` to be populated but got %+v", body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodPut, accountId, `{"location": "westeurope", "kind": "StorageV2", "tags": {"env": "test"}}`)
+	if response.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 when creating but got %d: %+v", response.StatusCode, body)
+	}
+	if body["type"] != "Microsoft.Storage/storageAccounts" || provisioningStateOf(body) != "Succeeded" {
+		t.Fatalf("expected the resource to be provisioned but got %+v", body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodPatch, accountId, `{"tags": {"env": null, "owner": "team"}, "properties": {"accessTier": "Cool"}}`)
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 when updating but got %d: %+v", response.StatusCode, body)
+	}
+	if tags := body["tags"].(map[string]interface{}); len(tags) != 1 || tags["owner"] != "team" {
+		t.Fatalf("expected the tags to be merged but got %+v", tags)
+	}
+	if body["kind"] != "StorageV2" || body["properties"].(map[string]interface{})["accessTier"] != "Cool" {
+		t.Fatalf("expected the patch to be merged into the existing resource but got %+v", body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodGet, testResourceGroupId+"/providers/Microsoft.Storage/storageAccounts", "")
+	if response.StatusCode != http.StatusOK || len(body["value"].([]interface{})) != 1 {
+		t.Fatalf("expected the collection to contain the Storage Account but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, _ = sendRequest(t, server, http.MethodDelete, testResourceGroupId, "")
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 when deleting but got %d", response.StatusCode)
+	}
+
+	response, body = sendRequest(t, server, http.MethodGet, accountId, "")
+	if response.StatusCode != http.StatusNotFound || errorCode(body) != "ResourceNotFound" {
+		t.Fatalf("expected the nested resource to be deleted but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, _ = sendRequest(t, server, http.MethodDelete, testResourceGroupId, "")
+	if response.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 when deleting a resource which doesn't exist but got %d", response.StatusCode)
+	}
+}
+
+func TestServerLongRunningOperations(t *testing.T) {
+	server := NewServer(Options{
+		PollsUntilComplete: 2,
+	})
+	defer server.Close()
+
+	server.PutResource(testResourceGroupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	vaultId := testResourceGroupId + "/providers/Microsoft.KeyVault/vaults/example"
+	response, body := sendRequest(t, server, http.MethodPut, vaultId, `{"location": "westeurope"}`)
+	if response.StatusCode != http.StatusCreated || provisioningStateOf(body) != "Creating" {
+		t.Fatalf("expected the resource to be `Creating` but got %d: %+v", response.StatusCode, body)
+	}
+	if response.Header.Get("Retry-After") != "0" {
+		t.Fatalf("expected the `Retry-After` header to be `0` but got %q", response.Header.Get("Retry-After"))
+	}
+
+	asyncOperation := response.Header.Get("Azure-AsyncOperation")
+	for i := 0; i < 2; i++ {
+		_, status := sendRequest(t, server, http.MethodGet, asyncOperation, "")
+		if status["status"] != "InProgress" {
+			t.Fatalf("expected poll %d to be `InProgress` but got %+v", i+1, status)
+		}
+	}
+	if _, body = sendRequest(t, server, http.MethodGet, vaultId, ""); provisioningStateOf(body) != "Creating" {
+		t.Fatalf("expected the resource to be `Creating` whilst the operation is in progress but got %+v", body)
+	}
+
+	_, status := sendRequest(t, server, http.MethodGet, asyncOperation, "")
+	if status["status"] != "Succeeded" {
+		t.Fatalf("expected the operation to have `Succeeded` but got %+v", status)
+	}
+	if _, body = sendRequest(t, server, http.MethodGet, vaultId, ""); provisioningStateOf(body) != "Succeeded" {
+		t.Fatalf("expected the resource to be `Succeeded` once the operation completed but got %+v", body)
+	}
+
+	response, _ = sendRequest(t, server, http.MethodDelete, vaultId, "")
+	if response.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected a 202 when deleting but got %d", response.StatusCode)
+	}
+	location := response.Header.Get("Location")
+	for i := 0; i < 2; i++ {
+		if response, _ = sendRequest(t, server, http.MethodGet, location, ""); response.StatusCode != http.StatusAccepted {
+			t.Fatalf("expected poll %d to return a 202 but got %d", i+1, response.StatusCode)
+		}
+	}
+	if response, _ = sendRequest(t, server, http.MethodGet, location, ""); response.StatusCode != http.StatusOK {
+		t.Fatalf("expected the operation to complete but got %d", response.StatusCode)
+	}
+}
+
+func TestServerRequiresApiVersionAndAuthorization(t *testing.T) {
+	server := NewServer(Options{})
+	defer server.Close()
+
+	response, body := sendRequest(t, server, http.MethodGet, server.URL()+testResourceGroupId, "")
+	if response.StatusCode != http.StatusBadRequest || errorCode(body) != "MissingApiVersionParameter" {
+		t.Fatalf("expected a 400 without an API version but got %d: %+v", response.StatusCode, body)
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, server.URL()+testResourceGroupId+"?api-version=2022-09-01", nil)
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected a 401 without an Authorization header but got %d", response.StatusCode)
+	}
+}
+
+func TestParentResourceId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/12345/resourceGroups/example",
+			Expected: "/subscriptions/12345",
+		},
+		{
+			Input:    "/subscriptions/12345/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account",
+			Expected: "/subscriptions/12345/resourceGroups/example",
+		},
+		{
+			Input:    "/subscriptions/12345/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account/blobServices/default",
+			Expected: "/subscriptions/12345/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account",
+		},
+		{
+			Input:    "/subscriptions/12345/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account/providers/Microsoft.Authorization/roleAssignments/assignment",
+			Expected: "/subscriptions/12345/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/account",
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+		if actual := parentResourceId(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestServerActions(t *testing.T) {
+	server := NewServer(Options{
+		PollsUntilComplete: 1,
+	})
+	defer server.Close()
+
+	server.PutResource(testResourceGroupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+	server.RegisterAction("listKeys", false, func(resource map[string]interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"name": resource["name"],
+		}, nil
+	})
+	server.RegisterAction("restart", true, func(_ map[string]interface{}, input map[string]interface{}) (map[string]interface{}, error) {
+		return input, nil
+	})
+
+	response, body := sendRequest(t, server, http.MethodPost, testResourceGroupId+"/listKeys", "")
+	if response.StatusCode != http.StatusOK || body["name"] != "example" {
+		t.Fatalf("expected a 200 containing the result of the action but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, _ = sendRequest(t, server, http.MethodPost, testResourceGroupId+"/restart", `{"force": true}`)
+	if response.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected a 202 for a long-running action but got %d", response.StatusCode)
+	}
+	location := response.Header.Get("Location")
+	if response, _ = sendRequest(t, server, http.MethodGet, location, ""); response.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected the action to be in progress but got %d", response.StatusCode)
+	}
+	if response, body = sendRequest(t, server, http.MethodGet, location, ""); response.StatusCode != http.StatusOK || body["force"] != true {
+		t.Fatalf("expected the result of the action once the operation completed but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodPost, testResourceGroupId+"/unknown", "")
+	if response.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected a 501 for an unregistered action but got %d: %+v", response.StatusCode, body)
+	}
+
+	response, body = sendRequest(t, server, http.MethodPost, testResourceGroupId+"/providers/Microsoft.Storage/storageAccounts/missing/listKeys", "")
+	if response.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 when the resource doesn't exist but got %d: %+v", response.StatusCode, body)
+	}
+}
+
+func TestServerEnvironment(t *testing.T) {
+	server := NewServer(Options{})
+	defer server.Close()
+
+	env := server.Environment()
+	if env.Authorization.LoginEndpoint != server.URL() {
+		t.Fatalf("expected the login endpoint to be %q but got %q", server.URL(), env.Authorization.LoginEndpoint)
+	}
+
+	v := reflect.ValueOf(env).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		api, ok := v.Field(i).Interface().(environments.Api)
+		if !ok {
+			continue
+		}
+
+		if _, ok := api.DomainSuffix(); ok {
+			continue
+		}
+
+		name := v.Type().Field(i).Name
+		if endpoint, ok := api.Endpoint(); !ok || *endpoint != server.URL() {
+			t.Fatalf("expected the endpoint for %q to be the fake server but got %v", name, endpoint)
+		}
+	}
+}
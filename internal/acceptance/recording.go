@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// the names of the variables stored within a recording, which are used to replay the test using the same values
+const (
+	recordingVariableRandomInteger     = "random_integer"
+	recordingVariableRandomString      = "random_string"
+	recordingVariableRandomSeed        = "random_seed"
+	recordingVariableLocationPrimary   = "location_primary"
+	recordingVariableLocationSecondary = "location_secondary"
+	recordingVariableLocationTernary   = "location_ternary"
+)
+
+var recordingNameRegex = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+var replaySubscriptionOnce sync.Once
+
+// recordingPath returns the path to the recording for the test, relative to the package containing the test
+func recordingPath(t *testing.T) string {
+	return filepath.Join("testdata", "recordings", fmt.Sprintf("%s.json", recordingNameRegex.ReplaceAllString(t.Name(), "_")))
+}
+
+// configureRecording configures the test to record the requests sent to Azure - or, when replaying, uses the
+// values stored within the recording so that the same requests are sent as when the test was recorded
+func (td *TestData) configureRecording(t *testing.T) {
+	replaying := testclient.Replaying()
+	path := recordingPath(t)
+
+	if replaying {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			// failing (rather than skipping) ensures a test which is expected to be replayed can't silently pass
+			t.Fatalf("replaying: no recording exists at %q - the test needs to be recorded using `ARM_TEST_RECORD=true`", path)
+			return
+		}
+
+		// the Subscription ID is required in the Provider block, so the placeholder is used when this isn't set
+		replaySubscriptionOnce.Do(func() {
+			if os.Getenv("ARM_SUBSCRIPTION_ID") == "" {
+				os.Setenv("ARM_SUBSCRIPTION_ID", common.RecordingSubscriptionId)
+			}
+		})
+	}
+
+	recorder, err := common.NewRecorder(path, replaying)
+	if err != nil {
+		t.Fatalf("configuring recording: %+v", err)
+		return
+	}
+
+	var seed int64
+	if replaying {
+		if seed, err = td.loadRecordingVariables(recorder); err != nil {
+			t.Fatalf("loading variables from the recording %q: %+v", path, err)
+			return
+		}
+	} else {
+		seed = time.Now().UnixNano()
+		td.saveRecordingVariables(recorder, seed)
+
+		t.Cleanup(func() {
+			if t.Failed() {
+				t.Logf("[DEBUG] Not saving the recording %q since the test failed", path)
+				return
+			}
+			if err := recorder.Save(); err != nil {
+				t.Errorf("saving recording: %+v", err)
+			}
+		})
+	}
+
+	td.random = rand.New(rand.NewSource(seed)) //nolint:gosec
+	td.recorder = recorder
+
+	// requests sent using the shared test client (e.g. to check a resource exists) are routed to the recording for
+	// this test using the random values, since these are used within the names of the resources for the test
+	testclient.Recorders().Add(recorder, strconv.Itoa(td.RandomInteger), td.RandomString)
+	t.Cleanup(func() {
+		testclient.Recorders().Remove(recorder)
+	})
+}
+
+func (td *TestData) saveRecordingVariables(recorder *common.Recorder, seed int64) {
+	recorder.SetVariable(recordingVariableRandomInteger, strconv.Itoa(td.RandomInteger))
+	recorder.SetVariable(recordingVariableRandomString, td.RandomString)
+	recorder.SetVariable(recordingVariableRandomSeed, strconv.FormatInt(seed, 10))
+	recorder.SetVariable(recordingVariableLocationPrimary, td.Locations.Primary)
+	recorder.SetVariable(recordingVariableLocationSecondary, td.Locations.Secondary)
+	recorder.SetVariable(recordingVariableLocationTernary, td.Locations.Ternary)
+
+	recorder.AddReplacement(td.Subscriptions.Primary, common.RecordingSubscriptionId)
+	recorder.AddReplacement(td.Subscriptions.Secondary, common.RecordingSecondarySubscriptionId)
+}
+
+func (td *TestData) loadRecordingVariables(recorder *common.Recorder) (int64, error) {
+	variable := func(name string) (string, error) {
+		v, ok := recorder.Variable(name)
+		if !ok {
+			return "", fmt.Errorf("the variable %q was not found", name)
+		}
+		return v, nil
+	}
+
+	randomInteger, err := variable(recordingVariableRandomInteger)
+	if err != nil {
+		return 0, err
+	}
+	if td.RandomInteger, err = strconv.Atoi(randomInteger); err != nil {
+		return 0, fmt.Errorf("parsing %q: %+v", recordingVariableRandomInteger, err)
+	}
+
+	if td.RandomString, err = variable(recordingVariableRandomString); err != nil {
+		return 0, err
+	}
+
+	seed, err := variable(recordingVariableRandomSeed)
+	if err != nil {
+		return 0, err
+	}
+	parsedSeed, err := strconv.ParseInt(seed, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing %q: %+v", recordingVariableRandomSeed, err)
+	}
+
+	td.Locations = Regions{}
+	td.Locations.Primary, _ = recorder.Variable(recordingVariableLocationPrimary)
+	td.Locations.Secondary, _ = recorder.Variable(recordingVariableLocationSecondary)
+	td.Locations.Ternary, _ = recorder.Variable(recordingVariableLocationTernary)
+
+	td.Subscriptions = Subscriptions{
+		Primary:   common.RecordingSubscriptionId,
+		Secondary: common.RecordingSecondarySubscriptionId,
+	}
+
+	return parsedSeed, nil
+}
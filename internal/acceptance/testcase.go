@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
@@ -42,6 +44,22 @@ func (td TestData) DataSourceTestInSequence(t *testing.T, steps []TestStep) {
 }
 
 func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, steps []TestStep) {
+	testCase := td.resourceTestCase(t, testResource, steps)
+	td.runAcceptanceTest(t, testCase)
+}
+
+// ResourceTestWithMinimumTerraformVersion runs the specified steps in the same manner as ResourceTest, but skips
+// the test when the version of Terraform is older than minimumVersion - for example where the configuration
+// uses write-only arguments, which are only supported in Terraform 1.11 and later.
+func (td TestData) ResourceTestWithMinimumTerraformVersion(t *testing.T, testResource types.TestResource, minimumVersion string, steps []TestStep) {
+	testCase := td.resourceTestCase(t, testResource, steps)
+	testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(version.Must(version.NewVersion(minimumVersion))),
+	}
+	td.runAcceptanceTest(t, testCase)
+}
+
+func (td TestData) resourceTestCase(t *testing.T, testResource types.TestResource, steps []TestStep) resource.TestCase {
 	// Testing framework as of 1.6.0 no longer auto-refreshes state, so adding it back in here for all steps that update
 	// the config rather than having to modify 1000's of tests individually to add a refresh-only step
 	var refreshStep = TestStep{
@@ -59,7 +77,7 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 	}
 	steps = newSteps
 
-	testCase := resource.TestCase{
+	return resource.TestCase{
 		PreCheck: func() { PreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
 			client, err := testclient.Build()
@@ -70,7 +88,6 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 		},
 		Steps: steps,
 	}
-	td.runAcceptanceTest(t, testCase)
 }
 
 // ResourceTestIgnoreCheckDestroyed skips the check to confirm the resource test has been destroyed.
@@ -138,13 +155,20 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 }
 
 func (td TestData) providers() map[string]func() (*schema.Provider, error) {
+	testProvider := provider.TestAzureProvider
+	if td.recorder != nil {
+		testProvider = func() *schema.Provider {
+			return provider.TestAzureProviderWithRecorder(td.recorder)
+		}
+	}
+
 	return map[string]func() (*schema.Provider, error){
 		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := testProvider()
 			return azurerm, nil
 		},
 		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := testProvider()
 			return azurerm, nil
 		},
 	}
@@ -72,6 +72,11 @@ func Build() (*clients.Client, error) {
 			SubscriptionID:    os.Getenv("ARM_SUBSCRIPTION_ID"),
 		}
 
+		// this is only set when recording or replaying, since a nil *common.RecorderSet isn't a nil RequestRecorder
+		if recorders := Recorders(); recorders != nil {
+			clientBuilder.Recorder = recorders
+		}
+
 		client, err := clients.Build(ctx, clientBuilder)
 		if err != nil {
 			return nil, fmt.Errorf("building test client: %+v", err)
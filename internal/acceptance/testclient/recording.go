@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testclient
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const (
+	// EnvTestRecord is the Environment Variable used to record the requests sent to Azure during acceptance tests
+	EnvTestRecord = "ARM_TEST_RECORD"
+
+	// EnvTestReplay is the Environment Variable used to replay the recorded requests during acceptance tests,
+	// rather than sending requests to Azure
+	EnvTestReplay = "ARM_TEST_REPLAY"
+)
+
+var recorders = newRecorderSet()
+
+// Recording returns whether requests sent to Azure during acceptance tests are being recorded
+func Recording() bool {
+	return !Replaying() && envEnabled(EnvTestRecord)
+}
+
+// Replaying returns whether acceptance tests are replaying recorded requests, rather than sending them to Azure
+func Replaying() bool {
+	return envEnabled(EnvTestReplay)
+}
+
+// Recorders returns the set of Recorders for the acceptance tests which are running, which requests sent using
+// the shared test client are routed to - or nil when requests aren't being recorded or replayed
+func Recorders() *common.RecorderSet {
+	return recorders
+}
+
+func newRecorderSet() *common.RecorderSet {
+	if Replaying() || Recording() {
+		return common.NewRecorderSet(Replaying())
+	}
+	return nil
+}
+
+func envEnabled(name string) bool {
+	v, err := strconv.ParseBool(os.Getenv(name))
+	return err == nil && v
+}
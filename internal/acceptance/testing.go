@@ -13,9 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 )
 
 func PreCheck(t *testing.T) {
+	// credentials aren't required when replaying recorded requests
+	if testclient.Replaying() {
+		return
+	}
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
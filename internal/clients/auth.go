@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/claims"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
@@ -142,3 +143,23 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 
 	return &account, nil
 }
+
+// recordingResourceManagerAccount returns the ResourceManagerAccount used when replaying recorded requests, which
+// uses the placeholders for the identifiers which are substituted when requests are recorded
+func recordingResourceManagerAccount(config auth.Credentials, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) *ResourceManagerAccount {
+	if subscriptionId == "" {
+		subscriptionId = common.RecordingSubscriptionId
+	}
+
+	return &ResourceManagerAccount{
+		Environment: config.Environment,
+
+		ClientId:       common.RecordingClientId,
+		ObjectId:       common.RecordingObjectId,
+		SubscriptionId: subscriptionId,
+		TenantId:       common.RecordingTenantId,
+
+		AuthenticatedAsAServicePrincipal: true,
+		RegisteredResourceProviders:      registeredResourceProviders,
+	}
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
@@ -24,14 +26,22 @@ type ClientBuilder struct {
 	Features   features.UserFeatures
 
 	CustomCorrelationRequestID  string
+	DefaultTags                 map[string]string
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
+	IgnoredTagKeys              []string
+	IgnoredTagKeyPrefixes       []string
+	MaxConcurrentWriteRequests  int
+	MetadataCache               *metadatacache.Cache
 	MetadataHost                string
 	PartnerID                   string
 	RegisteredResourceProviders resourceproviders.ResourceProviders
+	Recorder                    common.RequestRecorder
+	Retry                       *common.RetryOptions
 	StorageUseAzureAD           bool
 	SubscriptionID              string
 	TerraformVersion            string
+	UseResourceGraphForRefresh  bool
 }
 
 const azureStackEnvironmentError = `
@@ -51,25 +61,35 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf(azureStackEnvironmentError)
 	}
 
+	replaying := builder.Recorder != nil && builder.Recorder.Replaying()
+
+	// when replaying recorded requests there's no need to authenticate, so a static token is used instead
+	newAuthorizer := func(api environments.Api) (auth.Authorizer, error) {
+		if replaying {
+			return common.RecordingAuthorizer(), nil
+		}
+		return auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+	}
+
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -78,7 +98,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -88,7 +108,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -96,14 +116,26 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
-	if err != nil {
-		return nil, fmt.Errorf("building account: %+v", err)
+	var account *ResourceManagerAccount
+	if replaying {
+		account = recordingResourceManagerAccount(*builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	} else {
+		account, err = NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+		if err != nil {
+			return nil, fmt.Errorf("building account: %+v", err)
+		}
+	}
+
+	if builder.Recorder != nil {
+		builder.Recorder.AddReplacement(account.SubscriptionId, common.RecordingSubscriptionId)
+		builder.Recorder.AddReplacement(account.TenantId, common.RecordingTenantId)
+		builder.Recorder.AddReplacement(account.ClientId, common.RecordingClientId)
+		builder.Recorder.AddReplacement(account.ObjectId, common.RecordingObjectId)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -118,8 +150,16 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	client := Client{
 		Account: account,
+		Tags:    tags.NewProviderConfig(builder.DefaultTags, builder.IgnoredTagKeys, builder.IgnoredTagKeyPrefixes),
 	}
 
+	// recorded requests must include the metadata lookups, so the metadata cache isn't used when recording or replaying
+	metadataCache := builder.MetadataCache
+	if builder.Recorder != nil {
+		metadataCache = nil
+	}
+	client.ResourceProvidersMetadataCache = resourceproviders.NewMetadataCache(metadataCache, account.TenantId)
+
 	o := &common.ClientOptions{
 		Authorizers: &common.Authorizers{
 			BatchManagement: batchManagementAuth,
@@ -151,8 +191,25 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		SkipProviderReg:             len(builder.RegisteredResourceProviders) == 0,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		UseResourceGraphForRefresh:  builder.UseResourceGraphForRefresh,
 
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
+
+		MetadataCache: metadataCache,
+		Recorder:      builder.Recorder,
+		Retry:         builder.Retry,
+	}
+
+	if client.ResourceProvidersMetadataCache != nil {
+		// the persisted Resource Providers are stale once a request fails since a Resource Provider isn't registered
+		subscriptionId := commonids.NewSubscriptionID(account.SubscriptionId)
+		o.NotRegisteredListener = func() {
+			client.ResourceProvidersMetadataCache.Invalidate(subscriptionId)
+		}
+	}
+
+	if builder.MaxConcurrentWriteRequests > 0 {
+		o.WriteConcurrencyLimiter = common.NewWriteConcurrencyLimiter(builder.MaxConcurrentWriteRequests)
 	}
 
 	if err := client.Build(ctx, o); err != nil {
@@ -165,10 +222,15 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		ctx2, cancel := context.WithTimeout(ctx, 10*time.Minute)
 		defer cancel()
 
-		location.CacheSupportedLocations(ctx2, *resourceManagerEndpoint)
-		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId); err != nil {
+		// the supported locations are retrieved without using the SDK clients, so can't be replayed
+		if !replaying {
+			location.CacheSupportedLocations(ctx2, *resourceManagerEndpoint)
+		}
+		locations, err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, client.ResourceProvidersMetadataCache)
+		if err != nil {
 			log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		}
+		client.ResourceTypeLocations = locations
 	}
 
 	return &client, nil
@@ -26,6 +26,8 @@ import (
 	workloads_v2023_04_01 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourcegraph"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
 	advisor "github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/client"
 	analysisServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/client"
@@ -152,6 +154,7 @@ import (
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 )
 
 type Client struct {
@@ -163,6 +166,21 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// Tags contains the `default_tags` and `ignore_tags` configured for this instance of the Provider
+	Tags *tags.ProviderConfig
+
+	// ResourceGraph optionally serves the Resource Manager representation of resources from a snapshot retrieved
+	// from Azure Resource Graph, which is nil unless `use_resource_graph_for_refresh` is enabled
+	ResourceGraph *resourcegraph.Snapshot
+
+	// ResourceProvidersMetadataCache optionally persists the Resource Providers for this instance of the Provider
+	// between runs, which is nil unless the metadata cache is enabled
+	ResourceProvidersMetadataCache *resourceproviders.MetadataCache
+
+	// ResourceTypeLocations contains the locations where each Resource Type is available within the Subscription, which
+	// is nil unless Enhanced Validation is enabled
+	ResourceTypeLocations resourceproviders.ResourceTypeLocations
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -298,6 +316,16 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	// Disable the Azure SDK for Go's validation since it's unhelpful for our use-case
 	validation.Disabled = true
 
+	// the snapshot is notified of every write request, so must be configured prior to building the other clients
+	if o.UseResourceGraphForRefresh {
+		snapshot, err := resourcegraph.NewSnapshot(o)
+		if err != nil {
+			return fmt.Errorf("building Resource Graph snapshot: %+v", err)
+		}
+		client.ResourceGraph = snapshot
+		o.WriteListener = snapshot.Invalidate
+	}
+
 	if err := buildAutoClients(&client.autoClient, o); err != nil {
 		return fmt.Errorf("building auto-clients: %+v", err)
 	}
@@ -676,3 +704,14 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	return nil
 }
+
+// DefaultSubscriptionId returns the Subscription ID which is configured in the Provider
+func (client *Client) DefaultSubscriptionId() string {
+	return client.Account.SubscriptionId
+}
+
+// ValidateLocationForResourceType validates the location against the locations where the Resource Type is available
+// within the Subscription configured for this instance of the Provider
+func (client *Client) ValidateLocationForResourceType(resourceType, location string) error {
+	return client.ResourceTypeLocations.Validate(resourceType, location)
+}
@@ -9,11 +9,11 @@ import (
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
 	"github.com/hashicorp/terraform-provider-azurerm/version"
 )
 
@@ -50,6 +50,30 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// Retry optionally configures retries for throttled and failed requests, in addition to those performed by the SDKs
+	Retry *RetryOptions
+
+	// WriteConcurrencyLimiter optionally caps the number of concurrent write requests per Subscription and Resource Provider
+	WriteConcurrencyLimiter *WriteConcurrencyLimiter
+
+	// UseResourceGraphForRefresh specifies whether resources which support it are read from a snapshot of the
+	// resources retrieved from Azure Resource Graph, rather than from Resource Manager
+	UseResourceGraphForRefresh bool
+
+	// WriteListener is optionally notified of the path of each write request (PUT, PATCH, POST and DELETE) sent to
+	// Azure Resource Manager
+	WriteListener func(path string)
+
+	// NotRegisteredListener is optionally notified when a request fails since the Resource Provider isn't registered
+	// within the Subscription
+	NotRegisteredListener func()
+
+	// MetadataCache optionally persists metadata (such as the Data Plane URIs of Key Vaults) between runs of the Provider
+	MetadataCache *metadatacache.Cache
+
+	// Recorder optionally records requests sent to Azure, or replays recorded responses, which is used for acceptance tests
+	Recorder RequestRecorder
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -74,8 +98,27 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
+	if o.Retry != nil {
+		c.AppendRequestMiddleware(o.Retry.requestMiddleware())
+		c.AppendResponseMiddleware(o.Retry.responseMiddleware())
+	}
+
+	if o.WriteConcurrencyLimiter != nil {
+		c.AppendRequestMiddleware(o.WriteConcurrencyLimiter.requestMiddleware())
+	}
+
+	if o.WriteListener != nil {
+		c.AppendRequestMiddleware(writeListenerMiddleware(o.WriteListener))
+	}
+
+	if o.NotRegisteredListener != nil {
+		c.AppendResponseMiddleware(notRegisteredListenerMiddleware(o.NotRegisteredListener))
+	}
+
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+
+	ConfigureRecording(c, o.Recorder)
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,7 +126,19 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = buildSender("AzureRM", o.Recorder)
+	if o.Retry != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.Retry.sendDecorator())
+	}
+	if o.WriteConcurrencyLimiter != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, o.WriteConcurrencyLimiter.sendDecorator())
+	}
+	if o.WriteListener != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, writeListenerSendDecorator(o.WriteListener))
+	}
+	if o.NotRegisteredListener != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, notRegisteredListenerSendDecorator(o.NotRegisteredListener))
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// WriteConcurrencyLimiter caps the number of write requests (PUT, PATCH, POST and DELETE) to Azure Resource Manager
+// which can be in-flight at once for each Subscription and Resource Provider namespace (e.g. `Microsoft.Network`),
+// to avoid exhausting the write quotas for a Resource Provider when many resources are provisioned in parallel
+type WriteConcurrencyLimiter struct {
+	limit int
+
+	lock       sync.Mutex
+	semaphores map[string]chan struct{}
+
+	// waits and totalWait track how many requests have waited for a slot and the total time spent waiting
+	waits     int64
+	totalWait time.Duration
+}
+
+func NewWriteConcurrencyLimiter(limit int) *WriteConcurrencyLimiter {
+	return &WriteConcurrencyLimiter{
+		limit:      limit,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+// acquire blocks until a slot is available for the write request identified by key, returning a func to release the slot
+func (l *WriteConcurrencyLimiter) acquire(ctx context.Context, key string, method string, url string) (func(), error) {
+	semaphore := l.semaphore(key)
+
+	start := time.Now()
+	select {
+	case semaphore <- struct{}{}:
+	default:
+		// only log when the request has to wait, to avoid noise in the logs for the majority of requests
+		log.Printf("[DEBUG] Waiting for one of %d concurrent write slots for %q (%s %s)", l.limit, key, method, url)
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for a concurrent write slot for %q: %+v", key, ctx.Err())
+		}
+
+		waited := time.Since(start)
+		l.lock.Lock()
+		l.waits++
+		l.totalWait += waited
+		waits, totalWait := l.waits, l.totalWait
+		l.lock.Unlock()
+		log.Printf("[DEBUG] Waited %s for a concurrent write slot for %q (%s %s) - %d requests have waited a total of %s", waited, key, method, url, waits, totalWait)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-semaphore
+		})
+	}, nil
+}
+
+func (l *WriteConcurrencyLimiter) semaphore(key string) chan struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	semaphore, ok := l.semaphores[key]
+	if !ok {
+		semaphore = make(chan struct{}, l.limit)
+		l.semaphores[key] = semaphore
+	}
+	return semaphore
+}
+
+// requestMiddleware holds a slot whilst each attempt to send a write request is in-flight. The go-azure-sdk base
+// client retries requests using a transport which can't be configured, so the slot is acquired and released using
+// the hooks the transport calls for each attempt - rather than being held whilst the request is retried
+func (l *WriteConcurrencyLimiter) requestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		key, ok := writeConcurrencyKey(request)
+		if !ok {
+			return request, nil
+		}
+
+		slot := &writeSlot{
+			limiter: l,
+			key:     key,
+			method:  request.Method,
+			url:     request.URL.String(),
+		}
+		ctx := httptrace.WithClientTrace(request.Context(), slot.clientTrace())
+		slot.ctx = ctx
+
+		// an attempt which fails without a response may not call any further hooks, in which case the slot is
+		// reused by the next attempt - or released once the context for the request is done
+		context.AfterFunc(ctx, slot.release)
+
+		return request.WithContext(ctx), nil
+	}
+}
+
+// sendDecorator returns an autorest.SendDecorator which holds a slot whilst each attempt to send a write request
+// is in-flight, since the go-autorest retries are applied on top of the Sender being decorated
+func (l *WriteConcurrencyLimiter) sendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			key, ok := writeConcurrencyKey(request)
+			if !ok {
+				return s.Do(request)
+			}
+
+			release, err := l.acquire(request.Context(), key, request.Method, request.URL.String())
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+
+			return s.Do(request)
+		})
+	}
+}
+
+// writeSlot is the slot held by a single write request sent by a go-azure-sdk client
+type writeSlot struct {
+	ctx     context.Context
+	limiter *WriteConcurrencyLimiter
+	key     string
+	method  string
+	url     string
+
+	lock        sync.Mutex
+	releaseFunc func()
+}
+
+func (s *writeSlot) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		// GetConn is called (synchronously) at the start of each attempt to send the request
+		GetConn: func(_ string) {
+			s.acquire()
+		},
+		GotFirstResponseByte: s.release,
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			if info.Err != nil {
+				s.release()
+			}
+		},
+	}
+}
+
+func (s *writeSlot) acquire() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// the slot is still held when the previous attempt failed without a response
+	if s.releaseFunc != nil {
+		return
+	}
+
+	release, err := s.limiter.acquire(s.ctx, s.key, s.method, s.url)
+	if err != nil {
+		// the transport fails the attempt, since the context for the request is done
+		log.Printf("[DEBUG] %+v", err)
+		return
+	}
+	s.releaseFunc = release
+}
+
+func (s *writeSlot) release() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.releaseFunc != nil {
+		s.releaseFunc()
+		s.releaseFunc = nil
+	}
+}
+
+// writeConcurrencyKey returns the Subscription ID and Resource Provider namespace for write requests to Azure
+// Resource Manager, using the last `providers` segment since this is the Resource Provider handling the request
+func writeConcurrencyKey(request *http.Request) (string, bool) {
+	if !isWriteRequest(request) {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return "", false
+	}
+
+	// requests for Subscriptions and Resource Groups themselves are handled by `Microsoft.Resources`
+	namespace := "microsoft.resources"
+	for i := len(segments) - 2; i >= 2; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			namespace = strings.ToLower(segments[i+1])
+			break
+		}
+	}
+
+	return fmt.Sprintf("%s/%s", strings.ToLower(segments[1]), namespace), true
+}
+
+// isWriteRequest returns whether the request (potentially) modifies a resource
+func isWriteRequest(request *http.Request) bool {
+	switch request.Method {
+	case http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWriteConcurrencyKey(t *testing.T) {
+	testData := []struct {
+		Method   string
+		URL      string
+		Expected string
+		Limited  bool
+	}{
+		{
+			Method:   http.MethodPut,
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example?api-version=2023-09-01",
+			Expected: "11111111-1111-1111-1111-111111111111/microsoft.network",
+			Limited:  true,
+		},
+		{
+			Method:   http.MethodDelete,
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/providers/Microsoft.Authorization/locks/example",
+			Expected: "11111111-1111-1111-1111-111111111111/microsoft.authorization",
+			Limited:  true,
+		},
+		{
+			Method:   http.MethodPut,
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			Expected: "11111111-1111-1111-1111-111111111111/microsoft.resources",
+			Limited:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			URL:     "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Limited: false,
+		},
+		{
+			Method:  http.MethodPut,
+			URL:     "https://example.blob.core.windows.net/container/blob",
+			Limited: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %s", v.Method, v.URL)
+		req, _ := http.NewRequest(v.Method, v.URL, nil)
+		actual, limited := writeConcurrencyKey(req)
+		if limited != v.Limited {
+			t.Fatalf("expected limited to be %t but got %t", v.Limited, limited)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestWriteConcurrencyLimiterSendDecorator(t *testing.T) {
+	var inFlight, maxInFlight int32
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	limiter := NewWriteConcurrencyLimiter(2)
+	decorated := autorest.DecorateSender(sender, limiter.sendDecorator())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example", nil)
+			if _, err := decorated.Do(req); err != nil {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in-flight but got %d", maxInFlight)
+	}
+	if limiter.waits == 0 {
+		t.Fatal("expected requests to have waited for a slot")
+	}
+}
+
+func TestWriteConcurrencyLimiterMiddleware(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := NewWriteConcurrencyLimiter(2)
+	middleware := limiter.requestMiddleware()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example", nil)
+			req, err := middleware(req)
+			if err != nil {
+				t.Errorf("unexpected error: %+v", err)
+				return
+			}
+			resp, err := server.Client().Do(req)
+			if err != nil {
+				t.Errorf("unexpected error: %+v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in-flight but got %d", maxInFlight)
+	}
+	if len(limiter.semaphore("11111111-1111-1111-1111-111111111111/microsoft.network")) != 0 {
+		t.Fatal("expected all of the slots to be released")
+	}
+}
+
+func TestWriteConcurrencyLimiterMiddlewareFailedAttempts(t *testing.T) {
+	limiter := NewWriteConcurrencyLimiter(1)
+	url := "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example"
+	semaphore := limiter.semaphore("11111111-1111-1111-1111-111111111111/microsoft.storage")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPut, url, nil)
+	req, err := limiter.requestMiddleware()(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	trace := httptrace.ContextClientTrace(req.Context())
+
+	// the first attempt fails without a response, so the slot is reused by the next attempt rather than deadlocking
+	trace.GetConn("management.azure.com:443")
+	trace.GetConn("management.azure.com:443")
+	trace.GotFirstResponseByte()
+	if len(semaphore) != 0 {
+		t.Fatal("expected the slot to be released once a response was received")
+	}
+
+	// the slot is released when writing the request fails
+	trace.GetConn("management.azure.com:443")
+	trace.WroteRequest(httptrace.WroteRequestInfo{Err: errors.New("connection reset")})
+	if len(semaphore) != 0 {
+		t.Fatal("expected the slot to be released once writing the request failed")
+	}
+
+	// and once the context is done, when the final attempt fails without a response
+	trace.GetConn("management.azure.com:443")
+	cancel()
+	for i := 0; len(semaphore) != 0; i++ {
+		if i == 100 {
+			t.Fatal("expected the slot to be released once the context was done")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
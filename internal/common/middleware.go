@@ -4,13 +4,37 @@
 package common
 
 import (
-	"log"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
 	"net/http"
-	"net/http/httputil"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 )
 
+const (
+	// fieldHttpRequestUrl is the field key for the (redacted) URL of the request, including the host since requests
+	// are sent to both Resource Manager and the data plane APIs
+	fieldHttpRequestUrl = "tf_http_req_url"
+
+	// fieldHttpResponseLatency is the field key for the time taken to receive the response, in milliseconds
+	fieldHttpResponseLatency = "tf_http_res_latency_ms"
+
+	// fieldHttpCorrelationRequestId is the field key for the `x-ms-correlation-request-id` sent with the request
+	fieldHttpCorrelationRequestId = "tf_http_correlation_request_id"
+
+	// fieldHttpResponseRequestId is the field key for the `x-ms-request-id` returned by the API
+	fieldHttpResponseRequestId = "tf_http_res_request_id"
+)
+
+type requestStartTimeKey struct{}
+
 func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
 		// ensure the `X-Correlation-ID` field is set
@@ -21,39 +45,171 @@ func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 
 func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// strip the authorization header prior to printing
-		authHeaderName := "Authorization"
-		auth := request.Header.Get(authHeaderName)
-		if auth != "" {
-			request.Header.Del(authHeaderName)
-		}
+		ctx := withTransactionId(request.Context())
+		logRequest(ctx, providerName, request)
 
-		// dump request to wire format
-		if dump, err := httputil.DumpRequestOut(request, true); err == nil {
-			log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, request.URL)
-		}
+		ctx = context.WithValue(ctx, requestStartTimeKey{}, time.Now())
+		return request.WithContext(ctx), nil
+	}
+}
 
-		// add the auth header back
-		if auth != "" {
-			request.Header.Add(authHeaderName, auth)
+func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		var latency time.Duration
+		if start, ok := request.Context().Value(requestStartTimeKey{}).(time.Time); ok {
+			latency = time.Since(start)
 		}
 
-		return request, nil
+		logResponse(request.Context(), providerName, request, response, latency)
+		return response, nil
 	}
 }
 
-func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
-	return func(request *http.Request, response *http.Response) (*http.Response, error) {
-		// dump response to wire format
-		if dump, err2 := httputil.DumpResponse(response, true); err2 == nil {
-			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, request.URL, dump)
+// withRequestLogging returns an autorest.SendDecorator which logs requests sent using go-autorest, in the same
+// format as requests sent using go-azure-sdk
+func withRequestLogging(providerName string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			ctx := withTransactionId(request.Context())
+			request = request.WithContext(ctx)
+			logRequest(ctx, providerName, request)
+
+			start := time.Now()
+			response, err := s.Do(request)
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("%s Response Error", providerName), map[string]interface{}{
+					logging.FieldHttpOperationType: logging.OperationHttpResponse,
+					fieldHttpRequestUrl:            redactURL(request.URL),
+					fieldHttpResponseLatency:       time.Since(start).Milliseconds(),
+					"error":                        err.Error(),
+				})
+				return response, err
+			}
+			if response != nil {
+				logResponse(ctx, providerName, request, response, time.Since(start))
+			}
+			return response, err
+		})
+	}
+}
+
+// buildSender returns the autorest.Sender used for clients using go-autorest, which records or replays requests
+// when a RequestRecorder is specified
+func buildSender(providerName string, recorder RequestRecorder) autorest.Sender {
+	if recorder != nil {
+		return autorest.DecorateSender(recordingSender(recorder), withRequestLogging(providerName))
+	}
+
+	return autorest.DecorateSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}, withRequestLogging(providerName))
+}
+
+func withTransactionId(ctx context.Context) context.Context {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		id = fmt.Sprintf("Unable to assign Transaction ID: %+v", err)
+	}
+	return tflog.SetField(ctx, logging.FieldHttpTransactionId, id)
+}
+
+// logRequest logs the request with any secrets within the URL, headers and body redacted
+func logRequest(ctx context.Context, providerName string, request *http.Request) {
+	fields := headerFields(request.Header)
+	fields[logging.FieldHttpOperationType] = logging.OperationHttpRequest
+	fields[logging.FieldHttpRequestMethod] = request.Method
+	fields[logging.FieldHttpRequestUri] = request.URL.Path
+	fields[fieldHttpRequestUrl] = redactURL(request.URL)
+	fields[fieldHttpCorrelationRequestId] = request.Header.Get(HeaderCorrelationRequestID)
+
+	body, err := readRequestBody(request)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("%s Request: unable to read the request body: %+v", providerName, err), fields)
+		return
+	}
+	fields[logging.FieldHttpRequestBody] = string(redactBody(body, request.URL))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s Request", providerName), fields)
+}
+
+// logResponse logs the response with any secrets within the headers and body redacted
+func logResponse(ctx context.Context, providerName string, request *http.Request, response *http.Response, latency time.Duration) {
+	if response == nil {
+		return
+	}
+
+	fields := headerFields(response.Header)
+	fields[logging.FieldHttpOperationType] = logging.OperationHttpResponse
+	fields[logging.FieldHttpRequestMethod] = request.Method
+	fields[logging.FieldHttpRequestUri] = request.URL.Path
+	fields[fieldHttpRequestUrl] = redactURL(request.URL)
+	fields[logging.FieldHttpResponseStatusCode] = response.StatusCode
+	fields[logging.FieldHttpResponseStatusReason] = response.Status
+	fields[fieldHttpResponseLatency] = latency.Milliseconds()
+	fields[fieldHttpCorrelationRequestId] = request.Header.Get(HeaderCorrelationRequestID)
+	if v := response.Header.Get(HeaderCorrelationRequestID); v != "" {
+		fields[fieldHttpCorrelationRequestId] = v
+	}
+	if v := response.Header.Get("x-ms-request-id"); v != "" {
+		fields[fieldHttpResponseRequestId] = v
+	}
+
+	body, err := readResponseBody(response)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("%s Response: unable to read the response body: %+v", providerName, err), fields)
+		return
+	}
+	fields[logging.FieldHttpResponseBody] = string(redactBody(body, request.URL))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s Response", providerName), fields)
+}
+
+func headerFields(headers http.Header) map[string]interface{} {
+	redacted := redactHeaders(headers)
+	fields := make(map[string]interface{}, len(redacted)+8)
+	for k, v := range redacted {
+		if len(v) == 1 {
+			fields[k] = v[0]
 		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, request.URL)
+			fields[k] = v
 		}
-		return response, nil
 	}
+	return fields
+}
+
+// readRequestBody returns the body of the request, replacing it so that it can be read again when sent
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = request.Body.Close()
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return body, nil
+}
+
+// readResponseBody returns the body of the response, replacing it so that it can be read again by the caller
+func readResponseBody(response *http.Response) ([]byte, error) {
+	if response.Body == nil || response.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	_ = response.Body.Close()
+
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
 }
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestLoggerMiddlewarePreservesBodies(t *testing.T) {
+	requestBody := `{"properties":{"administratorLoginPassword":"secret"}}`
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Sql/servers/example", bytes.NewBufferString(requestBody))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	request, err = requestLoggerMiddleware("AzureRM")(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if _, ok := request.Context().Value(requestStartTimeKey{}).(time.Time); !ok {
+		t.Fatal("expected the start time to be set in the request context")
+	}
+
+	actualRequestBody, _ := io.ReadAll(request.Body)
+	if string(actualRequestBody) != requestBody {
+		t.Fatalf("expected the request body to be %q but got %q", requestBody, actualRequestBody)
+	}
+
+	responseBody := `{"properties":{"fullyQualifiedDomainName":"example.database.windows.net"}}`
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Header:     http.Header{"X-Ms-Request-Id": []string{"22222222-2222-2222-2222-222222222222"}},
+		Body:       io.NopCloser(bytes.NewBufferString(responseBody)),
+	}
+
+	response, err = responseLoggerMiddleware("AzureRM")(request, response)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	actualResponseBody, _ := io.ReadAll(response.Body)
+	if string(actualResponseBody) != responseBody {
+		t.Fatalf("expected the response body to be %q but got %q", responseBody, actualResponseBody)
+	}
+}
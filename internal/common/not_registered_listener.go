@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// notRegisteredListenerMiddleware notifies the listener when a request fails since the Resource Provider isn't
+// registered within the Subscription
+func notRegisteredListenerMiddleware(listener func()) client.ResponseMiddleware {
+	return func(_ *http.Request, response *http.Response) (*http.Response, error) {
+		if isResourceProviderNotRegistered(response) {
+			listener()
+		}
+		return response, nil
+	}
+}
+
+// notRegisteredListenerSendDecorator returns an autorest.SendDecorator which notifies the listener when a request
+// fails since the Resource Provider isn't registered within the Subscription
+func notRegisteredListenerSendDecorator(listener func()) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			response, err := s.Do(request)
+			if isResourceProviderNotRegistered(response) {
+				listener()
+			}
+			return response, err
+		})
+	}
+}
+
+// isResourceProviderNotRegistered returns whether the response is a `409 Conflict` with the error code
+// `MissingSubscriptionRegistration` - the body is buffered so that it can still be read by the caller
+func isResourceProviderNotRegistered(response *http.Response) bool {
+	if response == nil || response.StatusCode != http.StatusConflict || response.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(body), "MissingSubscriptionRegistration")
+}
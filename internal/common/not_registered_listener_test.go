@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestNotRegisteredListenerSendDecorator(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		body       string
+		expected   bool
+	}{
+		{
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.StorageCache'."}}`,
+			expected:   true,
+		},
+		{
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"Conflict","message":"Another operation is in progress."}}`,
+			expected:   false,
+		},
+		{
+			statusCode: http.StatusOK,
+			body:       `{}`,
+			expected:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %d %s..", testCase.statusCode, testCase.body)
+
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: testCase.statusCode,
+				Body:       io.NopCloser(strings.NewReader(testCase.body)),
+			}, nil
+		})
+
+		notified := false
+		decorated := autorest.DecorateSender(sender, notRegisteredListenerSendDecorator(func() {
+			notified = true
+		}))
+
+		req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.StorageCache/caches/example", nil)
+		resp, err := decorated.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if notified != testCase.expected {
+			t.Fatalf("expected the listener to be notified to be %t but got %t", testCase.expected, notified)
+		}
+
+		// the body must still be readable by the caller
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading body: %+v", err)
+		}
+		if string(body) != testCase.body {
+			t.Fatalf("expected the body %q but got %q", testCase.body, string(body))
+		}
+	}
+}
@@ -0,0 +1,629 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-uuid"
+	"golang.org/x/oauth2"
+)
+
+// The placeholders used in place of the identifiers for the Subscription and the authenticated principal within
+// recordings, which are also used as the identifiers when replaying requests
+const (
+	RecordingSubscriptionId          = "00000000-0000-0000-0000-000000000001"
+	RecordingSecondarySubscriptionId = "00000000-0000-0000-0000-000000000002"
+	RecordingTenantId                = "00000000-0000-0000-0000-000000000003"
+	RecordingClientId                = "00000000-0000-0000-0000-000000000004"
+	RecordingObjectId                = "00000000-0000-0000-0000-000000000005"
+)
+
+const (
+	// recordingSecretValue is recorded in place of secrets, which is valid base64 since some secrets (such as the
+	// key for a Storage Account) are decoded when replaying requests
+	recordingSecretValue = "cmVjb3JkZWQtc2VjcmV0"
+
+	// recordingIdHeader and recordingUpstreamHeader are used to pass the Recorder and the original scheme and host
+	// for requests sent by go-azure-sdk clients to the local recording server
+	recordingIdHeader       = "X-Azurerm-Recording-Id"
+	recordingUpstreamHeader = "X-Azurerm-Recording-Upstream"
+)
+
+// RequestRecorder records requests sent to Azure (and the responses) so that these can be replayed later, or
+// replays previously recorded responses without sending requests to Azure
+type RequestRecorder interface {
+	// AddReplacement replaces all occurrences of value with placeholder within the recorded requests and responses
+	AddReplacement(value, placeholder string)
+
+	// Replaying returns whether responses are replayed from a recording, rather than by sending requests to Azure
+	Replaying() bool
+
+	roundTrip(request *http.Request) (*http.Response, error)
+}
+
+var (
+	_ RequestRecorder = &Recorder{}
+	_ RequestRecorder = &RecorderSet{}
+)
+
+// Recorder records the requests sent during a single test into a recording on disk, or replays the responses
+// from an existing recording
+type Recorder struct {
+	path      string
+	replaying bool
+
+	lock         sync.Mutex
+	recording    recording
+	replacements map[string]string
+
+	// replayed is the number of interactions which have been replayed for each request
+	replayed map[string]int
+}
+
+type recording struct {
+	// Variables are the values which need to be consistent when replaying a test, such as the random values
+	// used within the names of resources
+	Variables map[string]string `json:"variables"`
+
+	Interactions []recordedInteraction `json:"interactions"`
+}
+
+type recordedInteraction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// NewRecorder returns a Recorder which replays the recording at path when replaying, or otherwise records requests
+// which are written to path when Save is called
+func NewRecorder(path string, replaying bool) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		replaying: replaying,
+		recording: recording{
+			Variables: map[string]string{},
+		},
+		replacements: map[string]string{},
+		replayed:     map[string]int{},
+	}
+
+	if replaying {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading recording %q: %+v", path, err)
+		}
+		if err := json.Unmarshal(contents, &r.recording); err != nil {
+			return nil, fmt.Errorf("parsing recording %q: %+v", path, err)
+		}
+		if r.recording.Variables == nil {
+			r.recording.Variables = map[string]string{}
+		}
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) Replaying() bool {
+	return r.replaying
+}
+
+func (r *Recorder) AddReplacement(value, placeholder string) {
+	if value == "" || r.replaying {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.replacements[value] = placeholder
+}
+
+// Variable returns the value of a variable stored within the recording
+func (r *Recorder) Variable(name string) (string, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v, ok := r.recording.Variables[name]
+	return v, ok
+}
+
+// SetVariable stores a variable within the recording, such as a random value used within the name of a resource
+func (r *Recorder) SetVariable(name, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.recording.Variables[name] = value
+}
+
+// Save writes the requests which have been recorded to disk, with any secrets redacted
+func (r *Recorder) Save() error {
+	if r.replaying {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	contents, err := json.MarshalIndent(r.recording, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing recording: %+v", err)
+	}
+	contents = r.replace(contents)
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for recording %q: %+v", r.path, err)
+	}
+	if err := os.WriteFile(r.path, contents, 0o644); err != nil {
+		return fmt.Errorf("writing recording %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+// replace substitutes the placeholders for each of the replacements, which is done when the recording is saved
+// since some values (such as the Object ID of the authenticated principal) are only known once requests are sent
+func (r *Recorder) replace(input []byte) []byte {
+	// replace the longest values first, so that a value containing another is replaced in full
+	values := make([]string, 0, len(r.replacements))
+	for k := range r.replacements {
+		values = append(values, k)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	out := input
+	for _, value := range values {
+		placeholder := []byte(r.replacements[value])
+		out = bytes.ReplaceAll(out, []byte(value), placeholder)
+		out = bytes.ReplaceAll(out, []byte(strings.ToLower(value)), placeholder)
+		out = bytes.ReplaceAll(out, []byte(strings.ToUpper(value)), placeholder)
+	}
+	return out
+}
+
+func (r *Recorder) roundTrip(request *http.Request) (*http.Response, error) {
+	if r.replaying {
+		return r.replay(request)
+	}
+	return r.record(request)
+}
+
+func (r *Recorder) record(request *http.Request) (*http.Response, error) {
+	if err := bufferRequestBody(request); err != nil {
+		return nil, err
+	}
+	var requestBody []byte
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		if requestBody, err = io.ReadAll(body); err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+	}
+
+	response, err := retryHttpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := readResponseBody(response)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	interaction := recordedInteraction{
+		Request: recordedRequest{
+			Method: request.Method,
+			URL:    redactURL(request.URL),
+			Body:   string(redactBodyWith(requestBody, request.URL, recordingSecretValue)),
+		},
+		Response: recordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    recordedHeaders(response.Header),
+			Body:       string(redactBodyWith(responseBody, request.URL, recordingSecretValue)),
+		},
+	}
+
+	r.lock.Lock()
+	r.recording.Interactions = append(r.recording.Interactions, interaction)
+	r.lock.Unlock()
+
+	return response, nil
+}
+
+// replay returns the next recorded response for the request - requests are matched on the method and URL, in the
+// order in which they were recorded, so that polling a long-running operation replays each response in turn
+func (r *Recorder) replay(request *http.Request) (*http.Response, error) {
+	key := recordingKey(request.Method, request.URL.String())
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var matches []recordedInteraction
+	for _, interaction := range r.recording.Interactions {
+		if recordingKey(interaction.Request.Method, interaction.Request.URL) == key {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no recorded response was found for %s %s in %q", request.Method, redactURL(request.URL), r.path)
+	}
+
+	// once the recorded responses have been replayed, the last response is repeated - since a resource may be
+	// retrieved more times than when the test was recorded, for example when Terraform refreshes the state
+	index := r.replayed[key]
+	if index >= len(matches) {
+		index = len(matches) - 1
+	}
+	r.replayed[key]++
+
+	recorded := matches[index].Response
+	response := &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{},
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       request,
+	}
+	for k, v := range recorded.Headers {
+		response.Header[k] = append([]string{}, v...)
+	}
+
+	// there's no need to wait between polling a long-running operation or retrying a request when replaying
+	if response.Header.Get("Retry-After") != "" {
+		response.Header.Set("Retry-After", "0")
+	}
+	response.Header.Del("x-ms-retry-after-ms")
+	response.Header.Del("retry-after-ms")
+
+	return response, nil
+}
+
+// recordedHeaders returns the response headers which are recorded, omitting any which contain credentials
+func recordedHeaders(input http.Header) http.Header {
+	out := http.Header{}
+	for k, v := range input {
+		if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(k)]; ok {
+			continue
+		}
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			values = append(values, redactURLString(value))
+		}
+		out[k] = values
+	}
+	return out
+}
+
+var recordingUUIDRegex = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+
+// recordingIgnoredQueryParameters are the (lower-cased) query string parameters which are ignored when matching a
+// request against the recording, since these differ each time a SAS Token is generated
+var recordingIgnoredQueryParameters = map[string]struct{}{
+	"se":  {},
+	"ske": {},
+	"skt": {},
+	"st":  {},
+}
+
+// recordingKey returns the key used to match a request against the recording, which is case-insensitive, ignores
+// the order of the query string parameters and ignores UUIDs - since these are replaced within the recording and
+// can also be generated by the Provider (for example as the name of a Role Assignment)
+func recordingKey(method, input string) string {
+	u, err := url.Parse(input)
+	if err != nil {
+		return strings.ToLower(fmt.Sprintf("%s %s", method, input))
+	}
+
+	query := make([]string, 0)
+	for k, values := range u.Query() {
+		name := strings.ToLower(k)
+		if _, ok := sensitiveQueryParameters[name]; ok {
+			continue
+		}
+		if _, ok := recordingIgnoredQueryParameters[name]; ok {
+			continue
+		}
+		for _, v := range values {
+			query = append(query, fmt.Sprintf("%s=%s", name, v))
+		}
+	}
+	sort.Strings(query)
+
+	key := fmt.Sprintf("%s %s%s?%s", strings.ToUpper(method), u.Host, strings.TrimSuffix(u.Path, "/"), strings.Join(query, "&"))
+	return recordingUUIDRegex.ReplaceAllString(strings.ToLower(key), "{uuid}")
+}
+
+// RecorderSet routes requests to the Recorder for the test which sent them, which is used for the client shared
+// between tests to check whether resources exist
+type RecorderSet struct {
+	replaying bool
+
+	lock         sync.Mutex
+	recorders    map[*Recorder][]string
+	replacements map[string]string
+}
+
+func NewRecorderSet(replaying bool) *RecorderSet {
+	return &RecorderSet{
+		replaying:    replaying,
+		recorders:    map[*Recorder][]string{},
+		replacements: map[string]string{},
+	}
+}
+
+// Add routes requests whose URL contains any of routingValues (such as the random values used within the names of
+// resources for the test) to the Recorder
+func (s *RecorderSet) Add(recorder *Recorder, routingValues ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.recorders[recorder] = routingValues
+	for value, placeholder := range s.replacements {
+		recorder.AddReplacement(value, placeholder)
+	}
+}
+
+func (s *RecorderSet) Remove(recorder *Recorder) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.recorders, recorder)
+}
+
+func (s *RecorderSet) AddReplacement(value, placeholder string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.replacements[value] = placeholder
+	for recorder := range s.recorders {
+		recorder.AddReplacement(value, placeholder)
+	}
+}
+
+func (s *RecorderSet) Replaying() bool {
+	return s.replaying
+}
+
+func (s *RecorderSet) roundTrip(request *http.Request) (*http.Response, error) {
+	recorder, err := s.recorderFor(request)
+	if err != nil {
+		return nil, err
+	}
+	return recorder.roundTrip(request)
+}
+
+func (s *RecorderSet) recorderFor(request *http.Request) (*Recorder, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	requestUrl := strings.ToLower(request.URL.String())
+	var matches []*Recorder
+	for recorder, routingValues := range s.recorders {
+		for _, value := range routingValues {
+			if value != "" && strings.Contains(requestUrl, strings.ToLower(value)) {
+				matches = append(matches, recorder)
+				break
+			}
+		}
+	}
+
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	// when a single test is running, there's no need to determine which test sent the request
+	if len(matches) == 0 && len(s.recorders) == 1 {
+		for recorder := range s.recorders {
+			return recorder, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unable to determine which recording the request %s %s belongs to (%d recordings matched)", request.Method, redactURL(request.URL), len(matches))
+}
+
+// recorders is the set of RequestRecorders which requests sent to the local recording server can be routed to
+var recorders = struct {
+	lock sync.Mutex
+	ids  map[RequestRecorder]string
+	byId map[string]RequestRecorder
+}{
+	ids:  map[RequestRecorder]string{},
+	byId: map[string]RequestRecorder{},
+}
+
+func recorderId(recorder RequestRecorder) (string, error) {
+	recorders.lock.Lock()
+	defer recorders.lock.Unlock()
+
+	if id, ok := recorders.ids[recorder]; ok {
+		return id, nil
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", fmt.Errorf("generating ID for recorder: %+v", err)
+	}
+	recorders.ids[recorder] = id
+	recorders.byId[id] = recorder
+	return id, nil
+}
+
+func recorderById(id string) (RequestRecorder, bool) {
+	recorders.lock.Lock()
+	defer recorders.lock.Unlock()
+
+	recorder, ok := recorders.byId[id]
+	return recorder, ok
+}
+
+var recordingServer struct {
+	once    sync.Once
+	address string
+	err     error
+}
+
+// recordingServerAddress returns the address of the local server which records and replays requests sent by
+// go-azure-sdk clients - since the HTTP client used by go-azure-sdk can't be configured, requests are instead
+// redirected to this server by a request middleware
+func recordingServerAddress() (string, error) {
+	recordingServer.once.Do(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			recordingServer.err = fmt.Errorf("starting the local recording server: %+v", err)
+			return
+		}
+		recordingServer.address = listener.Addr().String()
+
+		go func() {
+			if err := http.Serve(listener, http.HandlerFunc(serveRecording)); err != nil {
+				log.Printf("[DEBUG] The local recording server stopped: %+v", err)
+			}
+		}()
+	})
+
+	return recordingServer.address, recordingServer.err
+}
+
+func serveRecording(w http.ResponseWriter, r *http.Request) {
+	recorder, ok := recorderById(r.Header.Get(recordingIdHeader))
+	if !ok {
+		writeRecordingError(w, fmt.Errorf("the recorder %q was not found", r.Header.Get(recordingIdHeader)))
+		return
+	}
+
+	upstream, err := url.Parse(r.Header.Get(recordingUpstreamHeader))
+	if err != nil {
+		writeRecordingError(w, fmt.Errorf("parsing upstream %q: %+v", r.Header.Get(recordingUpstreamHeader), err))
+		return
+	}
+
+	request := r.Clone(r.Context())
+	request.RequestURI = ""
+	request.URL.Scheme = upstream.Scheme
+	request.URL.Host = upstream.Host
+	request.Host = upstream.Host
+	request.Header.Del(recordingIdHeader)
+	request.Header.Del(recordingUpstreamHeader)
+
+	response, err := recorder.roundTrip(request)
+	if err != nil {
+		writeRecordingError(w, err)
+		return
+	}
+	defer response.Body.Close()
+
+	for k, v := range response.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Del("Content-Length")
+	w.WriteHeader(response.StatusCode)
+	if _, err := io.Copy(w, response.Body); err != nil {
+		log.Printf("[DEBUG] Writing the recorded response for %s %s: %+v", request.Method, redactURL(request.URL), err)
+	}
+}
+
+// writeRecordingError returns a `501 Not Implemented` status, which isn't retried by the SDKs, with an error in the
+// format used by Azure Resource Manager so that this is surfaced by the SDKs
+func writeRecordingError(w http.ResponseWriter, err error) {
+	body, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]string{
+			"code":    "RecordingError",
+			"message": err.Error(),
+		},
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotImplemented)
+	_, _ = w.Write(body)
+}
+
+// recordingMiddleware redirects requests to the local recording server, which is appended last so that the
+// other middlewares (such as logging) see the original request
+func recordingMiddleware(recorder RequestRecorder) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		address, err := recordingServerAddress()
+		if err != nil {
+			return request, err
+		}
+		id, err := recorderId(recorder)
+		if err != nil {
+			return request, err
+		}
+
+		request.Header.Set(recordingIdHeader, id)
+		request.Header.Set(recordingUpstreamHeader, fmt.Sprintf("%s://%s", request.URL.Scheme, request.URL.Host))
+		request.URL.Scheme = "http"
+		request.URL.Host = address
+		request.Host = address
+		return request, nil
+	}
+}
+
+// ConfigureRecording configures a client which records or replays requests using the RequestRecorder, for clients
+// which aren't otherwise configured using ClientOptions (such as the data plane clients for Storage)
+func ConfigureRecording(c client.BaseClient, recorder RequestRecorder) {
+	if recorder != nil {
+		c.AppendRequestMiddleware(recordingMiddleware(recorder))
+	}
+}
+
+// recordingSender returns an autorest.Sender which records or replays requests sent by go-autorest clients
+func recordingSender(recorder RequestRecorder) autorest.Sender {
+	return autorest.SenderFunc(recorder.roundTrip)
+}
+
+// RecordingAuthorizer returns an Authorizer used when replaying requests, which returns a static token rather than
+// requiring credentials
+func RecordingAuthorizer() auth.Authorizer {
+	return recordingAuthorizer{}
+}
+
+type recordingAuthorizer struct{}
+
+func (recordingAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "recording",
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (recordingAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRecordingKey(t *testing.T) {
+	testData := []struct {
+		Method   string
+		First    string
+		Second   string
+		Expected bool
+	}{
+		{
+			Method:   http.MethodGet,
+			First:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/Example?api-version=2022-09-01",
+			Second:   "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001/resourcegroups/example?api-version=2022-09-01",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodGet,
+			First:    "https://example.blob.core.windows.net/container?restype=container&comp=list",
+			Second:   "https://example.blob.core.windows.net/container?comp=list&restype=container",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodGet,
+			First:    "https://example.blob.core.windows.net/container?restype=container&comp=list",
+			Second:   "https://example.blob.core.windows.net/container?restype=container&comp=metadata",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodGet,
+			First:    "https://example.blob.core.windows.net/container/blob?sv=2022-11-02&se=2024-01-01T00%3A00%3A00Z&sig=first",
+			Second:   "https://example.blob.core.windows.net/container/blob?sv=2022-11-02&se=2024-02-01T00%3A00%3A00Z&sig=REDACTED",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodGet,
+			First:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/first",
+			Second:   "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/second",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q and %q", v.First, v.Second)
+		actual := recordingKey(v.Method, v.First) == recordingKey(v.Method, v.Second)
+		if actual != v.Expected {
+			t.Fatalf("expected the keys to match to be %t but got %t (%q and %q)", v.Expected, actual, recordingKey(v.Method, v.First), recordingKey(v.Method, v.Second))
+		}
+	}
+
+	if recordingKey(http.MethodGet, "https://example.com/path") == recordingKey(http.MethodDelete, "https://example.com/path") {
+		t.Fatal("expected the keys for different methods not to match")
+	}
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	subscriptionId := "11111111-1111-1111-1111-111111111111"
+
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/listKeys"):
+			fmt.Fprint(w, `{"keys":[{"keyName":"key1","value":"super-secret"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/operation"):
+			if atomic.AddInt32(&polls, 1) == 1 {
+				w.Header().Set("Retry-After", "10")
+				fmt.Fprint(w, `{"status":"InProgress"}`)
+				return
+			}
+			fmt.Fprint(w, `{"status":"Succeeded"}`)
+		default:
+			fmt.Fprintf(w, `{"id":"/subscriptions/%s/resourceGroups/example"}`, subscriptionId)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "recordings", "TestExample.json")
+	recorder, err := NewRecorder(path, false)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	recorder.SetVariable("random_integer", "1234")
+	recorder.AddReplacement(subscriptionId, RecordingSubscriptionId)
+
+	requests := []string{
+		fmt.Sprintf("/subscriptions/%s/resourceGroups/example", subscriptionId),
+		fmt.Sprintf("/subscriptions/%s/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys", subscriptionId),
+		"/operation",
+		"/operation",
+	}
+	for _, requestPath := range requests {
+		request, _ := http.NewRequest(http.MethodGet, server.URL+requestPath, nil)
+		request.Header.Set("Authorization", "Bearer secret-token")
+		response, err := recorder.roundTrip(request)
+		if err != nil {
+			t.Fatalf("recording %q: %+v", requestPath, err)
+		}
+		body, _ := io.ReadAll(response.Body)
+		if len(body) == 0 {
+			t.Fatalf("expected the response body for %q to be returned when recording", requestPath)
+		}
+	}
+
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("saving recording: %+v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading recording: %+v", err)
+	}
+	for _, secret := range []string{subscriptionId, "super-secret", "secret-token"} {
+		if bytes.Contains(contents, []byte(secret)) {
+			t.Fatalf("expected %q to be removed from the recording:\n%s", secret, contents)
+		}
+	}
+
+	replayer, err := NewRecorder(path, true)
+	if err != nil {
+		t.Fatalf("building replaying recorder: %+v", err)
+	}
+	if v, _ := replayer.Variable("random_integer"); v != "1234" {
+		t.Fatalf("expected the variable `random_integer` to be %q but got %q", "1234", v)
+	}
+
+	// the placeholder subscription is used when replaying
+	replay := func(path string) (*http.Response, string) {
+		request, _ := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		response, err := replayer.roundTrip(request)
+		if err != nil {
+			t.Fatalf("replaying %q: %+v", path, err)
+		}
+		body, _ := io.ReadAll(response.Body)
+		return response, string(body)
+	}
+
+	_, body := replay(fmt.Sprintf("/subscriptions/%s/resourceGroups/example", RecordingSubscriptionId))
+	if expected := fmt.Sprintf(`{"id":"/subscriptions/%s/resourceGroups/example"}`, RecordingSubscriptionId); body != expected {
+		t.Fatalf("expected %s but got %s", expected, body)
+	}
+
+	_, body = replay(fmt.Sprintf("/subscriptions/%s/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys", RecordingSubscriptionId))
+	if !strings.Contains(body, recordingSecretValue) {
+		t.Fatalf("expected the key to be replaced with %q but got %s", recordingSecretValue, body)
+	}
+
+	response, body := replay("/operation")
+	if body != `{"status":"InProgress"}` {
+		t.Fatalf("expected the first poll to be replayed but got %s", body)
+	}
+	if v := response.Header.Get("Retry-After"); v != "0" {
+		t.Fatalf("expected the Retry-After header to be %q when replaying but got %q", "0", v)
+	}
+	for i := 0; i < 2; i++ {
+		if _, body = replay("/operation"); body != `{"status":"Succeeded"}` {
+			t.Fatalf("expected the final poll to be replayed but got %s", body)
+		}
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, server.URL+"/not-recorded", nil)
+	if _, err := replayer.roundTrip(request); err == nil {
+		t.Fatal("expected an error for a request which wasn't recorded")
+	}
+}
+
+func TestRecordingMiddlewareReplaysThroughLocalServer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestExample.json")
+	recording := `{
+  "variables": {},
+  "interactions": [
+    {
+      "request": {"method": "PUT", "url": "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/example?api-version=2022-09-01"},
+      "response": {"status_code": 201, "headers": {"Content-Type": ["application/json"]}, "body": "{\"name\":\"example\"}"}
+    }
+  ]
+}`
+	if err := os.WriteFile(path, []byte(recording), 0o644); err != nil {
+		t.Fatalf("writing recording: %+v", err)
+	}
+
+	recorder, err := NewRecorder(path, true)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	request, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/example?api-version=2022-09-01", strings.NewReader(`{"location":"westeurope"}`))
+	request, err = recordingMiddleware(recorder)(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if request.URL.Host == "management.azure.com" {
+		t.Fatal("expected the request to be redirected to the local recording server")
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer response.Body.Close()
+
+	body, _ := io.ReadAll(response.Body)
+	if response.StatusCode != http.StatusCreated || string(body) != `{"name":"example"}` {
+		t.Fatalf("expected the recorded response but got %d: %s", response.StatusCode, body)
+	}
+
+	// requests which weren't recorded return a `501 Not Implemented` status, which isn't retried
+	request, _ = http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/other", nil)
+	request, _ = recordingMiddleware(recorder)(request)
+	response, err = http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected a 501 status for a request which wasn't recorded but got %d", response.StatusCode)
+	}
+}
+
+func TestRecorderSetRoutesByValue(t *testing.T) {
+	first, _ := NewRecorder(filepath.Join(t.TempDir(), "first.json"), false)
+	second, _ := NewRecorder(filepath.Join(t.TempDir(), "second.json"), false)
+
+	set := NewRecorderSet(false)
+	set.Add(first, "1111", "abcde")
+
+	// with a single recorder, requests are routed to this regardless of the URL
+	request, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001", nil)
+	if actual, err := set.recorderFor(request); err != nil || actual != first {
+		t.Fatalf("expected the request to be routed to the only recorder, got %+v", err)
+	}
+
+	set.Add(second, "2222", "fghij")
+	request, _ = http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/acctestRG-2222", nil)
+	if actual, err := set.recorderFor(request); err != nil || actual != second {
+		t.Fatalf("expected the request to be routed to the second recorder, got %+v", err)
+	}
+
+	request, _ = http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/other", nil)
+	if _, err := set.recorderFor(request); err == nil {
+		t.Fatal("expected an error when the request can't be routed")
+	}
+
+	set.AddReplacement("11111111-1111-1111-1111-111111111111", RecordingSubscriptionId)
+	if first.replacements["11111111-1111-1111-1111-111111111111"] != RecordingSubscriptionId {
+		t.Fatal("expected the replacement to be added to each recorder")
+	}
+
+	set.Remove(second)
+	if _, ok := set.recorders[second]; ok {
+		t.Fatal("expected the recorder to be removed")
+	}
+}
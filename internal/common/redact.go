@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redactedValue is the value logged in place of a secret
+const redactedValue = "REDACTED"
+
+// sensitiveHeaders are the (canonicalised) HTTP Headers which contain credentials and are always redacted
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":                     {},
+	"Cookie":                            {},
+	"Set-Cookie":                        {},
+	"X-Ms-Authorization-Auxiliary":      {},
+	"X-Ms-Copy-Source-Authorization":    {},
+	"X-Ms-Encryption-Key":               {},
+	"X-Ms-Source-Encryption-Key":        {},
+	"X-Ms-Encryption-Key-Sha256":        {},
+	"X-Ms-Source-Encryption-Key-Sha256": {},
+}
+
+// sensitiveQueryParameters are the (lower-cased) query string parameters which contain secrets, such as the
+// signature of a SAS Token or the key for an Azure Function
+var sensitiveQueryParameters = map[string]struct{}{
+	"code":             {},
+	"connectionstring": {},
+	"primarykey":       {},
+	"secondarykey":     {},
+	"sig":              {},
+}
+
+// sensitiveFieldSuffixes are the (lower-cased) suffixes of JSON fields whose values are secrets, for example
+// `primaryKey`, `primaryConnectionString`, `administratorLoginPassword` and `clientSecret`
+var sensitiveFieldSuffixes = []string{
+	"accesstoken",
+	"connectionstring",
+	"key",
+	"password",
+	"sastoken",
+	"secret",
+	"sharedaccesssignature",
+}
+
+// secretEndpointSuffixes are the (lower-cased) suffixes of the `list` and `get` operations within Resource Manager
+// which return secrets within a `value` field, such as `listKeys` and `listConnectionStrings`
+var secretEndpointSuffixes = []string{
+	"connectionstrings",
+	"credential",
+	"credentials",
+	"key",
+	"keys",
+	"secret",
+	"secrets",
+}
+
+// redactHeaders returns a copy of the HTTP Headers with the values of sensitive headers redacted, and any secrets
+// within URLs (such as the source for a copy operation) removed
+func redactHeaders(input http.Header) http.Header {
+	out := make(http.Header, len(input))
+	for k, values := range input {
+		redacted := make([]string, 0, len(values))
+		for _, v := range values {
+			if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(k)]; ok {
+				redacted = append(redacted, redactedValue)
+				continue
+			}
+			redacted = append(redacted, redactURLString(v))
+		}
+		out[k] = redacted
+	}
+	return out
+}
+
+// redactURL returns the URL as a string with the values of any sensitive query string parameters redacted
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+	if input.RawQuery == "" {
+		return input.String()
+	}
+
+	out := *input
+	out.RawQuery = redactQuery(input.RawQuery)
+	return out.String()
+}
+
+// redactURLString redacts any sensitive query string parameters when the value is an absolute URL, returning the
+// value unchanged otherwise
+func redactURLString(input string) string {
+	if !strings.HasPrefix(input, "http://") && !strings.HasPrefix(input, "https://") {
+		return input
+	}
+	u, err := url.Parse(input)
+	if err != nil || u.RawQuery == "" {
+		return input
+	}
+	return redactURL(u)
+}
+
+// redactQuery redacts the values of sensitive parameters within the query string, preserving the order and
+// encoding of the other parameters
+func redactQuery(input string) string {
+	pairs := strings.Split(input, "&")
+	for i, pair := range pairs {
+		key, _, hasValue := strings.Cut(pair, "=")
+		if !hasValue {
+			continue
+		}
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			name = key
+		}
+		if _, ok := sensitiveQueryParameters[strings.ToLower(name)]; ok {
+			pairs[i] = key + "=" + redactedValue
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// isSecretEndpoint returns whether the URL is for an operation which returns secrets within a `value` field, such
+// as listing the keys for a Storage Account or retrieving a Key Vault Secret
+func isSecretEndpoint(input *url.URL) bool {
+	if input == nil {
+		return false
+	}
+
+	// the User Delegation Key for a Storage Account is retrieved from `/?restype=service&comp=userdelegationkey`
+	if strings.EqualFold(input.Query().Get("comp"), "userdelegationkey") {
+		return true
+	}
+
+	segments := strings.Split(strings.Trim(strings.ToLower(input.Path), "/"), "/")
+
+	// Key Vault Secrets are available at `/secrets/{name}` and `/secrets/{name}/{version}` - and deleted secrets
+	// at `/deletedsecrets/{name}`
+	for i, segment := range segments {
+		if (segment == "secrets" || segment == "deletedsecrets") && i < len(segments)-1 {
+			return true
+		}
+	}
+
+	last := segments[len(segments)-1]
+	if strings.HasPrefix(last, "regenerate") {
+		return true
+	}
+	if strings.HasPrefix(last, "list") || strings.HasPrefix(last, "get") {
+		for _, suffix := range secretEndpointSuffixes {
+			if strings.HasSuffix(last, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// redactBody returns the body with the values of any sensitive fields redacted. JSON bodies are redacted field by
+// field - other bodies are returned unchanged, unless the URL is for an operation which returns secrets, in which
+// case the body is redacted in its entirety.
+func redactBody(input []byte, u *url.URL) []byte {
+	return redactBodyWith(input, u, redactedValue)
+}
+
+// redactBodyWith redacts the body in the same manner as redactBody, replacing secrets with the specified value
+func redactBodyWith(input []byte, u *url.URL, replacement string) []byte {
+	if len(bytes.TrimSpace(input)) == 0 {
+		return input
+	}
+
+	secretEndpoint := isSecretEndpoint(u)
+
+	var body interface{}
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		if secretEndpoint {
+			return []byte(replacement)
+		}
+		return input
+	}
+
+	// HTML escaping is disabled so that URLs within the body remain readable
+	out := &bytes.Buffer{}
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactJSONValue(body, secretEndpoint, replacement)); err != nil {
+		return []byte(replacement)
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n"))
+}
+
+func redactJSONValue(input interface{}, secretEndpoint bool, replacement string) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isScalar(value) && isSensitiveField(key, secretEndpoint) {
+				v[key] = replacement
+				continue
+			}
+			v[key] = redactJSONValue(value, secretEndpoint, replacement)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJSONValue(value, secretEndpoint, replacement)
+		}
+		return v
+
+	case string:
+		return redactURLString(v)
+	}
+
+	return input
+}
+
+// isScalar returns whether the JSON value is a string or number - only these are redacted, since objects and
+// arrays (such as a `customerManagedKey` block) are walked to redact the sensitive fields within them
+func isScalar(input interface{}) bool {
+	switch input.(type) {
+	case string, json.Number:
+		return true
+	}
+	return false
+}
+
+func isSensitiveField(name string, secretEndpoint bool) bool {
+	name = strings.ToLower(name)
+	if secretEndpoint && name == "value" {
+		return true
+	}
+
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
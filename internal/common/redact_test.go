@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestRedactURL(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111?api-version=2022-12-01",
+			Expected: "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111?api-version=2022-12-01",
+		},
+		{
+			Input:    "https://example.blob.core.windows.net/container/blob?sv=2022-11-02&se=2024-01-01T00%3A00%3A00Z&sr=b&sp=r&sig=abc%2Fdef%3D",
+			Expected: "https://example.blob.core.windows.net/container/blob?sv=2022-11-02&se=2024-01-01T00%3A00%3A00Z&sr=b&sp=r&sig=REDACTED",
+		},
+		{
+			Input:    "https://example.azurewebsites.net/api/function?code=secret&name=example",
+			Expected: "https://example.azurewebsites.net/api/function?code=REDACTED&name=example",
+		},
+		{
+			Input:    "https://example.com/path?PrimaryKey=secret&connectionString=secret",
+			Expected: "https://example.com/path?PrimaryKey=REDACTED&connectionString=REDACTED",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+		u, err := url.Parse(v.Input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Input, err)
+		}
+		if actual := redactURL(u); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	input := http.Header{
+		"Authorization":                  []string{"Bearer secret"},
+		"X-Ms-Copy-Source":               []string{"https://example.blob.core.windows.net/container/blob?sig=secret"},
+		"X-Ms-Copy-Source-Authorization": []string{"Bearer secret"},
+		"X-Ms-Correlation-Request-Id":    []string{"11111111-1111-1111-1111-111111111111"},
+	}
+	expected := http.Header{
+		"Authorization":                  []string{"REDACTED"},
+		"X-Ms-Copy-Source":               []string{"https://example.blob.core.windows.net/container/blob?sig=REDACTED"},
+		"X-Ms-Copy-Source-Authorization": []string{"REDACTED"},
+		"X-Ms-Correlation-Request-Id":    []string{"11111111-1111-1111-1111-111111111111"},
+	}
+
+	actual := redactHeaders(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+	if input.Get("Authorization") != "Bearer secret" {
+		t.Fatal("expected the original headers to be unchanged")
+	}
+}
+
+func TestIsSecretEndpoint(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+			Expected: true,
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.ServiceBus/namespaces/example/authorizationRules/RootManageSharedAccessKey/regenerateKeys",
+			Expected: true,
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Web/sites/example/config/publishingcredentials/list",
+			Expected: false,
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.ContainerRegistry/registries/example/listCredentials",
+			Expected: true,
+		},
+		{
+			Input:    "https://example.vault.azure.net/secrets/example/00000000000000000000000000000000",
+			Expected: true,
+		},
+		{
+			Input:    "https://example.vault.azure.net/secrets",
+			Expected: false,
+		},
+		{
+			Input:    "https://example.blob.core.windows.net/?restype=service&comp=userdelegationkey",
+			Expected: true,
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+		u, err := url.Parse(v.Input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Input, err)
+		}
+		if actual := isSecretEndpoint(u); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		URL      string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "non-sensitive fields",
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			Input:    `{"location":"westeurope","tags":{"value":"example"},"properties":{"count":3,"enabled":true}}`,
+			Expected: `{"location":"westeurope","properties":{"count":3,"enabled":true},"tags":{"value":"example"}}`,
+		},
+		{
+			Name:     "sensitive fields",
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.ServiceBus/namespaces/example/authorizationRules/example",
+			Input:    `{"properties":{"primaryKey":"secret","primaryConnectionString":"Endpoint=sb://example;SharedAccessKey=secret","administratorLoginPassword":"secret","keyName":"example","customerManagedKey":{"keyVaultKeyId":"https://example.vault.azure.net/keys/example"}}}`,
+			Expected: `{"properties":{"administratorLoginPassword":"REDACTED","customerManagedKey":{"keyVaultKeyId":"https://example.vault.azure.net/keys/example"},"keyName":"example","primaryConnectionString":"REDACTED","primaryKey":"REDACTED"}}`,
+		},
+		{
+			Name:     "value on a secret endpoint",
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+			Input:    `{"keys":[{"keyName":"key1","value":"secret","permissions":"FULL"}]}`,
+			Expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"}]}`,
+		},
+		{
+			Name:     "value on a key vault secret",
+			URL:      "https://example.vault.azure.net/secrets/example",
+			Input:    `{"value":"secret","contentType":"text/plain"}`,
+			Expected: `{"contentType":"text/plain","value":"REDACTED"}`,
+		},
+		{
+			Name:     "sas url within a string",
+			URL:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Web/sites/example/config/logs",
+			Input:    `{"properties":{"sasUrl":"https://example.blob.core.windows.net/logs?sv=2022-11-02&sig=secret"}}`,
+			Expected: `{"properties":{"sasUrl":"https://example.blob.core.windows.net/logs?sv=2022-11-02&sig=REDACTED"}}`,
+		},
+		{
+			Name:     "non-json body",
+			URL:      "https://example.blob.core.windows.net/container?restype=container",
+			Input:    `<?xml version="1.0"?><Container/>`,
+			Expected: `<?xml version="1.0"?><Container/>`,
+		},
+		{
+			Name:     "non-json body on a secret endpoint",
+			URL:      "https://example.blob.core.windows.net/?restype=service&comp=userdelegationkey",
+			Input:    `<?xml version="1.0"?><UserDelegationKey><Value>secret</Value></UserDelegationKey>`,
+			Expected: `REDACTED`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+		u, err := url.Parse(v.URL)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.URL, err)
+		}
+
+		actual := string(redactBody([]byte(v.Input), u))
+		if actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	DefaultRetryMaxAttempts = 5
+	DefaultRetryMinBackoff  = 2 * time.Second
+	DefaultRetryMaxBackoff  = 60 * time.Second
+
+	// rateLimitRemainingThreshold is the number of requests remaining within a rate limit below which requests
+	// are delayed, to avoid exhausting the rate limit and being throttled by Azure Resource Manager
+	rateLimitRemainingThreshold = 10
+)
+
+var DefaultRetryOnStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryOptions configures how requests are retried when they're throttled or fail with a transient error
+type RetryOptions struct {
+	// MaxAttempts is the total number of times a request is sent, including the first attempt
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry, which is doubled for each subsequent retry
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries, unless a longer delay is requested using the `Retry-After` header
+	MaxBackoff time.Duration
+
+	// RetryOnStatusCodes is the list of HTTP Status Codes which should be retried
+	RetryOnStatusCodes []int
+
+	// rateLimits tracks the number of requests remaining within each rate limit reported by Azure Resource Manager
+	rateLimits *rateLimitTracker
+}
+
+// NewRetryOptions returns RetryOptions using the provided values, falling back to the defaults where unset
+func NewRetryOptions(maxAttempts int, minBackoff, maxBackoff time.Duration, retryOnStatusCodes []int) *RetryOptions {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultRetryMaxAttempts
+	}
+	if minBackoff <= 0 {
+		minBackoff = DefaultRetryMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+	if len(retryOnStatusCodes) == 0 {
+		retryOnStatusCodes = DefaultRetryOnStatusCodes
+	}
+
+	return &RetryOptions{
+		MaxAttempts:        maxAttempts,
+		MinBackoff:         minBackoff,
+		MaxBackoff:         maxBackoff,
+		RetryOnStatusCodes: retryOnStatusCodes,
+		rateLimits:         newRateLimitTracker(),
+	}
+}
+
+// retryHttpClient is used to resend requests made by go-azure-sdk clients, since the client used to send the
+// original request isn't exposed to response middleware
+var retryHttpClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     true,
+	},
+}
+
+// requestMiddleware buffers the request body so that the request can be resent, and delays the request when
+// the rate limit it's subject to is close to being exhausted
+func (o *RetryOptions) requestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := bufferRequestBody(request); err != nil {
+			return nil, err
+		}
+
+		if err := o.waitForRateLimit(request); err != nil {
+			return nil, err
+		}
+
+		return request, nil
+	}
+}
+
+// responseMiddleware resends the request when the response has a retryable status code. Note that the go-azure-sdk
+// base client has already retried throttled and failed requests at this point, so these retries are in addition
+func (o *RetryOptions) responseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		return o.retry(request, response, nil, retryHttpClient.Do)
+	}
+}
+
+// sendDecorator returns an autorest.SendDecorator which delays requests when the rate limit is close to being
+// exhausted, and retries them when the response has a retryable status code
+func (o *RetryOptions) sendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			if err := bufferRequestBody(request); err != nil {
+				return nil, err
+			}
+
+			if err := o.waitForRateLimit(request); err != nil {
+				return nil, err
+			}
+
+			response, err := s.Do(request)
+			return o.retry(request, response, err, s.Do)
+		})
+	}
+}
+
+// retry resends the request using send until a non-retryable response is returned or the maximum number of
+// attempts is reached, waiting between each attempt
+func (o *RetryOptions) retry(request *http.Request, response *http.Response, err error, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		o.rateLimits.record(request, response)
+
+		if attempt >= o.MaxAttempts || !o.shouldRetry(request, response, err) {
+			return response, err
+		}
+
+		delay := o.backoff(attempt, response)
+		if response != nil {
+			log.Printf("[DEBUG] Retrying %s request to %q in %s following a %d response (attempt %d of %d)", request.Method, request.URL, delay, response.StatusCode, attempt+1, o.MaxAttempts)
+			drainResponseBody(response)
+		} else {
+			log.Printf("[DEBUG] Retrying %s request to %q in %s following the error %q (attempt %d of %d)", request.Method, request.URL, delay, err, attempt+1, o.MaxAttempts)
+		}
+
+		if err := sleepWithContext(request.Context(), delay); err != nil {
+			return response, err
+		}
+
+		retryRequest, cloneErr := cloneRequest(request)
+		if cloneErr != nil {
+			return response, cloneErr
+		}
+		response, err = send(retryRequest)
+	}
+}
+
+func (o *RetryOptions) shouldRetry(request *http.Request, response *http.Response, err error) bool {
+	if response == nil {
+		// the connection may have been reset, which is only safe to retry when the request is idempotent
+		return err != nil && isIdempotent(request.Method)
+	}
+
+	for _, v := range o.RetryOnStatusCodes {
+		if response.StatusCode == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before the next attempt, which honours the delay requested by the service when
+// specified and otherwise backs off exponentially from MinBackoff up to MaxBackoff
+func (o *RetryOptions) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if delay, ok := retryAfter(response.Header); ok {
+			return delay
+		}
+	}
+
+	delay := float64(o.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if delay > float64(o.MaxBackoff) {
+		return o.MaxBackoff
+	}
+	return time.Duration(delay)
+}
+
+// waitForRateLimit delays the request when the rate limit it's subject to is close to being exhausted
+func (o *RetryOptions) waitForRateLimit(request *http.Request) error {
+	remaining, ok := o.rateLimits.remaining(request)
+	if !ok || remaining >= rateLimitRemainingThreshold {
+		return nil
+	}
+
+	// the fewer requests remain, the longer we wait for the rate limit to replenish
+	delay := o.MinBackoff * time.Duration(rateLimitRemainingThreshold-remaining)
+	if delay > o.MaxBackoff {
+		delay = o.MaxBackoff
+	}
+	log.Printf("[DEBUG] Delaying %s request to %q by %s since %d requests remain within the rate limit", request.Method, request.URL, delay, remaining)
+
+	return sleepWithContext(request.Context(), delay)
+}
+
+// retryAfter parses the delay requested by the service from the `Retry-After` header (in either seconds or as
+// an HTTP date) or the `x-ms-retry-after-ms` / `retry-after-ms` headers used by some data plane APIs
+func retryAfter(header http.Header) (time.Duration, bool) {
+	for _, name := range []string{"x-ms-retry-after-ms", "retry-after-ms"} {
+		if v := header.Get(name); v != "" {
+			if ms, err := strconv.ParseInt(v, 10, 64); err == nil && ms >= 0 {
+				return time.Duration(ms) * time.Millisecond, true
+			}
+		}
+	}
+
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// bufferRequestBody ensures that the body of the request can be read multiple times - the body is always read
+// since any existing GetBody func may not reflect a body which has since been replaced
+func bufferRequestBody(request *http.Request) error {
+	if request.Body == nil || request.Body == http.NoBody {
+		request.GetBody = nil
+		return nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body: %+v", err)
+	}
+	_ = request.Body.Close()
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return nil
+}
+
+func cloneRequest(request *http.Request) (*http.Request, error) {
+	out := request.Clone(request.Context())
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("retrieving request body to retry request: %+v", err)
+		}
+		out.Body = body
+	}
+	return out, nil
+}
+
+// drainResponseBody reads and closes the response body, so that the underlying connection can be reused
+func drainResponseBody(response *http.Response) {
+	if response.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 4096))
+	_ = response.Body.Close()
+}
+
+func sleepWithContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitHeaderRegex matches the `x-ms-ratelimit-remaining-*` headers for subscription and tenant level rate limits,
+// e.g. `x-ms-ratelimit-remaining-subscription-writes` or `x-ms-ratelimit-remaining-tenant-global-reads`
+var rateLimitHeaderRegex = regexp.MustCompile(`(?i)^x-ms-ratelimit-remaining-(subscription|tenant)-(?:global-)?(reads|writes|deletes)$`)
+
+var subscriptionIdRegex = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+
+// rateLimitTracker tracks the number of requests remaining within each of the rate limits reported by Azure
+// Resource Manager, keyed by the Host, scope (the Subscription or Tenant) and the kind of request
+type rateLimitTracker struct {
+	lock   sync.Mutex
+	limits map[string]int
+}
+
+func newRateLimitTracker() *rateLimitTracker {
+	return &rateLimitTracker{
+		limits: make(map[string]int),
+	}
+}
+
+func (t *rateLimitTracker) record(request *http.Request, response *http.Response) {
+	if t == nil || response == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for name, values := range response.Header {
+		matches := rateLimitHeaderRegex.FindStringSubmatch(name)
+		if len(matches) != 3 || len(values) == 0 {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(values[0])
+		if err != nil {
+			continue
+		}
+
+		// the most recent value is used, since the rate limits are replenished over time
+		t.limits[rateLimitKey(request, strings.ToLower(matches[1]), strings.ToLower(matches[2]))] = remaining
+	}
+}
+
+// remaining returns the lowest number of requests remaining within the rate limits the request is subject to
+func (t *rateLimitTracker) remaining(request *http.Request) (int, bool) {
+	if t == nil {
+		return 0, false
+	}
+
+	kind := "writes"
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		kind = "reads"
+	case http.MethodDelete:
+		kind = "deletes"
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	lowest, found := 0, false
+	for _, scope := range []string{"subscription", "tenant"} {
+		if v, ok := t.limits[rateLimitKey(request, scope, kind)]; ok && (!found || v < lowest) {
+			lowest, found = v, true
+		}
+	}
+	return lowest, found
+}
+
+func rateLimitKey(request *http.Request, scope, kind string) string {
+	scopeId := ""
+	if scope == "subscription" {
+		if matches := subscriptionIdRegex.FindStringSubmatch(request.URL.Path); len(matches) == 2 {
+			scopeId = strings.ToLower(matches[1])
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", strings.ToLower(request.URL.Host), scope, scopeId, kind)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryOptionsBackoff(t *testing.T) {
+	o := NewRetryOptions(5, time.Second, 5*time.Second, nil)
+
+	testData := []struct {
+		Name     string
+		Attempt  int
+		Header   http.Header
+		Expected time.Duration
+	}{
+		{
+			Name:     "First Retry",
+			Attempt:  1,
+			Expected: time.Second,
+		},
+		{
+			Name:     "Exponential",
+			Attempt:  3,
+			Expected: 4 * time.Second,
+		},
+		{
+			Name:     "Capped at Max Backoff",
+			Attempt:  4,
+			Expected: 5 * time.Second,
+		},
+		{
+			Name:     "Retry-After Seconds",
+			Attempt:  1,
+			Header:   http.Header{"Retry-After": []string{"17"}},
+			Expected: 17 * time.Second,
+		},
+		{
+			Name:     "Retry-After Milliseconds",
+			Attempt:  1,
+			Header:   http.Header{"X-Ms-Retry-After-Ms": []string{"250"}},
+			Expected: 250 * time.Millisecond,
+		},
+		{
+			Name:     "Invalid Retry-After",
+			Attempt:  2,
+			Header:   http.Header{"Retry-After": []string{"soon"}},
+			Expected: 2 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+		actual := o.backoff(v.Attempt, &http.Response{Header: v.Header})
+		if actual != v.Expected {
+			t.Fatalf("Expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestNewRetryOptionsDefaults(t *testing.T) {
+	o := NewRetryOptions(0, 0, 0, nil)
+	if o.MaxAttempts != DefaultRetryMaxAttempts {
+		t.Fatalf("expected MaxAttempts to be %d but got %d", DefaultRetryMaxAttempts, o.MaxAttempts)
+	}
+	if o.MinBackoff != DefaultRetryMinBackoff || o.MaxBackoff != DefaultRetryMaxBackoff {
+		t.Fatalf("expected the default backoff but got %s / %s", o.MinBackoff, o.MaxBackoff)
+	}
+	if len(o.RetryOnStatusCodes) != len(DefaultRetryOnStatusCodes) {
+		t.Fatalf("expected the default status codes but got %+v", o.RetryOnStatusCodes)
+	}
+
+	o = NewRetryOptions(1, 10*time.Second, time.Second, []int{409})
+	if o.MaxBackoff != 10*time.Second {
+		t.Fatalf("expected MaxBackoff to be raised to MinBackoff but got %s", o.MaxBackoff)
+	}
+}
+
+func TestRetryOptionsSendDecorator(t *testing.T) {
+	attempts := 0
+	bodies := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	o := NewRetryOptions(5, time.Millisecond, time.Millisecond, nil)
+	sender := autorest.DecorateSender(server.Client(), o.sendDecorator())
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"hello":"world"}`))
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts but got %d", attempts)
+	}
+	for _, body := range bodies {
+		if body != `{"hello":"world"}` {
+			t.Fatalf("expected the request body to be resent but got %q", body)
+		}
+	}
+}
+
+func TestRetryOptionsMaxAttempts(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	o := NewRetryOptions(2, time.Millisecond, time.Millisecond, nil)
+	sender := autorest.DecorateSender(server.Client(), o.sendDecorator())
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected a 503 but got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts but got %d", attempts)
+	}
+}
+
+func TestRetryOptionsNonRetryableStatusCode(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusConflict)
+	}))
+	defer server.Close()
+
+	o := NewRetryOptions(5, time.Millisecond, time.Millisecond, nil)
+	sender := autorest.DecorateSender(server.Client(), o.sendDecorator())
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt but got %d", attempts)
+	}
+}
+
+func TestRateLimitTracker(t *testing.T) {
+	tracker := newRateLimitTracker()
+
+	write, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example", nil)
+	tracker.record(write, &http.Response{
+		Header: http.Header{
+			"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"3"},
+			"X-Ms-Ratelimit-Remaining-Subscription-Reads":  []string{"11999"},
+		},
+	})
+
+	otherWrite, _ := http.NewRequest(http.MethodPatch, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/other", nil)
+	if remaining, ok := tracker.remaining(otherWrite); !ok || remaining != 3 {
+		t.Fatalf("expected 3 writes remaining but got %d (%t)", remaining, ok)
+	}
+
+	read, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/other", nil)
+	if remaining, ok := tracker.remaining(read); !ok || remaining != 11999 {
+		t.Fatalf("expected 11999 reads remaining but got %d (%t)", remaining, ok)
+	}
+
+	otherSubscription, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/example", nil)
+	if _, ok := tracker.remaining(otherSubscription); ok {
+		t.Fatal("expected no rate limit to be tracked for a different subscription")
+	}
+}
+
+func TestRetryOptionsMiddleware(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"hello":"world"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if attempts < 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	o := NewRetryOptions(3, time.Millisecond, time.Millisecond, nil)
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"hello":"world"}`))
+	req, err := o.requestMiddleware()(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	resp, err = o.responseMiddleware()(req, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 but got %d", resp.StatusCode)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 attempts but got %d", attempts)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// writeListenerMiddleware notifies the listener of the path of each write request, before it's sent
+func writeListenerMiddleware(listener func(path string)) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if isWriteRequest(request) {
+			listener(request.URL.Path)
+		}
+		return request, nil
+	}
+}
+
+// writeListenerSendDecorator returns an autorest.SendDecorator which notifies the listener of the path of each write
+// request, before it's sent
+func writeListenerSendDecorator(listener func(path string)) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			if isWriteRequest(request) {
+				listener(request.URL.Path)
+			}
+			return s.Do(request)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestWriteListenerSendDecorator(t *testing.T) {
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	paths := make([]string, 0)
+	decorated := autorest.DecorateSender(sender, writeListenerSendDecorator(func(path string) {
+		paths = append(paths, path)
+	}))
+
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete} {
+		req, _ := http.NewRequest(method, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/"+method, nil)
+		if _, err := decorated.Do(req); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+
+	expected := []string{
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/PUT",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/PATCH",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/POST",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/DELETE",
+	}
+	if !reflect.DeepEqual(expected, paths) {
+		t.Fatalf("expected the listener to be notified of %+v but got %+v", expected, paths)
+	}
+}
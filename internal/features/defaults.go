@@ -68,6 +68,9 @@ func Default() UserFeatures {
 		PostgresqlFlexibleServer: PostgresqlFlexibleServerFeatures{
 			RestartServerOnConfigurationValueChange: true,
 		},
+		PreflightValidation: PreflightValidationFeatures{
+			Enabled: false,
+		},
 		MachineLearning: MachineLearningFeatures{
 			PurgeSoftDeletedWorkspaceOnDestroy: false,
 		},
@@ -18,6 +18,7 @@ type UserFeatures struct {
 	ManagedDisk              ManagedDiskFeatures
 	Subscription             SubscriptionFeatures
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
+	PreflightValidation      PreflightValidationFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
 }
@@ -88,6 +89,10 @@ type SubscriptionFeatures struct {
 	PreventCancellationOnDestroy bool
 }
 
+type PreflightValidationFeatures struct {
+	Enabled bool
+}
+
 type RecoveryServicesVault struct {
 	RecoverSoftDeletedBackupProtectedVM bool
 }
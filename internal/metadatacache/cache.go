@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadatacache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultTTL is the duration for which cached metadata is used when no TTL is specified
+const DefaultTTL = 60 * time.Minute
+
+// Cache is an on-disk cache for metadata which is otherwise retrieved from Azure each time the Provider is launched -
+// such as the Resource Providers available within a Subscription - allowing this to be reused by subsequent runs
+// (and other workspaces) until the TTL expires.
+//
+// A nil Cache is valid and caches nothing, which is the default - and errors reading from or writing to the cache are
+// logged rather than returned, since the metadata can always be retrieved from Azure instead.
+type Cache struct {
+	directory string
+	ttl       time.Duration
+
+	// now returns the current time, which is overridden in tests
+	now func() time.Time
+}
+
+// Key identifies an item within the Cache, which is scoped to the Tenant and Subscription the item was retrieved from
+type Key struct {
+	TenantId       string
+	SubscriptionId string
+	Name           string
+}
+
+func (k Key) String() string {
+	return strings.ToLower(fmt.Sprintf("%s/%s/%s", k.TenantId, k.SubscriptionId, k.Name))
+}
+
+type entry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// New returns a Cache which stores items within the directory (which is created if it doesn't exist) for the TTL -
+// or nil when the directory is empty, meaning that caching is disabled
+func New(directory string, ttl time.Duration) (*Cache, error) {
+	if directory == "" {
+		return nil, nil
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, fmt.Errorf("creating the metadata cache directory %q: %+v", directory, err)
+	}
+
+	return &Cache{
+		directory: directory,
+		ttl:       ttl,
+		now:       time.Now,
+	}, nil
+}
+
+// Get populates `out` with the cached item for the Key, returning whether an unexpired item was found
+func (c *Cache) Get(key Key, out interface{}) bool {
+	if c == nil {
+		return false
+	}
+
+	path := c.path(key)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("[DEBUG] Reading %q from the metadata cache: %+v", key, err)
+		}
+		return false
+	}
+
+	var item entry
+	if err := json.Unmarshal(contents, &item); err != nil {
+		log.Printf("[DEBUG] Invalidating %q in the metadata cache since it couldn't be parsed: %+v", key, err)
+		c.Invalidate(key)
+		return false
+	}
+
+	if item.Key != key.String() {
+		return false
+	}
+
+	if !c.now().Before(item.Expires) {
+		log.Printf("[DEBUG] Invalidating %q in the metadata cache since it expired at %s", key, item.Expires.Format(time.RFC3339))
+		c.Invalidate(key)
+		return false
+	}
+
+	if err := json.Unmarshal(item.Value, out); err != nil {
+		log.Printf("[DEBUG] Invalidating %q in the metadata cache since it couldn't be parsed: %+v", key, err)
+		c.Invalidate(key)
+		return false
+	}
+
+	log.Printf("[DEBUG] Using %q from the metadata cache, which expires at %s", key, item.Expires.Format(time.RFC3339))
+	return true
+}
+
+// Set caches the value for the Key until the TTL expires
+func (c *Cache) Set(key Key, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	if err := c.write(key, value); err != nil {
+		log.Printf("[DEBUG] Writing %q to the metadata cache: %+v", key, err)
+	}
+}
+
+// Invalidate removes the cached item for the Key, for example when the item is found to be stale
+func (c *Cache) Invalidate(key Key) {
+	if c == nil {
+		return
+	}
+
+	if err := os.Remove(c.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		log.Printf("[DEBUG] Invalidating %q in the metadata cache: %+v", key, err)
+	}
+}
+
+func (c *Cache) write(key Key, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshaling: %+v", err)
+	}
+
+	contents, err := json.Marshal(entry{
+		Key:     key.String(),
+		Expires: c.now().Add(c.ttl).UTC(),
+		Value:   raw,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling: %+v", err)
+	}
+
+	// the cache can be shared by multiple processes (e.g. when running Terraform in several workspaces at once)
+	// so the item is written to a temporary file which then replaces the existing item
+	file, err := os.CreateTemp(c.directory, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %+v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(contents); err != nil {
+		file.Close()
+		return fmt.Errorf("writing temporary file: %+v", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("closing temporary file: %+v", err)
+	}
+
+	if err := os.Rename(file.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("renaming temporary file: %+v", err)
+	}
+
+	return nil
+}
+
+// path returns the path of the file containing the item for the Key - the Key is hashed since it can contain
+// characters which aren't valid in a file name
+func (c *Cache) path(key Key) string {
+	hash := sha256.Sum256([]byte(key.String()))
+	return filepath.Join(c.directory, fmt.Sprintf("%s.json", hex.EncodeToString(hash[:])))
+}
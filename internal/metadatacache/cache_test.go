@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadatacache
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	cache, err := New(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("building cache: %+v", err)
+	}
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	key := Key{
+		TenantId:       "00000000-0000-0000-0000-000000000000",
+		SubscriptionId: "11111111-1111-1111-1111-111111111111",
+		Name:           "resource-providers",
+	}
+	expected := map[string]string{
+		"Microsoft.Compute": "Registered",
+		"Microsoft.Storage": "NotRegistered",
+	}
+
+	var actual map[string]string
+	if cache.Get(key, &actual) {
+		t.Fatalf("expected a miss before the item was cached")
+	}
+
+	cache.Set(key, expected)
+	if !cache.Get(key, &actual) {
+		t.Fatalf("expected a hit after the item was cached")
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	otherSubscription := key
+	otherSubscription.SubscriptionId = "22222222-2222-2222-2222-222222222222"
+	if cache.Get(otherSubscription, &actual) {
+		t.Fatalf("expected a miss for a different Subscription")
+	}
+
+	cache.Invalidate(key)
+	if cache.Get(key, &actual) {
+		t.Fatalf("expected a miss after the item was invalidated")
+	}
+
+	cache.Set(key, expected)
+	now = now.Add(time.Hour)
+	if cache.Get(key, &actual) {
+		t.Fatalf("expected a miss after the item expired")
+	}
+	if _, err := os.Stat(cache.path(key)); !os.IsNotExist(err) {
+		t.Fatalf("expected the expired item to be removed")
+	}
+}
+
+func TestCacheCorrupt(t *testing.T) {
+	cache, err := New(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("building cache: %+v", err)
+	}
+
+	key := Key{
+		Name: "corrupt",
+	}
+	if err := os.WriteFile(cache.path(key), []byte("{"), 0o600); err != nil {
+		t.Fatalf("writing item: %+v", err)
+	}
+
+	var actual map[string]string
+	if cache.Get(key, &actual) {
+		t.Fatalf("expected a miss for a corrupt item")
+	}
+	if _, err := os.Stat(cache.path(key)); !os.IsNotExist(err) {
+		t.Fatalf("expected the corrupt item to be removed")
+	}
+}
+
+func TestCacheDisabled(t *testing.T) {
+	cache, err := New("", time.Hour)
+	if err != nil {
+		t.Fatalf("building cache: %+v", err)
+	}
+	if cache != nil {
+		t.Fatalf("expected no cache when the directory is empty")
+	}
+
+	// a nil cache is valid and caches nothing
+	key := Key{
+		Name: "disabled",
+	}
+	cache.Set(key, "value")
+
+	var actual string
+	if cache.Get(key, &actual) {
+		t.Fatalf("expected a miss when the cache is disabled")
+	}
+	cache.Invalidate(key)
+}
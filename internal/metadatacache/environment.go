@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadatacache
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// EnvironmentFromEndpoint returns the Environment described by the Metadata Host at the endpoint, using the cached
+// Environment when available. Environments aren't specific to a Tenant or Subscription, so are cached by endpoint.
+func (c *Cache) EnvironmentFromEndpoint(ctx context.Context, endpoint string) (*environments.Environment, error) {
+	key := Key{
+		Name: fmt.Sprintf("environment/%s", endpoint),
+	}
+
+	var cached cachedEnvironment
+	if c.Get(key, &cached) {
+		return cached.expand(), nil
+	}
+
+	env, err := environments.FromEndpoint(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Set(key, flattenEnvironment(env))
+	return env, nil
+}
+
+// cachedEnvironment is the serializable form of an Environment, since the Api implementation used by the SDK
+// doesn't export its fields
+type cachedEnvironment struct {
+	Name          string                          `json:"name"`
+	Authorization *environments.Authorization     `json:"authorization,omitempty"`
+	Apis          map[string]cachedEnvironmentApi `json:"apis"`
+}
+
+type cachedEnvironmentApi struct {
+	// Nil specifies that the Environment contains a nil pointer for this Api, meaning that it's unavailable
+	Nil bool `json:"nil,omitempty"`
+
+	AppId              *string `json:"appId,omitempty"`
+	DomainSuffix       *string `json:"domainSuffix,omitempty"`
+	Endpoint           *string `json:"endpoint,omitempty"`
+	Name               string  `json:"name"`
+	ResourceIdentifier *string `json:"resourceIdentifier,omitempty"`
+}
+
+var apiType = reflect.TypeOf((*environments.Api)(nil)).Elem()
+
+func flattenEnvironment(input *environments.Environment) cachedEnvironment {
+	output := cachedEnvironment{
+		Name:          input.Name,
+		Authorization: input.Authorization,
+		Apis:          make(map[string]cachedEnvironmentApi),
+	}
+
+	value := reflect.ValueOf(input).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type != apiType || value.Field(i).IsNil() {
+			continue
+		}
+
+		api := value.Field(i).Interface().(environments.Api)
+		if v := reflect.ValueOf(api); v.Kind() == reflect.Ptr && v.IsNil() {
+			output.Apis[field.Name] = cachedEnvironmentApi{
+				Nil: true,
+			}
+			continue
+		}
+
+		item := cachedEnvironmentApi{
+			Name: api.Name(),
+		}
+		if v, ok := api.AppId(); ok {
+			item.AppId = v
+		}
+		if v, ok := api.DomainSuffix(); ok {
+			item.DomainSuffix = v
+		}
+		if v, ok := api.Endpoint(); ok {
+			item.Endpoint = v
+		}
+		if v, ok := api.ResourceIdentifier(); ok {
+			item.ResourceIdentifier = v
+		}
+		output.Apis[field.Name] = item
+	}
+
+	return output
+}
+
+func (c cachedEnvironment) expand() *environments.Environment {
+	output := &environments.Environment{
+		Name:          c.Name,
+		Authorization: c.Authorization,
+	}
+
+	value := reflect.ValueOf(output).Elem()
+	for name, item := range c.Apis {
+		field := value.FieldByName(name)
+		if !field.IsValid() || field.Type() != apiType {
+			continue
+		}
+
+		var api environments.Api = (*cachedApi)(nil)
+		if !item.Nil {
+			api = &cachedApi{
+				appId:              item.AppId,
+				domainSuffix:       item.DomainSuffix,
+				endpoint:           item.Endpoint,
+				name:               item.Name,
+				resourceIdentifier: item.ResourceIdentifier,
+			}
+		}
+		field.Set(reflect.ValueOf(&api).Elem())
+	}
+
+	return output
+}
+
+var _ environments.Api = &cachedApi{}
+
+// cachedApi is an Api retrieved from the cache, which behaves the same as the ApiEndpoint it was created from
+type cachedApi struct {
+	appId              *string
+	domainSuffix       *string
+	endpoint           *string
+	name               string
+	resourceIdentifier *string
+}
+
+func (a *cachedApi) AppId() (*string, bool) {
+	if a == nil || a.appId == nil {
+		return nil, false
+	}
+	return a.appId, true
+}
+
+func (a *cachedApi) Available() bool {
+	return a != nil && (a.resourceIdentifier != nil || a.endpoint != nil)
+}
+
+func (a *cachedApi) DomainSuffix() (*string, bool) {
+	if a == nil {
+		return nil, false
+	}
+	return a.domainSuffix, a.domainSuffix != nil
+}
+
+func (a *cachedApi) Endpoint() (*string, bool) {
+	if a == nil {
+		return nil, false
+	}
+	return a.endpoint, a.endpoint != nil
+}
+
+func (a *cachedApi) Name() string {
+	if a == nil {
+		return "(nil)"
+	}
+	return a.name
+}
+
+func (a *cachedApi) ResourceIdentifier() (*string, bool) {
+	if a == nil {
+		return nil, false
+	}
+	return a.resourceIdentifier, a.resourceIdentifier != nil
+}
+
+func (a *cachedApi) WithResourceIdentifier(identifier string) environments.Api {
+	output := *a
+	output.resourceIdentifier = pointer.To(identifier)
+	return &output
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metadatacache
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestEnvironmentRoundTrip(t *testing.T) {
+	for _, expected := range []*environments.Environment{
+		environments.AzurePublic(),
+		environments.AzureUSGovernment(),
+		environments.AzureChina(),
+	} {
+		t.Run(expected.Name, func(t *testing.T) {
+			raw, err := json.Marshal(flattenEnvironment(expected))
+			if err != nil {
+				t.Fatalf("marshaling: %+v", err)
+			}
+			var cached cachedEnvironment
+			if err := json.Unmarshal(raw, &cached); err != nil {
+				t.Fatalf("unmarshaling: %+v", err)
+			}
+			actual := cached.expand()
+
+			if actual.Name != expected.Name {
+				t.Fatalf("expected the Name %q but got %q", expected.Name, actual.Name)
+			}
+			if !reflect.DeepEqual(actual.Authorization, expected.Authorization) {
+				t.Fatalf("expected the Authorization %+v but got %+v", expected.Authorization, actual.Authorization)
+			}
+
+			expectedValue := reflect.ValueOf(expected).Elem()
+			actualValue := reflect.ValueOf(actual).Elem()
+			for i := 0; i < expectedValue.NumField(); i++ {
+				field := expectedValue.Type().Field(i)
+				if field.Type != apiType {
+					continue
+				}
+
+				if expectedValue.Field(i).IsNil() {
+					if !actualValue.Field(i).IsNil() {
+						t.Fatalf("expected %s to be nil", field.Name)
+					}
+					continue
+				}
+
+				expectedApi := expectedValue.Field(i).Interface().(environments.Api)
+				actualApi := actualValue.Field(i).Interface().(environments.Api)
+				if expectedApi.Available() != actualApi.Available() {
+					t.Fatalf("expected %s to have Available %t", field.Name, expectedApi.Available())
+				}
+				if expectedApi.Name() != actualApi.Name() {
+					t.Fatalf("expected %s to have the Name %q but got %q", field.Name, expectedApi.Name(), actualApi.Name())
+				}
+				for method, get := range map[string]func(environments.Api) (*string, bool){
+					"AppId":              environments.Api.AppId,
+					"DomainSuffix":       environments.Api.DomainSuffix,
+					"Endpoint":           environments.Api.Endpoint,
+					"ResourceIdentifier": environments.Api.ResourceIdentifier,
+				} {
+					expectedString, expectedOk := get(expectedApi)
+					actualString, actualOk := get(actualApi)
+					if expectedOk != actualOk || !reflect.DeepEqual(expectedString, actualString) {
+						t.Fatalf("expected %s.%s to be %v (%t) but got %v (%t)", field.Name, method, expectedString, expectedOk, actualString, actualOk)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Timeout is the maximum duration of the preflight validation for a resource - which is performed during the plan,
+// where no timeout is otherwise configured
+const Timeout = 5 * time.Minute
+
+// ValidateFunc performs the preflight validation for a resource which is being created, using the planned values
+// within the ResourceDiff
+type ValidateFunc func(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error
+
+// CustomizeDiff returns a CustomizeDiffFunc which runs the ValidateFunc when Preflight Validation is enabled in the
+// `features` block - for use by resources built using the Plugin SDK
+func CustomizeDiff(validateFunc ValidateFunc) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok {
+			return nil
+		}
+		return Run(ctx, d, client, validateFunc)
+	}
+}
+
+// Run runs the ValidateFunc when Preflight Validation is enabled in the `features` block and the resource is being
+// created, surfacing any error as part of the plan
+func Run(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client, validateFunc ValidateFunc) error {
+	if client == nil || !client.Features.PreflightValidation.Enabled {
+		return nil
+	}
+
+	// only resources which are being created are validated, since existing resources have already been accepted by
+	// Resource Manager - and resources being replaced reuse the name of the resource being destroyed
+	if d.Id() != "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Timeout)
+	defer cancel()
+
+	if err := validateFunc(ctx, d, client); err != nil {
+		return fmt.Errorf("preflight validation: %+v", err)
+	}
+
+	return nil
+}
+
+// ValuesKnown returns whether the planned values for each of the keys are known - values which are only known after
+// the apply (for example those referencing resources which haven't been created yet) can't be validated
+func ValuesKnown(d *pluginsdk.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateName validates the name using the validation function from the schema - since values which aren't known
+// when the configuration is validated (such as those interpolated from other resources) are first known during the
+// plan, and an invalid name shouldn't be sent to the `checkNameAvailability` API
+func ValidateName(validateFunc pluginsdk.SchemaValidateFunc, key string, name string) error {
+	_, errs := validateFunc(name, key)
+	return errors.Join(errs...)
+}
+
+// NameUnavailableError returns the error for a name which the `checkNameAvailability` API reports is unavailable
+func NameUnavailableError(resourceType string, name string, reason *string, message *string) error {
+	details := "the name is already in use"
+	if message != nil && *message != "" {
+		details = *message
+	} else if reason != nil && *reason != "" {
+		details = *reason
+	}
+
+	return fmt.Errorf("the name %q isn't available for the %s: %s", name, resourceType, details)
+}
+
+// TemplateResource is the planned resource which is validated using the Resource Manager Deployments `validate` API -
+// this needn't contain the full payload, only the fields which Resource Manager validates before creating the
+// resource (such as the SKU and location)
+type TemplateResource struct {
+	Type       string
+	ApiVersion string
+	Name       string
+	Location   string
+	Kind       string
+	Sku        map[string]interface{}
+	Properties map[string]interface{}
+	Tags       map[string]interface{}
+}
+
+// ValidateDeployment validates the planned resource within the Resource Group using the Resource Manager Deployments
+// `validate` API, which checks the resource against the SKUs/quotas available in the location and any Azure Policies
+// assigned to the Resource Group - without deploying the resource.
+//
+// NOTE: the validation is skipped when the Resource Group doesn't exist yet, for example when it's created in the
+// same apply
+func ValidateDeployment(ctx context.Context, client *clients.Client, resourceGroupName string, resource TemplateResource) error {
+	resourceGroupId := commonids.NewResourceGroupID(client.Account.SubscriptionId, resourceGroupName)
+	resourceGroup, err := client.Resource.ResourceGroupsClient.Get(ctx, resourceGroupId)
+	if err != nil {
+		if response.WasNotFound(resourceGroup.HttpResponse) {
+			log.Printf("[DEBUG] Skipping the Deployment validation for %s %q since %s doesn't exist", resource.Type, resource.Name, resourceGroupId)
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", resourceGroupId, err)
+	}
+
+	deploymentsClient := client.Resource.DeploymentsClient
+	deployment := resources.Deployment{
+		Properties: &resources.DeploymentProperties{
+			Mode:     resources.DeploymentModeIncremental,
+			Template: template(resource),
+		},
+	}
+
+	name := deploymentName(resource.Name)
+	log.Printf("[DEBUG] Validating the Deployment of %s %q within %s..", resource.Type, resource.Name, resourceGroupId)
+	future, err := deploymentsClient.Validate(ctx, resourceGroupName, name, deployment)
+	if err != nil {
+		return fmt.Errorf("validating the Deployment of %s %q within %s: %+v", resource.Type, resource.Name, resourceGroupId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, deploymentsClient.Client); err != nil {
+		return fmt.Errorf("waiting for the validation of the Deployment of %s %q within %s: %+v", resource.Type, resource.Name, resourceGroupId, err)
+	}
+	result, err := future.Result(*deploymentsClient)
+	if err != nil {
+		return fmt.Errorf("retrieving the validation result for the Deployment of %s %q within %s: %+v", resource.Type, resource.Name, resourceGroupId, err)
+	}
+	if result.Error != nil {
+		return fmt.Errorf("the Deployment of %s %q within %s failed validation: %s", resource.Type, resource.Name, resourceGroupId, flattenErrorResponse(*result.Error))
+	}
+
+	return nil
+}
+
+// template returns the ARM Template containing the resource
+func template(resource TemplateResource) map[string]interface{} {
+	item := map[string]interface{}{
+		"type":       resource.Type,
+		"apiVersion": resource.ApiVersion,
+		"name":       resource.Name,
+		"location":   resource.Location,
+	}
+	if resource.Kind != "" {
+		item["kind"] = resource.Kind
+	}
+	if len(resource.Sku) > 0 {
+		item["sku"] = resource.Sku
+	}
+	if len(resource.Properties) > 0 {
+		item["properties"] = resource.Properties
+	}
+	if len(resource.Tags) > 0 {
+		item["tags"] = resource.Tags
+	}
+
+	return map[string]interface{}{
+		"$schema":        "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+		"contentVersion": "1.0.0.0",
+		"resources": []interface{}{
+			item,
+		},
+	}
+}
+
+// deploymentName returns the name of the Deployment used for the validation, which is limited to 64 characters
+func deploymentName(resourceName string) string {
+	name := fmt.Sprintf("terraform-preflight-%s", resourceName)
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return name
+}
+
+// flattenErrorResponse returns the messages within the error (and any nested errors) as a single string
+func flattenErrorResponse(input resources.ErrorResponse) string {
+	messages := make([]string, 0)
+
+	var flatten func(resources.ErrorResponse)
+	flatten = func(e resources.ErrorResponse) {
+		code := ""
+		if e.Code != nil {
+			code = *e.Code
+		}
+		if e.Message != nil && *e.Message != "" {
+			if code != "" {
+				messages = append(messages, fmt.Sprintf("%s: %s", code, *e.Message))
+			} else {
+				messages = append(messages, *e.Message)
+			}
+		}
+		if e.Details != nil {
+			for _, v := range *e.Details {
+				flatten(v)
+			}
+		}
+	}
+	flatten(input)
+
+	if len(messages) == 0 {
+		return "no details were returned"
+	}
+	return strings.Join(messages, "\n")
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preflight
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestTemplate(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    TemplateResource
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "Minimal",
+			Input: TemplateResource{
+				Type:       "Microsoft.ContainerRegistry/registries",
+				ApiVersion: "2021-08-01-preview",
+				Name:       "example",
+				Location:   "westeurope",
+			},
+			Expected: map[string]interface{}{
+				"type":       "Microsoft.ContainerRegistry/registries",
+				"apiVersion": "2021-08-01-preview",
+				"name":       "example",
+				"location":   "westeurope",
+			},
+		},
+		{
+			Name: "Complete",
+			Input: TemplateResource{
+				Type:       "Microsoft.Storage/storageAccounts",
+				ApiVersion: "2023-01-01",
+				Name:       "example",
+				Location:   "westeurope",
+				Kind:       "StorageV2",
+				Sku: map[string]interface{}{
+					"name": "Standard_LRS",
+				},
+				Properties: map[string]interface{}{
+					"accessTier": "Hot",
+				},
+				Tags: map[string]interface{}{
+					"environment": "test",
+				},
+			},
+			Expected: map[string]interface{}{
+				"type":       "Microsoft.Storage/storageAccounts",
+				"apiVersion": "2023-01-01",
+				"name":       "example",
+				"location":   "westeurope",
+				"kind":       "StorageV2",
+				"sku": map[string]interface{}{
+					"name": "Standard_LRS",
+				},
+				"properties": map[string]interface{}{
+					"accessTier": "Hot",
+				},
+				"tags": map[string]interface{}{
+					"environment": "test",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := template(v.Input)
+		if actual["contentVersion"] != "1.0.0.0" {
+			t.Fatalf("expected the `contentVersion` to be `1.0.0.0` but got %q", actual["contentVersion"])
+		}
+
+		items := actual["resources"].([]interface{})
+		if len(items) != 1 {
+			t.Fatalf("expected 1 resource but got %d", len(items))
+		}
+		if !reflect.DeepEqual(items[0], v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, items[0])
+		}
+	}
+}
+
+func TestDeploymentName(t *testing.T) {
+	if actual := deploymentName("example"); actual != "terraform-preflight-example" {
+		t.Fatalf("expected %q but got %q", "terraform-preflight-example", actual)
+	}
+
+	actual := deploymentName(strings.Repeat("a", 60))
+	if len(actual) != 64 {
+		t.Fatalf("expected the name to be truncated to 64 characters but got %d", len(actual))
+	}
+}
+
+func TestFlattenErrorResponse(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    resources.ErrorResponse
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    resources.ErrorResponse{},
+			Expected: "no details were returned",
+		},
+		{
+			Name: "Message",
+			Input: resources.ErrorResponse{
+				Message: pointer.To("The subscription is not registered."),
+			},
+			Expected: "The subscription is not registered.",
+		},
+		{
+			Name: "Nested",
+			Input: resources.ErrorResponse{
+				Code:    pointer.To("InvalidTemplateDeployment"),
+				Message: pointer.To("The template deployment failed."),
+				Details: &[]resources.ErrorResponse{
+					{
+						Code:    pointer.To("RequestDisallowedByPolicy"),
+						Message: pointer.To("Resource 'example' was disallowed by policy."),
+					},
+				},
+			},
+			Expected: "InvalidTemplateDeployment: The template deployment failed.\nRequestDisallowedByPolicy: Resource 'example' was disallowed by policy.",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := flattenErrorResponse(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
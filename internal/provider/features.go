@@ -338,6 +338,21 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+		"preflight_validation": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
 		"machine_learning": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -601,6 +616,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["preflight_validation"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			preflightValidationRaw := items[0].(map[string]interface{})
+			if v, ok := preflightValidationRaw["enabled"]; ok {
+				featuresMap.PreflightValidation.Enabled = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["machine_learning"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
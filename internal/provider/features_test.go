@@ -78,6 +78,9 @@ func TestExpandFeatures(t *testing.T) {
 				Subscription: features.SubscriptionFeatures{
 					PreventCancellationOnDestroy: false,
 				},
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled: false,
+				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: true,
 				},
@@ -141,6 +144,11 @@ func TestExpandFeatures(t *testing.T) {
 							"expand_without_downtime": true,
 						},
 					},
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
 					"postgresql_flexible_server": []interface{}{
 						map[string]interface{}{
 							"restart_server_on_configuration_value_change": true,
@@ -253,6 +261,9 @@ func TestExpandFeatures(t *testing.T) {
 					ForceDelete:               true,
 					ScaleToZeroOnDelete:       true,
 				},
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled: true,
+				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: true,
 				},
@@ -316,6 +327,11 @@ func TestExpandFeatures(t *testing.T) {
 							"expand_without_downtime": false,
 						},
 					},
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
 					"postgresql_flexible_server": []interface{}{
 						map[string]interface{}{
 							"restart_server_on_configuration_value_change": false,
@@ -428,6 +444,9 @@ func TestExpandFeatures(t *testing.T) {
 					RollInstancesWhenRequired: false,
 					ScaleToZeroOnDelete:       false,
 				},
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled: false,
+				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: false,
 				},
@@ -1544,6 +1563,71 @@ func TestExpandFeaturesPosgresqlFlexibleServer(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesPreflightValidation(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preflight_validation": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled: false,
+				},
+			},
+		},
+		{
+			Name: "Preflight Validation Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled: true,
+				},
+			},
+		},
+		{
+			Name: "Preflight Validation Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"preflight_validation": []interface{}{
+						map[string]interface{}{
+							"enabled": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreflightValidation: features.PreflightValidationFeatures{
+					Enabled: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.PreflightValidation, testCase.Expected.PreflightValidation) {
+			t.Fatalf("Expected %+v but got %+v", result.PreflightValidation, testCase.Expected.PreflightValidation)
+		}
+	}
+}
+
 func TestExpandFeaturesMachineLearning(t *testing.T) {
 	testData := []struct {
 		Name     string
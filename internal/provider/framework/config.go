@@ -13,7 +13,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 )
@@ -23,13 +25,27 @@ type ProviderConfig struct {
 	Client        *clients.Client
 }
 
+// ConfiguredClient returns the client configured by Load, which is used by the Typed Resources and Data Sources
+// served by the Plugin Framework
+func (p *ProviderConfig) ConfiguredClient() *clients.Client {
+	return p.Client
+}
+
 // Load handles the heavy lifting of configuring the provider and handling defaults
 func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersion string, diags *diag.Diagnostics) {
 	env := &environments.Environment{}
 	var err error
 
+	metadataCacheTTL := time.Duration(getEnvInt64OrDefault(data.MetadataCacheTTLInMinutes, "ARM_METADATA_CACHE_TTL_IN_MINUTES", 60)) * time.Minute
+	metadataCache, err := metadatacache.New(getEnvStringOrDefault(data.MetadataCacheDirectory, "ARM_METADATA_CACHE_DIRECTORY", ""), metadataCacheTTL)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Configuring metadata cache", err.Error()))
+		return
+	}
+	p.clientBuilder.MetadataCache = metadataCache
+
 	if metadataHost := getEnvStringOrDefault(data.MetaDataHost, "ARM_METADATA_HOSTNAME", ""); metadataHost != "" {
-		env, err = environments.FromEndpoint(ctx, metadataHost)
+		env, err = metadataCache.EnvironmentFromEndpoint(ctx, metadataHost)
 		if err != nil {
 			diags.Append(diag.NewErrorDiagnostic("Configuring metadata host", err.Error()))
 			return
@@ -118,7 +134,9 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.PartnerID = partnerId
 	p.clientBuilder.DisableCorrelationRequestID = getEnvBoolOrDefault(data.DisableCorrelationRequestId, "ARM_DISABLE_CORRELATION_REQUEST_ID", false)
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
+	p.clientBuilder.MaxConcurrentWriteRequests = int(getEnvInt64OrDefault(data.MaxConcurrentWriteRequests, "ARM_MAX_CONCURRENT_WRITE_REQUESTS", 0))
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
+	p.clientBuilder.UseResourceGraphForRefresh = getEnvBoolOrDefault(data.UseResourceGraphForRefresh, "ARM_USE_RESOURCE_GRAPH_FOR_REFRESH", false)
 
 	f := providerfeatures.UserFeatures{}
 
@@ -441,6 +459,22 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.PostgresqlFlexibleServer.RestartServerOnConfigurationValueChange = true
 		}
 
+		if !features.PreflightValidation.IsNull() && !features.PreflightValidation.IsUnknown() {
+			var feature []PreflightValidation
+			d := features.PreflightValidation.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.PreflightValidation.Enabled = false
+			if !feature[0].Enabled.IsNull() && !feature[0].Enabled.IsUnknown() {
+				f.PreflightValidation.Enabled = feature[0].Enabled.ValueBool()
+			}
+		} else {
+			f.PreflightValidation.Enabled = false
+		}
+
 		if !features.RecoveryService.IsNull() && !features.RecoveryService.IsUnknown() {
 			var feature []RecoveryService
 			d := features.RecoveryService.ElementsAs(ctx, &feature, true)
@@ -465,6 +499,76 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	}
 
 	p.clientBuilder.Features = f
+
+	p.clientBuilder.IgnoredTagKeys = make([]string, 0)
+	p.clientBuilder.IgnoredTagKeyPrefixes = make([]string, 0)
+	if !data.IgnoreTags.IsNull() && !data.IgnoreTags.IsUnknown() {
+		var ignoreTagsList []IgnoreTags
+		d := data.IgnoreTags.ElementsAs(ctx, &ignoreTagsList, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(ignoreTagsList) > 0 {
+			ignoreTags := ignoreTagsList[0]
+			if !ignoreTags.Keys.IsNull() && !ignoreTags.Keys.IsUnknown() {
+				diags.Append(ignoreTags.Keys.ElementsAs(ctx, &p.clientBuilder.IgnoredTagKeys, false)...)
+			}
+			if !ignoreTags.KeyPrefixes.IsNull() && !ignoreTags.KeyPrefixes.IsUnknown() {
+				diags.Append(ignoreTags.KeyPrefixes.ElementsAs(ctx, &p.clientBuilder.IgnoredTagKeyPrefixes, false)...)
+			}
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
+	p.clientBuilder.DefaultTags = make(map[string]string)
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		var defaultTagsList []DefaultTags
+		d := data.DefaultTags.ElementsAs(ctx, &defaultTagsList, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(defaultTagsList) > 0 && !defaultTagsList[0].Tags.IsNull() && !defaultTagsList[0].Tags.IsUnknown() {
+			diags.Append(defaultTagsList[0].Tags.ElementsAs(ctx, &p.clientBuilder.DefaultTags, false)...)
+			if diags.HasError() {
+				return
+			}
+		}
+	}
+
+	p.clientBuilder.Retry = nil
+	if !data.Retry.IsNull() && !data.Retry.IsUnknown() {
+		var retryList []Retry
+		d := data.Retry.ElementsAs(ctx, &retryList, true)
+		diags.Append(d...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(retryList) > 0 {
+			retry := retryList[0]
+
+			// the durations have been validated, and unset values fall back to the defaults
+			minBackoff, _ := time.ParseDuration(retry.MinBackoff.ValueString())
+			maxBackoff, _ := time.ParseDuration(retry.MaxBackoff.ValueString())
+
+			statusCodes := make([]int, 0)
+			if !retry.RetryOnStatusCodes.IsNull() && !retry.RetryOnStatusCodes.IsUnknown() {
+				diags.Append(retry.RetryOnStatusCodes.ElementsAs(ctx, &statusCodes, false)...)
+				if diags.HasError() {
+					return
+				}
+			}
+
+			p.clientBuilder.Retry = common.NewRetryOptions(int(retry.MaxAttempts.ValueInt64()), minBackoff, maxBackoff, statusCodes)
+		}
+	}
+
 	p.clientBuilder.AuthConfig = authConfig
 	p.clientBuilder.CustomCorrelationRequestID = os.Getenv("ARM_CORRELATION_REQUEST_ID")
 	p.clientBuilder.TerraformVersion = tfVersion
@@ -515,7 +619,7 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
-	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
+	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders, client.ResourceProvidersMetadataCache); err != nil {
 		diags.AddError("registering resource providers", err.Error())
 		return
 	}
@@ -185,6 +185,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 		t.Errorf("expected subscription.prevent_cancellation_on_destroy to be false")
 	}
 
+	if features.PreflightValidation.Enabled {
+		t.Errorf("expected preflight_validation.enabled to be false")
+	}
+
 	if !features.PostgresqlFlexibleServer.RestartServerOnConfigurationValueChange {
 		t.Errorf("expected postgresql.restart_server_on_configuration_value_change to be true")
 	}
@@ -285,6 +289,11 @@ func defaultFeaturesList() types.List {
 	})
 	postgresqlFlexibleServerList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(PostgresqlFlexibleServerAttributes), []attr.Value{postgresqlFlexibleServer})
 
+	preflightValidation, _ := basetypes.NewObjectValueFrom(context.Background(), PreflightValidationAttributes, map[string]attr.Value{
+		"enabled": basetypes.NewBoolNull(),
+	})
+	preflightValidationList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(PreflightValidationAttributes), []attr.Value{preflightValidation})
+
 	machineLearning, _ := basetypes.NewObjectValueFrom(context.Background(), MachineLearningAttributes, map[string]attr.Value{
 		"purge_soft_deleted_workspace_on_destroy": basetypes.NewBoolNull(),
 	})
@@ -316,6 +325,7 @@ func defaultFeaturesList() types.List {
 		"managed_disk":               managedDiskList,
 		"subscription":               subscriptionList,
 		"postgresql_flexible_server": postgresqlFlexibleServerList,
+		"preflight_validation":       preflightValidationList,
 		"machine_learning":           machineLearningList,
 		"recovery_service":           recoveryServicesList,
 		"recovery_services_vaults":   recoveryServicesVaultsList,
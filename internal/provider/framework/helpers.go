@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -172,6 +173,19 @@ func getEnvBoolOrDefault(val types.Bool, envVar string, def bool) bool {
 	return val.ValueBool()
 }
 
+// getEnvInt64OrDefault returns the value of the Int64Value if not Null / Unknown, otherwise the value of the Environment
+// Variable `envVar` when it's set to a valid integer, falling back to `def` in all other cases.
+func getEnvInt64OrDefault(val types.Int64, envVar string, def int64) int64 {
+	if val.IsNull() || val.IsUnknown() {
+		if v, err := strconv.ParseInt(os.Getenv(envVar), 10, 64); err == nil {
+			return v
+		}
+		return def
+	}
+
+	return val.ValueInt64()
+}
+
 // getEnvListOfStringsIfAbsent returns a []string for the types.List, or the contents of the supplied Environment
 // Variable `envVar` if set. If the separator is an empty string, then "," will be used as a default.
 func getEnvListOfStringsIfAbsent(val types.List, envVar string, separator string) []string {
@@ -300,3 +300,19 @@ func Test_decodeCertificateExpectError(t *testing.T) {
 		t.Fatalf("did not get expected error, got '%v'", err)
 	}
 }
+
+func Test_getEnvInt64OrDefault(t *testing.T) {
+	t.Setenv("ARM_TEST_INT64", "")
+	if v := getEnvInt64OrDefault(basetypes.NewInt64Null(), "ARM_TEST_INT64", 3); v != 3 {
+		t.Fatalf("expected the default value of 3 but got %d", v)
+	}
+
+	t.Setenv("ARM_TEST_INT64", "10")
+	if v := getEnvInt64OrDefault(basetypes.NewInt64Null(), "ARM_TEST_INT64", 3); v != 10 {
+		t.Fatalf("expected the environment variable value of 10 but got %d", v)
+	}
+
+	if v := getEnvInt64OrDefault(basetypes.NewInt64Value(5), "ARM_TEST_INT64", 3); v != 5 {
+		t.Fatalf("expected the configured value of 5 but got %d", v)
+	}
+}
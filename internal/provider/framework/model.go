@@ -33,8 +33,15 @@ type ProviderModel struct {
 	PartnerId                     types.String `tfsdk:"partner_id"`
 	DisableCorrelationRequestId   types.Bool   `tfsdk:"disable_correlation_request_id"`
 	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
+	MaxConcurrentWriteRequests    types.Int64  `tfsdk:"max_concurrent_write_requests"`
+	MetadataCacheDirectory        types.String `tfsdk:"metadata_cache_directory"`
+	MetadataCacheTTLInMinutes     types.Int64  `tfsdk:"metadata_cache_ttl_in_minutes"`
 	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
+	UseResourceGraphForRefresh    types.Bool   `tfsdk:"use_resource_graph_for_refresh"`
 	Features                      types.List   `tfsdk:"features"`
+	DefaultTags                   types.List   `tfsdk:"default_tags"`
+	IgnoreTags                    types.List   `tfsdk:"ignore_tags"`
+	Retry                         types.List   `tfsdk:"retry"`
 	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
@@ -54,6 +61,7 @@ type Features struct {
 	ManagedDisk              types.List `tfsdk:"managed_disk"`
 	Subscription             types.List `tfsdk:"subscription"`
 	PostgresqlFlexibleServer types.List `tfsdk:"postgresql_flexible_server"`
+	PreflightValidation      types.List `tfsdk:"preflight_validation"`
 	MachineLearning          types.List `tfsdk:"machine_learning"`
 	RecoveryService          types.List `tfsdk:"recovery_service"`
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
@@ -75,6 +83,7 @@ var FeaturesAttributes = map[string]attr.Type{
 	"managed_disk":               types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ManagedDiskAttributes)),
 	"subscription":               types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(SubscriptionAttributes)),
 	"postgresql_flexible_server": types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(PostgresqlFlexibleServerAttributes)),
+	"preflight_validation":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(PreflightValidationAttributes)),
 	"machine_learning":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(MachineLearningAttributes)),
 	"recovery_service":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceAttributes)),
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
@@ -220,6 +229,14 @@ var PostgresqlFlexibleServerAttributes = map[string]attr.Type{
 	"restart_server_on_configuration_value_change": types.BoolType,
 }
 
+type PreflightValidation struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var PreflightValidationAttributes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
 type MachineLearning struct {
 	PurgeSoftDeletedWorkspaceOnDestroy types.Bool `tfsdk:"purge_soft_deleted_workspace_on_destroy"`
 }
@@ -245,3 +262,35 @@ type RecoveryServiceVaults struct {
 var RecoveryServiceVaultsAttributes = map[string]attr.Type{
 	"recover_soft_deleted_backup_protected_vm": types.BoolType,
 }
+
+type DefaultTags struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+var DefaultTagsAttributes = map[string]attr.Type{
+	"tags": types.MapType{}.WithElementType(types.StringType),
+}
+
+type IgnoreTags struct {
+	Keys        types.List `tfsdk:"keys"`
+	KeyPrefixes types.List `tfsdk:"key_prefixes"`
+}
+
+var IgnoreTagsAttributes = map[string]attr.Type{
+	"keys":         types.ListType{}.WithElementType(types.StringType),
+	"key_prefixes": types.ListType{}.WithElementType(types.StringType),
+}
+
+type Retry struct {
+	MaxAttempts        types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff         types.String `tfsdk:"min_backoff"`
+	MaxBackoff         types.String `tfsdk:"max_backoff"`
+	RetryOnStatusCodes types.List   `tfsdk:"retry_on_status_codes"`
+}
+
+var RetryAttributes = map[string]attr.Type{
+	"max_attempts":          types.Int64Type,
+	"min_backoff":           types.StringType,
+	"max_backoff":           types.StringType,
+	"retry_on_status_codes": types.ListType{}.WithElementType(types.Int64Type),
+}
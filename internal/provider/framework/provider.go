@@ -6,17 +6,23 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	pluginsdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
 )
 
@@ -29,10 +35,21 @@ var _ provider.Provider = &azureRmFrameworkProvider{}
 
 var _ provider.ProviderWithFunctions = &azureRmFrameworkProvider{}
 
+var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
+
+var _ provider.ProviderWithListResources = &azureRmFrameworkProvider{}
+
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewGenerateResourceNameFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
+		providerfunction.NewParseKeyVaultIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewParseStorageBlobURLFunction,
+		providerfunction.NewResourceIDMatchesTypeFunction,
+		providerfunction.NewSubnetCIDRsFunction,
+		providerfunction.NewValidateResourceNameFunction,
 	}
 }
 
@@ -184,6 +201,27 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"max_concurrent_write_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of write requests which can be in-flight at once for each Subscription and Resource Provider. Defaults to `0`, meaning no limit.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"metadata_cache_directory": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a directory in which metadata (such as the registered Resource Providers) is cached between runs of the Provider. Defaults to an empty string, meaning metadata isn't cached.",
+			},
+
+			"metadata_cache_ttl_in_minutes": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of minutes for which cached metadata is used. Defaults to `60`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
 			// Advanced feature flags
 			"skip_provider_registration": schema.BoolAttribute{
 				Optional:           true,
@@ -196,6 +234,11 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
 
+			"use_resource_graph_for_refresh": schema.BoolAttribute{
+				Optional:    true,
+				Description: "(Experimental) Should resources which support it be refreshed from a snapshot retrieved from Azure Resource Graph, rather than retrieving each resource from Resource Manager? Defaults to `false`.",
+			},
+
 			"resource_provider_registrations": schema.StringAttribute{
 				Optional:    true,
 				Description: "The set of Resource Providers which should be automatically registered for the subscription.",
@@ -225,6 +268,101 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				Description: "A set of tags which should be applied to all taggable resources managed by this Provider.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A mapping of tags which should be assigned to all taggable resources, which are overridden by tags with the same key defined on the resource.",
+						},
+					},
+				},
+			},
+
+			"ignore_tags": schema.ListNestedBlock{
+				Description: "A set of tags which are managed outside of Terraform (for example by Azure Policy) and should be ignored by all resources managed by this Provider.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"keys": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+
+						"key_prefixes": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of tag key prefixes, where tags with a matching key should be ignored.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+			},
+
+			"retry": schema.ListNestedBlock{
+				Description: "Configures how requests to Azure which are throttled or fail with a transient error are retried.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times a request is sent, including the first attempt. Defaults to `5`.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+
+						"min_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The delay before the first retry as a duration (e.g. `2s`), which is doubled for each subsequent retry. Defaults to `2s`.",
+							Validators: []validator.String{
+								frameworkhelpers.WrappedStringValidator{
+									Func:         azurermprovider.ValidateRetryBackoff,
+									Desc:         "ValidateRetryBackoff validates that the value is a duration greater than zero.",
+									MarkdownDesc: "ValidateRetryBackoff validates that the value is a duration greater than zero.",
+								},
+							},
+						},
+
+						"max_backoff": schema.StringAttribute{
+							Optional:    true,
+							Description: "The maximum delay between retries as a duration (e.g. `60s`), unless a longer delay is requested by Azure. Defaults to `60s`.",
+							Validators: []validator.String{
+								frameworkhelpers.WrappedStringValidator{
+									Func:         azurermprovider.ValidateRetryBackoff,
+									Desc:         "ValidateRetryBackoff validates that the value is a duration greater than zero.",
+									MarkdownDesc: "ValidateRetryBackoff validates that the value is a duration greater than zero.",
+								},
+							},
+						},
+
+						"retry_on_status_codes": schema.ListAttribute{
+							ElementType: types.Int64Type,
+							Optional:    true,
+							Description: "A list of HTTP Status Codes which should be retried. Defaults to `429`, `500`, `502`, `503` and `504`.",
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+							},
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -425,6 +563,15 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"preflight_validation": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
 						"machine_learning": schema.ListNestedBlock{
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
@@ -475,20 +622,96 @@ func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provid
 
 		response.ResourceData = v
 		response.DataSourceData = v
+		response.EphemeralResourceData = v
+		response.ListResourceData = v
 	} else {
 		p.Load(ctx, &data, request.TerraformVersion, &response.Diagnostics)
 
 		response.DataSourceData = &p.ProviderConfig
 		response.ResourceData = &p.ProviderConfig
+
+		// Ephemeral Resources and List Resources only require the configured client
+		if p.ProviderConfig.Client != nil {
+			response.EphemeralResourceData = p.ProviderConfig.Client
+			response.ListResourceData = p.ProviderConfig.Client
+		}
 	}
 }
 
 func (p *azureRmFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	// We do not currently support any Native framework Data Sources
-	return nil
+	dataSources := make([]func() datasource.DataSource, 0)
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		if v, ok := service.(sdk.FrameworkTypedServiceRegistration); ok {
+			for _, ds := range v.FrameworkDataSources() {
+				dataSources = append(dataSources, sdk.NewFrameworkDataSourceWrapper(ds))
+			}
+		}
+	}
+
+	return dataSources
 }
 
 func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
-	// We do not currently support any Native framework Resources
-	return nil
+	resources := make([]func() resource.Resource, 0)
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		if v, ok := service.(sdk.FrameworkTypedServiceRegistration); ok {
+			for _, r := range v.FrameworkResources() {
+				resources = append(resources, sdk.NewFrameworkResourceWrapper(r))
+			}
+		}
+	}
+
+	return resources
+}
+
+func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	ephemeralResources := make([]func() ephemeral.EphemeralResource, 0)
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		ephemeralResources = append(ephemeralResources, service.EphemeralResources()...)
+	}
+
+	return ephemeralResources
+}
+
+func (p *azureRmFrameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	listResources := make([]func() list.ListResource, 0)
+
+	// the Resources being listed are served by the Plugin SDK, so the List Resources are only available when
+	// this Provider is muxed with the Plugin SDK Provider
+	v2Provider, ok := p.V2Provider.(*pluginsdkschema.Provider)
+	if !ok {
+		return listResources
+	}
+
+	services := make([]interface{}, 0)
+	for _, service := range azurermprovider.SupportedTypedServices() {
+		services = append(services, service)
+	}
+	for _, service := range azurermprovider.SupportedUntypedServices() {
+		services = append(services, service)
+	}
+
+	// a Service can be registered as both a Typed and an Untyped Service, so each List Resource is only registered once
+	registered := make(map[string]struct{})
+	for _, service := range services {
+		v, ok := service.(sdk.ServiceRegistrationWithListResources)
+		if !ok {
+			continue
+		}
+
+		for _, r := range v.ListResources() {
+			if _, exists := registered[r.ResourceType()]; exists {
+				continue
+			}
+			sdkResource, exists := v2Provider.ResourcesMap[r.ResourceType()]
+			if !exists {
+				continue
+			}
+
+			registered[r.ResourceType()] = struct{}{}
+			listResources = append(listResources, sdk.NewFrameworkListResourceWrapper(r, sdkResource))
+		}
+	}
+
+	return listResources
 }
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+func TestProviderEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkV5Provider().(*azureRmFrameworkProvider)
+
+	seen := make(map[string]struct{})
+	for _, f := range p.EphemeralResources(ctx) {
+		r := f()
+
+		metadata := ephemeral.MetadataResponse{}
+		r.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+		if metadata.TypeName == "" {
+			t.Fatalf("ephemeral resource %T has no type name", r)
+		}
+		if _, exists := seen[metadata.TypeName]; exists {
+			t.Fatalf("ephemeral resource %q is registered multiple times", metadata.TypeName)
+		}
+		seen[metadata.TypeName] = struct{}{}
+
+		schema := ephemeral.SchemaResponse{}
+		r.Schema(ctx, ephemeral.SchemaRequest{}, &schema)
+		if schema.Diagnostics.HasError() {
+			t.Fatalf("retrieving the schema for %q: %+v", metadata.TypeName, schema.Diagnostics)
+		}
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("validating the schema for %q: %+v", metadata.TypeName, diags)
+		}
+	}
+}
+
+func TestProviderResources(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkV5Provider().(*azureRmFrameworkProvider)
+	v2Provider := azurermprovider.AzureProvider()
+
+	seen := make(map[string]struct{})
+	for _, f := range p.Resources(ctx) {
+		r := f()
+
+		metadata := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+		if metadata.TypeName == "" {
+			t.Fatalf("resource %T has no type name", r)
+		}
+		if _, exists := seen[metadata.TypeName]; exists {
+			t.Fatalf("resource %q is registered multiple times", metadata.TypeName)
+		}
+		if _, exists := v2Provider.ResourcesMap[metadata.TypeName]; exists {
+			t.Fatalf("resource %q is registered in both the Plugin SDK and the Plugin Framework", metadata.TypeName)
+		}
+		seen[metadata.TypeName] = struct{}{}
+
+		schema := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schema)
+		if schema.Diagnostics.HasError() {
+			t.Fatalf("retrieving the schema for %q: %+v", metadata.TypeName, schema.Diagnostics)
+		}
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("validating the schema for %q: %+v", metadata.TypeName, diags)
+		}
+	}
+}
+
+func TestProviderDataSources(t *testing.T) {
+	ctx := context.Background()
+	p := NewFrameworkV5Provider().(*azureRmFrameworkProvider)
+	v2Provider := azurermprovider.AzureProvider()
+
+	seen := make(map[string]struct{})
+	for _, f := range p.DataSources(ctx) {
+		ds := f()
+
+		metadata := datasource.MetadataResponse{}
+		ds.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+		if metadata.TypeName == "" {
+			t.Fatalf("data source %T has no type name", ds)
+		}
+		if _, exists := seen[metadata.TypeName]; exists {
+			t.Fatalf("data source %q is registered multiple times", metadata.TypeName)
+		}
+		if _, exists := v2Provider.DataSourcesMap[metadata.TypeName]; exists {
+			t.Fatalf("data source %q is registered in both the Plugin SDK and the Plugin Framework", metadata.TypeName)
+		}
+		seen[metadata.TypeName] = struct{}{}
+
+		schema := datasource.SchemaResponse{}
+		ds.Schema(ctx, datasource.SchemaRequest{}, &schema)
+		if schema.Diagnostics.HasError() {
+			t.Fatalf("retrieving the schema for %q: %+v", metadata.TypeName, schema.Diagnostics)
+		}
+		if diags := schema.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("validating the schema for %q: %+v", metadata.TypeName, diags)
+		}
+	}
+}
+
+// TestProviderProtocolV5Schemas validates the schemas can be served over protocol version 5, since the Plugin
+// Framework supports schemas which protocol version 5 can't represent (for example nested attributes)
+func TestProviderProtocolV5Schemas(t *testing.T) {
+	ctx := context.Background()
+	server := V5ProviderWithoutPluginSDK()()
+
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving the provider schema: %+v", err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("retrieving the provider schema: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (b BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (b BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID from a scope, a full resource type and the names of each resource",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from a scope, a full resource type and the names of each resource",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "scope",
+				Description:         "The scope the resource is created within, such as a Resource Group ID",
+				MarkdownDescription: "The scope the resource is created within, such as a Resource Group ID",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The full resource type, such as Microsoft.Network/virtualNetworks/subnets",
+				MarkdownDescription: "The full resource type, such as `Microsoft.Network/virtualNetworks/subnets`",
+			},
+			function.ListParameter{
+				Name:                "names",
+				ElementType:         types.StringType,
+				Description:         "The names of the resource and each of its parent resources, in order",
+				MarkdownDescription: "The names of the resource and each of its parent resources, in order",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var scope, resourceType string
+	var names []string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &scope, &resourceType, &names))
+
+	if response.Error != nil {
+		return
+	}
+
+	if !strings.HasPrefix(scope, "/") {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected the scope %q to start with a `/`", scope))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(resourceType, "/"), "/")
+	if len(segments) < 2 || !strings.Contains(segments[0], ".") {
+		response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("expected the resource type %q to be in the format `{Namespace}/{type}[/{type}]` (e.g. `Microsoft.Network/virtualNetworks/subnets`)", resourceType))
+		return
+	}
+
+	resourceTypes := segments[1:]
+	if len(names) != len(resourceTypes) {
+		response.Error = function.NewArgumentFuncError(2, fmt.Sprintf("expected %d name(s) for the resource type %q but got %d", len(resourceTypes), resourceType, len(names)))
+		return
+	}
+
+	components := []string{
+		strings.TrimSuffix(scope, "/"),
+		"providers",
+		segments[0],
+	}
+	for i, name := range names {
+		if name == "" || strings.Contains(name, "/") {
+			response.Error = function.NewArgumentFuncError(2, fmt.Sprintf("expected the name at index %d to be non-empty and not contain a `/` but got %q", i, name))
+			return
+		}
+		components = append(components, resourceTypes[i], name)
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, strings.Join(components, "/")))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type GenerateResourceNameFunction struct{}
+
+var _ function.Function = GenerateResourceNameFunction{}
+
+func NewGenerateResourceNameFunction() function.Function {
+	return &GenerateResourceNameFunction{}
+}
+
+func (g GenerateResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "generate_resource_name"
+}
+
+func (g GenerateResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "generate_resource_name",
+		Description:         "Generates a name from a prefix and suffix which meets the naming rules used by the Provider for the specified resource type",
+		MarkdownDescription: "Generates a name from a prefix and suffix which meets the naming rules used by the Provider for the specified resource type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The Terraform resource type, such as azurerm_storage_account",
+				MarkdownDescription: "The Terraform resource type, such as `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "prefix",
+				Description:         "The prefix for the name, which is truncated when the name would exceed the maximum length",
+				MarkdownDescription: "The prefix for the name, which is truncated when the name would exceed the maximum length",
+			},
+			function.StringParameter{
+				Name:                "suffix",
+				Description:         "The suffix for the name, which is retained in full",
+				MarkdownDescription: "The suffix for the name, which is retained in full",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (g GenerateResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, prefix, suffix string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &prefix, &suffix))
+
+	if response.Error != nil {
+		return
+	}
+
+	rule, err := resourceNameRuleFor(resourceType)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	name, err := rule.generate(prefix, suffix)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("generating a name for %q: %s", resourceType, err))
+		return
+	}
+
+	// the sanitised name can still be invalid (e.g. too short, or starting with a number), so validate it to
+	// surface this here rather than when the resource is created
+	if errs := rule.validate(name); len(errs) > 0 {
+		response.Error = function.NewFuncError(fmt.Sprintf("the generated name %q is not valid for %q: %s", name, resourceType, strings.Join(errs, "; ")))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, name))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionGenerateResourceName_storageAccount(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenerateResourceNameOutput("azurerm_storage_account", "My-Production-Application-Data", "x7k2"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("name", "myproductionapplicatx7k2"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionGenerateResourceName_keyVault(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenerateResourceNameOutput("azurerm_key_vault", "contoso production secrets", "weu-01"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("name", "contoso-productio-weu-01"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionGenerateResourceName_containerRegistry(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGenerateResourceNameOutput("azurerm_container_registry", "shared-images", "dev"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("name", "sharedimagesdev"),
+				),
+			},
+		},
+	})
+}
+
+func testGenerateResourceNameOutput(resourceType, prefix, suffix string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "name" {
+  value = provider::azurerm::generate_resource_name("%s", "%s", "%s")
+}
+`, resourceType, prefix, suffix)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+)
+
+type ParseKeyVaultIDFunction struct{}
+
+var _ function.Function = ParseKeyVaultIDFunction{}
+
+var keyVaultIdParseResultTypes = map[string]attr.Type{
+	"key_vault_base_url": types.StringType,
+	"key_vault_name":     types.StringType,
+	"nested_item_type":   types.StringType,
+	"name":               types.StringType,
+	"version":            types.StringType,
+	"versionless_id":     types.StringType,
+}
+
+func NewParseKeyVaultIDFunction() function.Function {
+	return &ParseKeyVaultIDFunction{}
+}
+
+func (p ParseKeyVaultIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_key_vault_id"
+}
+
+func (p ParseKeyVaultIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_key_vault_id",
+		Description:         "Parses a versioned or versionless Key Vault Secret, Key or Certificate ID and exposes the contained information",
+		MarkdownDescription: "Parses a versioned or versionless Key Vault Secret, Key or Certificate ID and exposes the contained information",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Key Vault Secret, Key or Certificate ID",
+				MarkdownDescription: "Key Vault Secret, Key or Certificate ID",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: keyVaultIdParseResultTypes,
+		},
+	}
+}
+
+func (p ParseKeyVaultIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id))
+
+	if response.Error != nil {
+		return
+	}
+
+	parsed, err := parse.ParseOptionallyVersionedNestedItemID(id)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Parsing Key Vault ID Error: %s", err))
+		return
+	}
+
+	switch parsed.NestedItemType {
+	case parse.NestedItemTypeSecret, parse.NestedItemTypeKey, parse.NestedItemTypeCertificate:
+	default:
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected the nested item type to be one of `secrets`, `keys` or `certificates` but got %q", string(parsed.NestedItemType)))
+		return
+	}
+
+	baseUrl, err := url.Parse(parsed.KeyVaultBaseUrl)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing Key Vault Base URL %q: %s", parsed.KeyVaultBaseUrl, err))
+		return
+	}
+
+	output := map[string]attr.Value{
+		"key_vault_base_url": types.StringValue(parsed.KeyVaultBaseUrl),
+		"key_vault_name":     types.StringValue(strings.Split(baseUrl.Hostname(), ".")[0]),
+		"nested_item_type":   types.StringValue(string(parsed.NestedItemType)),
+		"name":               types.StringValue(parsed.Name),
+		"version":            types.StringValue(parsed.Version),
+		"versionless_id":     types.StringValue(parsed.VersionlessID()),
+	}
+
+	result, diags := types.ObjectValue(keyVaultIdParseResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionParseKeyVaultID_versioned(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseKeyVaultIdOutput("https://my-vault.vault.azure.net/secrets/secret1/abc123"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("key_vault_base_url", "https://my-vault.vault.azure.net/"),
+					acceptance.TestCheckOutput("key_vault_name", "my-vault"),
+					acceptance.TestCheckOutput("nested_item_type", "secrets"),
+					acceptance.TestCheckOutput("name", "secret1"),
+					acceptance.TestCheckOutput("version", "abc123"),
+					acceptance.TestCheckOutput("versionless_id", "https://my-vault.vault.azure.net/secrets/secret1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseKeyVaultID_versionless(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseKeyVaultIdOutput("https://my-vault.vault.azure.net/certificates/cert1"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("key_vault_base_url", "https://my-vault.vault.azure.net/"),
+					acceptance.TestCheckOutput("key_vault_name", "my-vault"),
+					acceptance.TestCheckOutput("nested_item_type", "certificates"),
+					acceptance.TestCheckOutput("name", "cert1"),
+					acceptance.TestCheckOutput("version", ""),
+					acceptance.TestCheckOutput("versionless_id", "https://my-vault.vault.azure.net/certificates/cert1"),
+				),
+			},
+		},
+	})
+}
+
+func testParseKeyVaultIdOutput(id string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  parsed_id = provider::azurerm::parse_key_vault_id("%s")
+}
+
+output "key_vault_base_url" {
+  value = local.parsed_id["key_vault_base_url"]
+}
+
+output "key_vault_name" {
+  value = local.parsed_id["key_vault_name"]
+}
+
+output "nested_item_type" {
+  value = local.parsed_id["nested_item_type"]
+}
+
+output "name" {
+  value = local.parsed_id["name"]
+}
+
+output "version" {
+  value = local.parsed_id["version"]
+}
+
+output "versionless_id" {
+  value = local.parsed_id["versionless_id"]
+}
+`, id)
+}
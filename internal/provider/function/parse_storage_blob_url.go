@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type ParseStorageBlobURLFunction struct{}
+
+var _ function.Function = ParseStorageBlobURLFunction{}
+
+var storageBlobUrlParseResultTypes = map[string]attr.Type{
+	"storage_account_name":  types.StringType,
+	"container_name":        types.StringType,
+	"blob_name":             types.StringType,
+	"domain_suffix":         types.StringType,
+	"primary_blob_endpoint": types.StringType,
+}
+
+func NewParseStorageBlobURLFunction() function.Function {
+	return &ParseStorageBlobURLFunction{}
+}
+
+func (p ParseStorageBlobURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_storage_blob_url"
+}
+
+func (p ParseStorageBlobURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "parse_storage_blob_url",
+		Description:         "Parses the URL of a Storage Blob and exposes the contained information",
+		MarkdownDescription: "Parses the URL of a Storage Blob and exposes the contained information",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				Description:         "Storage Blob URL",
+				MarkdownDescription: "Storage Blob URL",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: storageBlobUrlParseResultTypes,
+		},
+	}
+}
+
+func (p ParseStorageBlobURLFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	uri, err := url.Parse(input)
+	if err != nil || uri.Host == "" {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected %q to be a URL", input))
+		return
+	}
+
+	domainSuffix, err := storageDomainSuffix(uri.Hostname())
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// the port (if any) isn't part of the Blob ID, and any query string (e.g. a SAS Token) is ignored
+	uri.Host = uri.Hostname()
+	uri.RawQuery = ""
+	parsed, err := blobs.ParseBlobID(uri.String(), domainSuffix)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Parsing Storage Blob URL Error: %s", err))
+		return
+	}
+
+	if parsed.BlobName == "" {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected %q to contain a Blob Name", input))
+		return
+	}
+
+	output := map[string]attr.Value{
+		"storage_account_name":  types.StringValue(parsed.AccountId.AccountName),
+		"container_name":        types.StringValue(parsed.ContainerName),
+		"blob_name":             types.StringValue(parsed.BlobName),
+		"domain_suffix":         types.StringValue(parsed.AccountId.DomainSuffix),
+		"primary_blob_endpoint": types.StringValue(fmt.Sprintf("%s/", parsed.AccountId.ID())),
+	}
+
+	result, diags := types.ObjectValue(storageBlobUrlParseResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// storageDomainSuffix determines the domain suffix used by the Storage Account from the hostname of the Blob
+// Endpoint, which can be `{account}.blob.{suffix}`, `{account}.{dnszone}.blob.{suffix}` or
+// `{account}.blob.{edgezone}.edgestorage.{suffix}` - since this isn't known within a provider function
+func storageDomainSuffix(hostName string) (string, error) {
+	lowered := strings.ToLower(hostName)
+
+	if i := strings.Index(lowered, ".edgestorage."); i != -1 {
+		return hostName[i+1:], nil
+	}
+
+	if i := strings.Index(lowered, ".blob."); i != -1 {
+		return hostName[i+len(".blob."):], nil
+	}
+
+	return "", fmt.Errorf("expected the hostname %q to be a Storage Blob Endpoint", hostName)
+}
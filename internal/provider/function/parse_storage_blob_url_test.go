@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionParseStorageBlobURL_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseStorageBlobUrlOutput("https://account1.blob.core.windows.net/container1/path/to/blob1.txt"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account_name", "account1"),
+					acceptance.TestCheckOutput("container_name", "container1"),
+					acceptance.TestCheckOutput("blob_name", "path/to/blob1.txt"),
+					acceptance.TestCheckOutput("domain_suffix", "core.windows.net"),
+					acceptance.TestCheckOutput("primary_blob_endpoint", "https://account1.blob.core.windows.net/"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseStorageBlobURL_dnsZone(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseStorageBlobUrlOutput("https://account1.z12.blob.storage.azure.net/container1/blob1.txt"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account_name", "account1"),
+					acceptance.TestCheckOutput("container_name", "container1"),
+					acceptance.TestCheckOutput("blob_name", "blob1.txt"),
+					acceptance.TestCheckOutput("domain_suffix", "storage.azure.net"),
+					acceptance.TestCheckOutput("primary_blob_endpoint", "https://account1.z12.blob.storage.azure.net/"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionParseStorageBlobURL_sasToken(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testParseStorageBlobUrlOutput("https://account1.blob.core.windows.net/container1/blob1.txt?sv=2023-11-03&sig=abc"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("storage_account_name", "account1"),
+					acceptance.TestCheckOutput("container_name", "container1"),
+					acceptance.TestCheckOutput("blob_name", "blob1.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testParseStorageBlobUrlOutput(url string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  parsed_url = provider::azurerm::parse_storage_blob_url("%s")
+}
+
+output "storage_account_name" {
+  value = local.parsed_url["storage_account_name"]
+}
+
+output "container_name" {
+  value = local.parsed_url["container_name"]
+}
+
+output "blob_name" {
+  value = local.parsed_url["blob_name"]
+}
+
+output "domain_suffix" {
+  value = local.parsed_url["domain_suffix"]
+}
+
+output "primary_blob_endpoint" {
+  value = local.parsed_url["primary_blob_endpoint"]
+}
+`, url)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type ResourceIDMatchesTypeFunction struct{}
+
+var _ function.Function = ResourceIDMatchesTypeFunction{}
+
+func NewResourceIDMatchesTypeFunction() function.Function {
+	return &ResourceIDMatchesTypeFunction{}
+}
+
+func (r ResourceIDMatchesTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "resource_id_matches_type"
+}
+
+func (r ResourceIDMatchesTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "resource_id_matches_type",
+		Description:         "Checks whether an Azure Resource Manager ID is of the specified full resource type, ignoring casing",
+		MarkdownDescription: "Checks whether an Azure Resource Manager ID is of the specified full resource type, ignoring casing",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The full resource type, such as Microsoft.Network/virtualNetworks/subnets",
+				MarkdownDescription: "The full resource type, such as `Microsoft.Network/virtualNetworks/subnets`",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (r ResourceIDMatchesTypeFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id, resourceType string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id, &resourceType))
+
+	if response.Error != nil {
+		return
+	}
+
+	actual, err := fullResourceTypeFromID(id)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result := strings.EqualFold(actual, strings.Trim(resourceType, "/"))
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// fullResourceTypeFromID returns the full resource type (e.g. `Microsoft.Network/virtualNetworks/subnets`) for the
+// specified Resource ID, without needing the Resource ID to be known to the Provider
+func fullResourceTypeFromID(id string) (string, error) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 2 || len(id) == 0 {
+		return "", fmt.Errorf("expected %q to be an Azure Resource Manager ID", id)
+	}
+
+	// the last `providers` segment is the one describing this resource, since any prior ones are part of the scope
+	providersIndex := -1
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			providersIndex = i
+			break
+		}
+	}
+
+	if providersIndex == -1 {
+		switch {
+		case len(segments) == 2 && strings.EqualFold(segments[0], "subscriptions"):
+			return "Microsoft.Resources/subscriptions", nil
+		case len(segments) == 4 && strings.EqualFold(segments[0], "subscriptions") && strings.EqualFold(segments[2], "resourceGroups"):
+			return "Microsoft.Resources/resourceGroups", nil
+		}
+		return "", fmt.Errorf("expected %q to contain a `providers` segment", id)
+	}
+
+	// the remaining segments are `{Namespace}/{type}/{name}[/{type}/{name}]`
+	remaining := segments[providersIndex+1:]
+	if len(remaining) < 3 || len(remaining[1:])%2 != 0 {
+		return "", fmt.Errorf("expected %q to contain a `{type}/{name}` pair for each resource following the resource provider %q", id, remaining[0])
+	}
+
+	components := []string{remaining[0]}
+	for i := 1; i < len(remaining); i += 2 {
+		if remaining[i] == "" || remaining[i+1] == "" {
+			return "", fmt.Errorf("expected %q to not contain any empty segments", id)
+		}
+		components = append(components, remaining[i])
+	}
+
+	return strings.Join(components, "/"), nil
+}
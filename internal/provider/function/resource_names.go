@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	appConfigurationValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	managedHSMValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
+	serviceBusValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourceNameRule describes the naming rules for a Resource, which are validated using the same function as the
+// `name` field within the Resource's Schema - and used to sanitise a generated name prior to validating it
+type resourceNameRule struct {
+	// validateFunc is the validation function used for the `name` field within the Resource
+	validateFunc pluginsdk.SchemaValidateFunc
+
+	// maxLength is the maximum length of the name
+	maxLength int
+
+	// lowercase specifies whether the name must be lowercase
+	lowercase bool
+
+	// allowHyphens specifies whether hyphens are allowed within the name, in which case the prefix and suffix
+	// are joined using a hyphen
+	allowHyphens bool
+}
+
+var resourceNameRules = map[string]resourceNameRule{
+	"azurerm_app_configuration": {
+		validateFunc: appConfigurationValidate.ConfigurationStoreName,
+		maxLength:    50,
+		allowHyphens: true,
+	},
+	"azurerm_container_registry": {
+		validateFunc: containerValidate.ContainerRegistryName,
+		maxLength:    49,
+	},
+	"azurerm_key_vault": {
+		validateFunc: keyVaultValidate.VaultName,
+		maxLength:    24,
+		allowHyphens: true,
+	},
+	"azurerm_key_vault_certificate": {
+		validateFunc: keyVaultValidate.NestedItemName,
+		maxLength:    127,
+		allowHyphens: true,
+	},
+	"azurerm_key_vault_key": {
+		validateFunc: keyVaultValidate.NestedItemName,
+		maxLength:    127,
+		allowHyphens: true,
+	},
+	"azurerm_key_vault_managed_hardware_security_module": {
+		validateFunc: managedHSMValidate.ManagedHardwareSecurityModuleName,
+		maxLength:    24,
+		allowHyphens: true,
+	},
+	"azurerm_key_vault_secret": {
+		validateFunc: keyVaultValidate.NestedItemName,
+		maxLength:    127,
+		allowHyphens: true,
+	},
+	"azurerm_log_analytics_workspace": {
+		validateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceName,
+		maxLength:    63,
+		allowHyphens: true,
+	},
+	"azurerm_servicebus_namespace": {
+		validateFunc: serviceBusValidate.NamespaceName,
+		maxLength:    50,
+		allowHyphens: true,
+	},
+	"azurerm_storage_account": {
+		validateFunc: storageValidate.StorageAccountName,
+		maxLength:    24,
+		lowercase:    true,
+	},
+	"azurerm_storage_container": {
+		validateFunc: storageValidate.StorageContainerName,
+		maxLength:    63,
+		lowercase:    true,
+		allowHyphens: true,
+	},
+	"azurerm_storage_queue": {
+		validateFunc: storageValidate.StorageQueueName,
+		maxLength:    63,
+		lowercase:    true,
+		allowHyphens: true,
+	},
+	"azurerm_storage_share": {
+		validateFunc: storageValidate.StorageShareName,
+		maxLength:    63,
+		lowercase:    true,
+		allowHyphens: true,
+	},
+	"azurerm_storage_table": {
+		validateFunc: storageValidate.StorageTableName,
+		maxLength:    63,
+	},
+}
+
+func supportedResourceNameTypes() string {
+	out := make([]string, 0, len(resourceNameRules))
+	for k := range resourceNameRules {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return fmt.Sprintf("`%s`", strings.Join(out, "`, `"))
+}
+
+func resourceNameRuleFor(resourceType string) (*resourceNameRule, error) {
+	rule, ok := resourceNameRules[strings.ToLower(resourceType)]
+	if !ok {
+		return nil, fmt.Errorf("the resource type %q is not supported, supported resource types are: %s", resourceType, supportedResourceNameTypes())
+	}
+
+	return &rule, nil
+}
+
+// validate returns the errors (if any) from validating the name using the validation function for this Resource
+func (r resourceNameRule) validate(name string) []string {
+	_, errs := r.validateFunc(name, "name")
+
+	out := make([]string, 0, len(errs))
+	for _, err := range errs {
+		out = append(out, err.Error())
+	}
+	return out
+}
+
+var (
+	resourceNameInvalidCharactersWithHyphens = regexp.MustCompile(`[^a-zA-Z0-9-]`)
+	resourceNameInvalidCharacters            = regexp.MustCompile(`[^a-zA-Z0-9]`)
+	resourceNameConsecutiveHyphens           = regexp.MustCompile(`-{2,}`)
+)
+
+// sanitise removes any characters which aren't supported within the name and corrects the casing as required
+func (r resourceNameRule) sanitise(input string) string {
+	out := input
+	if r.lowercase {
+		out = strings.ToLower(out)
+	}
+
+	if r.allowHyphens {
+		out = resourceNameInvalidCharactersWithHyphens.ReplaceAllString(out, "-")
+		out = resourceNameConsecutiveHyphens.ReplaceAllString(out, "-")
+		return strings.Trim(out, "-")
+	}
+
+	return resourceNameInvalidCharacters.ReplaceAllString(out, "")
+}
+
+// generate builds a name from the prefix and suffix, truncating the prefix where the name would otherwise exceed
+// the maximum length, since the suffix is generally used to make the name unique
+func (r resourceNameRule) generate(prefix, suffix string) (string, error) {
+	prefix = r.sanitise(prefix)
+	suffix = r.sanitise(suffix)
+	if prefix == "" && suffix == "" {
+		return "", fmt.Errorf("at least one of the prefix and suffix must contain a supported character")
+	}
+
+	separator := ""
+	if r.allowHyphens && prefix != "" && suffix != "" {
+		separator = "-"
+	}
+
+	if available := r.maxLength - len(suffix) - len(separator); len(prefix) > available {
+		if available < 0 {
+			return "", fmt.Errorf("the suffix %q exceeds the maximum length of %d characters", suffix, r.maxLength)
+		}
+		prefix = strings.TrimRight(prefix[:available], "-")
+		if prefix == "" {
+			separator = ""
+		}
+	}
+
+	return prefix + separator + suffix, nil
+}
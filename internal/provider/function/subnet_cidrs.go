@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// azureSubnetMinimumPrefixLength is the largest Subnet supported by Azure (a /2)
+	azureSubnetMinimumPrefixLength = 2
+
+	// azureSubnetMaximumPrefixLength is the smallest Subnet supported by Azure (a /29), since Azure
+	// reserves 5 IP Addresses within each Subnet
+	azureSubnetMaximumPrefixLength = 29
+
+	// azureSubnetReservedAddressCount is the number of IP Addresses reserved within each Subnet - the
+	// network address, the default gateway, two addresses for Azure DNS and the broadcast address
+	azureSubnetReservedAddressCount = 5
+)
+
+type SubnetCIDRsFunction struct{}
+
+var _ function.Function = SubnetCIDRsFunction{}
+
+var subnetCidrResultTypes = map[string]attr.Type{
+	"address_prefix":          types.StringType,
+	"first_usable_ip_address": types.StringType,
+	"last_usable_ip_address":  types.StringType,
+	"usable_ip_address_count": types.Int64Type,
+}
+
+func NewSubnetCIDRsFunction() function.Function {
+	return &SubnetCIDRsFunction{}
+}
+
+func (s SubnetCIDRsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_cidrs"
+}
+
+func (s SubnetCIDRsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "subnet_cidrs",
+		Description:         "Allocates consecutive Subnets within an IPv4 Virtual Network address space, taking the IP Addresses reserved by Azure in each Subnet into account",
+		MarkdownDescription: "Allocates consecutive Subnets within an IPv4 Virtual Network address space, taking the IP Addresses reserved by Azure in each Subnet into account",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "address_space",
+				Description:         "The address space of the Virtual Network in CIDR notation, such as 10.0.0.0/16",
+				MarkdownDescription: "The address space of the Virtual Network in CIDR notation, such as `10.0.0.0/16`",
+			},
+			function.ListParameter{
+				Name:                "new_bits",
+				ElementType:         types.Int64Type,
+				Description:         "The number of additional prefix bits for each Subnet, for example 8 allocates a /24 within a /16",
+				MarkdownDescription: "The number of additional prefix bits for each Subnet, for example `8` allocates a `/24` within a `/16`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: subnetCidrResultTypes,
+			},
+		},
+	}
+}
+
+func (s SubnetCIDRsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpace string
+	var newBits []int64
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpace, &newBits))
+
+	if response.Error != nil {
+		return
+	}
+
+	_, network, err := net.ParseCIDR(addressSpace)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing %q: %s", addressSpace, err))
+		return
+	}
+
+	networkAddress := network.IP.To4()
+	if networkAddress == nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected %q to be an IPv4 address space", addressSpace))
+		return
+	}
+
+	prefixLength, _ := network.Mask.Size()
+	start := uint64(binary.BigEndian.Uint32(networkAddress))
+	end := start + (uint64(1) << (32 - prefixLength))
+
+	results := make([]attr.Value, 0, len(newBits))
+	next := start
+	for i, bits := range newBits {
+		subnetPrefixLength := int64(prefixLength) + bits
+		if bits < 0 || subnetPrefixLength < azureSubnetMinimumPrefixLength || subnetPrefixLength > azureSubnetMaximumPrefixLength {
+			response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the Subnet at index %d would be a /%d, but Azure only supports Subnets between a /%d and a /%d", i, subnetPrefixLength, azureSubnetMinimumPrefixLength, azureSubnetMaximumPrefixLength))
+			return
+		}
+
+		// each Subnet must be aligned to its own size, so skip over any gap left by the previous Subnet
+		size := uint64(1) << (32 - subnetPrefixLength)
+		subnetStart := (next + size - 1) &^ (size - 1)
+		if subnetStart+size > end {
+			response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("insufficient address space in %q to allocate the Subnet at index %d (a /%d)", addressSpace, i, subnetPrefixLength))
+			return
+		}
+		next = subnetStart + size
+
+		// Azure reserves the first four IP Addresses and the last IP Address within each Subnet
+		output := map[string]attr.Value{
+			"address_prefix":          types.StringValue(fmt.Sprintf("%s/%d", ipv4FromUint64(subnetStart), subnetPrefixLength)),
+			"first_usable_ip_address": types.StringValue(ipv4FromUint64(subnetStart + azureSubnetReservedAddressCount - 1).String()),
+			"last_usable_ip_address":  types.StringValue(ipv4FromUint64(subnetStart + size - 2).String()),
+			"usable_ip_address_count": types.Int64Value(int64(size) - azureSubnetReservedAddressCount),
+		}
+		result, diags := types.ObjectValue(subnetCidrResultTypes, output)
+		if diags.HasError() {
+			response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+			return
+		}
+		results = append(results, result)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: subnetCidrResultTypes}, results)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, list))
+}
+
+func ipv4FromUint64(input uint64) net.IP {
+	out := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(out, uint32(input))
+	return out
+}
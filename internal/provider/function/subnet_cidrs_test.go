@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionSubnetCIDRs_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetCidrsOutput("10.0.0.0/16", "[8, 8, 10]"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("subnet_count", "3"),
+					acceptance.TestCheckOutput("first_prefix", "10.0.0.0/24"),
+					acceptance.TestCheckOutput("first_first_usable", "10.0.0.4"),
+					acceptance.TestCheckOutput("first_last_usable", "10.0.0.254"),
+					acceptance.TestCheckOutput("first_usable_count", "251"),
+					acceptance.TestCheckOutput("last_prefix", "10.0.2.0/26"),
+					acceptance.TestCheckOutput("last_usable_count", "59"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionSubnetCIDRs_aligned(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testSubnetCidrsOutput("10.1.0.0/24", "[5, 3]"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("subnet_count", "2"),
+					acceptance.TestCheckOutput("first_prefix", "10.1.0.0/29"),
+					acceptance.TestCheckOutput("first_first_usable", "10.1.0.4"),
+					acceptance.TestCheckOutput("first_last_usable", "10.1.0.6"),
+					acceptance.TestCheckOutput("first_usable_count", "3"),
+					acceptance.TestCheckOutput("last_prefix", "10.1.0.32/27"),
+					acceptance.TestCheckOutput("last_usable_count", "27"),
+				),
+			},
+		},
+	})
+}
+
+func testSubnetCidrsOutput(addressSpace, newBits string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  subnets = provider::azurerm::subnet_cidrs("%s", %s)
+}
+
+output "subnet_count" {
+  value = length(local.subnets)
+}
+
+output "first_prefix" {
+  value = local.subnets[0]["address_prefix"]
+}
+
+output "first_first_usable" {
+  value = local.subnets[0]["first_usable_ip_address"]
+}
+
+output "first_last_usable" {
+  value = local.subnets[0]["last_usable_ip_address"]
+}
+
+output "first_usable_count" {
+  value = local.subnets[0]["usable_ip_address_count"]
+}
+
+output "last_prefix" {
+  value = local.subnets[length(local.subnets) - 1]["address_prefix"]
+}
+
+output "last_usable_count" {
+  value = local.subnets[length(local.subnets) - 1]["usable_ip_address_count"]
+}
+`, addressSpace, newBits)
+}
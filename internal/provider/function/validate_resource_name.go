@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ValidateResourceNameFunction struct{}
+
+var _ function.Function = ValidateResourceNameFunction{}
+
+var validateResourceNameResultTypes = map[string]attr.Type{
+	"valid": types.BoolType,
+	"errors": types.ListType{
+		ElemType: types.StringType,
+	},
+}
+
+func NewValidateResourceNameFunction() function.Function {
+	return &ValidateResourceNameFunction{}
+}
+
+func (v ValidateResourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "validate_resource_name"
+}
+
+func (v ValidateResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "validate_resource_name",
+		Description:         "Validates a name against the naming rules used by the Provider for the specified resource type",
+		MarkdownDescription: "Validates a name against the naming rules used by the Provider for the specified resource type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				Description:         "The Terraform resource type, such as azurerm_storage_account",
+				MarkdownDescription: "The Terraform resource type, such as `azurerm_storage_account`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				Description:         "The name to validate",
+				MarkdownDescription: "The name to validate",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: validateResourceNameResultTypes,
+		},
+	}
+}
+
+func (v ValidateResourceNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType, name string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &name))
+
+	if response.Error != nil {
+		return
+	}
+
+	rule, err := resourceNameRuleFor(resourceType)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	validationErrors := rule.validate(name)
+	errorValues, diags := types.ListValueFrom(ctx, types.StringType, validationErrors)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	output := map[string]attr.Value{
+		"valid":  types.BoolValue(len(validationErrors) == 0),
+		"errors": errorValues,
+	}
+
+	result, diags := types.ObjectValue(validateResourceNameResultTypes, output)
+	if diags.HasError() {
+		response.Error = function.ConcatFuncErrors(response.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
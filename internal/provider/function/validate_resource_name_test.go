@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionValidateResourceName_valid(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateResourceNameOutput("azurerm_storage_account", "examplestorage01"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid", "true"),
+					acceptance.TestCheckOutput("error_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_invalid(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateResourceNameOutput("azurerm_storage_account", "Example-Storage"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid", "false"),
+					acceptance.TestCheckOutput("error_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionValidateResourceName_keyVault(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testValidateResourceNameOutput("azurerm_key_vault", "example-keyvault-with-a-long-name"),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("valid", "false"),
+					acceptance.TestCheckOutput("error_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testValidateResourceNameOutput(resourceType, name string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  result = provider::azurerm::validate_resource_name("%s", "%s")
+}
+
+output "valid" {
+  value = local.result["valid"]
+}
+
+output "error_count" {
+  value = length(local.result["errors"])
+}
+`, resourceType, name)
+}
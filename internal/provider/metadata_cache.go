@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
+)
+
+// expandMetadataCache returns the on-disk metadata cache configured in the provider block - which is nil when the
+// metadata cache isn't enabled
+func expandMetadataCache(d *schema.ResourceData) (*metadatacache.Cache, error) {
+	ttl := time.Duration(d.Get("metadata_cache_ttl_in_minutes").(int)) * time.Minute
+	return metadatacache.New(d.Get("metadata_cache_directory").(string), ttl)
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -31,6 +33,14 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// TestAzureProviderWithRecorder returns the Provider used for acceptance tests, where requests sent to Azure are
+// recorded (or replayed from an existing recording) using the specified RequestRecorder
+func TestAzureProviderWithRecorder(recorder common.RequestRecorder) *schema.Provider {
+	p := azureProvider(true)
+	p.ConfigureContextFunc = providerConfigureWithRecorder(p, recorder)
+	return p
+}
+
 func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	// ValidatePartnerID checks if partner_id is any of the following:
 	//  * a valid UUID - will add "pid-" prefix to the ID if it is not already present
@@ -139,6 +149,18 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// merge the tags defined in the `default_tags` block into each taggable resource, surfacing these via `tags_all`
+	providerTags := func(meta interface{}) *tags.ProviderConfig {
+		if client, ok := meta.(*clients.Client); ok {
+			return client.Tags
+		}
+		return nil
+	}
+	for _, v := range resources {
+		tags.EnableDefaultTags(v, providerTags)
+		tags.EnableTagsAll(v, providerTags)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -321,6 +343,120 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"max_concurrent_write_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_WRITE_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of write requests which can be in-flight at once for each Subscription and Resource Provider. Defaults to `0`, meaning no limit.",
+			},
+
+			"metadata_cache_directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_CACHE_DIRECTORY", ""),
+				Description: "The path to a directory in which metadata (such as the registered Resource Providers) is cached between runs of the Provider. Defaults to an empty string, meaning metadata isn't cached.",
+			},
+
+			"metadata_cache_ttl_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_METADATA_CACHE_TTL_IN_MINUTES", 60),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of minutes for which cached metadata is used. Defaults to `60`.",
+			},
+
+			"ignore_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A set of tags which are managed outside of Terraform (for example by Azure Policy) and should be ignored by all resources managed by this Provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of tag keys which should be ignored.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"key_prefixes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of tag key prefixes, where tags with a matching key should be ignored.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A set of tags which should be applied to all taggable resources managed by this Provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: tags.Validate,
+							Description:  "A mapping of tags which should be assigned to all taggable resources, which are overridden by tags with the same key defined on the resource.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configures how requests to Azure which are throttled or fail with a transient error are retried.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of times a request is sent, including the first attempt. Defaults to `5`.",
+						},
+
+						"min_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateRetryBackoff,
+							Description:  "The delay before the first retry as a duration (e.g. `2s`), which is doubled for each subsequent retry. Defaults to `2s`.",
+						},
+
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateRetryBackoff,
+							Description:  "The maximum delay between retries as a duration (e.g. `60s`), unless a longer delay is requested by Azure. Defaults to `60s`.",
+						},
+
+						"retry_on_status_codes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of HTTP Status Codes which should be retried. Defaults to `429`, `500`, `502`, `503` and `504`.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+						},
+					},
+				},
+			},
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -361,6 +497,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
+
+			"use_resource_graph_for_refresh": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_RESOURCE_GRAPH_FOR_REFRESH", false),
+				Description: "(Experimental) Should resources which support it be refreshed from a snapshot retrieved from Azure Resource Graph, rather than retrieving each resource from Resource Manager? Defaults to `false`.",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -375,6 +518,15 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		delete(p.Schema, "resource_providers_to_register")
 	}
 
+	// the diff for tags matching the `ignore_tags` block is suppressed, which uses the configuration of this instance
+	// of the Provider since the Provider's meta isn't available when diffing
+	instanceTags := func() *tags.ProviderConfig {
+		return providerTags(p.Meta())
+	}
+	for _, v := range resources {
+		tags.EnableIgnoredTags(v, instanceTags)
+	}
+
 	p.ConfigureContextFunc = providerConfigure(p)
 
 	return p
@@ -384,6 +536,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 // To configure behavioral aspects of the provider, use the buildClient function instead.
 // This separation allows us to robustly test different authentication scenarios.
 func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+	return providerConfigureWithRecorder(p, nil)
+}
+
+func providerConfigureWithRecorder(p *schema.Provider, recorder common.RequestRecorder) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -433,8 +589,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 		)
 
 		if metadataHost != "" {
+			metadataCache, err := expandMetadataCache(d)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+
 			logEntry("[DEBUG] Configuring cloud environment from Metadata Service at %q", metadataHost)
-			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
+			if env, err = metadataCache.EnvironmentFromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
 				return nil, diag.FromErr(err)
 			}
 		} else {
@@ -477,13 +638,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			EnableAuthenticationUsingGitHubOIDC:        enableOidc,
 		}
 
-		return buildClient(ctx, p, d, authConfig)
+		return buildClient(ctx, p, d, authConfig, recorder)
 	}
 }
 
 // buildClient is used to configure behavioral aspects of the provider. To configure the
 // cloud environment and authentication-related settings, use the providerConfigure function.
-func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
+func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, recorder common.RequestRecorder) (*clients.Client, diag.Diagnostics) {
 	// TODO: This hardcoded default is for v3.x, where `resource_provider_registrations` is not defined. Remove this hardcoded default in v4.0
 	providerRegistrations := resourceproviders.ProviderRegistrationsLegacy
 	if features.FourPointOhBeta() {
@@ -511,17 +672,32 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		requiredResourceProviders.Merge(additionalProvidersToRegister)
 	}
 
+	ignoredTagKeys, ignoredTagKeyPrefixes := expandIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
+	metadataCache, err := expandMetadataCache(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		DefaultTags:                 expandDefaultTags(d.Get("default_tags").([]interface{})),
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
+		IgnoredTagKeys:              ignoredTagKeys,
+		IgnoredTagKeyPrefixes:       ignoredTagKeyPrefixes,
+		MaxConcurrentWriteRequests:  d.Get("max_concurrent_write_requests").(int),
+		MetadataCache:               metadataCache,
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
+		Recorder:                    recorder,
 		RegisteredResourceProviders: requiredResourceProviders,
+		Retry:                       expandRetry(d.Get("retry").([]interface{})),
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
+		UseResourceGraphForRefresh:  d.Get("use_resource_graph_for_refresh").(bool),
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
@@ -546,7 +722,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
-	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
+	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders, client.ResourceProvidersMetadataCache); err != nil {
 		return nil, diag.FromErr(err)
 
 	}
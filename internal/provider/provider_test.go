@@ -111,6 +111,23 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 	}
 }
 
+func TestResourceIdentitiesAreValid(t *testing.T) {
+	provider := TestAzureProvider()
+	for resourceName, resource := range provider.ResourcesMap {
+		if resource.Identity == nil {
+			continue
+		}
+
+		if err := resource.Identity.InternalIdentityValidate(); err != nil {
+			t.Fatalf("Resource %q has an invalid Resource Identity: %+v", resourceName, err)
+		}
+
+		if resource.Importer == nil {
+			t.Fatalf("Resource %q defines a Resource Identity but doesn't support being imported", resourceName)
+		}
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
@@ -354,7 +371,7 @@ func TestAccProvider_cliAuth(t *testing.T) {
 			EnableAuthenticatingUsingAzureCLI: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -400,7 +417,7 @@ func TestAccProvider_cliAuthWithSubscriptionIdHint(t *testing.T) {
 			AzureCliSubscriptionIDHint:        d.Get("subscription_id").(string),
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -472,7 +489,7 @@ func TestAccProvider_clientCertificateAuth(t *testing.T) {
 			EnableAuthenticatingUsingClientCertificate: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -545,7 +562,7 @@ func testAccProvider_clientSecretAuthFromEnvironment(t *testing.T) {
 			EnableAuthenticatingUsingClientSecret: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -613,7 +630,7 @@ func testAccProvider_clientSecretAuthFromFiles(t *testing.T) {
 			EnableAuthenticatingUsingClientSecret: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -673,7 +690,7 @@ func TestAccProvider_genericOidcAuth(t *testing.T) {
 			OIDCAssertionToken:            *oidcToken,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -732,7 +749,7 @@ func TestAccProvider_githubOidcAuth(t *testing.T) {
 			EnableAuthenticationUsingGitHubOIDC: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -798,7 +815,7 @@ func TestAccProvider_aksWorkloadIdentityAuth(t *testing.T) {
 			EnableAuthenticationUsingOIDC: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	// Ensure we enable AKS Workload Identity else the configuration will not be detected
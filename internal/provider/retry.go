@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func expandRetry(input []interface{}) *common.RetryOptions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	val := input[0].(map[string]interface{})
+
+	// the durations have been validated, and unset values fall back to the defaults
+	minBackoff, _ := time.ParseDuration(val["min_backoff"].(string))
+	maxBackoff, _ := time.ParseDuration(val["max_backoff"].(string))
+
+	statusCodes := make([]int, 0)
+	for _, v := range val["retry_on_status_codes"].([]interface{}) {
+		statusCodes = append(statusCodes, v.(int))
+	}
+
+	return common.NewRetryOptions(val["max_attempts"].(int), minBackoff, maxBackoff, statusCodes)
+}
+
+// ValidateRetryBackoff validates that the value is a duration greater than zero, such as `2s` or `1m`
+func ValidateRetryBackoff(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a duration (e.g. `2s` or `1m`): %+v", k, err)}
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be greater than zero", k))
+	}
+
+	return warnings, errors
+}
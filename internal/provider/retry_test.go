@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExpandRetry(t *testing.T) {
+	if actual := expandRetry([]interface{}{}); actual != nil {
+		t.Fatalf("expected no retry options when the block is omitted but got %+v", actual)
+	}
+
+	actual := expandRetry([]interface{}{
+		map[string]interface{}{
+			"max_attempts":          3,
+			"min_backoff":           "500ms",
+			"max_backoff":           "",
+			"retry_on_status_codes": []interface{}{409, 429},
+		},
+	})
+	if actual.MaxAttempts != 3 {
+		t.Fatalf("expected MaxAttempts to be 3 but got %d", actual.MaxAttempts)
+	}
+	if actual.MinBackoff != 500*time.Millisecond {
+		t.Fatalf("expected MinBackoff to be 500ms but got %s", actual.MinBackoff)
+	}
+	if actual.MaxBackoff != 60*time.Second {
+		t.Fatalf("expected MaxBackoff to default to 60s but got %s", actual.MaxBackoff)
+	}
+	if !reflect.DeepEqual(actual.RetryOnStatusCodes, []int{409, 429}) {
+		t.Fatalf("expected RetryOnStatusCodes to be [409 429] but got %+v", actual.RetryOnStatusCodes)
+	}
+}
+
+func TestValidateRetryBackoff(t *testing.T) {
+	testData := map[string]bool{
+		"2s":    true,
+		"1m30s": true,
+		"0s":    false,
+		"-1s":   false,
+		"5":     false,
+		"soon":  false,
+	}
+
+	for input, valid := range testData {
+		_, errors := ValidateRetryBackoff(input, "min_backoff")
+		if valid != (len(errors) == 0) {
+			t.Fatalf("expected %q to be valid: %t but got %+v", input, valid, errors)
+		}
+	}
+}
@@ -230,6 +230,13 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return services
 }
 
+func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
+	return []sdk.FrameworkServiceRegistration{
+		keyvault.Registration{},
+		storage.Registration{},
+	}
+}
+
 func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 	return func() []sdk.UntypedServiceRegistration {
 		out := []sdk.UntypedServiceRegistration{
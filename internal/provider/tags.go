@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	val := input[0].(map[string]interface{})
+	for k, v := range val["tags"].(map[string]interface{}) {
+		// Validate should have ignored this error already
+		value, _ := tags.TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+func expandIgnoreTags(input []interface{}) (keys []string, keyPrefixes []string) {
+	keys = make([]string, 0)
+	keyPrefixes = make([]string, 0)
+	if len(input) == 0 || input[0] == nil {
+		return keys, keyPrefixes
+	}
+
+	val := input[0].(map[string]interface{})
+	keys = *utils.ExpandStringSlice(val["keys"].([]interface{}))
+	keyPrefixes = *utils.ExpandStringSlice(val["key_prefixes"].([]interface{}))
+
+	return keys, keyPrefixes
+}
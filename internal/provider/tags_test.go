@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: map[string]string{},
+		},
+		{
+			Name:     "Nil Block",
+			Input:    []interface{}{nil},
+			Expected: map[string]string{},
+		},
+		{
+			Name: "With Tags",
+			Input: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"cost_center": "1234",
+						"env":         "prod",
+					},
+				},
+			},
+			Expected: map[string]string{
+				"cost_center": "1234",
+				"env":         "prod",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+		actual := expandDefaultTags(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestExpandIgnoreTags(t *testing.T) {
+	keys, keyPrefixes := expandIgnoreTags([]interface{}{
+		map[string]interface{}{
+			"keys":         []interface{}{"createdBy"},
+			"key_prefixes": []interface{}{"policy-", "hidden-"},
+		},
+	})
+
+	if !reflect.DeepEqual(keys, []string{"createdBy"}) {
+		t.Fatalf("Expected keys to be %+v but got %+v", []string{"createdBy"}, keys)
+	}
+	if !reflect.DeepEqual(keyPrefixes, []string{"policy-", "hidden-"}) {
+		t.Fatalf("Expected key prefixes to be %+v but got %+v", []string{"policy-", "hidden-"}, keyPrefixes)
+	}
+
+	keys, keyPrefixes = expandIgnoreTags([]interface{}{})
+	if len(keys) != 0 || len(keyPrefixes) != 0 {
+		t.Fatalf("Expected no keys or key prefixes but got %+v and %+v", keys, keyPrefixes)
+	}
+}
+
+func TestTypedResourcesInheritDefaultTags(t *testing.T) {
+	provider := TestAzureProvider()
+
+	for _, service := range SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			v, ok := r.Arguments()["tags"]
+			if !ok || v.Type != pluginsdk.TypeMap || v.ForceNew || !(v.Optional || v.Required) {
+				continue
+			}
+			if _, ok := r.(sdk.ResourceWithUpdate); !ok {
+				continue
+			}
+
+			resource, ok := provider.ResourcesMap[r.ResourceType()]
+			if !ok {
+				t.Fatalf("the Resource %q wasn't registered", r.ResourceType())
+			}
+			if _, ok := resource.Schema["tags_all"]; !ok {
+				t.Fatalf("the Typed Resource %q should inherit the Provider's default tags and expose `tags_all`", r.ResourceType())
+			}
+		}
+	}
+}
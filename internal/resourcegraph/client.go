@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegraph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+)
+
+// apiVersion is the version of the Resource Graph API used to query resources - there's no SDK for Resource Graph
+// within `hashicorp/go-azure-sdk`, so the query is sent using the base client
+const apiVersion = "2021-03-01"
+
+// pageSize is the maximum number of resources returned by each query, which is the maximum Resource Graph supports
+const pageSize = 1000
+
+type queryRequest struct {
+	Subscriptions []string            `json:"subscriptions"`
+	Query         string              `json:"query"`
+	Options       queryRequestOptions `json:"options"`
+}
+
+type queryRequestOptions struct {
+	ResultFormat string  `json:"resultFormat"`
+	SkipToken    *string `json:"$skipToken,omitempty"`
+	Top          int     `json:"$top"`
+}
+
+type queryResponse struct {
+	Count     int64             `json:"count"`
+	Data      []json.RawMessage `json:"data"`
+	SkipToken *string           `json:"$skipToken,omitempty"`
+}
+
+type queryClient struct {
+	client *resourcemanager.Client
+}
+
+// resources sends the query for resources within the Subscription, returning a single page of results
+func (c queryClient) resources(ctx context.Context, subscriptionId string, query string, skipToken *string) (*queryResponse, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       "/providers/Microsoft.ResourceGraph/resources",
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	input := queryRequest{
+		Subscriptions: []string{subscriptionId},
+		Query:         query,
+		Options: queryRequestOptions{
+			ResultFormat: "objectArray",
+			SkipToken:    skipToken,
+			Top:          pageSize,
+		},
+	}
+	if err := req.Marshal(input); err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var result queryResponse
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// queryTimeout is the maximum duration of the queries used to retrieve the resources within a Subscription
+const queryTimeout = 5 * time.Minute
+
+// supportedResourceType is a Resource Type which can be served from the Snapshot
+type supportedResourceType struct {
+	// resourceType is the Resource Type as returned from Resource Manager, since Resource Graph returns this lower-cased
+	resourceType string
+
+	// apiVersion is the API Version of the SDK model which the `properties` returned from Resource Graph for this
+	// Resource Type are deserialized into
+	apiVersion string
+}
+
+// supportedResourceTypes are the Resource Types (keyed by the lower-cased Resource Type) which can be served from the
+// Snapshot, which is an explicit opt-in for each Resource Type.
+//
+// Resource Graph doesn't return the API Version used for the `properties` of each resource, so resources are only
+// served from the Snapshot when the SDK model used by the resource is for the API Version listed here - when the SDK
+// model is updated to a newer API Version the resource is retrieved from Resource Manager, until the shape of the
+// `properties` returned from Resource Graph has been checked against the newer API Version and this is updated.
+var supportedResourceTypes = map[string]supportedResourceType{
+	"microsoft.compute/availabilitysets": {
+		resourceType: "Microsoft.Compute/availabilitySets",
+		apiVersion:   "2024-03-01",
+	},
+	"microsoft.compute/proximityplacementgroups": {
+		resourceType: "Microsoft.Compute/proximityPlacementGroups",
+		apiVersion:   "2022-03-01",
+	},
+	"microsoft.network/applicationsecuritygroups": {
+		resourceType: "Microsoft.Network/applicationSecurityGroups",
+		apiVersion:   "2023-11-01",
+	},
+}
+
+// Snapshot serves the Resource Manager representation of resources from Azure Resource Graph, allowing resources
+// to be refreshed without sending a request to Resource Manager for each resource - which otherwise trips the
+// read throttling limits for Resource Manager when refreshing thousands of resources.
+//
+// The resources of each of the supported Resource Types within a Subscription are retrieved in batches the first time
+// a resource within the Subscription is looked up. Resource Graph is eventually consistent, so resources which have
+// been written to by this Provider (or which are a parent/child of a resource which has been written to) are never
+// served from the Snapshot - and resources missing from the Snapshot are looked up from Resource Manager as usual.
+//
+// A nil Snapshot is valid and serves no resources, which is the default.
+type Snapshot struct {
+	client queryClient
+
+	lock          sync.Mutex
+	subscriptions map[string]*subscriptionSnapshot
+	written       []string
+}
+
+type subscriptionSnapshot struct {
+	once      sync.Once
+	resources map[string]resourceGraphRow
+	err       error
+}
+
+// resourceGraphRow is a resource returned from the `resources` table in Resource Graph, containing the columns which
+// make up the top-level Resource Manager representation of the resource
+type resourceGraphRow struct {
+	Id         string            `json:"id"`
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	Location   string            `json:"location"`
+	Tags       map[string]string `json:"tags"`
+	Sku        json.RawMessage   `json:"sku"`
+	Zones      []string          `json:"zones"`
+	Properties json.RawMessage   `json:"properties"`
+}
+
+// resourceManagerResource is the Resource Manager representation of a resource (that is: the response from a GET
+// for the resource), which is built from the resourceGraphRow
+type resourceManagerResource struct {
+	Id         string            `json:"id"`
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	Location   string            `json:"location,omitempty"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	Sku        json.RawMessage   `json:"sku,omitempty"`
+	Zones      []string          `json:"zones,omitempty"`
+	Properties json.RawMessage   `json:"properties"`
+}
+
+func NewSnapshot(o *common.ClientOptions) (*Snapshot, error) {
+	client, err := resourcemanager.NewResourceManagerClient(o.Environment.ResourceManager, "resourcegraph", apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building Resource Graph client: %+v", err)
+	}
+	o.Configure(client, o.Authorizers.ResourceManager)
+
+	return &Snapshot{
+		client: queryClient{
+			client: client,
+		},
+		subscriptions: make(map[string]*subscriptionSnapshot),
+	}, nil
+}
+
+// Lookup populates `model` (a pointer to the SDK model for the resource) with the Resource Manager representation of
+// the resource with the ID from the Snapshot, returning whether the resource was found.
+//
+// This should only be used by resources which only require the top-level Resource Manager representation of the
+// resource (that is: the same response as a GET for the resource) and whose Resource Type is listed in
+// `supportedResourceTypes` - when this returns false the resource should be retrieved from Resource Manager, which
+// also determines whether the resource exists.
+func (s *Snapshot) Lookup(ctx context.Context, id string, model interface{}) bool {
+	if s == nil {
+		return false
+	}
+
+	subscriptionId, resourceType, ok := parseResourceType(id)
+	if !ok {
+		return false
+	}
+
+	supported, ok := supportedResourceTypes[strings.ToLower(resourceType)]
+	if !ok {
+		log.Printf("[DEBUG] Retrieving %q from Resource Manager since the Resource Type %q isn't supported by the Resource Graph snapshot", id, resourceType)
+		return false
+	}
+	if v := modelApiVersion(model); v != supported.apiVersion {
+		log.Printf("[DEBUG] Retrieving %q from Resource Manager since the API Version of the model (%q) differs from the API Version supported by the Resource Graph snapshot (%q)", id, v, supported.apiVersion)
+		return false
+	}
+
+	if s.wasWritten(id) {
+		log.Printf("[DEBUG] Retrieving %q from Resource Manager rather than Resource Graph since it's been modified", id)
+		return false
+	}
+
+	resources, err := s.subscription(ctx, subscriptionId)
+	if err != nil {
+		log.Printf("[DEBUG] Retrieving %q from Resource Manager since the Subscription couldn't be retrieved from Resource Graph: %+v", id, err)
+		return false
+	}
+
+	row, ok := resources[strings.ToLower(id)]
+	if !ok {
+		return false
+	}
+
+	body, err := row.resourceManagerRepresentation(supported)
+	if err != nil {
+		log.Printf("[DEBUG] Retrieving %q from Resource Manager since the Resource Graph representation isn't in the expected shape: %+v", id, err)
+		return false
+	}
+
+	if err := json.Unmarshal(body, model); err != nil {
+		log.Printf("[DEBUG] Retrieving %q from Resource Manager since the Resource Graph representation couldn't be parsed: %+v", id, err)
+		return false
+	}
+
+	log.Printf("[DEBUG] Retrieved %q from Resource Graph", id)
+	return true
+}
+
+// resourceManagerRepresentation builds the Resource Manager representation of the resource from the row, which
+// contains additional columns (such as `resourceGroup`) and the lower-cased Resource Type
+func (r resourceGraphRow) resourceManagerRepresentation(supported supportedResourceType) ([]byte, error) {
+	if r.Id == "" || r.Name == "" {
+		return nil, fmt.Errorf("the `id` and `name` must be specified")
+	}
+	if !strings.EqualFold(r.Type, supported.resourceType) {
+		return nil, fmt.Errorf("expected the `type` to be %q but got %q", supported.resourceType, r.Type)
+	}
+
+	// the `properties` must be an object, otherwise these aren't the Resource Manager representation of the resource
+	var properties map[string]json.RawMessage
+	if err := json.Unmarshal(r.Properties, &properties); err != nil || properties == nil {
+		return nil, fmt.Errorf("the `properties` must be an object")
+	}
+
+	sku := r.Sku
+	if string(sku) == "null" {
+		sku = nil
+	}
+
+	return json.Marshal(resourceManagerResource{
+		Id:         r.Id,
+		Name:       r.Name,
+		Type:       supported.resourceType,
+		Location:   r.Location,
+		Tags:       r.Tags,
+		Sku:        sku,
+		Zones:      r.Zones,
+		Properties: r.Properties,
+	})
+}
+
+// Invalidate records that the resource at the path (a Resource ID, or the path of a request to Resource Manager)
+// has been written to, meaning that neither the resource nor its parent/child resources are served from the
+// Snapshot from now on
+func (s *Snapshot) Invalidate(path string) {
+	if s == nil {
+		return
+	}
+
+	path = normalizePath(path)
+	if !strings.HasPrefix(path, "/subscriptions/") {
+		return
+	}
+
+	s.lock.Lock()
+	s.written = append(s.written, path)
+	s.lock.Unlock()
+}
+
+func (s *Snapshot) wasWritten(id string) bool {
+	id = normalizePath(id)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, path := range s.written {
+		if isWithin(id, path) || isWithin(path, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subscription returns the resources of the supported Resource Types (keyed by the lower-cased Resource ID) within
+// the Subscription, which are retrieved from Resource Graph the first time they're required
+func (s *Snapshot) subscription(ctx context.Context, subscriptionId string) (map[string]resourceGraphRow, error) {
+	key := strings.ToLower(subscriptionId)
+
+	s.lock.Lock()
+	snapshot, ok := s.subscriptions[key]
+	if !ok {
+		snapshot = &subscriptionSnapshot{}
+		s.subscriptions[key] = snapshot
+	}
+	s.lock.Unlock()
+
+	snapshot.once.Do(func() {
+		// the context for the first lookup is used to populate the snapshot for all lookups, so a separate timeout
+		// is used to avoid a short-lived context cancelling the population of the snapshot
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), queryTimeout)
+		defer cancel()
+
+		snapshot.resources, snapshot.err = s.listResources(ctx, subscriptionId)
+	})
+
+	return snapshot.resources, snapshot.err
+}
+
+func (s *Snapshot) listResources(ctx context.Context, subscriptionId string) (map[string]resourceGraphRow, error) {
+	log.Printf("[DEBUG] Retrieving the resources within Subscription %q from Resource Graph..", subscriptionId)
+
+	resources := make(map[string]resourceGraphRow)
+	var skipToken *string
+	for {
+		page, err := s.client.resources(ctx, subscriptionId, resourcesQuery(), skipToken)
+		if err != nil {
+			return nil, fmt.Errorf("querying Resource Graph: %+v", err)
+		}
+
+		for _, item := range page.Data {
+			var row resourceGraphRow
+			if err := json.Unmarshal(item, &row); err != nil || row.Id == "" {
+				continue
+			}
+			resources[strings.ToLower(row.Id)] = row
+		}
+
+		if page.SkipToken == nil || *page.SkipToken == "" {
+			break
+		}
+		skipToken = page.SkipToken
+	}
+
+	log.Printf("[DEBUG] Retrieved %d resources within Subscription %q from Resource Graph", len(resources), subscriptionId)
+	return resources, nil
+}
+
+// resourcesQuery returns the query for the resources of the supported Resource Types, projecting the columns which
+// make up the Resource Manager representation of the resource
+func resourcesQuery() string {
+	resourceTypes := make([]string, 0, len(supportedResourceTypes))
+	for k := range supportedResourceTypes {
+		resourceTypes = append(resourceTypes, fmt.Sprintf("'%s'", k))
+	}
+	sort.Strings(resourceTypes)
+
+	return fmt.Sprintf("resources | where type in~ (%s) | project id, name, type, location, tags, sku, zones, properties | order by id asc", strings.Join(resourceTypes, ", "))
+}
+
+// modelApiVersion returns the API Version of the SDK package containing the model, which is part of the package path
+// (e.g. `resource-manager/compute/2024-03-01/availabilitysets`)
+func modelApiVersion(model interface{}) string {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+
+	for _, segment := range strings.Split(t.PkgPath(), "/") {
+		if apiVersionSegment.MatchString(segment) {
+			return segment
+		}
+	}
+
+	return ""
+}
+
+var apiVersionSegment = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
+
+// parseResourceType returns the Subscription ID and Resource Type for a top-level resource within a Resource Group,
+// child resources aren't supported since these can have a different representation in Resource Graph
+func parseResourceType(id string) (subscriptionId string, resourceType string, ok bool) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) != 8 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") || !strings.EqualFold(segments[4], "providers") {
+		return "", "", false
+	}
+
+	return segments[1], fmt.Sprintf("%s/%s", segments[5], segments[6]), true
+}
+
+func normalizePath(path string) string {
+	return "/" + strings.ToLower(strings.Trim(path, "/"))
+}
+
+// isWithin returns whether the (normalized) path is equal to, or within, the (normalized) parent path
+func isWithin(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, parent+"/")
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegraph
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	availabilitysetsV20230301 "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/availabilitysets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/availabilitysets"
+)
+
+const testAvailabilitySetId = "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Compute/availabilitySets/example"
+
+func testSnapshot() *Snapshot {
+	snapshot := &Snapshot{
+		subscriptions: map[string]*subscriptionSnapshot{},
+	}
+
+	subscription := &subscriptionSnapshot{
+		resources: map[string]resourceGraphRow{},
+	}
+	rows := []string{
+		`{
+			"id": "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Compute/availabilitySets/example",
+			"name": "example",
+			"type": "microsoft.compute/availabilitysets",
+			"location": "westeurope",
+			"tags": {"env": "test"},
+			"sku": {"name": "Aligned"},
+			"zones": null,
+			"properties": {"platformFaultDomainCount": 2}
+		}`,
+		`{
+			"id": "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Compute/availabilitySets/noproperties",
+			"name": "noproperties",
+			"type": "microsoft.compute/availabilitysets",
+			"location": "westeurope",
+			"tags": null,
+			"sku": null,
+			"zones": null,
+			"properties": null
+		}`,
+	}
+	for _, v := range rows {
+		var row resourceGraphRow
+		if err := json.Unmarshal([]byte(v), &row); err != nil {
+			panic(err)
+		}
+		subscription.resources[strings.ToLower(row.Id)] = row
+	}
+
+	// the resources have already been retrieved, so Resource Graph isn't queried
+	subscription.once.Do(func() {})
+	snapshot.subscriptions["11111111-1111-1111-1111-111111111111"] = subscription
+
+	return snapshot
+}
+
+func TestSnapshotLookup(t *testing.T) {
+	snapshot := testSnapshot()
+
+	var model *availabilitysets.AvailabilitySet
+	if !snapshot.Lookup(context.TODO(), testAvailabilitySetId, &model) {
+		t.Fatalf("expected the resource to be found")
+	}
+	if model.Location != "westeurope" || (*model.Tags)["env"] != "test" || *model.Properties.PlatformFaultDomainCount != 2 {
+		t.Fatalf("unexpected model %+v", *model)
+	}
+	if model.Type == nil || *model.Type != "Microsoft.Compute/availabilitySets" {
+		t.Fatalf("expected the Resource Type to be in the Resource Manager casing but got %v", model.Type)
+	}
+	if model.Sku == nil || model.Sku.Name == nil || *model.Sku.Name != "Aligned" {
+		t.Fatalf("expected the Sku to be set but got %+v", model.Sku)
+	}
+
+	if snapshot.Lookup(context.TODO(), testAvailabilitySetId+"2", &model) {
+		t.Fatalf("expected a resource missing from the snapshot not to be found")
+	}
+
+	if snapshot.Lookup(context.TODO(), testAvailabilitySetId[:strings.LastIndex(testAvailabilitySetId, "/")]+"/noproperties", &model) {
+		t.Fatalf("expected a resource without `properties` not to be found")
+	}
+
+	var nilSnapshot *Snapshot
+	if nilSnapshot.Lookup(context.TODO(), testAvailabilitySetId, &model) {
+		t.Fatalf("expected a nil snapshot not to find the resource")
+	}
+	nilSnapshot.Invalidate(testAvailabilitySetId)
+}
+
+func TestSnapshotLookupUnsupportedModel(t *testing.T) {
+	snapshot := testSnapshot()
+
+	// the `properties` returned from Resource Graph haven't been checked against other API Versions
+	var otherApiVersion *availabilitysetsV20230301.AvailabilitySet
+	if snapshot.Lookup(context.TODO(), testAvailabilitySetId, &otherApiVersion) {
+		t.Fatalf("expected a model for another API Version not to be populated")
+	}
+
+	var untyped map[string]interface{}
+	if snapshot.Lookup(context.TODO(), testAvailabilitySetId, &untyped) {
+		t.Fatalf("expected a model without an API Version not to be populated")
+	}
+}
+
+func TestModelApiVersion(t *testing.T) {
+	var model *availabilitysets.AvailabilitySet
+	if actual := modelApiVersion(&model); actual != "2024-03-01" {
+		t.Fatalf("expected %q but got %q", "2024-03-01", actual)
+	}
+	if actual := modelApiVersion(nil); actual != "" {
+		t.Fatalf("expected no API Version but got %q", actual)
+	}
+}
+
+func TestSnapshotInvalidate(t *testing.T) {
+	testCases := []struct {
+		path        string
+		invalidated bool
+	}{
+		{
+			// the resource itself
+			path:        "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/EXAMPLE/providers/Microsoft.Compute/availabilitySets/example",
+			invalidated: true,
+		},
+		{
+			// the Resource Group containing the resource
+			path:        "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			invalidated: true,
+		},
+		{
+			// a child resource, or an action on the resource
+			path:        "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Compute/availabilitySets/example/convertToVirtualMachineScaleSet",
+			invalidated: true,
+		},
+		{
+			// a resource with a name containing the name of the resource
+			path:        "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Compute/availabilitySets/example2",
+			invalidated: false,
+		},
+		{
+			// a request outside of a Subscription
+			path:        "/providers/Microsoft.ResourceGraph/resources",
+			invalidated: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.path)
+
+		snapshot := testSnapshot()
+		snapshot.Invalidate(testCase.path)
+
+		var model *availabilitysets.AvailabilitySet
+		found := snapshot.Lookup(context.TODO(), testAvailabilitySetId, &model)
+		if found == testCase.invalidated {
+			t.Fatalf("expected invalidated to be %t but got %t", testCase.invalidated, !found)
+		}
+	}
+}
+
+func TestParseResourceType(t *testing.T) {
+	testCases := []struct {
+		id             string
+		subscriptionId string
+		resourceType   string
+		ok             bool
+	}{
+		{
+			id:             testAvailabilitySetId,
+			subscriptionId: "11111111-1111-1111-1111-111111111111",
+			resourceType:   "Microsoft.Compute/availabilitySets",
+			ok:             true,
+		},
+		{
+			id: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example",
+			ok: false,
+		},
+		{
+			// child resources aren't supported
+			id: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/example",
+			ok: false,
+		},
+		{
+			id: "/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Authorization/policyDefinitions/example",
+			ok: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		subscriptionId, resourceType, ok := parseResourceType(testCase.id)
+		if ok != testCase.ok || subscriptionId != testCase.subscriptionId || resourceType != testCase.resourceType {
+			t.Fatalf("expected %q, %q, %t for %q but got %q, %q, %t", testCase.subscriptionId, testCase.resourceType, testCase.ok, testCase.id, subscriptionId, resourceType, ok)
+		}
+	}
+}
@@ -10,7 +10,9 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
 )
 
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
@@ -20,19 +22,86 @@ var unregisteredResourceProviders map[string]struct{}
 
 var cacheLock = &sync.Mutex{}
 
-// CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
-// and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
-	// already populated
-	if cachedResourceProviders != nil {
+// cachedResourceProviderMetadata is the form in which the Resource Providers are persisted in the metadata cache
+type cachedResourceProviderMetadata struct {
+	Registered            []string              `json:"registered"`
+	Unregistered          []string              `json:"unregistered"`
+	ResourceTypeLocations ResourceTypeLocations `json:"resourceTypeLocations"`
+}
+
+// MetadataCache persists the Resource Providers for each Subscription within a Tenant between runs of the Provider,
+// rather than these being retrieved each time the Provider is launched
+type MetadataCache struct {
+	cache    *metadatacache.Cache
+	tenantId string
+}
+
+// NewMetadataCache returns a MetadataCache for the Tenant, which is nil when the metadata cache isn't enabled
+func NewMetadataCache(cache *metadatacache.Cache, tenantId string) *MetadataCache {
+	if cache == nil {
 		return nil
 	}
 
-	if err := populateCache(ctx, client, subscriptionId); err != nil {
-		return fmt.Errorf("populating cache: %+v", err)
+	return &MetadataCache{
+		cache:    cache,
+		tenantId: tenantId,
 	}
+}
 
-	return nil
+// Invalidate removes the persisted Resource Providers for the Subscription, which is required once the registration
+// state of a Resource Provider changes (or is found to differ from the persisted state)
+func (c *MetadataCache) Invalidate(subscriptionId commonids.SubscriptionId) {
+	if c == nil {
+		return
+	}
+
+	c.cache.Invalidate(c.key(subscriptionId))
+}
+
+func (c *MetadataCache) get(subscriptionId commonids.SubscriptionId, out *cachedResourceProviderMetadata) bool {
+	if c == nil {
+		return false
+	}
+
+	return c.cache.Get(c.key(subscriptionId), out)
+}
+
+func (c *MetadataCache) set(subscriptionId commonids.SubscriptionId, input cachedResourceProviderMetadata) {
+	if c == nil {
+		return
+	}
+
+	c.cache.Set(c.key(subscriptionId), input)
+}
+
+func (c *MetadataCache) key(subscriptionId commonids.SubscriptionId) metadatacache.Key {
+	return metadatacache.Key{
+		TenantId:       c.tenantId,
+		SubscriptionId: subscriptionId.SubscriptionId,
+		Name:           "resource-providers",
+	}
+}
+
+// CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API (or the
+// metadata cache, when enabled) and caches them, for used in enhanced validation.
+//
+// The locations where each Resource Type is available within the Subscription are returned, rather than cached, so
+// that these can be scoped to the instance of the Provider which is configured for this Subscription.
+func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, metadataCache *MetadataCache) (ResourceTypeLocations, error) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	metadata, err := retrieveResourceProviders(ctx, client, subscriptionId, metadataCache)
+	if err != nil {
+		return nil, fmt.Errorf("populating cache: %+v", err)
+	}
+
+	// already populated
+	if cachedResourceProviders == nil {
+		loadCache(*metadata)
+	}
+
+	return metadata.ResourceTypeLocations, nil
 }
 
 func ClearCache() {
@@ -43,32 +112,86 @@ func ClearCache() {
 	cacheLock.Unlock()
 }
 
-func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, metadataCache *MetadataCache) error {
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
+	metadata, err := retrieveResourceProviders(ctx, client, subscriptionId, metadataCache)
+	if err != nil {
+		return err
+	}
+
+	loadCache(*metadata)
+	return nil
+}
+
+// retrieveResourceProviders returns the Resource Providers (and the locations where each Resource Type is available)
+// for the Subscription from the metadata cache when present, otherwise from the Resource Manager API
+func retrieveResourceProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, metadataCache *MetadataCache) (*cachedResourceProviderMetadata, error) {
+	var cached cachedResourceProviderMetadata
+	if metadataCache.get(subscriptionId, &cached) {
+		if cached.ResourceTypeLocations == nil {
+			cached.ResourceTypeLocations = make(ResourceTypeLocations)
+		}
+		return &cached, nil
+	}
+
 	providers, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
 	if err != nil {
-		return fmt.Errorf("listing Resource Providers: %+v", err)
+		return nil, fmt.Errorf("listing Resource Providers: %+v", err)
 	}
 
-	providerNames := make([]string, 0)
-	registeredResourceProviders = make(map[string]struct{})
-	unregisteredResourceProviders = make(map[string]struct{})
+	cached = cachedResourceProviderMetadata{
+		Registered:            make([]string, 0),
+		Unregistered:          make([]string, 0),
+		ResourceTypeLocations: make(ResourceTypeLocations),
+	}
 	for _, provider := range providers.Items {
 		if provider.Namespace == nil {
 			continue
 		}
 
-		providerNames = append(providerNames, *provider.Namespace)
 		registered := provider.RegistrationState != nil && strings.EqualFold(*provider.RegistrationState, "registered")
 		if registered {
-			registeredResourceProviders[*provider.Namespace] = struct{}{}
+			cached.Registered = append(cached.Registered, *provider.Namespace)
 		} else {
-			unregisteredResourceProviders[*provider.Namespace] = struct{}{}
+			cached.Unregistered = append(cached.Unregistered, *provider.Namespace)
+		}
+
+		if provider.ResourceTypes == nil {
+			continue
 		}
+		for _, resourceType := range *provider.ResourceTypes {
+			if resourceType.ResourceType == nil || resourceType.Locations == nil {
+				continue
+			}
+
+			locations := make([]string, 0, len(*resourceType.Locations))
+			for _, v := range *resourceType.Locations {
+				locations = append(locations, location.Normalize(v))
+			}
+			key := strings.ToLower(fmt.Sprintf("%s/%s", *provider.Namespace, *resourceType.ResourceType))
+			cached.ResourceTypeLocations[key] = locations
+		}
+	}
+
+	metadataCache.set(subscriptionId, cached)
+	return &cached, nil
+}
+
+// loadCache populates the in-memory cache from the Resource Providers - the caller must hold the cacheLock
+func loadCache(input cachedResourceProviderMetadata) {
+	providerNames := make([]string, 0, len(input.Registered)+len(input.Unregistered))
+	registeredResourceProviders = make(map[string]struct{})
+	unregisteredResourceProviders = make(map[string]struct{})
+	for _, v := range input.Registered {
+		providerNames = append(providerNames, v)
+		registeredResourceProviders[v] = struct{}{}
+	}
+	for _, v := range input.Unregistered {
+		providerNames = append(providerNames, v)
+		unregisteredResourceProviders[v] = struct{}{}
 	}
 
 	cachedResourceProviders = &providerNames
-	return nil
 }
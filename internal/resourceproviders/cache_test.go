@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
+)
+
+func TestPopulateCacheFromMetadataCache(t *testing.T) {
+	cache, err := metadatacache.New(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("building metadata cache: %+v", err)
+	}
+
+	subscriptionId := commonids.NewSubscriptionID("00000000-0000-0000-0000-000000000000")
+	metadataCache := NewMetadataCache(cache, "11111111-1111-1111-1111-111111111111")
+	defer ClearCache()
+
+	metadataCache.set(subscriptionId, cachedResourceProviderMetadata{
+		Registered:   []string{"Microsoft.Compute", "Microsoft.Storage"},
+		Unregistered: []string{"Microsoft.StorageCache"},
+		ResourceTypeLocations: ResourceTypeLocations{
+			"microsoft.storagecache/amlfilesystems": {"eastus", "westeurope"},
+		},
+	})
+
+	// the Resource Providers are read from the metadata cache, so the API isn't called
+	if err := populateCache(context.TODO(), nil, subscriptionId, metadataCache); err != nil {
+		t.Fatalf("populating cache: %+v", err)
+	}
+
+	providerNames := *cachedResourceProviders
+	sort.Strings(providerNames)
+	if expected := []string{"Microsoft.Compute", "Microsoft.Storage", "Microsoft.StorageCache"}; !reflect.DeepEqual(expected, providerNames) {
+		t.Fatalf("expected the Resource Providers %+v but got %+v", expected, providerNames)
+	}
+	if _, ok := registeredResourceProviders["Microsoft.Storage"]; !ok {
+		t.Fatalf("expected Microsoft.Storage to be registered")
+	}
+	if _, ok := unregisteredResourceProviders["Microsoft.StorageCache"]; !ok {
+		t.Fatalf("expected Microsoft.StorageCache to be unregistered")
+	}
+	// the locations where each Resource Type is available are returned for the Subscription, rather than cached
+	locations, err := CacheSupportedProviders(context.TODO(), nil, subscriptionId, metadataCache)
+	if err != nil {
+		t.Fatalf("caching supported providers: %+v", err)
+	}
+	if expected := []string{"eastus", "westeurope"}; !reflect.DeepEqual(expected, locations["microsoft.storagecache/amlfilesystems"]) {
+		t.Fatalf("expected the locations %+v but got %+v", expected, locations["microsoft.storagecache/amlfilesystems"])
+	}
+
+	metadataCache.Invalidate(subscriptionId)
+	var cached cachedResourceProviderMetadata
+	if metadataCache.get(subscriptionId, &cached) {
+		t.Fatalf("expected the Resource Providers to be removed from the metadata cache")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// ResourceTypeLocations contains the (normalized) locations where each Resource Type (keyed by the lower-cased type,
+// e.g. `microsoft.storage/storageaccounts`) is available within a Subscription - which can (validly) be nil
+type ResourceTypeLocations map[string][]string
+
+// Validate returns an error when the Resource Type (e.g. `Microsoft.Storage/storageAccounts`) isn't available in the
+// location.
+//
+// NOTE: this is best-effort - if the locations for the Resource Type weren't retrieved, no validation is performed
+func (l ResourceTypeLocations) Validate(resourceType, input string) error {
+	if input == "" {
+		return nil
+	}
+
+	// some Resource Types (such as those which are global) don't return any locations
+	locations, ok := l[strings.ToLower(resourceType)]
+	if !ok || len(locations) == 0 {
+		return nil
+	}
+
+	normalized := location.Normalize(input)
+	for _, v := range locations {
+		if v == normalized {
+			return nil
+		}
+	}
+
+	supported := append([]string{}, locations...)
+	sort.Strings(supported)
+	return fmt.Errorf("the Resource Type %q is not available in the location %q - the locations where this is available are: %s", resourceType, normalized, strings.Join(supported, ", "))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"testing"
+)
+
+func TestResourceTypeLocationsValidate(t *testing.T) {
+	testCases := []struct {
+		resourceType string
+		location     string
+		valid        bool
+	}{
+		{
+			resourceType: "Microsoft.StorageCache/amlFilesystems",
+			location:     "West Europe",
+			valid:        true,
+		},
+		{
+			resourceType: "microsoft.storagecache/amlfilesystems",
+			location:     "westeurope",
+			valid:        true,
+		},
+		{
+			resourceType: "Microsoft.StorageCache/amlFilesystems",
+			location:     "westus",
+			valid:        false,
+		},
+		{
+			// the locations for this Resource Type weren't retrieved
+			resourceType: "Microsoft.Storage/storageAccounts",
+			location:     "westus",
+			valid:        true,
+		},
+		{
+			// global Resource Types don't return any locations
+			resourceType: "Microsoft.Network/dnsZones",
+			location:     "global",
+			valid:        true,
+		},
+	}
+
+	locations := ResourceTypeLocations{
+		"microsoft.storagecache/amlfilesystems": {"eastus", "westeurope"},
+		"microsoft.network/dnszones":            {},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q in %q..", testCase.resourceType, testCase.location)
+
+		err := locations.Validate(testCase.resourceType, testCase.location)
+		if valid := err == nil; testCase.valid != valid {
+			t.Errorf("Expected %t but got %t (%+v)", testCase.valid, valid, err)
+		}
+	}
+}
+
+func TestResourceTypeLocationsValidateNotRetrieved(t *testing.T) {
+	var locations ResourceTypeLocations
+	if err := locations.Validate("Microsoft.StorageCache/amlFilesystems", "westus"); err != nil {
+		t.Fatalf("expected no error when the locations weren't retrieved but got %+v", err)
+	}
+}
@@ -22,7 +22,7 @@ import (
 // EnsureRegistered tries to determine whether all requiredRPs are registered in the subscription, and attempts to
 // register them if it appears they are not. Note that this may fail if a resource provider is not available in the
 // current cloud environment (a warning message will be logged to indicate when a resource provider is not listed).
-func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs ResourceProviders) error {
+func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, requiredRPs ResourceProviders, metadataCache *MetadataCache) error {
 	// Cache supported resource providers if RP registration and enhanced validation are not both disabled
 	if len(requiredRPs) == 0 && !features.EnhancedValidationEnabled() {
 		log.Printf("[DEBUG] Skipping populating the resource provider cache, since resource provider registration and enhanced validation are both disabled")
@@ -30,7 +30,7 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 	}
 
 	if cachedResourceProviders == nil || registeredResourceProviders == nil || unregisteredResourceProviders == nil {
-		if err := populateCache(ctx, client, subscriptionId); err != nil {
+		if err := populateCache(ctx, client, subscriptionId, metadataCache); err != nil {
 			return fmt.Errorf("populating Resource Provider cache: %+v", err)
 		}
 	}
@@ -47,7 +47,12 @@ func EnsureRegistered(ctx context.Context, client *providers.ProvidersClient, su
 	}
 
 	log.Printf("[DEBUG] Registering %d Resource Providers", len(*providersToRegister))
-	if err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister); err != nil {
+	err = registerForSubscription(ctx, client, subscriptionId, *providersToRegister)
+
+	// the registration state has changed (or couldn't be changed) so the persisted Resource Providers are stale
+	metadataCache.Invalidate(subscriptionId)
+
+	if err != nil {
 		return userError(err)
 	}
 
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// EphemeralResourceMetadata is embedded within each Ephemeral Resource, providing
+// access to the Azure Clients configured by the Provider
+type EphemeralResourceMetadata struct {
+	Client *clients.Client
+
+	SubscriptionId string
+
+	Features features.UserFeatures
+}
+
+// Defaults configures the metadata for this Ephemeral Resource from the Provider Data, and should
+// be called from the `Configure` function of each Ephemeral Resource
+func (r *EphemeralResourceMetadata) Defaults(req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// the Provider Data isn't available during validation, so there's nothing to configure
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("expected the Provider Data to be a `*clients.Client` but got %T", req.ProviderData))
+		return
+	}
+
+	r.Client = client
+	r.SubscriptionId = client.Account.SubscriptionId
+	r.Features = client.Features
+}
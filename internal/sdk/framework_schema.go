@@ -0,0 +1,544 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// frameworkResourceSchema converts the Plugin SDK Schema for a Resource into the equivalent Plugin Framework
+// Schema - where blocks become nested attributes, `ForceNew` becomes a `RequiresReplace` plan modifier and
+// Computed fields retain their existing value (via `UseStateForUnknown`) unless they're changed in the config.
+//
+// The Type of the Framework Schema intentionally matches the Type of the Plugin SDK Schema, such that values
+// can be converted between the two when calling into the Plugin SDK.
+func frameworkResourceSchema(resource *schema.Resource) (*resourceschema.Schema, error) {
+	attributes := make(map[string]resourceschema.Attribute)
+	for _, key := range sortedSchemaKeys(resource.SchemaMap()) {
+		attribute, err := frameworkResourceAttribute(resource.SchemaMap()[key])
+		if err != nil {
+			return nil, fmt.Errorf("converting %q: %+v", key, err)
+		}
+		attributes[key] = attribute
+	}
+
+	if _, ok := attributes["id"]; !ok {
+		attributes["id"] = resourceschema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	out := resourceschema.Schema{
+		Attributes:         attributes,
+		DeprecationMessage: resource.DeprecationMessage,
+	}
+
+	if timeouts := frameworkTimeoutAttributeNames(resource.Timeouts); len(timeouts) > 0 {
+		timeoutAttributes := make(map[string]resourceschema.Attribute)
+		for _, name := range timeouts {
+			timeoutAttributes[name] = resourceschema.StringAttribute{
+				Optional: true,
+			}
+		}
+		out.Blocks = map[string]resourceschema.Block{
+			schema.TimeoutsConfigKey: resourceschema.SingleNestedBlock{
+				Attributes: timeoutAttributes,
+			},
+		}
+	}
+
+	return &out, nil
+}
+
+func frameworkResourceAttribute(input *schema.Schema) (resourceschema.Attribute, error) {
+	if input.DefaultFunc != nil {
+		return nil, fmt.Errorf("`DefaultFunc` is not supported by the Plugin Framework, use `Default` instead")
+	}
+
+	computed := input.Computed || input.Default != nil
+
+	switch input.Type {
+	case pluginsdk.TypeString:
+		out := resourceschema.StringAttribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}
+		if input.Default != nil {
+			v, ok := input.Default.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected the `Default` to be a string but got %T", input.Default)
+			}
+			out.Default = stringdefault.StaticString(v)
+		}
+		if input.ForceNew {
+			out.PlanModifiers = append(out.PlanModifiers, stringplanmodifier.RequiresReplace())
+		}
+		if computed {
+			out.PlanModifiers = append(out.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+		}
+		return out, nil
+
+	case pluginsdk.TypeBool:
+		out := resourceschema.BoolAttribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}
+		if input.Default != nil {
+			v, ok := input.Default.(bool)
+			if !ok {
+				return nil, fmt.Errorf("expected the `Default` to be a bool but got %T", input.Default)
+			}
+			out.Default = booldefault.StaticBool(v)
+		}
+		if input.ForceNew {
+			out.PlanModifiers = append(out.PlanModifiers, boolplanmodifier.RequiresReplace())
+		}
+		if computed {
+			out.PlanModifiers = append(out.PlanModifiers, boolplanmodifier.UseStateForUnknown())
+		}
+		return out, nil
+
+	case pluginsdk.TypeInt:
+		out := resourceschema.Int64Attribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}
+		if input.Default != nil {
+			v, ok := input.Default.(int)
+			if !ok {
+				return nil, fmt.Errorf("expected the `Default` to be an int but got %T", input.Default)
+			}
+			out.Default = int64default.StaticInt64(int64(v))
+		}
+		if input.ForceNew {
+			out.PlanModifiers = append(out.PlanModifiers, int64planmodifier.RequiresReplace())
+		}
+		if computed {
+			out.PlanModifiers = append(out.PlanModifiers, int64planmodifier.UseStateForUnknown())
+		}
+		return out, nil
+
+	case pluginsdk.TypeFloat:
+		out := resourceschema.Float64Attribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}
+		if input.Default != nil {
+			v, ok := input.Default.(float64)
+			if !ok {
+				return nil, fmt.Errorf("expected the `Default` to be a float64 but got %T", input.Default)
+			}
+			out.Default = float64default.StaticFloat64(v)
+		}
+		if input.ForceNew {
+			out.PlanModifiers = append(out.PlanModifiers, float64planmodifier.RequiresReplace())
+		}
+		if computed {
+			out.PlanModifiers = append(out.PlanModifiers, float64planmodifier.UseStateForUnknown())
+		}
+		return out, nil
+
+	case pluginsdk.TypeMap:
+		if input.Default != nil {
+			return nil, fmt.Errorf("`Default` is not supported for a Map")
+		}
+		elementType, err := frameworkElementType(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		out := resourceschema.MapAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}
+		if input.ForceNew {
+			out.PlanModifiers = append(out.PlanModifiers, mapplanmodifier.RequiresReplace())
+		}
+		if input.Computed {
+			out.PlanModifiers = append(out.PlanModifiers, mapplanmodifier.UseStateForUnknown())
+		}
+		return out, nil
+
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		if input.Default != nil {
+			return nil, fmt.Errorf("`Default` is not supported for a List or Set")
+		}
+
+		if nested, ok := input.Elem.(*schema.Resource); ok {
+			attributes := make(map[string]resourceschema.Attribute)
+			for _, key := range sortedSchemaKeys(nested.SchemaMap()) {
+				attribute, err := frameworkResourceAttribute(nested.SchemaMap()[key])
+				if err != nil {
+					return nil, fmt.Errorf("converting %q: %+v", key, err)
+				}
+				attributes[key] = attribute
+			}
+			nestedObject := resourceschema.NestedAttributeObject{
+				Attributes: attributes,
+			}
+
+			if input.Type == pluginsdk.TypeSet {
+				out := resourceschema.SetNestedAttribute{
+					NestedObject:       nestedObject,
+					Required:           input.Required,
+					Optional:           input.Optional,
+					Computed:           input.Computed,
+					Sensitive:          input.Sensitive,
+					Description:        input.Description,
+					DeprecationMessage: input.Deprecated,
+				}
+				out.PlanModifiers = frameworkSetPlanModifiers(input)
+				return out, nil
+			}
+
+			out := resourceschema.ListNestedAttribute{
+				NestedObject:       nestedObject,
+				Required:           input.Required,
+				Optional:           input.Optional,
+				Computed:           input.Computed,
+				Sensitive:          input.Sensitive,
+				Description:        input.Description,
+				DeprecationMessage: input.Deprecated,
+			}
+			out.PlanModifiers = frameworkListPlanModifiers(input)
+			return out, nil
+		}
+
+		elementType, err := frameworkElementType(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.Type == pluginsdk.TypeSet {
+			out := resourceschema.SetAttribute{
+				ElementType:        elementType,
+				Required:           input.Required,
+				Optional:           input.Optional,
+				Computed:           input.Computed,
+				Sensitive:          input.Sensitive,
+				Description:        input.Description,
+				DeprecationMessage: input.Deprecated,
+			}
+			out.PlanModifiers = frameworkSetPlanModifiers(input)
+			return out, nil
+		}
+
+		out := resourceschema.ListAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}
+		out.PlanModifiers = frameworkListPlanModifiers(input)
+		return out, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %q", input.Type.String())
+}
+
+func frameworkListPlanModifiers(input *schema.Schema) []planmodifier.List {
+	out := make([]planmodifier.List, 0)
+	if input.ForceNew {
+		out = append(out, listplanmodifier.RequiresReplace())
+	}
+	if input.Computed {
+		out = append(out, listplanmodifier.UseStateForUnknown())
+	}
+	return out
+}
+
+func frameworkSetPlanModifiers(input *schema.Schema) []planmodifier.Set {
+	out := make([]planmodifier.Set, 0)
+	if input.ForceNew {
+		out = append(out, setplanmodifier.RequiresReplace())
+	}
+	if input.Computed {
+		out = append(out, setplanmodifier.UseStateForUnknown())
+	}
+	return out
+}
+
+// frameworkDataSourceSchema converts the Plugin SDK Schema for a Data Source into the equivalent Plugin
+// Framework Schema, where blocks become nested attributes
+func frameworkDataSourceSchema(resource *schema.Resource) (*datasourceschema.Schema, error) {
+	attributes := make(map[string]datasourceschema.Attribute)
+	for _, key := range sortedSchemaKeys(resource.SchemaMap()) {
+		attribute, err := frameworkDataSourceAttribute(resource.SchemaMap()[key])
+		if err != nil {
+			return nil, fmt.Errorf("converting %q: %+v", key, err)
+		}
+		attributes[key] = attribute
+	}
+
+	if _, ok := attributes["id"]; !ok {
+		attributes["id"] = datasourceschema.StringAttribute{
+			Computed: true,
+		}
+	}
+
+	out := datasourceschema.Schema{
+		Attributes:         attributes,
+		DeprecationMessage: resource.DeprecationMessage,
+	}
+
+	if timeouts := frameworkTimeoutAttributeNames(resource.Timeouts); len(timeouts) > 0 {
+		timeoutAttributes := make(map[string]datasourceschema.Attribute)
+		for _, name := range timeouts {
+			timeoutAttributes[name] = datasourceschema.StringAttribute{
+				Optional: true,
+			}
+		}
+		out.Blocks = map[string]datasourceschema.Block{
+			schema.TimeoutsConfigKey: datasourceschema.SingleNestedBlock{
+				Attributes: timeoutAttributes,
+			},
+		}
+	}
+
+	return &out, nil
+}
+
+func frameworkDataSourceAttribute(input *schema.Schema) (datasourceschema.Attribute, error) {
+	if input.DefaultFunc != nil {
+		return nil, fmt.Errorf("`DefaultFunc` is not supported by the Plugin Framework")
+	}
+	if input.Default != nil {
+		return nil, fmt.Errorf("`Default` is not supported within a Data Source")
+	}
+
+	switch input.Type {
+	case pluginsdk.TypeString:
+		return datasourceschema.StringAttribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeBool:
+		return datasourceschema.BoolAttribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeInt:
+		return datasourceschema.Int64Attribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeFloat:
+		return datasourceschema.Float64Attribute{
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeMap:
+		elementType, err := frameworkElementType(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+		return datasourceschema.MapAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		if nested, ok := input.Elem.(*schema.Resource); ok {
+			attributes := make(map[string]datasourceschema.Attribute)
+			for _, key := range sortedSchemaKeys(nested.SchemaMap()) {
+				attribute, err := frameworkDataSourceAttribute(nested.SchemaMap()[key])
+				if err != nil {
+					return nil, fmt.Errorf("converting %q: %+v", key, err)
+				}
+				attributes[key] = attribute
+			}
+			nestedObject := datasourceschema.NestedAttributeObject{
+				Attributes: attributes,
+			}
+
+			if input.Type == pluginsdk.TypeSet {
+				return datasourceschema.SetNestedAttribute{
+					NestedObject:       nestedObject,
+					Required:           input.Required,
+					Optional:           input.Optional,
+					Computed:           input.Computed,
+					Sensitive:          input.Sensitive,
+					Description:        input.Description,
+					DeprecationMessage: input.Deprecated,
+				}, nil
+			}
+
+			return datasourceschema.ListNestedAttribute{
+				NestedObject:       nestedObject,
+				Required:           input.Required,
+				Optional:           input.Optional,
+				Computed:           input.Computed,
+				Sensitive:          input.Sensitive,
+				Description:        input.Description,
+				DeprecationMessage: input.Deprecated,
+			}, nil
+		}
+
+		elementType, err := frameworkElementType(input.Elem)
+		if err != nil {
+			return nil, err
+		}
+
+		if input.Type == pluginsdk.TypeSet {
+			return datasourceschema.SetAttribute{
+				ElementType:        elementType,
+				Required:           input.Required,
+				Optional:           input.Optional,
+				Computed:           input.Computed,
+				Sensitive:          input.Sensitive,
+				Description:        input.Description,
+				DeprecationMessage: input.Deprecated,
+			}, nil
+		}
+
+		return datasourceschema.ListAttribute{
+			ElementType:        elementType,
+			Required:           input.Required,
+			Optional:           input.Optional,
+			Computed:           input.Computed,
+			Sensitive:          input.Sensitive,
+			Description:        input.Description,
+			DeprecationMessage: input.Deprecated,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %q", input.Type.String())
+}
+
+// frameworkElementType returns the Framework type for the Elem of a primitive List, Set or Map - noting
+// that (as in the Plugin SDK) the elements of a Map default to a String when not specified
+func frameworkElementType(elem interface{}) (attr.Type, error) {
+	if elem == nil {
+		return types.StringType, nil
+	}
+
+	v, ok := elem.(*schema.Schema)
+	if !ok {
+		// the Plugin SDK treats a Map containing a Resource as a Map of Strings
+		if _, isResource := elem.(*schema.Resource); isResource {
+			return types.StringType, nil
+		}
+		return nil, fmt.Errorf("unsupported `Elem` type %T", elem)
+	}
+
+	switch v.Type {
+	case pluginsdk.TypeString:
+		return types.StringType, nil
+	case pluginsdk.TypeBool:
+		return types.BoolType, nil
+	case pluginsdk.TypeInt:
+		return types.Int64Type, nil
+	case pluginsdk.TypeFloat:
+		return types.Float64Type, nil
+	}
+
+	return nil, fmt.Errorf("unsupported element type %q", v.Type.String())
+}
+
+// frameworkTimeoutAttributeNames returns the names of the timeouts which can be configured for this resource,
+// matching the `timeouts` block exposed by the Plugin SDK
+func frameworkTimeoutAttributeNames(input *schema.ResourceTimeout) []string {
+	out := make([]string, 0)
+	if input == nil {
+		return out
+	}
+
+	if input.Create != nil {
+		out = append(out, schema.TimeoutCreate)
+	}
+	if input.Read != nil {
+		out = append(out, schema.TimeoutRead)
+	}
+	if input.Update != nil {
+		out = append(out, schema.TimeoutUpdate)
+	}
+	if input.Delete != nil {
+		out = append(out, schema.TimeoutDelete)
+	}
+	if input.Default != nil {
+		out = append(out, schema.TimeoutDefault)
+	}
+
+	return out
+}
+
+func sortedSchemaKeys(input map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testFrameworkSchemaResource() *pluginsdk.Resource {
+	d := func(duration time.Duration) *time.Duration {
+		return &duration
+	}
+
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"capacity": {
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"ratio": {
+				Type:     pluginsdk.TypeFloat,
+				Optional: true,
+			},
+			"tags": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+			"zones": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Required: true,
+						},
+						"ports": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeInt,
+							},
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"uri": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: d(time.Minute),
+			Read:   d(time.Minute),
+			Delete: d(time.Minute),
+		},
+	}
+}
+
+func TestFrameworkResourceSchema_TypeMatchesPluginSdk(t *testing.T) {
+	ctx := context.TODO()
+	input := testFrameworkSchemaResource()
+
+	actual, err := frameworkResourceSchema(input)
+	if err != nil {
+		t.Fatalf("building schema: %+v", err)
+	}
+
+	if diags := actual.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("validating schema: %+v", diags)
+	}
+
+	expected := tftypesFromCtyType(t, input.CoreConfigSchema().ImpliedType())
+	if ty := actual.Type().TerraformType(ctx); !ty.Equal(expected) {
+		t.Fatalf("expected the type to be %s but got %s", expected, ty)
+	}
+}
+
+func TestFrameworkResourceSchema_Attributes(t *testing.T) {
+	actual, err := frameworkResourceSchema(testFrameworkSchemaResource())
+	if err != nil {
+		t.Fatalf("building schema: %+v", err)
+	}
+
+	name, ok := actual.Attributes["name"].(resourceschema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected `name` to be a StringAttribute but got %T", actual.Attributes["name"])
+	}
+	if !name.Required || len(name.PlanModifiers) != 1 {
+		t.Fatalf("expected `name` to be Required with a single (RequiresReplace) plan modifier but got %+v", name)
+	}
+
+	enabled, ok := actual.Attributes["enabled"].(resourceschema.BoolAttribute)
+	if !ok {
+		t.Fatalf("expected `enabled` to be a BoolAttribute but got %T", actual.Attributes["enabled"])
+	}
+	if !enabled.Optional || !enabled.Computed || enabled.Default == nil {
+		t.Fatalf("expected `enabled` to be Optional and Computed with a Default but got %+v", enabled)
+	}
+
+	capacity, ok := actual.Attributes["capacity"].(resourceschema.Int64Attribute)
+	if !ok {
+		t.Fatalf("expected `capacity` to be an Int64Attribute but got %T", actual.Attributes["capacity"])
+	}
+	if len(capacity.PlanModifiers) != 1 {
+		t.Fatalf("expected `capacity` to have a single (UseStateForUnknown) plan modifier but got %d", len(capacity.PlanModifiers))
+	}
+
+	rule, ok := actual.Attributes["rule"].(resourceschema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected `rule` to be a ListNestedAttribute but got %T", actual.Attributes["rule"])
+	}
+	if _, ok := rule.NestedObject.Attributes["priority"].(resourceschema.Int64Attribute); !ok {
+		t.Fatalf("expected `rule.priority` to be an Int64Attribute but got %T", rule.NestedObject.Attributes["priority"])
+	}
+
+	if _, ok := actual.Attributes["endpoint"].(resourceschema.SetNestedAttribute); !ok {
+		t.Fatalf("expected `endpoint` to be a SetNestedAttribute but got %T", actual.Attributes["endpoint"])
+	}
+
+	if _, ok := actual.Attributes["id"].(resourceschema.StringAttribute); !ok {
+		t.Fatalf("expected `id` to be a StringAttribute but got %T", actual.Attributes["id"])
+	}
+
+	timeouts, ok := actual.Blocks["timeouts"].(resourceschema.SingleNestedBlock)
+	if !ok {
+		t.Fatalf("expected `timeouts` to be a SingleNestedBlock but got %T", actual.Blocks["timeouts"])
+	}
+	if len(timeouts.Attributes) != 3 {
+		t.Fatalf("expected `timeouts` to contain 3 attributes but got %d", len(timeouts.Attributes))
+	}
+}
+
+func TestFrameworkResourceSchema_DefaultFuncUnsupported(t *testing.T) {
+	input := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				DefaultFunc: func() (interface{}, error) {
+					return "hello", nil
+				},
+			},
+		},
+	}
+
+	if _, err := frameworkResourceSchema(input); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestFrameworkDataSourceSchema_TypeMatchesPluginSdk(t *testing.T) {
+	ctx := context.TODO()
+	input := testFrameworkSchemaResource()
+	// Data Sources don't support Defaults
+	input.Schema["enabled"].Default = nil
+
+	actual, err := frameworkDataSourceSchema(input)
+	if err != nil {
+		t.Fatalf("building schema: %+v", err)
+	}
+
+	if diags := actual.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("validating schema: %+v", diags)
+	}
+
+	expected := tftypesFromCtyType(t, input.CoreConfigSchema().ImpliedType())
+	if ty := actual.Type().TerraformType(ctx); !ty.Equal(expected) {
+		t.Fatalf("expected the type to be %s but got %s", expected, ty)
+	}
+}
+
+func tftypesFromCtyType(t *testing.T, input cty.Type) tftypes.Type {
+	switch {
+	case input == cty.String:
+		return tftypes.String
+	case input == cty.Number:
+		return tftypes.Number
+	case input == cty.Bool:
+		return tftypes.Bool
+	case input.IsListType():
+		return tftypes.List{ElementType: tftypesFromCtyType(t, input.ElementType())}
+	case input.IsSetType():
+		return tftypes.Set{ElementType: tftypesFromCtyType(t, input.ElementType())}
+	case input.IsMapType():
+		return tftypes.Map{ElementType: tftypesFromCtyType(t, input.ElementType())}
+	case input.IsObjectType():
+		attributes := make(map[string]tftypes.Type)
+		for name, ty := range input.AttributeTypes() {
+			attributes[name] = tftypesFromCtyType(t, ty)
+		}
+		return tftypes.Object{AttributeTypes: attributes}
+	}
+
+	t.Fatalf("unsupported type %s", input.FriendlyName())
+	return nil
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// ctyValueFromFrameworkValue converts a value provided by the Plugin Framework into the cty.Value
+// used by the Plugin SDK, where `ty` is the Type implied by the Plugin SDK Schema
+func ctyValueFromFrameworkValue(ty cty.Type, input tftypes.Value) (cty.Value, error) {
+	dynamicValue, err := tfprotov5.NewDynamicValue(input.Type(), input)
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("marshaling value: %+v", err)
+	}
+
+	out, err := msgpack.Unmarshal(dynamicValue.MsgPack, ty)
+	if err != nil {
+		return cty.NilVal, fmt.Errorf("unmarshaling value: %+v", err)
+	}
+
+	return out, nil
+}
+
+// frameworkValueFromCtyValue converts a cty.Value returned from the Plugin SDK into a value which can
+// be returned to the Plugin Framework, where `ty` is the Type of the Plugin Framework Schema
+func frameworkValueFromCtyValue(ty tftypes.Type, input cty.Value) (tftypes.Value, error) {
+	raw, err := msgpack.Marshal(input, input.Type())
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("marshaling value: %+v", err)
+	}
+
+	dynamicValue := tfprotov5.DynamicValue{
+		MsgPack: raw,
+	}
+	out, err := dynamicValue.Unmarshal(ty)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("unmarshaling value: %+v", err)
+	}
+
+	return out, nil
+}
+
+// ctyValueFromInstanceState converts the InstanceState returned from the Plugin SDK into a cty.Value,
+// where a nil InstanceState (or one without an ID) means that the resource doesn't exist
+func ctyValueFromInstanceState(ty cty.Type, input *terraform.InstanceState) (cty.Value, error) {
+	if input == nil || input.ID == "" {
+		return cty.NullVal(ty), nil
+	}
+
+	return input.AttrsAsObjectValue(ty)
+}
+
+// normalizeNullValues replaces the zero values which the Plugin SDK uses to represent an unset field with
+// null, where the corresponding value in `src` (the planned value, or the prior state) is null.
+//
+// Unlike the Plugin SDK, the values returned by a Plugin Framework resource must be consistent with the
+// planned values - and as the Plugin SDK can't distinguish between an empty and an unset value this needs
+// to be done once the Plugin SDK has returned.
+func normalizeNullValues(dst, src cty.Value) cty.Value {
+	if !src.IsKnown() || !dst.IsKnown() {
+		return dst
+	}
+
+	if src.IsNull() {
+		if !dst.IsNull() && isZeroValue(dst) {
+			return cty.NullVal(dst.Type())
+		}
+		return dst
+	}
+
+	if dst.IsNull() || !src.Type().Equals(dst.Type()) {
+		return dst
+	}
+
+	ty := dst.Type()
+	switch {
+	case ty.IsObjectType():
+		attributes := dst.AsValueMap()
+		if len(attributes) == 0 {
+			return dst
+		}
+		for name, value := range attributes {
+			attributes[name] = normalizeNullValues(value, src.GetAttr(name))
+		}
+		return cty.ObjectVal(attributes)
+
+	case ty.IsListType():
+		if dst.LengthInt() == 0 {
+			return dst
+		}
+		elements := dst.AsValueSlice()
+		srcElements := src.AsValueSlice()
+		for i := range elements {
+			if i < len(srcElements) {
+				elements[i] = normalizeNullValues(elements[i], srcElements[i])
+			}
+		}
+		return cty.ListVal(elements)
+
+	case ty.IsMapType():
+		if dst.LengthInt() == 0 {
+			return dst
+		}
+		elements := dst.AsValueMap()
+		srcElements := src.AsValueMap()
+		for key, value := range elements {
+			if srcValue, ok := srcElements[key]; ok {
+				elements[key] = normalizeNullValues(value, srcValue)
+			}
+		}
+		return cty.MapVal(elements)
+
+	case ty.IsSetType():
+		if dst.LengthInt() == 0 || src.LengthInt() == 0 {
+			return dst
+		}
+		// set elements are identified by their value, so the best we can do is to match each element
+		// against an element in `src` which it's identical to once normalized
+		elements := dst.AsValueSlice()
+		srcElements := src.AsValueSlice()
+		for i, value := range elements {
+			for _, candidate := range srcElements {
+				if normalized := normalizeNullValues(value, candidate); normalized.RawEquals(candidate) {
+					elements[i] = normalized
+					break
+				}
+			}
+		}
+		return cty.SetVal(elements)
+	}
+
+	return dst
+}
+
+// withComputedValuesUnknown marks any unset Computed fields within the top-level of `input` as unknown,
+// such that these are populated with the value from the Plugin SDK rather than being normalized to null
+func withComputedValuesUnknown(input cty.Value, resource *schema.Resource) cty.Value {
+	if input.IsNull() || !input.IsKnown() {
+		return input
+	}
+
+	attributes := input.AsValueMap()
+	for name, value := range attributes {
+		if !value.IsNull() {
+			continue
+		}
+
+		if v, ok := resource.SchemaMap()[name]; (ok && v.Computed) || name == "id" {
+			attributes[name] = cty.UnknownVal(value.Type())
+		}
+	}
+
+	return cty.ObjectVal(attributes)
+}
+
+func isZeroValue(input cty.Value) bool {
+	ty := input.Type()
+	switch {
+	case ty == cty.String:
+		return input.AsString() == ""
+	case ty == cty.Number:
+		return input.Equals(cty.Zero).True()
+	case ty == cty.Bool:
+		return input.False()
+	case ty.IsListType(), ty.IsSetType(), ty.IsMapType():
+		return input.LengthInt() == 0
+	}
+
+	return false
+}
+
+// copyTimeoutValues copies the `timeouts` block from `src` into `dst`, since the Plugin SDK stores the
+// timeouts in the metadata for the resource rather than in the state
+func copyTimeoutValues(dst, src cty.Value) cty.Value {
+	if dst.IsNull() || !dst.IsKnown() || !dst.Type().IsObjectType() || !dst.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		return dst
+	}
+
+	attributes := dst.AsValueMap()
+	attributes[schema.TimeoutsConfigKey] = cty.NullVal(dst.Type().AttributeType(schema.TimeoutsConfigKey))
+	if !src.IsNull() && src.IsKnown() && src.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		attributes[schema.TimeoutsConfigKey] = src.GetAttr(schema.TimeoutsConfigKey)
+	}
+
+	return cty.ObjectVal(attributes)
+}
+
+// resourceTimeoutsFromValue parses the `timeouts` block (if any) from the specified value
+func resourceTimeoutsFromValue(resource *schema.Resource, input cty.Value) (*schema.ResourceTimeout, error) {
+	timeouts := schema.ResourceTimeout{}
+	if input.IsNull() || !input.IsKnown() {
+		return &timeouts, nil
+	}
+
+	config := terraform.NewResourceConfigShimmed(input, resource.CoreConfigSchema())
+	if err := timeouts.ConfigDecode(resource, config); err != nil {
+		return nil, fmt.Errorf("decoding `timeouts`: %+v", err)
+	}
+
+	return &timeouts, nil
+}
+
+// frameworkDiagnosticsFromPluginSdk converts the Diagnostics returned from the Plugin SDK into those used
+// by the Plugin Framework, retaining the path to the attribute where possible
+func frameworkDiagnosticsFromPluginSdk(input diag.Diagnostics) frameworkdiag.Diagnostics {
+	out := frameworkdiag.Diagnostics{}
+	for _, v := range input {
+		attributePath, ok := frameworkPathFromCtyPath(v.AttributePath)
+
+		switch {
+		case v.Severity == diag.Warning && ok:
+			out.AddAttributeWarning(attributePath, v.Summary, v.Detail)
+		case v.Severity == diag.Warning:
+			out.AddWarning(v.Summary, v.Detail)
+		case ok:
+			out.AddAttributeError(attributePath, v.Summary, v.Detail)
+		default:
+			out.AddError(v.Summary, v.Detail)
+		}
+	}
+
+	return out
+}
+
+// frameworkPathFromCtyPath converts a cty.Path into a Plugin Framework path, returning false if the path is
+// empty or refers to an element within a Set (which can't be represented)
+func frameworkPathFromCtyPath(input cty.Path) (path.Path, bool) {
+	if len(input) == 0 {
+		return path.Empty(), false
+	}
+
+	var out path.Path
+	for i, step := range input {
+		switch v := step.(type) {
+		case cty.GetAttrStep:
+			if i == 0 {
+				out = path.Root(v.Name)
+				continue
+			}
+			out = out.AtName(v.Name)
+
+		case cty.IndexStep:
+			if i == 0 {
+				return path.Empty(), false
+			}
+			switch v.Key.Type() {
+			case cty.Number:
+				index, _ := v.Key.AsBigFloat().Int64()
+				out = out.AtListIndex(int(index))
+			case cty.String:
+				out = out.AtMapKey(v.Key.AsString())
+			default:
+				return path.Empty(), false
+			}
+
+		default:
+			return path.Empty(), false
+		}
+	}
+
+	return out, true
+}
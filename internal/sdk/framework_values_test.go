@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizeNullValues(t *testing.T) {
+	objectType := cty.Object(map[string]cty.Type{
+		"name":    cty.String,
+		"enabled": cty.Bool,
+		"count":   cty.Number,
+		"tags":    cty.Map(cty.String),
+		"rule": cty.List(cty.Object(map[string]cty.Type{
+			"priority": cty.Number,
+			"note":     cty.String,
+		})),
+	})
+
+	testData := []struct {
+		name     string
+		dst      cty.Value
+		src      cty.Value
+		expected cty.Value
+	}{
+		{
+			name:     "zero value with a null source",
+			dst:      cty.StringVal(""),
+			src:      cty.NullVal(cty.String),
+			expected: cty.NullVal(cty.String),
+		},
+		{
+			name:     "non-zero value with a null source",
+			dst:      cty.StringVal("hello"),
+			src:      cty.NullVal(cty.String),
+			expected: cty.StringVal("hello"),
+		},
+		{
+			name:     "zero value with an unknown source",
+			dst:      cty.False,
+			src:      cty.UnknownVal(cty.Bool),
+			expected: cty.False,
+		},
+		{
+			name:     "zero value with a known source",
+			dst:      cty.Zero,
+			src:      cty.Zero,
+			expected: cty.Zero,
+		},
+		{
+			name: "nested values",
+			dst: cty.ObjectVal(map[string]cty.Value{
+				"name":    cty.StringVal("example"),
+				"enabled": cty.False,
+				"count":   cty.Zero,
+				"tags":    cty.MapValEmpty(cty.String),
+				"rule": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"priority": cty.NumberIntVal(100),
+						"note":     cty.StringVal(""),
+					}),
+				}),
+			}),
+			src: cty.ObjectVal(map[string]cty.Value{
+				"name":    cty.StringVal("example"),
+				"enabled": cty.NullVal(cty.Bool),
+				"count":   cty.UnknownVal(cty.Number),
+				"tags":    cty.NullVal(cty.Map(cty.String)),
+				"rule": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"priority": cty.NumberIntVal(100),
+						"note":     cty.NullVal(cty.String),
+					}),
+				}),
+			}),
+			expected: cty.ObjectVal(map[string]cty.Value{
+				"name":    cty.StringVal("example"),
+				"enabled": cty.NullVal(cty.Bool),
+				"count":   cty.Zero,
+				"tags":    cty.NullVal(cty.Map(cty.String)),
+				"rule": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"priority": cty.NumberIntVal(100),
+						"note":     cty.NullVal(cty.String),
+					}),
+				}),
+			}),
+		},
+		{
+			name:     "null object",
+			dst:      cty.NullVal(objectType),
+			src:      cty.NullVal(objectType),
+			expected: cty.NullVal(objectType),
+		},
+		{
+			name: "set elements",
+			dst: cty.SetVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("first"),
+					"note": cty.StringVal(""),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("second"),
+					"note": cty.StringVal("hello"),
+				}),
+			}),
+			src: cty.SetVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("first"),
+					"note": cty.NullVal(cty.String),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("second"),
+					"note": cty.StringVal("hello"),
+				}),
+			}),
+			expected: cty.SetVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("first"),
+					"note": cty.NullVal(cty.String),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"name": cty.StringVal("second"),
+					"note": cty.StringVal("hello"),
+				}),
+			}),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := normalizeNullValues(v.dst, v.src)
+		if !actual.RawEquals(v.expected) {
+			t.Fatalf("expected %#v but got %#v", v.expected, actual)
+		}
+	}
+}
+
+func TestFrameworkValueRoundTrip(t *testing.T) {
+	ctyType := cty.Object(map[string]cty.Type{
+		"name":  cty.String,
+		"count": cty.Number,
+		"zones": cty.Set(cty.String),
+	})
+	frameworkType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"count": tftypes.Number,
+			"zones": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	input := cty.ObjectVal(map[string]cty.Value{
+		"name":  cty.StringVal("example"),
+		"count": cty.UnknownVal(cty.Number),
+		"zones": cty.SetVal([]cty.Value{cty.StringVal("1"), cty.StringVal("2")}),
+	})
+
+	frameworkValue, err := frameworkValueFromCtyValue(frameworkType, input)
+	if err != nil {
+		t.Fatalf("converting to a framework value: %+v", err)
+	}
+
+	actual, err := ctyValueFromFrameworkValue(ctyType, frameworkValue)
+	if err != nil {
+		t.Fatalf("converting to a cty value: %+v", err)
+	}
+
+	if !actual.RawEquals(input) {
+		t.Fatalf("expected %#v but got %#v", input, actual)
+	}
+}
+
+func TestFrameworkPathFromCtyPath(t *testing.T) {
+	testData := []struct {
+		input    cty.Path
+		expected *path.Path
+	}{
+		{
+			input:    cty.Path{},
+			expected: nil,
+		},
+		{
+			input:    cty.GetAttrPath("name"),
+			expected: pointer.To(path.Root("name")),
+		},
+		{
+			input:    cty.GetAttrPath("rule").IndexInt(1).GetAttr("priority"),
+			expected: pointer.To(path.Root("rule").AtListIndex(1).AtName("priority")),
+		},
+		{
+			input:    cty.GetAttrPath("tags").IndexString("env"),
+			expected: pointer.To(path.Root("tags").AtMapKey("env")),
+		},
+		{
+			input:    cty.GetAttrPath("zones").Index(cty.StringVal("1")).Index(cty.True),
+			expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %#v", v.input)
+
+		actual, ok := frameworkPathFromCtyPath(v.input)
+		if v.expected == nil {
+			if ok {
+				t.Fatalf("expected no path but got %s", actual)
+			}
+			continue
+		}
+
+		if !ok || !actual.Equal(*v.expected) {
+			t.Fatalf("expected %s but got %s", *v.expected, actual)
+		}
+	}
+}
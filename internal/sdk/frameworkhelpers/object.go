@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RequiredBlockValidator ensures that a block is specified in the configuration, since blocks (which are used
+// rather than nested attributes so that the schema can be served over protocol version 5) can't be marked as Required
+type RequiredBlockValidator struct{}
+
+func (w RequiredBlockValidator) Description(_ context.Context) string {
+	return "block must be specified"
+}
+
+func (w RequiredBlockValidator) MarkdownDescription(ctx context.Context) string {
+	return w.Description(ctx)
+}
+
+func (w RequiredBlockValidator) ValidateObject(_ context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	path := request.Path.String()
+	response.Diagnostics.AddAttributeError(request.Path, fmt.Sprintf("missing required block %s", path), fmt.Sprintf("the block %q must be specified", path))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// ListResource lists the instances of a Resource which exist within Azure, allowing existing resources to be
+// discovered in bulk (for example using `terraform query`) and then imported using the Resource ID.
+type ListResource interface {
+	// ResourceType is the name of the Resource being listed, for example `azurerm_resource_group`
+	ResourceType() string
+
+	// List returns the instances of the Resource within the Subscription, or within the Resource Group when
+	// one is specified in the ListRequest
+	List(ctx context.Context, metadata ListMetadata, request ListRequest) ([]ListResult, error)
+}
+
+// ListMetadata provides access to the Azure Clients configured by the Provider when listing resources
+type ListMetadata struct {
+	Client *clients.Client
+
+	SubscriptionId string
+}
+
+// ListRequest is the request to list the instances of a Resource
+type ListRequest struct {
+	// ResourceGroupName is the name of the Resource Group to list resources within - when empty, resources
+	// within the entire Subscription should be listed
+	ResourceGroupName string
+}
+
+// ListResult is a single instance of a Resource which has been listed
+type ListResult struct {
+	// Id is the Resource ID for this resource, which must be valid for the importer of the Resource
+	Id resourceids.ResourceId
+
+	// Name is the name of this resource, used both to filter by `name_prefix` and as the display name
+	Name string
+
+	// Tags are the Tags assigned to this resource, used to filter by `tags` - which is nil for resources
+	// which don't support Tags
+	Tags map[string]string
+}
+
+// listResultFilter filters the results returned from a ListResource, since these filters apply equally to
+// each Resource they're applied once the resources have been listed, rather than by each ListResource
+type listResultFilter struct {
+	namePrefix string
+	tags       map[string]string
+}
+
+func (f listResultFilter) matches(result ListResult) bool {
+	if f.namePrefix != "" && !strings.HasPrefix(strings.ToLower(result.Name), strings.ToLower(f.namePrefix)) {
+		return false
+	}
+
+	for k, v := range f.tags {
+		if existing, ok := result.Tags[k]; !ok || existing != v {
+			return false
+		}
+	}
+
+	return true
+}
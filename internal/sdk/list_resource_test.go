@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func TestListResultFilter(t *testing.T) {
+	result := ListResult{
+		Id:   pointer.To(commonids.NewVirtualNetworkID("00000000-0000-0000-0000-000000000000", "example", "example-network")),
+		Name: "example-network",
+		Tags: map[string]string{
+			"environment": "production",
+			"team":        "networking",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   listResultFilter
+		expected bool
+	}{
+		{
+			name:     "no filter",
+			filter:   listResultFilter{},
+			expected: true,
+		},
+		{
+			name: "matching name prefix",
+			filter: listResultFilter{
+				namePrefix: "EXAMPLE-",
+			},
+			expected: true,
+		},
+		{
+			name: "different name prefix",
+			filter: listResultFilter{
+				namePrefix: "other",
+			},
+			expected: false,
+		},
+		{
+			name: "matching tags",
+			filter: listResultFilter{
+				tags: map[string]string{
+					"environment": "production",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "different tag value",
+			filter: listResultFilter{
+				tags: map[string]string{
+					"environment": "staging",
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "missing tag",
+			filter: listResultFilter{
+				tags: map[string]string{
+					"cost-centre": "1234",
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		if actual := testCase.filter.matches(result); actual != testCase.expected {
+			t.Fatalf("expected %t but got %t", testCase.expected, actual)
+		}
+	}
+
+	// resources which don't support Tags never match a filter on Tags
+	untagged := ListResult{
+		Id:   pointer.To(commonids.NewSubnetID("00000000-0000-0000-0000-000000000000", "example", "example-network", "internal")),
+		Name: "internal",
+	}
+	if (listResultFilter{tags: map[string]string{"environment": "production"}}).matches(untagged) {
+		t.Fatalf("expected a resource without Tags not to match a filter on Tags")
+	}
+}
@@ -148,6 +148,18 @@ type ResourceWithDeprecationAndNoReplacement interface {
 	DeprecationMessage() string
 }
 
+// ResourceWithIdentity is an optional interface
+//
+// Resources implementing this interface publish a Resource Identity built
+// from the segments of the Resource ID type, allowing the resource to be
+// imported using the attributes of the Resource Identity.
+type ResourceWithIdentity interface {
+	Resource
+
+	// Identity returns the Resource ID type used for this Resource
+	Identity() resourceids.ResourceId
+}
+
 // ResourceWithCustomizeDiff is an optional interface
 type ResourceWithCustomizeDiff interface {
 	Resource
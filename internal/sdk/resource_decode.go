@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // Decode will decode the Terraform Schema into the specified object consisting of Supported Go native Types
@@ -62,6 +64,21 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 			return fmt.Errorf("parsing struct tags for %q: %+v", field.Name, err)
 		}
 
+		if structTags != nil && structTags.writeOnly {
+			tfschemaValue, err := writeOnlyValue(stateRetriever, structTags.hclPath, field.Type)
+			if err != nil {
+				return fmt.Errorf("retrieving write-only value for model field %q: %+v", field.Name, err)
+			}
+			if tfschemaValue == nil {
+				continue
+			}
+
+			if err := setValue(input, tfschemaValue, i, field.Name, debugLogger); err != nil {
+				return fmt.Errorf("while setting write-only value of model field %q: %+v", field.Name, err)
+			}
+			continue
+		}
+
 		if structTags != nil {
 			tfschemaValue, valExists := stateRetriever.GetOkExists(structTags.hclPath)
 			if !valExists {
@@ -79,6 +96,53 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	return nil
 }
 
+// writeOnlyValue returns the value of the write-only argument at hclPath from the raw config, converted
+// into the type used by the Plugin SDK for the model field, or nil when this isn't specified
+func writeOnlyValue(stateRetriever stateRetriever, hclPath string, fieldType reflect.Type) (interface{}, error) {
+	retriever, ok := stateRetriever.(interface{ GetRawConfig() cty.Value })
+	if !ok {
+		return nil, fmt.Errorf("the raw config isn't available")
+	}
+
+	value, err := pluginsdk.GetWriteOnly(retriever, hclPath)
+	if err != nil {
+		return nil, err
+	}
+	if value.IsNull() || !value.IsKnown() {
+		return nil, nil
+	}
+
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		if value.Type().Equals(cty.String) {
+			return value.AsString(), nil
+		}
+
+	case reflect.Bool:
+		if value.Type().Equals(cty.Bool) {
+			return value.True(), nil
+		}
+
+	case reflect.Int64:
+		if value.Type().Equals(cty.Number) {
+			v, _ := value.AsBigFloat().Int64()
+			return int(v), nil
+		}
+
+	case reflect.Float64:
+		if value.Type().Equals(cty.Number) {
+			v, _ := value.AsBigFloat().Float64()
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("write-only values of type %s cannot be decoded into a %s", value.Type().FriendlyName(), fieldType.Kind())
+}
+
 func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
 	debugLogger.Infof("setting value for %q..", fieldName)
 	defer func() {
@@ -8,10 +8,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 type decodeTestData struct {
 	State       map[string]interface{}
+	Config      cty.Value
 	Input       interface{}
 	Expected    interface{}
 	ExpectError bool
@@ -1035,6 +1037,55 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleOptionalItems(t *testing.T)
 	}.test(t)
 }
 
+func TestDecode_TopLevelFieldsWriteOnly(t *testing.T) {
+	type SimpleType struct {
+		Name            string  `tfschema:"name"`
+		Password        string  `tfschema:"password,writeOnly"`
+		OptionalSecret  *string `tfschema:"optional_secret,writeOnly"`
+		PasswordVersion int64   `tfschema:"password_version"`
+		Port            *int64  `tfschema:"port,writeOnly"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name":             "bingo",
+			"password":         "",
+			"optional_secret":  "",
+			"password_version": 2,
+		},
+		Config: cty.ObjectVal(map[string]cty.Value{
+			"name":             cty.StringVal("bingo"),
+			"password":         cty.StringVal("s3cr3t"),
+			"optional_secret":  cty.NullVal(cty.String),
+			"password_version": cty.NumberIntVal(2),
+			"port":             cty.NumberIntVal(5432),
+		}),
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Name:            "bingo",
+			Password:        "s3cr3t",
+			PasswordVersion: 2,
+			Port:            pointer.To(int64(5432)),
+		},
+	}.test(t)
+}
+
+func TestDecode_TopLevelFieldsWriteOnlyNoConfig(t *testing.T) {
+	type SimpleType struct {
+		Name     string `tfschema:"name"`
+		Password string `tfschema:"password,writeOnly"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name":     "bingo",
+			"password": "",
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Name: "bingo",
+		},
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -1058,11 +1109,13 @@ func (testData decodeTestData) test(t *testing.T) {
 func (testData decodeTestData) stateWrapper() testDataGetter {
 	return testDataGetter{
 		values: testData.State,
+		config: testData.Config,
 	}
 }
 
 type testDataGetter struct {
 	values map[string]interface{}
+	config cty.Value
 }
 
 func (td testDataGetter) Get(key string) interface{} {
@@ -1079,3 +1132,10 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+func (td testDataGetter) GetRawConfig() cty.Value {
+	if td.config == cty.NilVal {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return td.config
+}
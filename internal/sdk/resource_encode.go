@@ -70,6 +70,11 @@ func recurse(objType reflect.Type, objVal reflect.Value, debugLogger Logger) (ou
 				continue
 			}
 
+			if structTags.writeOnly {
+				debugLogger.Infof("The HCL Path %q is marked as write-only - skipping", structTags.hclPath)
+				continue
+			}
+
 			switch field.Type.Kind() {
 			case reflect.Int64:
 				iv := fieldVal.Int()
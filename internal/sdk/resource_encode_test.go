@@ -97,6 +97,26 @@ func TestResourceEncode_TopLevel(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_TopLevelWriteOnly(t *testing.T) {
+	type SimpleType struct {
+		Name            string `tfschema:"name"`
+		Password        string `tfschema:"password,writeOnly"`
+		PasswordVersion int64  `tfschema:"password_version"`
+	}
+
+	encodeTestData{
+		Input: &SimpleType{
+			Name:            "world",
+			Password:        "s3cr3t",
+			PasswordVersion: 2,
+		},
+		Expected: map[string]interface{}{
+			"name":             "world",
+			"password_version": int64(2),
+		},
+	}.test(t)
+}
+
 func TestResourceEncode_TopLevelAllTypesAndCombinations(t *testing.T) {
 	encodeTestData{
 		Input: &OneOfEverything{
@@ -4,6 +4,7 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -41,6 +42,35 @@ type UntypedServiceRegistration interface {
 	SupportedResources() map[string]*pluginsdk.Resource
 }
 
+// FrameworkServiceRegistration is the interface used for resources which are implemented natively
+// using the Terraform Plugin Framework, rather than being served through the Plugin SDK
+type FrameworkServiceRegistration interface {
+	// Name is the name of this Service
+	Name() string
+
+	// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+	EphemeralResources() []func() ephemeral.EphemeralResource
+
+	// WebsiteCategories returns a list of categories which can be used for the sidebar
+	WebsiteCategories() []string
+}
+
+// FrameworkTypedServiceRegistration is a superset of FrameworkServiceRegistration allowing Typed
+// Resources and Data Sources to opt into being served natively by the Terraform Plugin Framework,
+// rather than through the Plugin SDK.
+//
+// NOTE: Resources and Data Sources returned here must not also be returned from the
+// TypedServiceRegistration for this Service, since each can only be served by a single Provider
+type FrameworkTypedServiceRegistration interface {
+	FrameworkServiceRegistration
+
+	// FrameworkDataSources returns a list of Typed Data Sources served by the Plugin Framework
+	FrameworkDataSources() []DataSource
+
+	// FrameworkResources returns a list of Typed Resources served by the Plugin Framework
+	FrameworkResources() []Resource
+}
+
 // TypedServiceRegistrationWithAGitHubLabel is a superset of TypedServiceRegistration allowing
 // a single GitHub Label to be specified that will be automatically applied to any Pull Requests
 // making changes to this package.
@@ -64,3 +94,13 @@ type UntypedServiceRegistrationWithAGitHubLabel interface {
 
 	AssociatedGitHubLabel() string
 }
+
+// ServiceRegistrationWithListResources is an optional interface for a (Typed or Untyped) Service Registration,
+// allowing a Service to support listing the existing instances of its Resources, so that these can be
+// discovered and imported in bulk.
+//
+// NOTE: the Resource being listed must be served by the Plugin SDK and be registered by this Service
+type ServiceRegistrationWithListResources interface {
+	// ListResources returns a list of List Resources supported by this Service
+	ListResources() []ListResource
+}
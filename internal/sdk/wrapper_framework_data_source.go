@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	_ datasource.DataSourceWithConfigure      = &FrameworkDataSourceWrapper{}
+	_ datasource.DataSourceWithValidateConfig = &FrameworkDataSourceWrapper{}
+)
+
+// FrameworkDataSourceWrapper is a wrapper for serving a (Typed) Data Source implementation natively
+// through the Terraform Plugin Framework, reusing the existing Read function via the Plugin SDK
+type FrameworkDataSourceWrapper struct {
+	dataSource DataSource
+
+	// meta is the `*clients.Client` configured by the Provider, which is passed into the Plugin SDK
+	meta interface{}
+}
+
+// NewFrameworkDataSourceWrapper returns a function which returns a FrameworkDataSourceWrapper for this
+// Data Source implementation, which can be registered within the Plugin Framework Provider
+func NewFrameworkDataSourceWrapper(dataSource DataSource) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &FrameworkDataSourceWrapper{
+			dataSource: dataSource,
+		}
+	}
+}
+
+func (fw *FrameworkDataSourceWrapper) pluginSdkDataSource() (*schema.Resource, error) {
+	wrapper := NewDataSourceWrapper(fw.dataSource)
+	return wrapper.DataSource()
+}
+
+func (fw *FrameworkDataSourceWrapper) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fw.dataSource.ResourceType()
+}
+
+func (fw *FrameworkDataSourceWrapper) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sdkDataSource, err := fw.pluginSdkDataSource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Data Source %q", fw.dataSource.ResourceType()), err.Error())
+		return
+	}
+
+	dataSourceSchema, err := frameworkDataSourceSchema(sdkDataSource)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Schema for Data Source %q", fw.dataSource.ResourceType()), err.Error())
+		return
+	}
+
+	resp.Schema = *dataSourceSchema
+}
+
+func (fw *FrameworkDataSourceWrapper) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// the Provider Data isn't available during validation, so there's nothing to configure
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := clientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Provider Data Error", err.Error())
+		return
+	}
+
+	fw.meta = client
+}
+
+// ValidateConfig validates the configuration using the Plugin SDK Schema, so that the existing validation
+// functions and constraints (e.g. `ConflictsWith` and `ExactlyOneOf`) continue to be used
+func (fw *FrameworkDataSourceWrapper) ValidateConfig(_ context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	sdkDataSource, err := fw.pluginSdkDataSource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Data Source %q", fw.dataSource.ResourceType()), err.Error())
+		return
+	}
+
+	config, err := ctyValueFromFrameworkValue(sdkDataSource.CoreConfigSchema().ImpliedType(), req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting Config", err.Error())
+		return
+	}
+
+	diags := sdkDataSource.Validate(terraform.NewResourceConfigShimmed(config, sdkDataSource.CoreConfigSchema()))
+	resp.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(diags)...)
+}
+
+// Read reads the Data Source by calling into the Plugin SDK, in the same manner as the Plugin SDK's own
+// implementation of `ReadDataSource`
+func (fw *FrameworkDataSourceWrapper) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	sdkDataSource, err := fw.pluginSdkDataSource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Data Source %q", fw.dataSource.ResourceType()), err.Error())
+		return
+	}
+	ty := sdkDataSource.CoreConfigSchema().ImpliedType()
+
+	config, err := ctyValueFromFrameworkValue(ty, req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting Config", err.Error())
+		return
+	}
+
+	diff, err := sdkDataSource.Diff(ctx, nil, terraform.NewResourceConfigShimmed(config, sdkDataSource.CoreConfigSchema()), fw.meta)
+	if err != nil {
+		resp.Diagnostics.AddError("building Diff", err.Error())
+		return
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{
+			Attributes: make(map[string]*terraform.ResourceAttrDiff),
+		}
+	}
+	diff.RawConfig = config
+
+	timeouts, err := resourceTimeoutsFromValue(sdkDataSource, config)
+	if err != nil {
+		resp.Diagnostics.AddError("converting Config", err.Error())
+		return
+	}
+	if err := timeouts.DiffEncode(diff); err != nil {
+		resp.Diagnostics.AddError("encoding `timeouts`", err.Error())
+		return
+	}
+
+	newInstanceState, diags := sdkDataSource.ReadDataApply(ctx, diff, fw.meta)
+	resp.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(diags)...)
+	if diags.HasError() {
+		return
+	}
+
+	newState, err := ctyValueFromInstanceState(ty, newInstanceState)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	newState = copyTimeoutValues(newState, config)
+	newState = normalizeNullValues(newState, withComputedValuesUnknown(config, sdkDataSource))
+
+	out, err := frameworkValueFromCtyValue(req.Config.Raw.Type(), newState)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	resp.State.Raw = out
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	_ list.ListResourceWithConfigure    = &FrameworkListResourceWrapper{}
+	_ list.ListResourceWithRawV5Schemas = &FrameworkListResourceWrapper{}
+)
+
+// FrameworkListResourceWrapper is a wrapper for serving a ListResource through the Terraform Plugin Framework,
+// for a Resource which is served by the Plugin SDK.
+//
+// The resources returned from the ListResource are filtered using the (common) `name_prefix` and `tags` fields,
+// and the Resource Identity for each is built from the Resource ID. When the full resource is requested, the
+// existing Read function for the Resource is called (via the Plugin SDK) to populate the resource.
+type FrameworkListResourceWrapper struct {
+	listResource ListResource
+
+	// resource is the Plugin SDK representation of the Resource being listed
+	resource *schema.Resource
+
+	// client is the `*clients.Client` configured by the Provider
+	client *clients.Client
+}
+
+// NewFrameworkListResourceWrapper returns a function which returns a FrameworkListResourceWrapper for this
+// ListResource, which can be registered within the Plugin Framework Provider
+func NewFrameworkListResourceWrapper(listResource ListResource, sdkResource *schema.Resource) func() list.ListResource {
+	return func() list.ListResource {
+		return &FrameworkListResourceWrapper{
+			listResource: listResource,
+			resource:     sdkResource,
+		}
+	}
+}
+
+type frameworkListResourceModel struct {
+	ResourceGroupName types.String `tfsdk:"resource_group_name"`
+	NamePrefix        types.String `tfsdk:"name_prefix"`
+	Tags              types.Map    `tfsdk:"tags"`
+}
+
+func (fw *FrameworkListResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fw.listResource.ResourceType()
+}
+
+func (fw *FrameworkListResourceWrapper) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"resource_group_name": listschema.StringAttribute{
+				Description: "The name of the Resource Group to list resources within. When omitted, resources within the entire Subscription are listed.",
+				Optional:    true,
+			},
+			"name_prefix": listschema.StringAttribute{
+				Description: "Only resources with a name starting with this (case-insensitive) prefix are listed.",
+				Optional:    true,
+			},
+			"tags": listschema.MapAttribute{
+				Description: "Only resources which have all of these Tags assigned are listed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (fw *FrameworkListResourceWrapper) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	resp.ProtoV5Schema = fw.resource.ProtoSchema(ctx)()
+	if identitySchema := fw.resource.ProtoIdentitySchema(ctx); identitySchema != nil {
+		resp.ProtoV5IdentitySchema = identitySchema()
+	}
+}
+
+func (fw *FrameworkListResourceWrapper) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// the Provider Data isn't available during validation, so there's nothing to configure
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Client Provider Data Error", fmt.Sprintf("expected the Provider Data to be a `*clients.Client` but got %T", req.ProviderData))
+		return
+	}
+
+	fw.client = client
+}
+
+func (fw *FrameworkListResourceWrapper) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags frameworkdiag.Diagnostics
+
+	var config frameworkListResourceModel
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	filter := listResultFilter{
+		namePrefix: config.NamePrefix.ValueString(),
+	}
+	if !config.Tags.IsNull() && !config.Tags.IsUnknown() {
+		diags.Append(config.Tags.ElementsAs(ctx, &filter.tags, false)...)
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	if fw.client == nil {
+		diags.AddError("Client Provider Data Error", "the Provider hasn't been configured")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	metadata := ListMetadata{
+		Client:         fw.client,
+		SubscriptionId: fw.client.Account.SubscriptionId,
+	}
+	request := ListRequest{
+		ResourceGroupName: config.ResourceGroupName.ValueString(),
+	}
+	results, err := fw.listResource.List(ctx, metadata, request)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("listing %s", fw.listResource.ResourceType()), err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return strings.ToLower(results[i].Id.ID()) < strings.ToLower(results[j].Id.ID())
+	})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		count := int64(0)
+		for _, item := range results {
+			if !filter.matches(item) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.Name
+
+			identity, err := pluginsdk.ResourceIdentityValues(item.Id)
+			if err != nil {
+				result.Diagnostics.AddError("building Resource Identity", err.Error())
+			}
+			for k, v := range identity {
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(k), v)...)
+			}
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				raw, diags := fw.readResource(ctx, item.Id.ID(), req.ResourceSchema.Type().TerraformType(ctx))
+				result.Diagnostics.Append(diags...)
+				if !diags.HasError() {
+					result.Resource.Raw = raw
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+			count++
+		}
+	}
+}
+
+// readResource retrieves the resource with the ID by calling the Read function for the Resource via the
+// Plugin SDK, in the same manner as when the resource is refreshed after being imported
+func (fw *FrameworkListResourceWrapper) readResource(ctx context.Context, id string, ty tftypes.Type) (tftypes.Value, frameworkdiag.Diagnostics) {
+	var diags frameworkdiag.Diagnostics
+
+	instanceState := &terraform.InstanceState{
+		ID: id,
+		Attributes: map[string]string{
+			"id": id,
+		},
+	}
+	if fw.resource.Timeouts != nil {
+		if err := fw.resource.Timeouts.StateEncode(instanceState); err != nil {
+			diags.AddError("encoding `timeouts`", err.Error())
+			return tftypes.Value{}, diags
+		}
+	}
+
+	newInstanceState, sdkDiags := fw.resource.RefreshWithoutUpgrade(ctx, instanceState, fw.client)
+	diags.Append(frameworkDiagnosticsFromPluginSdk(sdkDiags)...)
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+	if newInstanceState == nil || newInstanceState.ID == "" {
+		diags.AddError(fmt.Sprintf("retrieving %s", fw.listResource.ResourceType()), fmt.Sprintf("%q was not found", id))
+		return tftypes.Value{}, diags
+	}
+
+	state, err := ctyValueFromInstanceState(fw.resource.CoreConfigSchema().ImpliedType(), newInstanceState)
+	if err != nil {
+		diags.AddError("converting State", err.Error())
+		return tftypes.Value{}, diags
+	}
+
+	out, err := frameworkValueFromCtyValue(ty, state)
+	if err != nil {
+		diags.AddError("converting State", err.Error())
+		return tftypes.Value{}, diags
+	}
+
+	return out, diags
+}
@@ -0,0 +1,373 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	frameworkdiag "github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+var (
+	_ resource.ResourceWithConfigure      = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithImportState    = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithValidateConfig = &FrameworkResourceWrapper{}
+)
+
+// FrameworkResourceWrapper is a wrapper for serving a (Typed) Resource implementation natively
+// through the Terraform Plugin Framework.
+//
+// The Schema for the Resource is converted into the equivalent Plugin Framework Schema (using nested
+// attributes and plan modifiers) - however the existing CRUD functions are reused as-is, by calling
+// into them via the Plugin SDK, so that Resources can opt into being served by the Plugin Framework
+// without needing to be rewritten.
+type FrameworkResourceWrapper struct {
+	resource Resource
+
+	// meta is the `*clients.Client` configured by the Provider, which is passed into the Plugin SDK
+	meta interface{}
+}
+
+// NewFrameworkResourceWrapper returns a function which returns a FrameworkResourceWrapper for this
+// Resource implementation, which can be registered within the Plugin Framework Provider
+func NewFrameworkResourceWrapper(typedResource Resource) func() resource.Resource {
+	return func() resource.Resource {
+		return &FrameworkResourceWrapper{
+			resource: typedResource,
+		}
+	}
+}
+
+// pluginSdkResource returns the Plugin SDK representation of this Resource, which is used to call into
+// the CRUD functions for this Resource
+func (fw *FrameworkResourceWrapper) pluginSdkResource() (*schema.Resource, error) {
+	if _, ok := fw.resource.(ResourceWithCustomizeDiff); ok {
+		return nil, fmt.Errorf("Resource %q implements ResourceWithCustomizeDiff which isn't supported by the Plugin Framework, use plan modifiers instead", fw.resource.ResourceType())
+	}
+	if _, ok := fw.resource.(ResourceWithStateMigration); ok {
+		return nil, fmt.Errorf("Resource %q implements ResourceWithStateMigration which isn't supported by the Plugin Framework", fw.resource.ResourceType())
+	}
+
+	wrapper := NewResourceWrapper(fw.resource)
+	return wrapper.Resource()
+}
+
+func (fw *FrameworkResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fw.resource.ResourceType()
+}
+
+func (fw *FrameworkResourceWrapper) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	sdkResource, err := fw.pluginSdkResource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Resource %q", fw.resource.ResourceType()), err.Error())
+		return
+	}
+
+	resourceSchema, err := frameworkResourceSchema(sdkResource)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Schema for Resource %q", fw.resource.ResourceType()), err.Error())
+		return
+	}
+
+	resp.Schema = *resourceSchema
+}
+
+func (fw *FrameworkResourceWrapper) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// the Provider Data isn't available during validation, so there's nothing to configure
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, err := clientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Provider Data Error", err.Error())
+		return
+	}
+
+	fw.meta = client
+}
+
+// ValidateConfig validates the configuration using the Plugin SDK Schema, so that the existing validation
+// functions and constraints (e.g. `ConflictsWith` and `ExactlyOneOf`) continue to be used
+func (fw *FrameworkResourceWrapper) ValidateConfig(_ context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	sdkResource, err := fw.pluginSdkResource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Resource %q", fw.resource.ResourceType()), err.Error())
+		return
+	}
+
+	config, err := ctyValueFromFrameworkValue(sdkResource.CoreConfigSchema().ImpliedType(), req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting Config", err.Error())
+		return
+	}
+
+	diags := sdkResource.Validate(terraform.NewResourceConfigShimmed(config, sdkResource.CoreConfigSchema()))
+	resp.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(diags)...)
+}
+
+func (fw *FrameworkResourceWrapper) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// there's no prior state when creating the resource, so this is the (null) state from the response
+	newState, diags := fw.apply(ctx, resp.State, req.Plan.Raw, req.Config.Raw)
+	resp.Diagnostics.Append(diags...)
+	if newState != nil {
+		resp.State.Raw = *newState
+	}
+}
+
+func (fw *FrameworkResourceWrapper) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	sdkResource, err := fw.pluginSdkResource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Resource %q", fw.resource.ResourceType()), err.Error())
+		return
+	}
+	ty := sdkResource.CoreConfigSchema().ImpliedType()
+
+	state, err := ctyValueFromFrameworkValue(ty, req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+
+	instanceState, err := sdkResource.ShimInstanceStateFromValue(state)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	timeouts, err := resourceTimeoutsFromValue(sdkResource, state)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	if err := timeouts.StateEncode(instanceState); err != nil {
+		resp.Diagnostics.AddError("encoding `timeouts`", err.Error())
+		return
+	}
+
+	newInstanceState, diags := sdkResource.RefreshWithoutUpgrade(ctx, instanceState, fw.meta)
+	resp.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(diags)...)
+	if diags.HasError() {
+		return
+	}
+
+	if newInstanceState == nil || newInstanceState.ID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, err := fw.newStateValue(ty, newInstanceState, state, req.State.Raw.Type())
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	resp.State.Raw = newState
+}
+
+func (fw *FrameworkResourceWrapper) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	newState, diags := fw.apply(ctx, req.State, req.Plan.Raw, req.Config.Raw)
+	resp.Diagnostics.Append(diags...)
+	if newState != nil {
+		resp.State.Raw = *newState
+	}
+}
+
+func (fw *FrameworkResourceWrapper) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	sdkResource, err := fw.pluginSdkResource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Resource %q", fw.resource.ResourceType()), err.Error())
+		return
+	}
+	ty := sdkResource.CoreConfigSchema().ImpliedType()
+
+	prior, err := ctyValueFromFrameworkValue(ty, req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+
+	priorState, err := sdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+
+	diff := &terraform.InstanceDiff{
+		Attributes: make(map[string]*terraform.ResourceAttrDiff),
+		Meta:       make(map[string]interface{}),
+		Destroy:    true,
+		RawPlan:    cty.NullVal(ty),
+		RawState:   prior,
+		RawConfig:  cty.NullVal(ty),
+	}
+	timeouts, err := resourceTimeoutsFromValue(sdkResource, prior)
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	if err := timeouts.DiffEncode(diff); err != nil {
+		resp.Diagnostics.AddError("encoding `timeouts`", err.Error())
+		return
+	}
+
+	_, diags := sdkResource.Apply(ctx, priorState, diff, fw.meta)
+	resp.Diagnostics.Append(frameworkDiagnosticsFromPluginSdk(diags)...)
+}
+
+func (fw *FrameworkResourceWrapper) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	sdkResource, err := fw.pluginSdkResource()
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("building Resource %q", fw.resource.ResourceType()), err.Error())
+		return
+	}
+	ty := sdkResource.CoreConfigSchema().ImpliedType()
+
+	data := sdkResource.Data(&terraform.InstanceState{
+		ID: req.ID,
+	})
+	imported, err := sdkResource.Importer.StateContext(ctx, data, fw.meta)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %q", req.ID), err.Error())
+		return
+	}
+	if len(imported) != 1 {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %q", req.ID), fmt.Sprintf("expected a single resource to be imported but got %d", len(imported)))
+		return
+	}
+
+	// the remaining fields are populated when the resource is subsequently read
+	newState, err := fw.newStateValue(ty, imported[0].State(), cty.NullVal(ty), resp.State.Raw.Type())
+	if err != nil {
+		resp.Diagnostics.AddError("converting State", err.Error())
+		return
+	}
+	resp.State.Raw = newState
+}
+
+// apply creates or updates the resource by calling into the Plugin SDK, in the same manner as the
+// Plugin SDK's own implementation of `ApplyResourceChange` - returning the new state for the resource
+func (fw *FrameworkResourceWrapper) apply(ctx context.Context, priorState tfsdk.State, plannedValue, configValue tftypes.Value) (*tftypes.Value, frameworkdiag.Diagnostics) {
+	diags := frameworkdiag.Diagnostics{}
+
+	sdkResource, err := fw.pluginSdkResource()
+	if err != nil {
+		diags.AddError(fmt.Sprintf("building Resource %q", fw.resource.ResourceType()), err.Error())
+		return nil, diags
+	}
+	ty := sdkResource.CoreConfigSchema().ImpliedType()
+
+	prior, err := ctyValueFromFrameworkValue(ty, priorState.Raw)
+	if err != nil {
+		diags.AddError("converting State", err.Error())
+		return nil, diags
+	}
+	planned, err := ctyValueFromFrameworkValue(ty, plannedValue)
+	if err != nil {
+		diags.AddError("converting Plan", err.Error())
+		return nil, diags
+	}
+	config, err := ctyValueFromFrameworkValue(ty, configValue)
+	if err != nil {
+		diags.AddError("converting Config", err.Error())
+		return nil, diags
+	}
+
+	instanceState, err := sdkResource.ShimInstanceStateFromValue(prior)
+	if err != nil {
+		diags.AddError("converting State", err.Error())
+		return nil, diags
+	}
+
+	diff, err := schema.DiffFromValues(ctx, prior, planned, config, sdkResource)
+	if err != nil {
+		diags.AddError("building Diff", err.Error())
+		return nil, diags
+	}
+	if diff == nil {
+		diff = &terraform.InstanceDiff{
+			Attributes: make(map[string]*terraform.ResourceAttrDiff),
+		}
+	}
+	diff.Meta = make(map[string]interface{})
+	diff.RawConfig = config
+	diff.RawPlan = planned
+	diff.RawState = prior
+
+	for k, d := range diff.Attributes {
+		// replacements are handled by the Plugin Framework (which calls Delete and then Create), so any
+		// RequiresNew needs to be removed, else the state is dropped from the ResourceData
+		d.RequiresNew = false
+
+		// remove any "removed" attributes that don't exist in the prior state, to avoid confusing the Plugin SDK
+		if d.NewRemoved {
+			if _, ok := instanceState.Attributes[k]; !ok {
+				delete(diff.Attributes, k)
+			}
+		}
+	}
+
+	timeouts, err := resourceTimeoutsFromValue(sdkResource, config)
+	if err != nil {
+		diags.AddError("converting Config", err.Error())
+		return nil, diags
+	}
+	if err := timeouts.DiffEncode(diff); err != nil {
+		diags.AddError("encoding `timeouts`", err.Error())
+		return nil, diags
+	}
+
+	newInstanceState, sdkDiags := sdkResource.Apply(ctx, instanceState, diff, fw.meta)
+	diags.Append(frameworkDiagnosticsFromPluginSdk(sdkDiags)...)
+
+	newState, err := fw.newStateValue(ty, newInstanceState, planned, priorState.Raw.Type())
+	if err != nil {
+		diags.AddError("converting State", err.Error())
+		return nil, diags
+	}
+
+	return &newState, diags
+}
+
+// newStateValue converts the InstanceState returned from the Plugin SDK into the value stored in the
+// Plugin Framework state, where `src` is the planned value (or prior state) used to normalize nulls
+func (fw *FrameworkResourceWrapper) newStateValue(ty cty.Type, input *terraform.InstanceState, src cty.Value, frameworkType tftypes.Type) (tftypes.Value, error) {
+	newState, err := ctyValueFromInstanceState(ty, input)
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	newState = copyTimeoutValues(newState, src)
+	newState = normalizeNullValues(newState, src)
+
+	return frameworkValueFromCtyValue(frameworkType, newState)
+}
+
+// configuredClientProvider is implemented by the Provider Configuration, which is used as the Provider Data when
+// the Plugin Framework Provider isn't muxed with the Plugin SDK Provider
+type configuredClientProvider interface {
+	ConfiguredClient() *clients.Client
+}
+
+// clientFromProviderData returns the configured client from the Provider Data, which is either the client itself
+// (when muxed with the Plugin SDK Provider) or the Provider Configuration
+func clientFromProviderData(input interface{}) (*clients.Client, error) {
+	switch v := input.(type) {
+	case *clients.Client:
+		return v, nil
+	case configuredClientProvider:
+		if client := v.ConfiguredClient(); client != nil {
+			return client, nil
+		}
+		return nil, fmt.Errorf("the Provider Data doesn't contain a configured client")
+	}
+
+	return nil, fmt.Errorf("expected the Provider Data to be a `*clients.Client` but got %T", input)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+type testConfiguredClientProvider struct {
+	client *clients.Client
+}
+
+func (p testConfiguredClientProvider) ConfiguredClient() *clients.Client {
+	return p.client
+}
+
+func TestClientFromProviderData(t *testing.T) {
+	client := &clients.Client{}
+
+	testData := []struct {
+		name     string
+		input    interface{}
+		expected *clients.Client
+	}{
+		{
+			name:     "client",
+			input:    client,
+			expected: client,
+		},
+		{
+			name:     "provider configuration",
+			input:    testConfiguredClientProvider{client: client},
+			expected: client,
+		},
+		{
+			name:  "unconfigured provider configuration",
+			input: testConfiguredClientProvider{},
+		},
+		{
+			name:  "unexpected type",
+			input: "client",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := clientFromProviderData(v.input)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %p but got %p", v.expected, actual)
+		}
+	}
+}
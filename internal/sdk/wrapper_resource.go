@@ -90,7 +90,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 				for _, err := range errors {
 					out += err.Error()
 				}
-				return fmt.Errorf(out)
+				return fmt.Errorf("%s", out)
 			}
 
 			return nil
@@ -179,6 +179,14 @@ and we recommend using the %[2]q resource instead.
 	}
 	// TODO: State Migrations
 
+	if v, ok := rw.resource.(ResourceWithIdentity); ok {
+		// the Resource ID type identifies the Resource Manager Resource Type, so the `location` is validated during the
+		// plan against the locations where the Resource Type is available
+		pluginsdk.EnableLocationValidation(&resource, v.Identity())
+
+		return pluginsdk.WithResourceIdentity(&resource, v.Identity()), nil
+	}
+
 	return &resource, nil
 }
 
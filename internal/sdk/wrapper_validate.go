@@ -67,6 +67,20 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 		if structTags == nil {
 			return fmt.Errorf("field %q is missing a struct tag for `tfschema`", fieldName)
 		}
+
+		if structTags.writeOnly {
+			if prefix != "" {
+				return fmt.Errorf("field %q is marked as `writeOnly` but only top-level fields can be write-only", fieldName)
+			}
+
+			kind := field.Type.Kind()
+			if kind == reflect.Pointer {
+				kind = field.Type.Elem().Kind()
+			}
+			if kind != reflect.String && kind != reflect.Bool && kind != reflect.Int64 && kind != reflect.Float64 {
+				return fmt.Errorf("field %q is marked as `writeOnly` but write-only fields must be a string, bool, int64 or float64", fieldName)
+			}
+		}
 	}
 
 	return nil
@@ -69,3 +69,35 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateWriteOnlyObjectValid(t *testing.T) {
+	type Person struct {
+		Name     string  `tfschema:"name"`
+		Password *string `tfschema:"password,writeOnly"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateWriteOnlyObjectInvalid(t *testing.T) {
+	t.Log("Nested")
+	type Pet struct {
+		Name string `tfschema:"name,writeOnly"`
+	}
+	type Person1 struct {
+		Name string `tfschema:"name"`
+		Pets []Pet  `tfschema:"pets"`
+	}
+	if err := ValidateModelObject(&Person1{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("List")
+	type Person2 struct {
+		Passwords []string `tfschema:"passwords,writeOnly"`
+	}
+	if err := ValidateModelObject(&Person2{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview/tenants"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -50,6 +51,10 @@ func (r AadB2cDirectoryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return tenants.ValidateB2CDirectoryID
 }
 
+func (r AadB2cDirectoryResource) Identity() resourceids.ResourceId {
+	return &tenants.B2CDirectoryId{}
+}
+
 func (r AadB2cDirectoryResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"domain_name": {
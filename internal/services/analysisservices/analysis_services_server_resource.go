@@ -162,7 +162,10 @@ func resourceAnalysisServicesServer() *pluginsdk.Resource {
 			ConflictsWith: []string{"power_bi_service_enabled"},
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &servers.ServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &servers.ServerId{})
 }
 
 func resourceAnalysisServicesServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceApiManagementApiDiagnostic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiDiagnosticCreateUpdate,
 		Read:   resourceApiManagementApiDiagnosticRead,
 		Update: resourceApiManagementApiDiagnosticCreateUpdate,
@@ -123,7 +123,7 @@ func resourceApiManagementApiDiagnostic() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &apidiagnostic.ApiDiagnosticId{})
 }
 
 func resourceApiManagementApiDiagnosticAdditionalContentSchema() *pluginsdk.Schema {
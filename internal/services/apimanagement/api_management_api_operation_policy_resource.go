@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementAPIOperationPolicyCreateUpdate,
 		Read:   resourceApiManagementAPIOperationPolicyRead,
 		Update: resourceApiManagementAPIOperationPolicyCreateUpdate,
@@ -68,7 +68,7 @@ func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 			},
 		},
-	}
+	}, &apioperationpolicy.OperationId{})
 }
 
 func resourceApiManagementAPIOperationPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementApiOperation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiOperationCreateUpdate,
 		Read:   resourceApiManagementApiOperationRead,
 		Update: resourceApiManagementApiOperationCreateUpdate,
@@ -111,7 +111,7 @@ func resourceApiManagementApiOperation() *pluginsdk.Resource {
 
 			"template_parameter": schemaz.SchemaApiManagementOperationParameterContract(),
 		},
-	}
+	}, &apioperation.OperationId{})
 }
 
 func resourceApiManagementApiOperationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementApiOperationTag() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiOperationTagCreateUpdate,
 		Read:   resourceApiManagementApiOperationTagRead,
 		Update: resourceApiManagementApiOperationTagCreateUpdate,
@@ -59,7 +59,7 @@ func resourceApiManagementApiOperationTag() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &apioperationtag.OperationTagId{})
 }
 
 func resourceApiManagementApiOperationTagCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementApiPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementAPIPolicyCreateUpdate,
 		Read:   resourceApiManagementAPIPolicyRead,
 		Update: resourceApiManagementAPIPolicyCreateUpdate,
@@ -66,7 +66,7 @@ func resourceApiManagementApiPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 			},
 		},
-	}
+	}, &apipolicy.ApiId{})
 }
 
 func resourceApiManagementAPIPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementApiRelease() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiReleaseCreateUpdate,
 		Read:   resourceApiManagementApiReleaseRead,
 		Update: resourceApiManagementApiReleaseCreateUpdate,
@@ -60,7 +60,7 @@ func resourceApiManagementApiRelease() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &apirelease.ReleaseId{})
 }
 
 func resourceApiManagementApiReleaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
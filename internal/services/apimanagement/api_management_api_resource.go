@@ -348,7 +348,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &api.ApiId{})
 }
 
 func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
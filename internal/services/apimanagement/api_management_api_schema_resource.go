@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementApiSchema() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiSchemaCreateUpdate,
 		Read:   resourceApiManagementApiSchemaRead,
 		Update: resourceApiManagementApiSchemaCreateUpdate,
@@ -81,7 +81,7 @@ func resourceApiManagementApiSchema() *pluginsdk.Resource {
 				ExactlyOneOf:     []string{"value", "definitions", "components"},
 			},
 		},
-	}
+	}, &apischema.ApiSchemaId{})
 }
 
 func resourceApiManagementApiSchemaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
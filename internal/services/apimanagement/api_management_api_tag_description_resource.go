@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementApiTagDescription() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiTagDescriptionCreateUpdate,
 		Read:   resourceApiManagementApiTagDescriptionRead,
 		Update: resourceApiManagementApiTagDescriptionCreateUpdate,
@@ -63,7 +63,7 @@ func resourceApiManagementApiTagDescription() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &apitagdescription.TagDescriptionId{})
 }
 
 func resourceApiManagementApiTagDescriptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
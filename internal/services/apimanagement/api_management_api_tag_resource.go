@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementApiTag() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiTagCreate,
 		Read:   resourceApiManagementApiTagRead,
 		Delete: resourceApiManagementApiTagDelete,
@@ -50,7 +50,7 @@ func resourceApiManagementApiTag() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &apitag.ApiTagId{})
 }
 
 func resourceApiManagementApiTagCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceApiManagementApiVersionSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementApiVersionSetCreateUpdate,
 		Read:   resourceApiManagementApiVersionSetRead,
 		Update: resourceApiManagementApiVersionSetCreateUpdate,
@@ -88,7 +88,7 @@ func resourceApiManagementApiVersionSet() *pluginsdk.Resource {
 				ConflictsWith: []string{"version_header_name"},
 			},
 		},
-	}
+	}, &apiversionset.ApiVersionSetId{})
 }
 
 func resourceApiManagementApiVersionSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
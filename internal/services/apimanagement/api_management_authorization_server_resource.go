@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementAuthorizationServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementAuthorizationServerCreateUpdate,
 		Read:   resourceApiManagementAuthorizationServerRead,
 		Update: resourceApiManagementAuthorizationServerCreateUpdate,
@@ -186,7 +186,7 @@ func resourceApiManagementAuthorizationServer() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &authorizationserver.AuthorizationServerId{})
 }
 
 func resourceApiManagementAuthorizationServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
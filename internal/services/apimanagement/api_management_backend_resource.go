@@ -24,7 +24,7 @@ import (
 )
 
 func resourceApiManagementBackend() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementBackendCreateUpdate,
 		Read:   resourceApiManagementBackendRead,
 		Update: resourceApiManagementBackendCreateUpdate,
@@ -254,7 +254,7 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &backend.BackendId{})
 }
 
 func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
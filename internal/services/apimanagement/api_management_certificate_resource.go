@@ -23,7 +23,7 @@ import (
 )
 
 func resourceApiManagementCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementCertificateCreateUpdate,
 		Read:   resourceApiManagementCertificateRead,
 		Update: resourceApiManagementCertificateCreateUpdate,
@@ -93,7 +93,7 @@ func resourceApiManagementCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &certificate.CertificateId{})
 }
 
 func resourceApiManagementCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 var apiManagementCustomDomainResourceName = "azurerm_api_management_custom_domain"
 
 func resourceApiManagementCustomDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: apiManagementCustomDomainCreateUpdate,
 		Read:   apiManagementCustomDomainRead,
 		Update: apiManagementCustomDomainCreateUpdate,
@@ -90,7 +90,7 @@ func resourceApiManagementCustomDomain() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.CustomDomainId{})
 }
 
 func apiManagementCustomDomainCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceApiManagementDiagnostic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementDiagnosticCreateUpdate,
 		Read:   resourceApiManagementDiagnosticRead,
 		Update: resourceApiManagementDiagnosticCreateUpdate,
@@ -127,7 +127,7 @@ func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &diagnostic.DiagnosticId{})
 }
 
 func resourceApiManagementDiagnosticCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
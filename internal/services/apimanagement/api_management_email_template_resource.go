@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementEmailTemplate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementEmailTemplateCreateUpdate,
 		Read:   resourceApiManagementEmailTemplateRead,
 		Update: resourceApiManagementEmailTemplateCreateUpdate,
@@ -85,7 +85,7 @@ func resourceApiManagementEmailTemplate() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &emailtemplates.TemplateId{})
 }
 
 func resourceApiManagementEmailTemplateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementGatewayApi() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGatewayApiCreate,
 		Read:   resourceApiManagementGatewayApiRead,
 		Delete: resourceApiManagementGatewayApiDelete,
@@ -57,7 +57,7 @@ func resourceApiManagementGatewayApi() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 		},
-	}
+	}, &gatewayapi.GatewayApiId{})
 }
 
 func resourceApiManagementGatewayApiCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementGatewayCertificateAuthority() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGatewayCertificateAuthorityCreateUpdate,
 		Read:   resourceApiManagementGatewayCertificateAuthorityRead,
 		Update: resourceApiManagementGatewayCertificateAuthorityCreateUpdate,
@@ -56,7 +56,7 @@ func resourceApiManagementGatewayCertificateAuthority() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &gatewaycertificateauthority.CertificateAuthorityId{})
 }
 
 func resourceApiManagementGatewayCertificateAuthorityCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementGatewayHostNameConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGatewayHostNameConfigurationCreateUpdate,
 		Read:   resourceApiManagementGatewayHostNameConfigurationRead,
 		Update: resourceApiManagementGatewayHostNameConfigurationCreateUpdate,
@@ -85,7 +85,7 @@ func resourceApiManagementGatewayHostNameConfiguration() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &gatewayhostnameconfiguration.HostnameConfigurationId{})
 }
 
 func resourceApiManagementGatewayHostNameConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
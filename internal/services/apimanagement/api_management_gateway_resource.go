@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGatewayCreateUpdate,
 		Read:   resourceApiManagementGatewayRead,
 		Update: resourceApiManagementGatewayCreateUpdate,
@@ -79,7 +79,7 @@ func resourceApiManagementGateway() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &gateway.GatewayId{})
 }
 
 func resourceApiManagementGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
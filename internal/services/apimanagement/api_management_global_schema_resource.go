@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementGlobalSchema() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGlobalSchemaCreateUpdate,
 		Read:   resourceApiManagementGlobalSchemaRead,
 		Update: resourceApiManagementGlobalSchemaCreateUpdate,
@@ -65,7 +65,7 @@ func resourceApiManagementGlobalSchema() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &schema.SchemaId{})
 }
 
 func resourceApiManagementGlobalSchemaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
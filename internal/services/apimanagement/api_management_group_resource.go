@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGroupCreateUpdate,
 		Read:   resourceApiManagementGroupRead,
 		Update: resourceApiManagementGroupCreateUpdate,
@@ -74,7 +74,7 @@ func resourceApiManagementGroup() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &group.GroupId{})
 }
 
 func resourceApiManagementGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceApiManagementGroupUser() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementGroupUserCreate,
 		Read:   resourceApiManagementGroupUserRead,
 		Delete: resourceApiManagementGroupUserDelete,
@@ -43,7 +43,7 @@ func resourceApiManagementGroupUser() *pluginsdk.Resource {
 
 			"api_management_name": schemaz.SchemaApiManagementName(),
 		},
-	}
+	}, &groupuser.GroupUserId{})
 }
 
 func resourceApiManagementGroupUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
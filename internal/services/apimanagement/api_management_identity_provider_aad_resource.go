@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementIdentityProviderAAD() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementIdentityProviderAADCreateUpdate,
 		Read:   resourceApiManagementIdentityProviderAADRead,
 		Update: resourceApiManagementIdentityProviderAADCreateUpdate,
@@ -76,7 +76,7 @@ func resourceApiManagementIdentityProviderAAD() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &identityprovider.IdentityProviderId{})
 }
 
 func resourceApiManagementIdentityProviderAADCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceArmApiManagementIdentityProviderAADB2C() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmApiManagementIdentityProviderAADB2CCreateUpdate,
 		Read:   resourceArmApiManagementIdentityProviderAADB2CRead,
 		Update: resourceArmApiManagementIdentityProviderAADB2CCreateUpdate,
@@ -106,7 +106,7 @@ func resourceArmApiManagementIdentityProviderAADB2C() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &identityprovider.IdentityProviderId{})
 }
 
 func resourceArmApiManagementIdentityProviderAADB2CCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementIdentityProviderFacebook() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementIdentityProviderFacebookCreateUpdate,
 		Read:   resourceApiManagementIdentityProviderFacebookRead,
 		Update: resourceApiManagementIdentityProviderFacebookCreateUpdate,
@@ -54,7 +54,7 @@ func resourceApiManagementIdentityProviderFacebook() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &identityprovider.IdentityProviderId{})
 }
 
 func resourceApiManagementIdentityProviderFacebookCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
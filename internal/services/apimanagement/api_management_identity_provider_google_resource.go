@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementIdentityProviderGoogle() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementIdentityProviderGoogleCreateUpdate,
 		Read:   resourceApiManagementIdentityProviderGoogleRead,
 		Update: resourceApiManagementIdentityProviderGoogleCreateUpdate,
@@ -55,7 +55,7 @@ func resourceApiManagementIdentityProviderGoogle() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &identityprovider.IdentityProviderId{})
 }
 
 func resourceApiManagementIdentityProviderGoogleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementIdentityProviderMicrosoft() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementIdentityProviderMicrosoftCreateUpdate,
 		Read:   resourceApiManagementIdentityProviderMicrosoftRead,
 		Update: resourceApiManagementIdentityProviderMicrosoftCreateUpdate,
@@ -54,7 +54,7 @@ func resourceApiManagementIdentityProviderMicrosoft() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &identityprovider.IdentityProviderId{})
 }
 
 func resourceApiManagementIdentityProviderMicrosoftCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
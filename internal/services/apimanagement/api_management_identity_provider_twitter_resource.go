@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementIdentityProviderTwitter() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementIdentityProviderTwitterCreateUpdate,
 		Read:   resourceApiManagementIdentityProviderTwitterRead,
 		Update: resourceApiManagementIdentityProviderTwitterCreateUpdate,
@@ -55,7 +55,7 @@ func resourceApiManagementIdentityProviderTwitter() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &identityprovider.IdentityProviderId{})
 }
 
 func resourceApiManagementIdentityProviderTwitterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceApiManagementLogger() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementLoggerCreate,
 		Read:   resourceApiManagementLoggerRead,
 		Update: resourceApiManagementLoggerUpdate,
@@ -135,7 +135,7 @@ func resourceApiManagementLogger() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &logger.LoggerId{})
 }
 
 func resourceApiManagementLoggerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
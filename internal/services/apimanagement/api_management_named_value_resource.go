@@ -24,7 +24,7 @@ import (
 )
 
 func resourceApiManagementNamedValue() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementNamedValueCreateUpdate,
 		Read:   resourceApiManagementNamedValueRead,
 		Update: resourceApiManagementNamedValueCreateUpdate,
@@ -98,7 +98,7 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &namedvalue.NamedValueId{})
 }
 
 func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,10 +9,12 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/notificationrecipientemail"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -183,3 +185,7 @@ func (r ApiManagementNotificationRecipientEmailResource) Delete() sdk.ResourceFu
 func (r ApiManagementNotificationRecipientEmailResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.NotificationRecipientEmailID
 }
+
+func (r ApiManagementNotificationRecipientEmailResource) Identity() resourceids.ResourceId {
+	return &parse.NotificationRecipientEmailId{}
+}
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementOpenIDConnectProvider() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementOpenIDConnectProviderCreateUpdate,
 		Read:   resourceApiManagementOpenIDConnectProviderRead,
 		Update: resourceApiManagementOpenIDConnectProviderCreateUpdate,
@@ -76,7 +76,7 @@ func resourceApiManagementOpenIDConnectProvider() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &openidconnectprovider.OpenidConnectProviderId{})
 }
 
 func resourceApiManagementOpenIDConnectProviderCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementPolicyFragmentCreate,
 		Read:   resourceApiManagementPolicyFragmentRead,
 		Update: resourceApiManagementPolicyFragmentUpdate,
@@ -88,7 +88,7 @@ func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &policyfragment.PolicyFragmentId{})
 }
 
 func resourceApiManagementPolicyFragmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementPolicyCreateUpdate,
 		Read:   resourceApiManagementPolicyRead,
 		Update: resourceApiManagementPolicyCreateUpdate,
@@ -69,7 +69,7 @@ func resourceApiManagementPolicy() *pluginsdk.Resource {
 				ExactlyOneOf:  []string{"xml_link", "xml_content"},
 			},
 		},
-	}
+	}, &policy.ServiceId{})
 }
 
 func resourceApiManagementPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
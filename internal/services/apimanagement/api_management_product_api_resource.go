@@ -19,7 +19,7 @@ import (
 )
 
 func resourceApiManagementProductApi() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementProductApiCreate,
 		Read:   resourceApiManagementProductApiRead,
 		Delete: resourceApiManagementProductApiDelete,
@@ -43,7 +43,7 @@ func resourceApiManagementProductApi() *pluginsdk.Resource {
 
 			"api_management_name": schemaz.SchemaApiManagementName(),
 		},
-	}
+	}, &productapi.ProductApiId{})
 }
 
 func resourceApiManagementProductApiCreate(d *pluginsdk.ResourceData, meta interface{}) error {
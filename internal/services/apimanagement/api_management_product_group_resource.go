@@ -19,7 +19,7 @@ import (
 )
 
 func resourceApiManagementProductGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementProductGroupCreate,
 		Read:   resourceApiManagementProductGroupRead,
 		Delete: resourceApiManagementProductGroupDelete,
@@ -43,7 +43,7 @@ func resourceApiManagementProductGroup() *pluginsdk.Resource {
 
 			"api_management_name": schemaz.SchemaApiManagementName(),
 		},
-	}
+	}, &productgroup.ProductGroupId{})
 }
 
 func resourceApiManagementProductGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
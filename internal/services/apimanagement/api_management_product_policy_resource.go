@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApiManagementProductPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementProductPolicyCreateUpdate,
 		Read:   resourceApiManagementProductPolicyRead,
 		Update: resourceApiManagementProductPolicyCreateUpdate,
@@ -66,7 +66,7 @@ func resourceApiManagementProductPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 			},
 		},
-	}
+	}, &productpolicy.ProductId{})
 }
 
 func resourceApiManagementProductPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementProduct() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementProductCreateUpdate,
 		Read:   resourceApiManagementProductRead,
 		Update: resourceApiManagementProductCreateUpdate,
@@ -82,7 +82,7 @@ func resourceApiManagementProduct() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &product.ProductId{})
 }
 
 func resourceApiManagementProductCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementProductTag() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementProductTagCreate,
 		Read:   resourceApiManagementProductTagRead,
 		Delete: resourceApiManagementProductTagDelete,
@@ -44,7 +44,7 @@ func resourceApiManagementProductTag() *pluginsdk.Resource {
 
 			"name": schemaz.SchemaApiManagementChildName(),
 		},
-	}
+	}, &producttag.ProductTagId{})
 }
 
 func resourceApiManagementProductTagCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceApiManagementRedisCache() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementRedisCacheCreateUpdate,
 		Read:   resourceApiManagementRedisCacheRead,
 		Update: resourceApiManagementRedisCacheCreateUpdate,
@@ -84,7 +84,7 @@ func resourceApiManagementRedisCache() *pluginsdk.Resource {
 				DiffSuppressFunc: location.DiffSuppressFunc,
 			},
 		},
-	}
+	}, &cache.CacheId{})
 }
 
 func resourceApiManagementRedisCacheCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
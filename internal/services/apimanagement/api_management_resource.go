@@ -63,7 +63,7 @@ var (
 )
 
 func resourceApiManagementService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceApiManagementServiceCreate,
 		Read:   resourceApiManagementServiceRead,
 		Update: resourceApiManagementServiceUpdate,
@@ -97,6 +97,10 @@ func resourceApiManagementService() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &apimanagementservice.ServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &apimanagementservice.ServiceId{})
 }
 
 func resourceApiManagementSchema() map[string]*pluginsdk.Schema {
@@ -29,7 +29,7 @@ import (
 )
 
 func resourceApiManagementSubscription() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementSubscriptionCreateUpdate,
 		Read:   resourceApiManagementSubscriptionRead,
 		Update: resourceApiManagementSubscriptionCreateUpdate,
@@ -124,7 +124,7 @@ func resourceApiManagementSubscription() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &subscription.Subscriptions2Id{})
 }
 
 func resourceApiManagementSubscriptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
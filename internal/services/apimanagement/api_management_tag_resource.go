@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApiManagementTag() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementTagCreateUpdate,
 		Read:   resourceApiManagementTagRead,
 		Update: resourceApiManagementTagCreateUpdate,
@@ -60,7 +60,7 @@ func resourceApiManagementTag() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &tag.TagId{})
 }
 
 func resourceApiManagementTagCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
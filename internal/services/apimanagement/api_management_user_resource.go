@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApiManagementUser() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApiManagementUserCreateUpdate,
 		Read:   resourceApiManagementUserRead,
 		Update: resourceApiManagementUserCreateUpdate,
@@ -95,7 +95,7 @@ func resourceApiManagementUser() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &user.UserId{})
 }
 
 func resourceApiManagementUserCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
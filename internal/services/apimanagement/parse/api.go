@@ -43,6 +43,22 @@ func (id ApiId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Api ID
+func (id ApiId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("name", "api1"),
+	}
+}
+
 // ApiID parses a Api ID into an ApiId struct
 func ApiID(input string) (*ApiId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func ApiIDInsensitively(input string) (*ApiId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ApiId using the ParseResult provided in 'input'
+func (id *ApiId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
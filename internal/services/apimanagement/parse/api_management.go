@@ -40,6 +40,20 @@ func (id ApiManagementId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApiManagement ID
+func (id ApiManagementId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+	}
+}
+
 // ApiManagementID parses a ApiManagement ID into an ApiManagementId struct
 func ApiManagementID(input string) (*ApiManagementId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func ApiManagementID(input string) (*ApiManagementId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ApiManagementId using the ParseResult provided in 'input'
+func (id *ApiManagementId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestApiOperationPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiOperationPolicyIDSegments(t *testing.T) {
+	expected := NewApiOperationPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApiOperationPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApiOperationPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApiOperationPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestApiOperationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiOperationIDSegments(t *testing.T) {
+	expected := NewApiOperationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApiOperationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApiOperationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApiOperationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id ApiReleaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.ReleaseName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApiRelease ID
+func (id ApiReleaseId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("apiName", "api1"),
+		resourceids.StaticSegment("staticReleases", "releases", "releases"),
+		resourceids.UserSpecifiedSegment("releaseName", "release1"),
+	}
+}
+
 // ApiReleaseID parses a ApiRelease ID into an ApiReleaseId struct
 func ApiReleaseID(input string) (*ApiReleaseId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func ApiReleaseID(input string) (*ApiReleaseId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ApiReleaseId using the ParseResult provided in 'input'
+func (id *ApiReleaseId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.ApiName, ok = input.Parsed["apiName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "apiName", input)
+	}
+
+	if id.ReleaseName, ok = input.Parsed["releaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "releaseName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestApiSchemaIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiSchemaIDSegments(t *testing.T) {
+	expected := NewApiSchemaID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "schema1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApiSchemaId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApiSchemaId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApiSchemaID(t *testing.T) {
 	testData := []struct {
 		Input    string
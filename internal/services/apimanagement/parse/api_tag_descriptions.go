@@ -46,6 +46,24 @@ func (id ApiTagDescriptionsId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.TagDescriptionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApiTagDescriptions ID
+func (id ApiTagDescriptionsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("apiName", "api1"),
+		resourceids.StaticSegment("staticTagDescriptions", "tagDescriptions", "tagDescriptions"),
+		resourceids.UserSpecifiedSegment("tagDescriptionName", "tagDescriptionId1"),
+	}
+}
+
 // ApiTagDescriptionsID parses a ApiTagDescriptions ID into an ApiTagDescriptionsId struct
 func ApiTagDescriptionsID(input string) (*ApiTagDescriptionsId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func ApiTagDescriptionsID(input string) (*ApiTagDescriptionsId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ApiTagDescriptionsId using the ParseResult provided in 'input'
+func (id *ApiTagDescriptionsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.ApiName, ok = input.Parsed["apiName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "apiName", input)
+	}
+
+	if id.TagDescriptionName, ok = input.Parsed["tagDescriptionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tagDescriptionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestApiTagDescriptionsIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiTagDescriptionsIDSegments(t *testing.T) {
+	expected := NewApiTagDescriptionsID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "tagDescriptionId1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApiTagDescriptionsId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApiTagDescriptionsId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApiTagDescriptionsID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id ApiVersionSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApiVersionSet ID
+func (id ApiVersionSetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticApiVersionSets", "apiVersionSets", "apiVersionSets"),
+		resourceids.UserSpecifiedSegment("name", "apiVersionSet1"),
+	}
+}
+
 // ApiVersionSetID parses a ApiVersionSet ID into an ApiVersionSetId struct
 func ApiVersionSetID(input string) (*ApiVersionSetId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ApiVersionSetID(input string) (*ApiVersionSetId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ApiVersionSetId using the ParseResult provided in 'input'
+func (id *ApiVersionSetId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestApiVersionSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiVersionSetIDSegments(t *testing.T) {
+	expected := NewApiVersionSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "apiVersionSet1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApiVersionSetId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApiVersionSetId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApiVersionSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
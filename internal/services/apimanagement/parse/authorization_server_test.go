@@ -21,6 +21,23 @@ func TestAuthorizationServerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAuthorizationServerIDSegments(t *testing.T) {
+	expected := NewAuthorizationServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "authorizationserver1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AuthorizationServerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AuthorizationServerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAuthorizationServerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id BackendId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Backend ID
+func (id BackendId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticBackends", "backends", "backends"),
+		resourceids.UserSpecifiedSegment("name", "backend1"),
+	}
+}
+
 // BackendID parses a Backend ID into an BackendId struct
 func BackendID(input string) (*BackendId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func BackendID(input string) (*BackendId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the BackendId using the ParseResult provided in 'input'
+func (id *BackendId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCustomDomainIDSegments(t *testing.T) {
+	expected := NewCustomDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "customdomain")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CustomDomainId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CustomDomainId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestDiagnosticIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDiagnosticIDSegments(t *testing.T) {
+	expected := NewDiagnosticID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "diagnostic1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DiagnosticId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DiagnosticId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDiagnosticID(t *testing.T) {
 	testData := []struct {
 		Input    string
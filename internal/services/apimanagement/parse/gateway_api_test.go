@@ -21,6 +21,23 @@ func TestGatewayApiIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGatewayApiIDSegments(t *testing.T) {
+	expected := NewGatewayApiID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "gateway1", "api1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&GatewayApiId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := GatewayApiId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestGatewayApiID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id GatewayCertificateAuthorityId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.GatewayName, id.CertificateAuthorityName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this GatewayCertificateAuthority ID
+func (id GatewayCertificateAuthorityId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticGateways", "gateways", "gateways"),
+		resourceids.UserSpecifiedSegment("gatewayName", "gateway1"),
+		resourceids.StaticSegment("staticCertificateAuthorities", "certificateAuthorities", "certificateAuthorities"),
+		resourceids.UserSpecifiedSegment("certificateAuthorityName", "cert1"),
+	}
+}
+
 // GatewayCertificateAuthorityID parses a GatewayCertificateAuthority ID into an GatewayCertificateAuthorityId struct
 func GatewayCertificateAuthorityID(input string) (*GatewayCertificateAuthorityId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func GatewayCertificateAuthorityID(input string) (*GatewayCertificateAuthorityId
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the GatewayCertificateAuthorityId using the ParseResult provided in 'input'
+func (id *GatewayCertificateAuthorityId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.GatewayName, ok = input.Parsed["gatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "gatewayName", input)
+	}
+
+	if id.CertificateAuthorityName, ok = input.Parsed["certificateAuthorityName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "certificateAuthorityName", input)
+	}
+
+	return nil
+}
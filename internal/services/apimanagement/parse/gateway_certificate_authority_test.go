@@ -21,6 +21,23 @@ func TestGatewayCertificateAuthorityIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGatewayCertificateAuthorityIDSegments(t *testing.T) {
+	expected := NewGatewayCertificateAuthorityID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "gateway1", "cert1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&GatewayCertificateAuthorityId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := GatewayCertificateAuthorityId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestGatewayCertificateAuthorityID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestGatewayHostNameConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGatewayHostNameConfigurationIDSegments(t *testing.T) {
+	expected := NewGatewayHostNameConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "gateway1", "hostname1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&GatewayHostNameConfigurationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := GatewayHostNameConfigurationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestGatewayHostNameConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
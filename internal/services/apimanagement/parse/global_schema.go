@@ -43,6 +43,22 @@ func (id GlobalSchemaId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.SchemaName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this GlobalSchema ID
+func (id GlobalSchemaId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticSchemas", "schemas", "schemas"),
+		resourceids.UserSpecifiedSegment("schemaName", "schema1"),
+	}
+}
+
 // GlobalSchemaID parses a GlobalSchema ID into an GlobalSchemaId struct
 func GlobalSchemaID(input string) (*GlobalSchemaId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func GlobalSchemaID(input string) (*GlobalSchemaId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the GlobalSchemaId using the ParseResult provided in 'input'
+func (id *GlobalSchemaId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.SchemaName, ok = input.Parsed["schemaName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "schemaName", input)
+	}
+
+	return nil
+}
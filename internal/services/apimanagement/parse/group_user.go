@@ -46,6 +46,24 @@ func (id GroupUserId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.GroupName, id.UserName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this GroupUser ID
+func (id GroupUserId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticGroups", "groups", "groups"),
+		resourceids.UserSpecifiedSegment("groupName", "group1"),
+		resourceids.StaticSegment("staticUsers", "users", "users"),
+		resourceids.UserSpecifiedSegment("userName", "user1"),
+	}
+}
+
 // GroupUserID parses a GroupUser ID into an GroupUserId struct
 func GroupUserID(input string) (*GroupUserId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func GroupUserID(input string) (*GroupUserId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the GroupUserId using the ParseResult provided in 'input'
+func (id *GroupUserId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.GroupName, ok = input.Parsed["groupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "groupName", input)
+	}
+
+	if id.UserName, ok = input.Parsed["userName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "userName", input)
+	}
+
+	return nil
+}
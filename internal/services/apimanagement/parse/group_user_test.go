@@ -21,6 +21,23 @@ func TestGroupUserIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGroupUserIDSegments(t *testing.T) {
+	expected := NewGroupUserID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "group1", "user1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&GroupUserId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := GroupUserId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestGroupUserID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestIdentityProviderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIdentityProviderIDSegments(t *testing.T) {
+	expected := NewIdentityProviderID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "identityProvider1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&IdentityProviderId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := IdentityProviderId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestIdentityProviderID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id NotificationRecipientEmailId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.NotificationName, id.RecipientEmailName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this NotificationRecipientEmail ID
+func (id NotificationRecipientEmailId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticNotifications", "notifications", "notifications"),
+		resourceids.UserSpecifiedSegment("notificationName", "notificationName1"),
+		resourceids.StaticSegment("staticRecipientEmails", "recipientEmails", "recipientEmails"),
+		resourceids.UserSpecifiedSegment("recipientEmailName", "email1"),
+	}
+}
+
 // NotificationRecipientEmailID parses a NotificationRecipientEmail ID into an NotificationRecipientEmailId struct
 func NotificationRecipientEmailID(input string) (*NotificationRecipientEmailId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func NotificationRecipientEmailID(input string) (*NotificationRecipientEmailId,
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the NotificationRecipientEmailId using the ParseResult provided in 'input'
+func (id *NotificationRecipientEmailId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.NotificationName, ok = input.Parsed["notificationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "notificationName", input)
+	}
+
+	if id.RecipientEmailName, ok = input.Parsed["recipientEmailName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "recipientEmailName", input)
+	}
+
+	return nil
+}
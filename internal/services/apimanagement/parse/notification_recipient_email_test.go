@@ -21,6 +21,23 @@ func TestNotificationRecipientEmailIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNotificationRecipientEmailIDSegments(t *testing.T) {
+	expected := NewNotificationRecipientEmailID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "notificationName1", "email1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&NotificationRecipientEmailId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := NotificationRecipientEmailId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestNotificationRecipientEmailID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id NotificationRecipientUserId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.NotificationName, id.RecipientUserName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this NotificationRecipientUser ID
+func (id NotificationRecipientUserId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticNotifications", "notifications", "notifications"),
+		resourceids.UserSpecifiedSegment("notificationName", "notificationName1"),
+		resourceids.StaticSegment("staticRecipientUsers", "recipientUsers", "recipientUsers"),
+		resourceids.UserSpecifiedSegment("recipientUserName", "user1"),
+	}
+}
+
 // NotificationRecipientUserID parses a NotificationRecipientUser ID into an NotificationRecipientUserId struct
 func NotificationRecipientUserID(input string) (*NotificationRecipientUserId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func NotificationRecipientUserID(input string) (*NotificationRecipientUserId, er
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the NotificationRecipientUserId using the ParseResult provided in 'input'
+func (id *NotificationRecipientUserId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.NotificationName, ok = input.Parsed["notificationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "notificationName", input)
+	}
+
+	if id.RecipientUserName, ok = input.Parsed["recipientUserName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "recipientUserName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestNotificationRecipientUserIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNotificationRecipientUserIDSegments(t *testing.T) {
+	expected := NewNotificationRecipientUserID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "notificationName1", "user1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&NotificationRecipientUserId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := NotificationRecipientUserId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestNotificationRecipientUserID(t *testing.T) {
 	testData := []struct {
 		Input    string
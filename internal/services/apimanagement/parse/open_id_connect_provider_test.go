@@ -21,6 +21,23 @@ func TestOpenIDConnectProviderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOpenIDConnectProviderIDSegments(t *testing.T) {
+	expected := NewOpenIDConnectProviderID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "opid1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&OpenIDConnectProviderId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := OpenIDConnectProviderId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestOpenIDConnectProviderID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -49,6 +49,26 @@ func (id OperationTagId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.TagName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this OperationTag ID
+func (id OperationTagId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("apiName", "api1"),
+		resourceids.StaticSegment("staticOperations", "operations", "operations"),
+		resourceids.UserSpecifiedSegment("operationName", "operation1"),
+		resourceids.StaticSegment("staticTags", "tags", "tags"),
+		resourceids.UserSpecifiedSegment("tagName", "tag1"),
+	}
+}
+
 // OperationTagID parses a OperationTag ID into an OperationTagId struct
 func OperationTagID(input string) (*OperationTagId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -88,3 +108,34 @@ func OperationTagID(input string) (*OperationTagId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the OperationTagId using the ParseResult provided in 'input'
+func (id *OperationTagId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.ApiName, ok = input.Parsed["apiName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "apiName", input)
+	}
+
+	if id.OperationName, ok = input.Parsed["operationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "operationName", input)
+	}
+
+	if id.TagName, ok = input.Parsed["tagName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tagName", input)
+	}
+
+	return nil
+}
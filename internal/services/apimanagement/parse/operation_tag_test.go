@@ -21,6 +21,23 @@ func TestOperationTagIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOperationTagIDSegments(t *testing.T) {
+	expected := NewOperationTagID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1", "tag1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&OperationTagId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := OperationTagId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestOperationTagID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestProductApiIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductApiIDSegments(t *testing.T) {
+	expected := NewProductApiID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "api1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProductApiId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProductApiId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProductApiID(t *testing.T) {
 	testData := []struct {
 		Input    string
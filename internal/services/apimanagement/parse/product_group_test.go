@@ -21,6 +21,23 @@ func TestProductGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductGroupIDSegments(t *testing.T) {
+	expected := NewProductGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "group1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProductGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProductGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProductGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id ProductPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.PolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ProductPolicy ID
+func (id ProductPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticProducts", "products", "products"),
+		resourceids.UserSpecifiedSegment("productName", "product1"),
+		resourceids.StaticSegment("staticPolicies", "policies", "policies"),
+		resourceids.UserSpecifiedSegment("policyName", "policy1"),
+	}
+}
+
 // ProductPolicyID parses a ProductPolicy ID into an ProductPolicyId struct
 func ProductPolicyID(input string) (*ProductPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func ProductPolicyID(input string) (*ProductPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ProductPolicyId using the ParseResult provided in 'input'
+func (id *ProductPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.ProductName, ok = input.Parsed["productName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "productName", input)
+	}
+
+	if id.PolicyName, ok = input.Parsed["policyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestProductPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductPolicyIDSegments(t *testing.T) {
+	expected := NewProductPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProductPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProductPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProductPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
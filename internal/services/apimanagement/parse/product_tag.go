@@ -46,6 +46,24 @@ func (id ProductTagId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.TagName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ProductTag ID
+func (id ProductTagId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "service1"),
+		resourceids.StaticSegment("staticProducts", "products", "products"),
+		resourceids.UserSpecifiedSegment("productName", "product1"),
+		resourceids.StaticSegment("staticTags", "tags", "tags"),
+		resourceids.UserSpecifiedSegment("tagName", "tagId1"),
+	}
+}
+
 // ProductTagID parses a ProductTag ID into an ProductTagId struct
 func ProductTagID(input string) (*ProductTagId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func ProductTagID(input string) (*ProductTagId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ProductTagId using the ParseResult provided in 'input'
+func (id *ProductTagId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServiceName, ok = input.Parsed["serviceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serviceName", input)
+	}
+
+	if id.ProductName, ok = input.Parsed["productName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "productName", input)
+	}
+
+	if id.TagName, ok = input.Parsed["tagName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tagName", input)
+	}
+
+	return nil
+}
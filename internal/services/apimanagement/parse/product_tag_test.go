@@ -21,6 +21,23 @@ func TestProductTagIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductTagIDSegments(t *testing.T) {
+	expected := NewProductTagID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "tagId1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProductTagId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProductTagId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProductTagID(t *testing.T) {
 	testData := []struct {
 		Input    string
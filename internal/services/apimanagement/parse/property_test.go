@@ -21,6 +21,23 @@ func TestPropertyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPropertyIDSegments(t *testing.T) {
+	expected := NewPropertyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "namedvalue1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PropertyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PropertyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPropertyID(t *testing.T) {
 	testData := []struct {
 		Input    string
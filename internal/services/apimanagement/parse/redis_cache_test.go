@@ -21,6 +21,23 @@ func TestRedisCacheIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRedisCacheIDSegments(t *testing.T) {
+	expected := NewRedisCacheID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "redisCache1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&RedisCacheId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := RedisCacheId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestRedisCacheID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSubscriptionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionIDSegments(t *testing.T) {
+	expected := NewSubscriptionID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "subscription1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestTagIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTagIDSegments(t *testing.T) {
+	expected := NewTagID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "tag1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&TagId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := TagId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestTagID(t *testing.T) {
 	testData := []struct {
 		Input    string
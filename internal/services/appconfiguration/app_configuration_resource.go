@@ -34,7 +34,7 @@ import (
 )
 
 func resourceAppConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAppConfigurationCreate,
 		Read:   resourceAppConfigurationRead,
 		Update: resourceAppConfigurationUpdate,
@@ -256,6 +256,10 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &configurationstores.ConfigurationStoreId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &configurationstores.ConfigurationStoreId{})
 }
 
 func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
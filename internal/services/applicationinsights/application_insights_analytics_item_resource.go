@@ -27,7 +27,7 @@ var (
 )
 
 func resourceApplicationInsightsAnalyticsItem() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApplicationInsightsAnalyticsItemCreate,
 		Read:   resourceApplicationInsightsAnalyticsItemRead,
 		Update: resourceApplicationInsightsAnalyticsItemUpdate,
@@ -116,7 +116,7 @@ func resourceApplicationInsightsAnalyticsItem() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &analyticsitems.ProviderComponentId{})
 }
 
 func resourceApplicationInsightsAnalyticsItemCreate(d *pluginsdk.ResourceData, meta interface{}) error {
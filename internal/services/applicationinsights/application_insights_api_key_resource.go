@@ -20,7 +20,7 @@ import (
 )
 
 func resourceApplicationInsightsAPIKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApplicationInsightsAPIKeyCreate,
 		Read:   resourceApplicationInsightsAPIKeyRead,
 		Delete: resourceApplicationInsightsAPIKeyDelete,
@@ -85,7 +85,7 @@ func resourceApplicationInsightsAPIKey() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	}, &apikeys.ApiKeyId{})
 }
 
 func resourceApplicationInsightsAPIKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -184,7 +184,10 @@ func resourceApplicationInsights() *pluginsdk.Resource {
 			Computed: true,
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &components.ComponentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &components.ComponentId{})
 }
 
 func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceApplicationInsightsSmartDetectionRuleUpdate,
 		Read:   resourceApplicationInsightsSmartDetectionRuleRead,
 		Update: resourceApplicationInsightsSmartDetectionRuleUpdate,
@@ -92,7 +92,7 @@ func resourceApplicationInsightsSmartDetectionRule() *pluginsdk.Resource {
 				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
 			},
 		},
-	}
+	}, &smartdetection.ProactiveDetectionConfigId{})
 }
 
 func resourceApplicationInsightsSmartDetectionRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceApplicationInsightsWebTests() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceApplicationInsightsWebTestsCreateUpdate,
 		Read:   resourceApplicationInsightsWebTestsRead,
 		Update: resourceApplicationInsightsWebTestsCreateUpdate,
@@ -135,6 +135,10 @@ func resourceApplicationInsightsWebTests() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &webtests.WebTestId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &webtests.WebTestId{})
 }
 
 func resourceApplicationInsightsWebTestsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	workbooks "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/validate"
@@ -36,6 +37,7 @@ type ApplicationInsightsWorkbookModel struct {
 type ApplicationInsightsWorkbookResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApplicationInsightsWorkbookResource{}
+var _ sdk.ResourceWithIdentity = ApplicationInsightsWorkbookResource{}
 
 func (r ApplicationInsightsWorkbookResource) ResourceType() string {
 	return "azurerm_application_insights_workbook"
@@ -49,6 +51,10 @@ func (r ApplicationInsightsWorkbookResource) IDValidationFunc() pluginsdk.Schema
 	return workbooks.ValidateWorkbookID
 }
 
+func (r ApplicationInsightsWorkbookResource) Identity() resourceids.ResourceId {
+	return &workbooks.WorkbookId{}
+}
+
 func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
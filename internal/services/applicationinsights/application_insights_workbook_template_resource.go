@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	workbooktemplates "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -42,6 +43,7 @@ type WorkbookTemplateGalleryModel struct {
 type ApplicationInsightsWorkbookTemplateResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApplicationInsightsWorkbookTemplateResource{}
+var _ sdk.ResourceWithIdentity = ApplicationInsightsWorkbookTemplateResource{}
 
 func (r ApplicationInsightsWorkbookTemplateResource) ResourceType() string {
 	return "azurerm_application_insights_workbook_template"
@@ -55,6 +57,10 @@ func (r ApplicationInsightsWorkbookTemplateResource) IDValidationFunc() pluginsd
 	return workbooktemplates.ValidateWorkbookTemplateID
 }
 
+func (r ApplicationInsightsWorkbookTemplateResource) Identity() resourceids.ResourceId {
+	return &workbooktemplates.WorkbookTemplateId{}
+}
+
 func (r ApplicationInsightsWorkbookTemplateResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
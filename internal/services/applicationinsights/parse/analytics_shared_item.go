@@ -43,6 +43,22 @@ func (id AnalyticsSharedItemId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.AnalyticsItemName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AnalyticsSharedItem ID
+func (id AnalyticsSharedItemId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftInsights", "Microsoft.Insights", "Microsoft.Insights"),
+		resourceids.StaticSegment("staticComponents", "components", "components"),
+		resourceids.UserSpecifiedSegment("componentName", "component1"),
+		resourceids.StaticSegment("staticAnalyticsItems", "analyticsItems", "analyticsItems"),
+		resourceids.UserSpecifiedSegment("analyticsItemName", "item1"),
+	}
+}
+
 // AnalyticsSharedItemID parses a AnalyticsSharedItem ID into an AnalyticsSharedItemId struct
 func AnalyticsSharedItemID(input string) (*AnalyticsSharedItemId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func AnalyticsSharedItemID(input string) (*AnalyticsSharedItemId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AnalyticsSharedItemId using the ParseResult provided in 'input'
+func (id *AnalyticsSharedItemId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ComponentName, ok = input.Parsed["componentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "componentName", input)
+	}
+
+	if id.AnalyticsItemName, ok = input.Parsed["analyticsItemName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "analyticsItemName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestAnalyticsSharedItemIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAnalyticsSharedItemIDSegments(t *testing.T) {
+	expected := NewAnalyticsSharedItemID("12345678-1234-9876-4563-123456789012", "group1", "component1", "item1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AnalyticsSharedItemId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AnalyticsSharedItemId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAnalyticsSharedItemID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestAnalyticsUserItemIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAnalyticsUserItemIDSegments(t *testing.T) {
+	expected := NewAnalyticsUserItemID("12345678-1234-9876-4563-123456789012", "group1", "component1", "item1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AnalyticsUserItemId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AnalyticsUserItemId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAnalyticsUserItemID(t *testing.T) {
 	testData := []struct {
 		Input    string
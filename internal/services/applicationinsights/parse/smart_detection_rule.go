@@ -43,6 +43,22 @@ func (id SmartDetectionRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.SmartDetectionRuleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SmartDetectionRule ID
+func (id SmartDetectionRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftInsights", "Microsoft.Insights", "Microsoft.Insights"),
+		resourceids.StaticSegment("staticComponents", "components", "components"),
+		resourceids.UserSpecifiedSegment("componentName", "component1"),
+		resourceids.StaticSegment("staticSmartDetectionRule", "smartDetectionRule", "smartDetectionRule"),
+		resourceids.UserSpecifiedSegment("smartDetectionRuleName", "rule1"),
+	}
+}
+
 // SmartDetectionRuleID parses a SmartDetectionRule ID into an SmartDetectionRuleId struct
 func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SmartDetectionRuleIDInsensitively(input string) (*SmartDetectionRuleId, err
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SmartDetectionRuleId using the ParseResult provided in 'input'
+func (id *SmartDetectionRuleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ComponentName, ok = input.Parsed["componentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "componentName", input)
+	}
+
+	if id.SmartDetectionRuleName, ok = input.Parsed["smartDetectionRuleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "smartDetectionRuleName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSmartDetectionRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSmartDetectionRuleIDSegments(t *testing.T) {
+	expected := NewSmartDetectionRuleID("12345678-1234-9876-4563-123456789012", "group1", "component1", "rule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SmartDetectionRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SmartDetectionRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSmartDetectionRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -46,6 +47,7 @@ type FunctionFiles struct {
 }
 
 var _ sdk.ResourceWithUpdate = FunctionAppFunctionResource{}
+var _ sdk.ResourceWithIdentity = FunctionAppFunctionResource{}
 
 func (r FunctionAppFunctionResource) ModelObject() interface{} {
 	return &FunctionAppFunctionModel{}
@@ -59,6 +61,10 @@ func (r FunctionAppFunctionResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return webapps.ValidateFunctionID
 }
 
+func (r FunctionAppFunctionResource) Identity() resourceids.ResourceId {
+	return &webapps.FunctionId{}
+}
+
 func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
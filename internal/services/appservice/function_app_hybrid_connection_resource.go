@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
@@ -40,6 +41,7 @@ type FunctionAppHybridConnectionModel struct {
 var _ sdk.ResourceWithUpdate = FunctionAppHybridConnectionResource{}
 
 var _ sdk.ResourceWithCustomImporter = FunctionAppHybridConnectionResource{}
+var _ sdk.ResourceWithIdentity = FunctionAppHybridConnectionResource{}
 
 func (r FunctionAppHybridConnectionResource) ModelObject() interface{} {
 	return &FunctionAppHybridConnectionModel{}
@@ -53,6 +55,10 @@ func (r FunctionAppHybridConnectionResource) IDValidationFunc() pluginsdk.Schema
 	return webapps.ValidateRelayID
 }
 
+func (r FunctionAppHybridConnectionResource) Identity() resourceids.ResourceId {
+	return &webapps.RelayId{}
+}
+
 func (r FunctionAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"function_app_id": {
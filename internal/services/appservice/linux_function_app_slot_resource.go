@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -80,6 +81,7 @@ type LinuxFunctionAppSlotModel struct {
 var _ sdk.ResourceWithUpdate = LinuxFunctionAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxFunctionAppSlotResource{}
+var _ sdk.ResourceWithIdentity = LinuxFunctionAppSlotResource{}
 
 func (r LinuxFunctionAppSlotResource) ModelObject() interface{} {
 	return &LinuxFunctionAppSlotModel{}
@@ -93,6 +95,10 @@ func (r LinuxFunctionAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return webapps.ValidateSlotID
 }
 
+func (r LinuxFunctionAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r LinuxFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	s := map[string]*pluginsdk.Schema{
 		"name": {
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/migration"
@@ -76,6 +78,9 @@ var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
+var _ sdk.ResourceWithIdentity = LinuxWebAppResource{}
+
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -730,6 +735,10 @@ func (r LinuxWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateAppServiceID
 }
 
+func (r LinuxWebAppResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
 func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -1037,6 +1046,15 @@ func (r LinuxWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 	}
 }
 
+func (r LinuxWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: preflight.Timeout,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return preflight.Run(ctx, metadata.ResourceDiff, metadata.Client, webAppPreflightValidation("app,linux"))
+		},
+	}
+}
+
 func (r LinuxWebAppResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -71,6 +72,7 @@ type LinuxWebAppSlotModel struct {
 var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = LinuxWebAppSlotResource{}
+var _ sdk.ResourceWithIdentity = LinuxWebAppSlotResource{}
 
 func (r LinuxWebAppSlotResource) ModelObject() interface{} {
 	return &LinuxWebAppSlotModel{}
@@ -84,6 +86,10 @@ func (r LinuxWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return webapps.ValidateSlotID
 }
 
+func (r LinuxWebAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
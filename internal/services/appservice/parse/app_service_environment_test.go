@@ -21,6 +21,23 @@ func TestAppServiceEnvironmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServiceEnvironmentIDSegments(t *testing.T) {
+	expected := NewAppServiceEnvironmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "hostingEnvironment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AppServiceEnvironmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AppServiceEnvironmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAppServiceEnvironmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
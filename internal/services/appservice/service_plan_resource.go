@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/appserviceplans"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
@@ -31,6 +32,7 @@ type ServicePlanResource struct{}
 var _ sdk.ResourceWithUpdate = ServicePlanResource{}
 
 var _ sdk.ResourceWithStateMigration = ServicePlanResource{}
+var _ sdk.ResourceWithIdentity = ServicePlanResource{}
 
 type OSType string
 
@@ -305,6 +307,10 @@ func (r ServicePlanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateAppServicePlanID
 }
 
+func (r ServicePlanResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServicePlanId{}
+}
+
 func (r ServicePlanResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 60 * time.Minute,
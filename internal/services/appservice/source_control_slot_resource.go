@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -307,3 +308,7 @@ func (r SourceControlSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	// This is a meta resource with a 1:1 relationship with the slot it's pointed at, so we use the same ID
 	return webapps.ValidateSlotID
 }
+
+func (SourceControlSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,7 @@ import (
 type StaticWebAppCustomDomainResource struct{}
 
 var _ sdk.Resource = StaticWebAppCustomDomainResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppCustomDomainResource{}
 
 type StaticWebAppCustomDomainResourceModel struct {
 	DomainName      string `tfschema:"domain_name"`
@@ -232,3 +234,7 @@ func (r StaticWebAppCustomDomainResource) Delete() sdk.ResourceFunc {
 func (r StaticWebAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticsites.ValidateCustomDomainID
 }
+
+func (r StaticWebAppCustomDomainResource) Identity() resourceids.ResourceId {
+	return &staticsites.CustomDomainId{}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -20,6 +21,7 @@ import (
 type StaticWebAppFunctionAppRegistrationResource struct{}
 
 var _ sdk.Resource = StaticWebAppFunctionAppRegistrationResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppFunctionAppRegistrationResource{}
 
 type StaticWebAppFunctionAppRegistrationModel struct {
 	StaticWebAppID string `tfschema:"static_web_app_id"`
@@ -38,6 +40,10 @@ func (r StaticWebAppFunctionAppRegistrationResource) IDValidationFunc() pluginsd
 	return staticsites.ValidateUserProvidedFunctionAppID
 }
 
+func (r StaticWebAppFunctionAppRegistrationResource) Identity() resourceids.ResourceId {
+	return &staticsites.UserProvidedFunctionAppId{}
+}
+
 func (r StaticWebAppFunctionAppRegistrationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"static_web_app_id": {
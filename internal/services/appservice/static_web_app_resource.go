@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -30,6 +31,7 @@ type StaticWebAppResource struct{}
 var _ sdk.ResourceWithUpdate = StaticWebAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = StaticWebAppResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppResource{}
 
 type StaticWebAppResourceModel struct {
 	Name                string                                     `tfschema:"name"`
@@ -132,6 +134,10 @@ func (r StaticWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticsites.ValidateStaticSiteID
 }
 
+func (r StaticWebAppResource) Identity() resourceids.ResourceId {
+	return &staticsites.StaticSiteId{}
+}
+
 func (r StaticWebAppResource) ResourceType() string {
 	return "azurerm_static_web_app"
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -28,6 +29,7 @@ type WebAppActiveSlotModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = WebAppActiveSlotResource{}
+var _ sdk.ResourceWithIdentity = WebAppActiveSlotResource{}
 
 func (r WebAppActiveSlotResource) ModelObject() interface{} {
 	return &WebAppActiveSlotModel{}
@@ -41,6 +43,10 @@ func (r WebAppActiveSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return commonids.ValidateAppServiceID
 }
 
+func (r WebAppActiveSlotResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
 func (r WebAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"slot_id": {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
@@ -40,6 +41,7 @@ type WebAppHybridConnectionModel struct {
 var _ sdk.ResourceWithUpdate = WebAppHybridConnectionResource{}
 
 var _ sdk.ResourceWithCustomImporter = WebAppHybridConnectionResource{}
+var _ sdk.ResourceWithIdentity = WebAppHybridConnectionResource{}
 
 func (r WebAppHybridConnectionResource) ModelObject() interface{} {
 	return &WebAppHybridConnectionModel{}
@@ -53,6 +55,10 @@ func (r WebAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return webapps.ValidateRelayID
 }
 
+func (r WebAppHybridConnectionResource) Identity() resourceids.ResourceId {
+	return &webapps.RelayId{}
+}
+
 func (r WebAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"web_app_id": {
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// webAppPreflightValidation returns a function which checks the name of the Web App is available and that Resource
+// Manager accepts the planned Web App of the specified kind (e.g. `app,linux`), when Preflight Validation is enabled
+func webAppPreflightValidation(kind string) preflight.ValidateFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+		// the name availability depends on the Service Plan, so this is only validated once the plan exists
+		if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "service_plan_id") {
+			return nil
+		}
+
+		name := d.Get("name").(string)
+		if err := preflight.ValidateName(validate.WebAppName, "name", name); err != nil {
+			return err
+		}
+
+		servicePlanId, err := commonids.ParseAppServicePlanID(d.Get("service_plan_id").(string))
+		if err != nil {
+			return err
+		}
+
+		servicePlan, err := client.AppService.ServicePlanClient.Get(ctx, *servicePlanId)
+		if err != nil {
+			return fmt.Errorf("reading %s: %+v", servicePlanId, err)
+		}
+
+		// Web Apps within an App Service Environment use the domain of the App Service Environment, which is checked
+		// during the apply - so the name is only checked for Web Apps using the default domain
+		if model := servicePlan.Model; model != nil && model.Properties != nil && model.Properties.HostingEnvironmentProfile != nil {
+			log.Printf("[DEBUG] Skipping the name availability check for Web App %q since %s is within an App Service Environment", name, servicePlanId)
+		} else {
+			subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+			availabilityRequest := resourceproviders.ResourceNameAvailabilityRequest{
+				Name: name,
+				Type: resourceproviders.CheckNameResourceTypesMicrosoftPointWebSites,
+			}
+			resp, err := client.AppService.ResourceProvidersClient.CheckNameAvailability(ctx, subscriptionId, availabilityRequest)
+			if err != nil {
+				return fmt.Errorf("checking if the name %q was available: %+v", name, err)
+			}
+			if model := resp.Model; model != nil && model.NameAvailable != nil && !*model.NameAvailable {
+				return preflight.NameUnavailableError("Web App", name, (*string)(model.Reason), model.Message)
+			}
+		}
+
+		resource := preflight.TemplateResource{
+			Type:       "Microsoft.Web/sites",
+			ApiVersion: "2023-01-01",
+			Name:       name,
+			Location:   location.Normalize(d.Get("location").(string)),
+			Kind:       kind,
+			Properties: map[string]interface{}{
+				"serverFarmId": servicePlanId.ID(),
+				"reserved":     strings.Contains(kind, "linux"),
+			},
+		}
+		if d.NewValueKnown("tags") {
+			resource.Tags = d.Get("tags").(map[string]interface{})
+		}
+
+		return preflight.ValidateDeployment(ctx, client, d.Get("resource_group_name").(string), resource)
+	}
+}
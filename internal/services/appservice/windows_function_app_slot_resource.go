@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -80,6 +81,7 @@ type WindowsFunctionAppSlotModel struct {
 var _ sdk.ResourceWithUpdate = WindowsFunctionAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsFunctionAppSlotResource{}
+var _ sdk.ResourceWithIdentity = WindowsFunctionAppSlotResource{}
 
 func (r WindowsFunctionAppSlotResource) ModelObject() interface{} {
 	return &WindowsFunctionAppSlotModel{}
@@ -93,6 +95,10 @@ func (r WindowsFunctionAppSlotResource) IDValidationFunc() pluginsdk.SchemaValid
 	return webapps.ValidateSlotID
 }
 
+func (r WindowsFunctionAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r WindowsFunctionAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	s := map[string]*pluginsdk.Schema{
 		"name": {
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/migration"
@@ -74,6 +76,9 @@ var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppResource{}
+var _ sdk.ResourceWithIdentity = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -767,6 +772,10 @@ func (r WindowsWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateAppServiceID
 }
 
+func (r WindowsWebAppResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
 func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -1096,6 +1105,15 @@ func (r WindowsWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 	}
 }
 
+func (r WindowsWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: preflight.Timeout,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return preflight.Run(ctx, metadata.ResourceDiff, metadata.Client, webAppPreflightValidation("app"))
+		},
+	}
+}
+
 func (r WindowsWebAppResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -71,6 +72,7 @@ type WindowsWebAppSlotModel struct {
 var _ sdk.ResourceWithUpdate = WindowsWebAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsWebAppSlotResource{}
+var _ sdk.ResourceWithIdentity = WindowsWebAppSlotResource{}
 
 func (r WindowsWebAppSlotResource) ModelObject() interface{} {
 	return &WindowsWebAppSlotModel{}
@@ -84,6 +86,10 @@ func (r WindowsWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return webapps.ValidateSlotID
 }
 
+func (r WindowsWebAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r WindowsWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
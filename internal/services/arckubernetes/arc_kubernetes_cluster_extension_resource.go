@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	arckubernetes "github.com/hashicorp/go-azure-sdk/resource-manager/hybridkubernetes/2024-01-01/connectedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -69,6 +70,10 @@ func (r ArcKubernetesClusterExtensionResource) IDValidationFunc() pluginsdk.Sche
 	}
 }
 
+func (r ArcKubernetesClusterExtensionResource) Identity() resourceids.ResourceId {
+	return &extensions.ScopedExtensionId{}
+}
+
 func (r ArcKubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceArcKubernetesCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArcKubernetesClusterCreate,
 		Read:   resourceArcKubernetesClusterRead,
 		Update: resourceArcKubernetesClusterUpdate,
@@ -103,6 +103,10 @@ func resourceArcKubernetesCluster() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &arckubernetes.ConnectedClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &arckubernetes.ConnectedClusterId{})
 }
 
 func resourceArcKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resourceconnector/2022-10-27/appliances"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,7 @@ import (
 )
 
 var _ sdk.Resource = ArcResourceBridgeApplianceResource{}
+var _ sdk.ResourceWithIdentity = ArcResourceBridgeApplianceResource{}
 
 type ArcResourceBridgeApplianceResource struct{}
 
@@ -277,3 +279,7 @@ func (r ArcResourceBridgeApplianceResource) Delete() sdk.ResourceFunc {
 func (r ArcResourceBridgeApplianceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return appliances.ValidateApplianceID
 }
+
+func (r ArcResourceBridgeApplianceResource) Identity() resourceids.ResourceId {
+	return &appliances.ApplianceId{}
+}
@@ -30,7 +30,7 @@ import (
 )
 
 func resourceAttestationProvider() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAttestationProviderCreate,
 		Read:   resourceAttestationProviderRead,
 		Update: resourceAttestationProviderUpdate,
@@ -149,6 +149,10 @@ func resourceAttestationProvider() *pluginsdk.Resource {
 			return s
 		}(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &attestationproviders.AttestationProvidersId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &attestationproviders.AttestationProvidersId{})
 }
 
 func resourceAttestationProviderCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.ServiceRegistrationWithListResources       = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -62,3 +63,10 @@ func (r Registration) Resources() []sdk.Resource {
 	}
 	return resources
 }
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		RoleAssignmentListResource{},
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type RoleAssignmentListResource struct{}
+
+var _ sdk.ListResource = RoleAssignmentListResource{}
+
+func (r RoleAssignmentListResource) ResourceType() string {
+	return "azurerm_role_assignment"
+}
+
+func (r RoleAssignmentListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+
+	scopeId := commonids.NewScopeID(commonids.NewSubscriptionID(metadata.SubscriptionId).ID())
+	if request.ResourceGroupName != "" {
+		scopeId = commonids.NewScopeID(commonids.NewResourceGroupID(metadata.SubscriptionId, request.ResourceGroupName).ID())
+	}
+
+	resp, err := client.ListForScopeComplete(ctx, scopeId, roleassignments.DefaultListForScopeOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Assignments within %s: %+v", scopeId, err)
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := roleassignments.ParseScopedRoleAssignmentIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Role Assignments inherited from a parent scope (e.g. a Management Group) are also returned, which
+		// aren't managed within this scope - so only Role Assignments at or below the scope are listed
+		if !isWithinScope(id.Scope, scopeId.Scope) {
+			continue
+		}
+
+		// Role Assignments don't support Tags
+		results = append(results, sdk.ListResult{
+			Id:   id,
+			Name: id.RoleAssignmentName,
+		})
+	}
+
+	return results, nil
+}
+
+func isWithinScope(scope, parent string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	parent = strings.ToLower(strings.TrimSuffix(parent, "/"))
+	return scope == parent || strings.HasPrefix(scope, parent+"/")
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	"github.com/hashicorp/go-uuid"
@@ -32,7 +33,8 @@ import (
 // TODO: this wants splitting into virtual resources with Virtual IDs
 
 func resourceArmRoleAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	// the Tenant ID used for cross-tenant Role Assignments isn't part of the Resource ID, so isn't included
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmRoleAssignmentCreate,
 		Read:   resourceArmRoleAssignmentRead,
 		Delete: resourceArmRoleAssignmentDelete,
@@ -153,7 +155,7 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &roleassignments.ScopedRoleAssignmentId{})
 }
 
 func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -296,6 +298,18 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("loading Role Assignment %q: %+v", d.Id(), err)
 	}
 
+	// the ID of a cross-tenant Role Assignment is suffixed with the Tenant ID (`{id}|{tenantId}`), so the Resource
+	// Identity can't be built from it and is instead set using the ID within Azure
+	if id.TenantId != "" {
+		scopedId, err := roleassignments.ParseScopedRoleAssignmentIDInsensitively(id.AzureResourceID())
+		if err != nil {
+			return err
+		}
+		if err := pluginsdk.SetResourceIdentityData(d, scopedId); err != nil {
+			return err
+		}
+	}
+
 	d.Set("name", resp.Name)
 
 	if props := resp.RoleAssignmentPropertiesWithScope; props != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofiles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automanage/migration"
@@ -92,6 +93,7 @@ type AutoManageConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = AutoManageConfigurationResource{}
 var _ sdk.ResourceWithStateMigration = AutoManageConfigurationResource{}
+var _ sdk.ResourceWithIdentity = AutoManageConfigurationResource{}
 
 func (r AutoManageConfigurationResource) ResourceType() string {
 	return "azurerm_automanage_configuration"
@@ -105,6 +107,10 @@ func (r AutoManageConfigurationResource) IDValidationFunc() pluginsdk.SchemaVali
 	return configurationprofiles.ValidateConfigurationProfileID
 }
 
+func (r AutoManageConfigurationResource) Identity() resourceids.ResourceId {
+	return &configurationprofiles.ConfigurationProfileId{}
+}
+
 func (r AutoManageConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofileassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -166,4 +167,9 @@ func (v VirtualMachineConfigurationAssignment) IDValidationFunc() pluginsdk.Sche
 	return configurationprofileassignments.ValidateVirtualMachineProviders2ConfigurationProfileAssignmentID
 }
 
+func (v VirtualMachineConfigurationAssignment) Identity() resourceids.ResourceId {
+	return &configurationprofileassignments.VirtualMachineProviders2ConfigurationProfileAssignmentId{}
+}
+
 var _ sdk.Resource = &VirtualMachineConfigurationAssignment{}
+var _ sdk.ResourceWithIdentity = &VirtualMachineConfigurationAssignment{}
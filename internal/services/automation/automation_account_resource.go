@@ -30,7 +30,7 @@ import (
 )
 
 func resourceAutomationAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAutomationAccountCreate,
 		Read:   resourceAutomationAccountRead,
 		Update: resourceAutomationAccountUpdate,
@@ -155,6 +155,10 @@ func resourceAutomationAccount() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &automationaccount.AutomationAccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &automationaccount.AutomationAccountId{})
 }
 
 func resourceAutomationAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
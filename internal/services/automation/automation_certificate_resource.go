@@ -88,7 +88,7 @@ func resourceAutomationCertificate() *pluginsdk.Resource {
 			Optional: true,
 		}
 	}
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &certificate.CertificateId{})
 }
 
 func resourceAutomationCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceAutomationConnectionCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationConnectionCertificateCreateUpdate,
 		Read:   resourceAutomationConnectionCertificateRead,
 		Update: resourceAutomationConnectionCertificateCreateUpdate,
@@ -73,7 +73,7 @@ func resourceAutomationConnectionCertificate() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &connection.ConnectionId{})
 }
 
 func resourceAutomationConnectionCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceAutomationConnectionClassicCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationConnectionClassicCertificateCreateUpdate,
 		Read:   resourceAutomationConnectionClassicCertificateRead,
 		Update: resourceAutomationConnectionClassicCertificateCreateUpdate,
@@ -79,7 +79,7 @@ func resourceAutomationConnectionClassicCertificate() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &connection.ConnectionId{})
 }
 
 func resourceAutomationConnectionClassicCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
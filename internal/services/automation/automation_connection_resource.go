@@ -23,7 +23,7 @@ import (
 )
 
 func resourceAutomationConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationConnectionCreateUpdate,
 		Read:   resourceAutomationConnectionRead,
 		Update: resourceAutomationConnectionCreateUpdate,
@@ -78,7 +78,7 @@ func resourceAutomationConnection() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &connection.ConnectionId{})
 }
 
 func resourceAutomationConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceAutomationConnectionServicePrincipal() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationConnectionServicePrincipalCreateUpdate,
 		Read:   resourceAutomationConnectionServicePrincipalRead,
 		Update: resourceAutomationConnectionServicePrincipalCreateUpdate,
@@ -85,7 +85,7 @@ func resourceAutomationConnectionServicePrincipal() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &connection.ConnectionId{})
 }
 
 func resourceAutomationConnectionServicePrincipalCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceAutomationCredential() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationCredentialCreateUpdate,
 		Read:   resourceAutomationCredentialRead,
 		Update: resourceAutomationCredentialCreateUpdate,
@@ -69,7 +69,7 @@ func resourceAutomationCredential() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &credential.CredentialId{})
 }
 
 func resourceAutomationCredentialCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
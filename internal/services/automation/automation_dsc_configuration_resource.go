@@ -26,7 +26,7 @@ import (
 )
 
 func resourceAutomationDscConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAutomationDscConfigurationCreateUpdate,
 		Read:   resourceAutomationDscConfigurationRead,
 		Update: resourceAutomationDscConfigurationCreateUpdate,
@@ -91,6 +91,10 @@ func resourceAutomationDscConfiguration() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dscconfiguration.ConfigurationId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dscconfiguration.ConfigurationId{})
 }
 
 func resourceAutomationDscConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceAutomationDscNodeConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationDscNodeConfigurationCreateUpdate,
 		Read:   resourceAutomationDscNodeConfigurationRead,
 		Update: resourceAutomationDscNodeConfigurationCreateUpdate,
@@ -68,7 +68,7 @@ func resourceAutomationDscNodeConfiguration() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &dscnodeconfiguration.NodeConfigurationId{})
 }
 
 func resourceAutomationDscNodeConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
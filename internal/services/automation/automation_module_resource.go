@@ -20,7 +20,7 @@ import (
 )
 
 func resourceAutomationModule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationModuleCreateUpdate,
 		Read:   resourceAutomationModuleRead,
 		Update: resourceAutomationModuleCreateUpdate,
@@ -87,7 +87,7 @@ func resourceAutomationModule() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &module.ModuleId{})
 }
 
 func resourceAutomationModuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
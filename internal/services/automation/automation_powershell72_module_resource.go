@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -19,6 +20,7 @@ import (
 )
 
 var _ sdk.Resource = PowerShell72ModuleResource{}
+var _ sdk.ResourceWithIdentity = PowerShell72ModuleResource{}
 
 type ModuleLinkModel struct {
 	Uri  string       `tfschema:"uri"`
@@ -106,6 +108,10 @@ func (r PowerShell72ModuleResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return module.ValidatePowerShell72ModuleID
 }
 
+func (r PowerShell72ModuleResource) Identity() resourceids.ResourceId {
+	return &module.PowerShell72ModuleId{}
+}
+
 func (r PowerShell72ModuleResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
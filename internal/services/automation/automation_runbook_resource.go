@@ -81,7 +81,7 @@ func contentLinkSchema(isDraft bool) *pluginsdk.Schema {
 }
 
 func resourceAutomationRunbook() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAutomationRunbookCreateUpdate,
 		Read:   resourceAutomationRunbookRead,
 		Update: resourceAutomationRunbookCreateUpdate,
@@ -280,6 +280,10 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &runbook.RunbookId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &runbook.RunbookId{})
 }
 
 func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
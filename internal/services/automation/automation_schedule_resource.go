@@ -30,7 +30,7 @@ import (
 )
 
 func resourceAutomationSchedule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationScheduleCreate,
 		Read:   resourceAutomationScheduleRead,
 		Update: resourceAutomationScheduleUpdate,
@@ -210,7 +210,7 @@ func resourceAutomationSchedule() *pluginsdk.Resource {
 
 			return nil
 		}),
-	}
+	}, &schedule.ScheduleId{})
 }
 
 func resourceAutomationScheduleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
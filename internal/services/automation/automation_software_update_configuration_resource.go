@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2019-06-01/softwareupdateconfiguration"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -135,6 +136,7 @@ type SoftwareUpdateConfigurationModel struct {
 type SoftwareUpdateConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = SoftwareUpdateConfigurationResource{}
+var _ sdk.ResourceWithIdentity = SoftwareUpdateConfigurationResource{}
 
 func (m SoftwareUpdateConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	linux := pluginsdk.Resource{}
@@ -1192,6 +1194,10 @@ func (m SoftwareUpdateConfigurationResource) IDValidationFunc() pluginsdk.Schema
 	return softwareupdateconfiguration.ValidateSoftwareUpdateConfigurationID
 }
 
+func (m SoftwareUpdateConfigurationResource) Identity() resourceids.ResourceId {
+	return &softwareupdateconfiguration.SoftwareUpdateConfigurationId{}
+}
+
 func expandUpdateConfig(input SoftwareUpdateConfigurationModel) *softwareupdateconfiguration.SoftwareUpdateConfiguration {
 	result := &softwareupdateconfiguration.SoftwareUpdateConfiguration{
 		Properties: softwareupdateconfiguration.SoftwareUpdateConfigurationProperties{
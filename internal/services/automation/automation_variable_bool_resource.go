@@ -11,7 +11,7 @@ import (
 )
 
 func resourceAutomationVariableBool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationVariableBoolCreateUpdate,
 		Read:   resourceAutomationVariableBoolRead,
 		Update: resourceAutomationVariableBoolCreateUpdate,
@@ -30,7 +30,7 @@ func resourceAutomationVariableBool() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeBool, nil),
-	}
+	}, &variable.VariableId{})
 }
 
 func resourceAutomationVariableBoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,7 +12,7 @@ import (
 )
 
 func resourceAutomationVariableDateTime() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationVariableDateTimeCreateUpdate,
 		Read:   resourceAutomationVariableDateTimeRead,
 		Update: resourceAutomationVariableDateTimeCreateUpdate,
@@ -31,7 +31,7 @@ func resourceAutomationVariableDateTime() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeString, validation.IsRFC3339Time),
-	}
+	}, &variable.VariableId{})
 }
 
 func resourceAutomationVariableDateTimeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
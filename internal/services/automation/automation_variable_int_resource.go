@@ -11,7 +11,7 @@ import (
 )
 
 func resourceAutomationVariableInt() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationVariableIntCreateUpdate,
 		Read:   resourceAutomationVariableIntRead,
 		Update: resourceAutomationVariableIntCreateUpdate,
@@ -30,7 +30,7 @@ func resourceAutomationVariableInt() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeInt, nil),
-	}
+	}, &variable.VariableId{})
 }
 
 func resourceAutomationVariableIntCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,7 +12,7 @@ import (
 )
 
 func resourceAutomationVariableObject() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationVariableObjectCreate,
 		Read:   resourceAutomationVariableObjectRead,
 		Update: resourceAutomationVariableObjectUpdate,
@@ -31,7 +31,7 @@ func resourceAutomationVariableObject() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeString, validation.StringIsNotEmpty),
-	}
+	}, &variable.VariableId{})
 }
 
 func resourceAutomationVariableObjectCreate(d *pluginsdk.ResourceData, meta interface{}) error {
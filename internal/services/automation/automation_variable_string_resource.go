@@ -12,7 +12,7 @@ import (
 )
 
 func resourceAutomationVariableString() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationVariableStringCreateUpdate,
 		Read:   resourceAutomationVariableStringRead,
 		Update: resourceAutomationVariableStringCreateUpdate,
@@ -31,7 +31,7 @@ func resourceAutomationVariableString() *pluginsdk.Resource {
 		},
 
 		Schema: resourceAutomationVariableCommonSchema(pluginsdk.TypeString, validation.StringIsNotEmpty),
-	}
+	}, &variable.VariableId{})
 }
 
 func resourceAutomationVariableStringCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2020-01-13-preview/watcher"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -262,3 +263,7 @@ func (m WatcherResource) Delete() sdk.ResourceFunc {
 func (m WatcherResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return watcher.ValidateWatcherID
 }
+
+func (m WatcherResource) Identity() resourceids.ResourceId {
+	return &watcher.WatcherId{}
+}
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceAutomationWebhook() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAutomationWebhookCreateUpdate,
 		Read:   resourceAutomationWebhookRead,
 		Update: resourceAutomationWebhookCreateUpdate,
@@ -104,7 +104,7 @@ func resourceAutomationWebhook() *pluginsdk.Resource {
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 		},
-	}
+	}, &webhook.WebHookId{})
 }
 
 func resourceAutomationWebhookCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceArmStackHCICluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmStackHCIClusterCreate,
 		Read:   resourceArmStackHCIClusterRead,
 		Update: resourceArmStackHCIClusterUpdate,
@@ -99,6 +99,10 @@ func resourceArmStackHCICluster() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &clusters.ClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &clusters.ClusterId{})
 }
 
 func resourceArmStackHCIClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -204,7 +204,9 @@ func resourceBatchAccount() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &batchaccount.BatchAccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &batchaccount.BatchAccountId{})
 }
 
 func resourceBatchAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
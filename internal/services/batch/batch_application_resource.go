@@ -20,7 +20,7 @@ import (
 )
 
 func resourceBatchApplication() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceBatchApplicationCreate,
 		Read:   resourceBatchApplicationRead,
 		Update: resourceBatchApplicationUpdate,
@@ -73,7 +73,7 @@ func resourceBatchApplication() *pluginsdk.Resource {
 				ValidateFunc: validate.ApplicationDisplayName,
 			},
 		},
-	}
+	}, &application.ApplicationId{})
 }
 
 func resourceBatchApplicationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceBatchCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceBatchCertificateCreate,
 		Read:   resourceBatchCertificateRead,
 		Update: resourceBatchCertificateUpdate,
@@ -99,7 +99,7 @@ func resourceBatchCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &certificate.CertificateId{})
 }
 
 func resourceBatchCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
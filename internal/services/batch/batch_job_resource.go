@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/batchaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -91,6 +92,10 @@ func (r BatchJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.JobID
 }
 
+func (r BatchJobResource) Identity() resourceids.ResourceId {
+	return &parse.JobId{}
+}
+
 func (r BatchJobResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -49,6 +49,7 @@ func resourceBatchPool() *pluginsdk.Resource {
 			_, err := pool.ParsePoolID(id)
 			return err
 		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -868,7 +869,7 @@ func resourceBatchPool() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &pool.PoolId{})
 }
 
 func resourceBatchPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
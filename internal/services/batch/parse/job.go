@@ -46,6 +46,24 @@ func (id JobId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.PoolName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Job ID
+func (id JobId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftBatch", "Microsoft.Batch", "Microsoft.Batch"),
+		resourceids.StaticSegment("staticBatchAccounts", "batchAccounts", "batchAccounts"),
+		resourceids.UserSpecifiedSegment("batchAccountName", "account1"),
+		resourceids.StaticSegment("staticPools", "pools", "pools"),
+		resourceids.UserSpecifiedSegment("poolName", "pool1"),
+		resourceids.StaticSegment("staticJobs", "jobs", "jobs"),
+		resourceids.UserSpecifiedSegment("name", "job1"),
+	}
+}
+
 // JobID parses a Job ID into an JobId struct
 func JobID(input string) (*JobId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func JobID(input string) (*JobId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the JobId using the ParseResult provided in 'input'
+func (id *JobId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.BatchAccountName, ok = input.Parsed["batchAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "batchAccountName", input)
+	}
+
+	if id.PoolName, ok = input.Parsed["poolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "poolName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
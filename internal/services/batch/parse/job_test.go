@@ -21,6 +21,23 @@ func TestJobIDFormatter(t *testing.T) {
 	}
 }
 
+func TestJobIDSegments(t *testing.T) {
+	expected := NewJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "pool1", "job1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&JobId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := JobId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestJobID(t *testing.T) {
 	testData := []struct {
 		Input    string
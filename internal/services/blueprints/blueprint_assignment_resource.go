@@ -26,7 +26,7 @@ import (
 )
 
 func resourceBlueprintAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBlueprintAssignmentCreateUpdate,
 		Update: resourceBlueprintAssignmentCreateUpdate,
 		Read:   resourceBlueprintAssignmentRead,
@@ -138,6 +138,10 @@ func resourceBlueprintAssignment() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &assignment.ScopedBlueprintAssignmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &assignment.ScopedBlueprintAssignmentId{})
 }
 
 func resourceBlueprintAssignmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
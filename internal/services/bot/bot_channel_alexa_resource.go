@@ -26,7 +26,7 @@ import (
 )
 
 func resourceBotChannelAlexa() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelAlexaCreate,
 		Read:   resourceBotChannelAlexaRead,
 		Delete: resourceBotChannelAlexaDelete,
@@ -63,6 +63,10 @@ func resourceBotChannelAlexa() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelAlexaCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelDirectLineSpeechCreate,
 		Read:   resourceBotChannelDirectLineSpeechRead,
 		Delete: resourceBotChannelDirectLineSpeechDelete,
@@ -84,6 +84,10 @@ func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelDirectLineSpeechCreate(d *pluginsdk.ResourceData, meta interface{}) error {
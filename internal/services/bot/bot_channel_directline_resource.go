@@ -23,7 +23,7 @@ import (
 )
 
 func resourceBotChannelDirectline() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelDirectlineCreate,
 		Read:   resourceBotChannelDirectlineRead,
 		Delete: resourceBotChannelDirectlineDelete,
@@ -135,6 +135,10 @@ func resourceBotChannelDirectline() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelDirectlineCreate(d *pluginsdk.ResourceData, meta interface{}) error {
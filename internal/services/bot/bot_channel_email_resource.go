@@ -25,7 +25,7 @@ import (
 )
 
 func resourceBotChannelEmail() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelEmailCreate,
 		Read:   resourceBotChannelEmailRead,
 		Delete: resourceBotChannelEmailDelete,
@@ -78,6 +78,10 @@ func resourceBotChannelEmail() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelEmailCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceBotChannelFacebook() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelFacebookCreate,
 		Read:   resourceBotChannelFacebookRead,
 		Delete: resourceBotChannelFacebookDelete,
@@ -89,6 +89,10 @@ func resourceBotChannelFacebook() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelFacebookCreate(d *pluginsdk.ResourceData, meta interface{}) error {
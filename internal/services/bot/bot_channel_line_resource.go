@@ -24,7 +24,7 @@ import (
 )
 
 func resourceBotChannelLine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelLineCreate,
 		Read:   resourceBotChannelLineRead,
 		Delete: resourceBotChannelLineDelete,
@@ -77,6 +77,10 @@ func resourceBotChannelLine() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelLineCreate(d *pluginsdk.ResourceData, meta interface{}) error {
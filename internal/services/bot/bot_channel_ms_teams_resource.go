@@ -24,7 +24,7 @@ import (
 )
 
 func resourceBotChannelMsTeams() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelMsTeamsCreate,
 		Read:   resourceBotChannelMsTeamsRead,
 		Delete: resourceBotChannelMsTeamsDelete,
@@ -81,6 +81,10 @@ func resourceBotChannelMsTeams() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelMsTeamsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
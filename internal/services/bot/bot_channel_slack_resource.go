@@ -23,7 +23,7 @@ import (
 )
 
 func resourceBotChannelSlack() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelSlackCreate,
 		Read:   resourceBotChannelSlackRead,
 		Delete: resourceBotChannelSlackDelete,
@@ -87,6 +87,10 @@ func resourceBotChannelSlack() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelSlackCreate(d *pluginsdk.ResourceData, meta interface{}) error {
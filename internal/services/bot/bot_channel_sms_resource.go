@@ -24,7 +24,7 @@ import (
 )
 
 func resourceBotChannelSMS() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotChannelSMSCreate,
 		Read:   resourceBotChannelSMSRead,
 		Delete: resourceBotChannelSMSDelete,
@@ -74,6 +74,10 @@ func resourceBotChannelSMS() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelSMSCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -108,7 +108,9 @@ func resourceBotChannelWebChat() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.BotChannelId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotChannelId{})
 }
 
 func resourceBotChannelWebChatCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -185,7 +185,9 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.BotServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotServiceId{})
 }
 
 func resourceBotChannelsRegistrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
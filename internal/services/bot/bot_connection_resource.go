@@ -107,7 +107,9 @@ func resourceArmBotConnection() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.BotConnectionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotConnectionId{})
 }
 
 func resourceArmBotConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceHealthbotService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthbotServiceCreate,
 		Read:   resourceHealthbotServiceRead,
 		Update: resourceHealthbotServiceUpdate,
@@ -66,6 +66,10 @@ func resourceHealthbotService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &healthbots.HealthBotId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &healthbots.HealthBotId{})
 }
 
 func resourceHealthbotServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
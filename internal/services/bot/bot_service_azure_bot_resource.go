@@ -4,7 +4,9 @@
 package bot
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -47,6 +49,10 @@ func (r AzureBotServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return validate.BotServiceID
 }
 
+func (r AzureBotServiceResource) Identity() resourceids.ResourceId {
+	return &parse.BotServiceId{}
+}
+
 func (r AzureBotServiceResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), string(botservice.KindAzurebot))
 }
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceBotWebApp() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBotWebAppCreate,
 		Read:   resourceBotWebAppRead,
 		Update: resourceBotWebAppUpdate,
@@ -142,6 +142,10 @@ func resourceBotWebApp() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.BotServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.BotServiceId{})
 }
 
 func resourceBotWebAppCreate(d *pluginsdk.ResourceData, meta interface{}) error {
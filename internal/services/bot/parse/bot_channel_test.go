@@ -21,6 +21,23 @@ func TestBotChannelIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotChannelIDSegments(t *testing.T) {
+	expected := NewBotChannelID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1", "Discovery1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BotChannelId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BotChannelId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBotChannelID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id BotConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ConnectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BotConnection ID
+func (id BotConnectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftBotService", "Microsoft.BotService", "Microsoft.BotService"),
+		resourceids.StaticSegment("staticBotServices", "botServices", "botServices"),
+		resourceids.UserSpecifiedSegment("botServiceName", "botService1"),
+		resourceids.StaticSegment("staticConnections", "connections", "connections"),
+		resourceids.UserSpecifiedSegment("connectionName", "connection1"),
+	}
+}
+
 // BotConnectionID parses a BotConnection ID into an BotConnectionId struct
 func BotConnectionID(input string) (*BotConnectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func BotConnectionID(input string) (*BotConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the BotConnectionId using the ParseResult provided in 'input'
+func (id *BotConnectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.BotServiceName, ok = input.Parsed["botServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "botServiceName", input)
+	}
+
+	if id.ConnectionName, ok = input.Parsed["connectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "connectionName", input)
+	}
+
+	return nil
+}
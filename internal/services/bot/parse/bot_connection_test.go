@@ -21,6 +21,23 @@ func TestBotConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotConnectionIDSegments(t *testing.T) {
+	expected := NewBotConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1", "connection1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BotConnectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BotConnectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBotConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
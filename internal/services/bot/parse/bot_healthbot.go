@@ -40,6 +40,20 @@ func (id BotHealthbotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HealthBotName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BotHealthbot ID
+func (id BotHealthbotId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHealthBot", "Microsoft.HealthBot", "Microsoft.HealthBot"),
+		resourceids.StaticSegment("staticHealthBots", "healthBots", "healthBots"),
+		resourceids.UserSpecifiedSegment("healthBotName", "bot1"),
+	}
+}
+
 // BotHealthbotID parses a BotHealthbot ID into an BotHealthbotId struct
 func BotHealthbotID(input string) (*BotHealthbotId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func BotHealthbotID(input string) (*BotHealthbotId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the BotHealthbotId using the ParseResult provided in 'input'
+func (id *BotHealthbotId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.HealthBotName, ok = input.Parsed["healthBotName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "healthBotName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestBotHealthbotIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotHealthbotIDSegments(t *testing.T) {
+	expected := NewBotHealthbotID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "bot1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BotHealthbotId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BotHealthbotId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBotHealthbotID(t *testing.T) {
 	testData := []struct {
 		Input    string
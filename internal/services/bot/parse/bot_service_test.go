@@ -21,6 +21,23 @@ func TestBotServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotServiceIDSegments(t *testing.T) {
+	expected := NewBotServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BotServiceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BotServiceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBotServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -133,7 +133,7 @@ func resourceArmCdnEndpointCustomDomain() *pluginsdk.Resource {
 		}
 	}
 
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmCdnEndpointCustomDomainCreate,
 		Read:   resourceArmCdnEndpointCustomDomainRead,
 		Update: resourceArmCdnEndpointCustomDomainUpdate,
@@ -152,7 +152,7 @@ func resourceArmCdnEndpointCustomDomain() *pluginsdk.Resource {
 		},
 
 		Schema: schema,
-	}
+	}, &parse.CustomDomainId{})
 }
 
 func resourceArmCdnEndpointCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
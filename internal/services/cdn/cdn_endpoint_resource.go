@@ -25,7 +25,7 @@ import (
 )
 
 func resourceCdnEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCdnEndpointCreate,
 		Read:   resourceCdnEndpointRead,
 		Update: resourceCdnEndpointUpdate,
@@ -208,6 +208,10 @@ func resourceCdnEndpoint() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.EndpointId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.EndpointId{})
 }
 
 func resourceCdnEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
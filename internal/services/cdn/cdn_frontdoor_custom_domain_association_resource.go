@@ -20,7 +20,7 @@ var cdnFrontDoorCustomDomainResourceName = "azurerm_cdn_frontdoor_custom_domain"
 var cdnFrontDoorRouteResourceName = "azurerm_cdn_frontdoor_route"
 
 func resourceCdnFrontDoorCustomDomainAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorCustomDomainAssociationCreate,
 		Read:   resourceCdnFrontDoorCustomDomainAssociationRead,
 		Update: resourceCdnFrontDoorCustomDomainAssociationUpdate,
@@ -56,7 +56,7 @@ func resourceCdnFrontDoorCustomDomainAssociation() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FrontDoorCustomDomainAssociationId{})
 }
 
 func resourceCdnFrontDoorCustomDomainAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -120,7 +120,7 @@ func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
 		},
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &parse.FrontDoorCustomDomainId{})
 }
 
 func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCdnFrontDoorEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorEndpointCreate,
 		Read:   resourceCdnFrontDoorEndpointRead,
 		Update: resourceCdnFrontDoorEndpointUpdate,
@@ -67,7 +67,7 @@ func resourceCdnFrontDoorEndpoint() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.FrontDoorEndpointId{})
 }
 
 func resourceCdnFrontDoorEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorFirewallPolicyCreate,
 		Read:   resourceCdnFrontDoorFirewallPolicyRead,
 		Update: resourceCdnFrontDoorFirewallPolicyUpdate,
@@ -450,7 +450,7 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &parse.FrontDoorFirewallPolicyId{})
 }
 
 func resourceCdnFrontDoorFirewallPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceCdnFrontDoorOriginGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorOriginGroupCreate,
 		Read:   resourceCdnFrontDoorOriginGroupRead,
 		Update: resourceCdnFrontDoorOriginGroupUpdate,
@@ -140,7 +140,7 @@ func resourceCdnFrontDoorOriginGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 50),
 			},
 		},
-	}
+	}, &parse.FrontDoorOriginGroupId{})
 }
 
 func resourceCdnFrontDoorOriginGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
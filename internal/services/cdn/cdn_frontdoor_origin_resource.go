@@ -172,7 +172,9 @@ func resourceCdnFrontDoorOrigin() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.FrontDoorOriginId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.FrontDoorOriginId{})
 }
 
 func resourceCdnFrontDoorOriginCreate(d *pluginsdk.ResourceData, meta interface{}) error {
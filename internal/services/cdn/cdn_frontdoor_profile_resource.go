@@ -22,7 +22,7 @@ import (
 )
 
 func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorProfileCreate,
 		Read:   resourceCdnFrontDoorProfileRead,
 		Update: resourceCdnFrontDoorProfileUpdate,
@@ -74,7 +74,7 @@ func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.FrontDoorProfileId{})
 }
 
 func resourceCdnFrontDoorProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
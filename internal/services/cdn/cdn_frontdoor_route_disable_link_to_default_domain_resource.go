@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCdnFrontDoorRouteDisableLinkToDefaultDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorRouteDisableLinkToDefaultDomainCreate,
 		Read:   resourceCdnFrontDoorRouteDisableLinkToDefaultDomainRead,
 		Update: resourceCdnFrontDoorRouteDisableLinkToDefaultDomainUpdate,
@@ -60,7 +60,7 @@ func resourceCdnFrontDoorRouteDisableLinkToDefaultDomain() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FrontDoorRouteDisableLinkToDefaultDomainId{})
 }
 
 func resourceCdnFrontDoorRouteDisableLinkToDefaultDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorRouteCreate,
 		Read:   resourceCdnFrontDoorRouteRead,
 		Update: resourceCdnFrontDoorRouteUpdate,
@@ -198,7 +198,7 @@ func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FrontDoorRouteId{})
 }
 
 func resourceCdnFrontDoorRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
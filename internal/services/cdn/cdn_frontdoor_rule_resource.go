@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCdnFrontDoorRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorRuleCreate,
 		Read:   resourceCdnFrontDoorRuleRead,
 		Update: resourceCdnFrontDoorRuleUpdate,
@@ -616,7 +616,7 @@ func resourceCdnFrontDoorRule() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.FrontDoorRuleId{})
 }
 
 func resourceCdnFrontDoorRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
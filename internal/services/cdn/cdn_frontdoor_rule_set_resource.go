@@ -17,7 +17,7 @@ import (
 )
 
 func resourceCdnFrontDoorRuleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorRuleSetCreate,
 		Read:   resourceCdnFrontDoorRuleSetRead,
 		Delete: resourceCdnFrontDoorRuleSetDelete,
@@ -48,7 +48,7 @@ func resourceCdnFrontDoorRuleSet() *pluginsdk.Resource {
 				ValidateFunc: validate.FrontDoorProfileID,
 			},
 		},
-	}
+	}, &parse.FrontDoorRuleSetId{})
 }
 
 func resourceCdnFrontDoorRuleSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontDoorSecretCreate,
 		Read:   resourceCdnFrontDoorSecretRead,
 		Delete: resourceCdnFrontDoorSecretDelete,
@@ -95,7 +95,7 @@ func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.FrontDoorSecretId{})
 }
 
 func resourceCdnFrontDoorSecretCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCdnFrontdoorSecurityPolicyCreate,
 		Read:   resourceCdnFrontdoorSecurityPolicyRead,
 		Delete: resourceCdnFrontdoorSecurityPolicyDelete,
@@ -134,7 +134,7 @@ func resourceCdnFrontDoorSecurityPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FrontDoorSecurityPolicyId{})
 }
 
 func resourceCdnFrontdoorSecurityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCdnProfile() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCdnProfileCreate,
 		Read:   resourceCdnProfileRead,
 		Update: resourceCdnProfileUpdate,
@@ -73,6 +73,10 @@ func resourceCdnProfile() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ProfileId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ProfileId{})
 }
 
 func resourceCdnProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
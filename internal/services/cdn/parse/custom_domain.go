@@ -46,6 +46,24 @@ func (id CustomDomainId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.EndpointName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this CustomDomain ID
+func (id CustomDomainId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticEndpoints", "endpoints", "endpoints"),
+		resourceids.UserSpecifiedSegment("endpointName", "endpoint1"),
+		resourceids.StaticSegment("staticCustomDomains", "customDomains", "customDomains"),
+		resourceids.UserSpecifiedSegment("name", "domain1"),
+	}
+}
+
 // CustomDomainID parses a CustomDomain ID into an CustomDomainId struct
 func CustomDomainID(input string) (*CustomDomainId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func CustomDomainID(input string) (*CustomDomainId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the CustomDomainId using the ParseResult provided in 'input'
+func (id *CustomDomainId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.EndpointName, ok = input.Parsed["endpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "endpointName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
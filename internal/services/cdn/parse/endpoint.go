@@ -43,6 +43,22 @@ func (id EndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Endpoint ID
+func (id EndpointId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticEndpoints", "endpoints", "endpoints"),
+		resourceids.UserSpecifiedSegment("name", "endpoint1"),
+	}
+}
+
 // EndpointID parses a Endpoint ID into an EndpointId struct
 func EndpointID(input string) (*EndpointId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func EndpointIDInsensitively(input string) (*EndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the EndpointId using the ParseResult provided in 'input'
+func (id *EndpointId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
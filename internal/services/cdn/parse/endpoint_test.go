@@ -21,6 +21,23 @@ func TestEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointIDSegments(t *testing.T) {
+	expected := NewEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "endpoint1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&EndpointId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := EndpointId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
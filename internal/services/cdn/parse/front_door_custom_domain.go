@@ -43,6 +43,22 @@ func (id FrontDoorCustomDomainId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorCustomDomain ID
+func (id FrontDoorCustomDomainId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticCustomDomains", "customDomains", "customDomains"),
+		resourceids.UserSpecifiedSegment("customDomainName", "customDomain1"),
+	}
+}
+
 // FrontDoorCustomDomainID parses a FrontDoorCustomDomain ID into an FrontDoorCustomDomainId struct
 func FrontDoorCustomDomainID(input string) (*FrontDoorCustomDomainId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func FrontDoorCustomDomainIDInsensitively(input string) (*FrontDoorCustomDomainI
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorCustomDomainId using the ParseResult provided in 'input'
+func (id *FrontDoorCustomDomainId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.CustomDomainName, ok = input.Parsed["customDomainName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "customDomainName", input)
+	}
+
+	return nil
+}
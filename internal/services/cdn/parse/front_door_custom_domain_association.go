@@ -43,6 +43,22 @@ func (id FrontDoorCustomDomainAssociationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AssociationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorCustomDomainAssociation ID
+func (id FrontDoorCustomDomainAssociationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticAssociations", "associations", "associations"),
+		resourceids.UserSpecifiedSegment("associationName", "assoc1"),
+	}
+}
+
 // FrontDoorCustomDomainAssociationID parses a FrontDoorCustomDomainAssociation ID into an FrontDoorCustomDomainAssociationId struct
 func FrontDoorCustomDomainAssociationID(input string) (*FrontDoorCustomDomainAssociationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func FrontDoorCustomDomainAssociationID(input string) (*FrontDoorCustomDomainAss
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorCustomDomainAssociationId using the ParseResult provided in 'input'
+func (id *FrontDoorCustomDomainAssociationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.AssociationName, ok = input.Parsed["associationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "associationName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestFrontDoorCustomDomainAssociationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorCustomDomainAssociationIDSegments(t *testing.T) {
+	expected := NewFrontDoorCustomDomainAssociationID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "assoc1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorCustomDomainAssociationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorCustomDomainAssociationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorCustomDomainAssociationID(t *testing.T) {
 	testData := []struct {
 		Input    string
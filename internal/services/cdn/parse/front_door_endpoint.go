@@ -43,6 +43,22 @@ func (id FrontDoorEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorEndpoint ID
+func (id FrontDoorEndpointId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticAfdEndpoints", "afdEndpoints", "afdEndpoints"),
+		resourceids.UserSpecifiedSegment("afdEndpointName", "endpoint1"),
+	}
+}
+
 // FrontDoorEndpointID parses a FrontDoorEndpoint ID into an FrontDoorEndpointId struct
 func FrontDoorEndpointID(input string) (*FrontDoorEndpointId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func FrontDoorEndpointIDInsensitively(input string) (*FrontDoorEndpointId, error
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorEndpointId using the ParseResult provided in 'input'
+func (id *FrontDoorEndpointId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.AfdEndpointName, ok = input.Parsed["afdEndpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "afdEndpointName", input)
+	}
+
+	return nil
+}
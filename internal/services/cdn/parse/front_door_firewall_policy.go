@@ -40,6 +40,20 @@ func (id FrontDoorFirewallPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorWebApplicationFirewallPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorFirewallPolicy ID
+func (id FrontDoorFirewallPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticFrontDoorWebApplicationFirewallPolicies", "frontDoorWebApplicationFirewallPolicies", "frontDoorWebApplicationFirewallPolicies"),
+		resourceids.UserSpecifiedSegment("frontDoorWebApplicationFirewallPolicyName", "policy1"),
+	}
+}
+
 // FrontDoorFirewallPolicyID parses a FrontDoorFirewallPolicy ID into an FrontDoorFirewallPolicyId struct
 func FrontDoorFirewallPolicyID(input string) (*FrontDoorFirewallPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -114,3 +128,22 @@ func FrontDoorFirewallPolicyIDInsensitively(input string) (*FrontDoorFirewallPol
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorFirewallPolicyId using the ParseResult provided in 'input'
+func (id *FrontDoorFirewallPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FrontDoorWebApplicationFirewallPolicyName, ok = input.Parsed["frontDoorWebApplicationFirewallPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "frontDoorWebApplicationFirewallPolicyName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestFrontDoorFirewallPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorFirewallPolicyIDSegments(t *testing.T) {
+	expected := NewFrontDoorFirewallPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorFirewallPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorFirewallPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorFirewallPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id FrontDoorOriginId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.OriginGroupName, id.OriginName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorOrigin ID
+func (id FrontDoorOriginId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticOriginGroups", "originGroups", "originGroups"),
+		resourceids.UserSpecifiedSegment("originGroupName", "originGroup1"),
+		resourceids.StaticSegment("staticOrigins", "origins", "origins"),
+		resourceids.UserSpecifiedSegment("originName", "origin1"),
+	}
+}
+
 // FrontDoorOriginID parses a FrontDoorOrigin ID into an FrontDoorOriginId struct
 func FrontDoorOriginID(input string) (*FrontDoorOriginId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -150,3 +168,30 @@ func FrontDoorOriginIDInsensitively(input string) (*FrontDoorOriginId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorOriginId using the ParseResult provided in 'input'
+func (id *FrontDoorOriginId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.OriginGroupName, ok = input.Parsed["originGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "originGroupName", input)
+	}
+
+	if id.OriginName, ok = input.Parsed["originName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "originName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestFrontDoorOriginGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorOriginGroupIDSegments(t *testing.T) {
+	expected := NewFrontDoorOriginGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "originGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorOriginGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorOriginGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorOriginGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestFrontDoorOriginIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorOriginIDSegments(t *testing.T) {
+	expected := NewFrontDoorOriginID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "originGroup1", "origin1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorOriginId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorOriginId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorOriginID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,20 @@ func (id FrontDoorProfileId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorProfile ID
+func (id FrontDoorProfileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+	}
+}
+
 // FrontDoorProfileID parses a FrontDoorProfile ID into an FrontDoorProfileId struct
 func FrontDoorProfileID(input string) (*FrontDoorProfileId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -114,3 +128,22 @@ func FrontDoorProfileIDInsensitively(input string) (*FrontDoorProfileId, error)
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorProfileId using the ParseResult provided in 'input'
+func (id *FrontDoorProfileId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	return nil
+}
@@ -49,6 +49,26 @@ func (id FrontDoorRouteDisableLinkToDefaultDomainId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, id.DisableLinkToDefaultDomainName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorRouteDisableLinkToDefaultDomain ID
+func (id FrontDoorRouteDisableLinkToDefaultDomainId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticAfdEndpoints", "afdEndpoints", "afdEndpoints"),
+		resourceids.UserSpecifiedSegment("afdEndpointName", "endpoint1"),
+		resourceids.StaticSegment("staticRoutes", "routes", "routes"),
+		resourceids.UserSpecifiedSegment("routeName", "route1"),
+		resourceids.StaticSegment("staticDisableLinkToDefaultDomain", "disableLinkToDefaultDomain", "disableLinkToDefaultDomain"),
+		resourceids.UserSpecifiedSegment("disableLinkToDefaultDomainName", "disableLinkToDefaultDomain1"),
+	}
+}
+
 // FrontDoorRouteDisableLinkToDefaultDomainID parses a FrontDoorRouteDisableLinkToDefaultDomain ID into an FrontDoorRouteDisableLinkToDefaultDomainId struct
 func FrontDoorRouteDisableLinkToDefaultDomainID(input string) (*FrontDoorRouteDisableLinkToDefaultDomainId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -88,3 +108,34 @@ func FrontDoorRouteDisableLinkToDefaultDomainID(input string) (*FrontDoorRouteDi
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorRouteDisableLinkToDefaultDomainId using the ParseResult provided in 'input'
+func (id *FrontDoorRouteDisableLinkToDefaultDomainId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.AfdEndpointName, ok = input.Parsed["afdEndpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "afdEndpointName", input)
+	}
+
+	if id.RouteName, ok = input.Parsed["routeName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "routeName", input)
+	}
+
+	if id.DisableLinkToDefaultDomainName, ok = input.Parsed["disableLinkToDefaultDomainName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "disableLinkToDefaultDomainName", input)
+	}
+
+	return nil
+}
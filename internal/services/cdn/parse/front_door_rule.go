@@ -46,6 +46,24 @@ func (id FrontDoorRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorRule ID
+func (id FrontDoorRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticRuleSets", "ruleSets", "ruleSets"),
+		resourceids.UserSpecifiedSegment("ruleSetName", "ruleSet1"),
+		resourceids.StaticSegment("staticRules", "rules", "rules"),
+		resourceids.UserSpecifiedSegment("ruleName", "rule1"),
+	}
+}
+
 // FrontDoorRuleID parses a FrontDoorRule ID into an FrontDoorRuleId struct
 func FrontDoorRuleID(input string) (*FrontDoorRuleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -150,3 +168,30 @@ func FrontDoorRuleIDInsensitively(input string) (*FrontDoorRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorRuleId using the ParseResult provided in 'input'
+func (id *FrontDoorRuleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.RuleSetName, ok = input.Parsed["ruleSetName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ruleSetName", input)
+	}
+
+	if id.RuleName, ok = input.Parsed["ruleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ruleName", input)
+	}
+
+	return nil
+}
@@ -43,6 +43,22 @@ func (id FrontDoorRuleSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorRuleSet ID
+func (id FrontDoorRuleSetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticRuleSets", "ruleSets", "ruleSets"),
+		resourceids.UserSpecifiedSegment("ruleSetName", "ruleSet1"),
+	}
+}
+
 // FrontDoorRuleSetID parses a FrontDoorRuleSet ID into an FrontDoorRuleSetId struct
 func FrontDoorRuleSetID(input string) (*FrontDoorRuleSetId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func FrontDoorRuleSetIDInsensitively(input string) (*FrontDoorRuleSetId, error)
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorRuleSetId using the ParseResult provided in 'input'
+func (id *FrontDoorRuleSetId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.RuleSetName, ok = input.Parsed["ruleSetName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ruleSetName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestFrontDoorRuleSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorRuleSetIDSegments(t *testing.T) {
+	expected := NewFrontDoorRuleSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "ruleSet1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorRuleSetId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorRuleSetId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorRuleSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
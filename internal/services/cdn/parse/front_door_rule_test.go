@@ -21,6 +21,23 @@ func TestFrontDoorRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorRuleIDSegments(t *testing.T) {
+	expected := NewFrontDoorRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "ruleSet1", "rule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id FrontDoorSecretId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.SecretName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorSecret ID
+func (id FrontDoorSecretId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticSecrets", "secrets", "secrets"),
+		resourceids.UserSpecifiedSegment("secretName", "secret1"),
+	}
+}
+
 // FrontDoorSecretID parses a FrontDoorSecret ID into an FrontDoorSecretId struct
 func FrontDoorSecretID(input string) (*FrontDoorSecretId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func FrontDoorSecretIDInsensitively(input string) (*FrontDoorSecretId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorSecretId using the ParseResult provided in 'input'
+func (id *FrontDoorSecretId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.SecretName, ok = input.Parsed["secretName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "secretName", input)
+	}
+
+	return nil
+}
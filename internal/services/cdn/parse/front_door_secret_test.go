@@ -21,6 +21,23 @@ func TestFrontDoorSecretIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorSecretIDSegments(t *testing.T) {
+	expected := NewFrontDoorSecretID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "secret1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorSecretId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorSecretId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorSecretID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id FrontDoorSecurityPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.SecurityPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoorSecurityPolicy ID
+func (id FrontDoorSecurityPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profile1"),
+		resourceids.StaticSegment("staticSecurityPolicies", "securityPolicies", "securityPolicies"),
+		resourceids.UserSpecifiedSegment("securityPolicyName", "securityPolicy1"),
+	}
+}
+
 // FrontDoorSecurityPolicyID parses a FrontDoorSecurityPolicy ID into an FrontDoorSecurityPolicyId struct
 func FrontDoorSecurityPolicyID(input string) (*FrontDoorSecurityPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func FrontDoorSecurityPolicyIDInsensitively(input string) (*FrontDoorSecurityPol
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorSecurityPolicyId using the ParseResult provided in 'input'
+func (id *FrontDoorSecurityPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ProfileName, ok = input.Parsed["profileName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "profileName", input)
+	}
+
+	if id.SecurityPolicyName, ok = input.Parsed["securityPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "securityPolicyName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestProfileIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProfileIDSegments(t *testing.T) {
+	expected := NewProfileID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProfileId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProfileId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProfileID(t *testing.T) {
 	testData := []struct {
 		Input    string
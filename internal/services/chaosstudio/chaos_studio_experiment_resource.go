@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/experiments"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,7 @@ import (
 
 var _ sdk.Resource = ChaosStudioExperimentResource{}
 var _ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+var _ sdk.ResourceWithIdentity = ChaosStudioExperimentResource{}
 
 const (
 	continuousActionType = "continuous"
@@ -75,6 +77,10 @@ func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValida
 	return experiments.ValidateExperimentID
 }
 
+func (r ChaosStudioExperimentResource) Identity() resourceids.ResourceId {
+	return &experiments.ExperimentId{}
+}
+
 func (r ChaosStudioExperimentResource) ResourceType() string {
 	return "azurerm_chaos_studio_experiment"
 }
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCognitiveAccountCustomerManagedKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCognitiveAccountCustomerManagedKeyCreateUpdate,
 		Read:   resourceCognitiveAccountCustomerManagedKeyRead,
 		Update: resourceCognitiveAccountCustomerManagedKeyCreateUpdate,
@@ -59,7 +59,7 @@ func resourceCognitiveAccountCustomerManagedKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &cognitiveservicesaccounts.AccountId{})
 }
 
 func resourceCognitiveAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
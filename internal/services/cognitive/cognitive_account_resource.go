@@ -34,7 +34,7 @@ import (
 )
 
 func resourceCognitiveAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCognitiveAccountCreate,
 		Read:   resourceCognitiveAccountRead,
 		Update: resourceCognitiveAccountUpdate,
@@ -315,6 +315,10 @@ func resourceCognitiveAccount() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &cognitiveservicesaccounts.AccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &cognitiveservicesaccounts.AccountId{})
 }
 
 func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
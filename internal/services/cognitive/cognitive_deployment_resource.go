@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -46,6 +47,7 @@ type DeploymentScaleSettingsModel struct {
 type CognitiveDeploymentResource struct{}
 
 var _ sdk.Resource = CognitiveDeploymentResource{}
+var _ sdk.ResourceWithIdentity = CognitiveDeploymentResource{}
 
 func (r CognitiveDeploymentResource) ResourceType() string {
 	return "azurerm_cognitive_deployment"
@@ -59,6 +61,10 @@ func (r CognitiveDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return deployments.ValidateDeploymentID
 }
 
+func (r CognitiveDeploymentResource) Identity() resourceids.ResourceId {
+	return &deployments.DeploymentId{}
+}
+
 func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
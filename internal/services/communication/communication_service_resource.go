@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/migration"
@@ -283,3 +284,7 @@ func (CommunicationServiceResource) Delete() sdk.ResourceFunc {
 func (CommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return communicationservices.ValidateCommunicationServiceID
 }
+
+func (CommunicationServiceResource) Identity() resourceids.ResourceId {
+	return &communicationservices.CommunicationServiceId{}
+}
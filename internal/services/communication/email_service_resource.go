@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
@@ -229,3 +230,7 @@ func (EmailCommunicationServiceResource) Delete() sdk.ResourceFunc {
 func (EmailCommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return emailservices.ValidateEmailServiceID
 }
+
+func (EmailCommunicationServiceResource) Identity() resourceids.ResourceId {
+	return &emailservices.EmailServiceId{}
+}
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceAvailabilitySet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAvailabilitySetCreateUpdate,
 		Read:   resourceAvailabilitySetRead,
 		Update: resourceAvailabilitySetCreateUpdate,
@@ -96,6 +96,10 @@ func resourceAvailabilitySet() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.AvailabilitySetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.AvailabilitySetId{})
 }
 
 func resourceAvailabilitySetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -164,20 +168,27 @@ func resourceAvailabilitySetRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id)
-	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
-			d.SetId("")
-			return nil
+	// only the Resource Manager representation of the Availability Set is required, so this can be served from
+	// Resource Graph
+	// - the Resource Type and the API Version of this model must be supported by the snapshot
+	var model *availabilitysets.AvailabilitySet
+	if !meta.(*clients.Client).ResourceGraph.Lookup(ctx, id.ID(), &model) {
+		resp, err := client.Get(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving %s: %+v", id, err)
 		}
-		return fmt.Errorf("retrieving %s: %+v", id, err)
+		model = resp.Model
 	}
 
 	d.Set("name", id.AvailabilitySetName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		managed := false
 		if model.Sku != nil && model.Sku.Name != nil {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceCapacityReservationGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCapacityReservationGroupCreate,
 		Read:   resourceCapacityReservationGroupRead,
 		Update: resourceCapacityReservationGroupUpdate,
@@ -59,6 +59,10 @@ func resourceCapacityReservationGroup() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &capacityreservationgroups.CapacityReservationGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &capacityreservationgroups.CapacityReservationGroupId{})
 }
 
 func resourceCapacityReservationGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
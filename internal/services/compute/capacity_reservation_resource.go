@@ -25,7 +25,7 @@ import (
 )
 
 func resourceCapacityReservation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCapacityReservationCreate,
 		Read:   resourceCapacityReservationRead,
 		Update: resourceCapacityReservationUpdate,
@@ -84,7 +84,7 @@ func resourceCapacityReservation() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &capacityreservations.CapacityReservationId{})
 }
 
 func resourceCapacityReservationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceDedicatedHostGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDedicatedHostGroupCreate,
 		Read:   resourceDedicatedHostGroupRead,
 		Update: resourceDedicatedHostGroupUpdate,
@@ -72,6 +72,10 @@ func resourceDedicatedHostGroup() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.DedicatedHostGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.DedicatedHostGroupId{})
 }
 
 func resourceDedicatedHostGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceDedicatedHost() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDedicatedHostCreate,
 		Read:   resourceDedicatedHostRead,
 		Update: resourceDedicatedHostUpdate,
@@ -141,6 +141,10 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.DedicatedHostId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.DedicatedHostId{})
 }
 
 func resourceDedicatedHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDiskAccess() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDiskAccessCreateUpdate,
 		Read:   resourceDiskAccessRead,
 		Update: resourceDiskAccessCreateUpdate,
@@ -52,6 +52,10 @@ func resourceDiskAccess() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &diskaccesses.DiskAccessId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &diskaccesses.DiskAccessId{})
 }
 
 func resourceDiskAccessCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
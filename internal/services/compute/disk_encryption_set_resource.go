@@ -36,7 +36,7 @@ import (
 )
 
 func resourceDiskEncryptionSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDiskEncryptionSetCreate,
 		Read:   resourceDiskEncryptionSetRead,
 		Update: resourceDiskEncryptionSetUpdate,
@@ -122,6 +122,10 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.DiskEncryptionSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.DiskEncryptionSetId{})
 }
 
 func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -29,7 +29,7 @@ type Output struct {
 }
 
 func resourceManagedDiskSasToken() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceManagedDiskSasTokenCreate,
 		Read:   resourceManagedDiskSasTokenRead,
 		Delete: resourceManagedDiskSasTokenDelete,
@@ -77,7 +77,7 @@ func resourceManagedDiskSasToken() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	}, &commonids.ManagedDiskId{})
 }
 
 func resourceManagedDiskSasTokenCreate(d *pluginsdk.ResourceData, meta interface{}) error {
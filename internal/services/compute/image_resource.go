@@ -25,7 +25,7 @@ import (
 )
 
 func resourceImage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceImageCreateUpdate,
 		Read:   resourceImageRead,
 		Update: resourceImageCreateUpdate,
@@ -195,6 +195,10 @@ func resourceImage() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &images.ImageId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &images.ImageId{})
 }
 
 func resourceImageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -36,7 +36,7 @@ import (
 )
 
 func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLinuxVirtualMachineScaleSetCreate,
 		Read:   resourceLinuxVirtualMachineScaleSetRead,
 		Update: resourceLinuxVirtualMachineScaleSetUpdate,
@@ -59,6 +59,10 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 
 		Schema: resourceLinuxVirtualMachineScaleSetSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.VirtualMachineScaleSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.VirtualMachineScaleSetId{})
 }
 
 func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -35,7 +35,7 @@ import (
 )
 
 func resourceManagedDisk() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceManagedDiskCreate,
 		Read:   resourceManagedDiskRead,
 		Update: resourceManagedDiskUpdate,
@@ -315,6 +315,10 @@ func resourceManagedDisk() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.ManagedDiskId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.ManagedDiskId{})
 }
 
 func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMarketplaceAgreement() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMarketplaceAgreementCreate,
 		Read:   resourceMarketplaceAgreementRead,
 		Delete: resourceMarketplaceAgreementDelete,
@@ -66,7 +66,7 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &agreements.PlanId{})
 }
 
 func resourceMarketplaceAgreementCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -36,7 +36,7 @@ import (
 )
 
 func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceOrchestratedVirtualMachineScaleSetCreate,
 		Read:   resourceOrchestratedVirtualMachineScaleSetRead,
 		Update: resourceOrchestratedVirtualMachineScaleSetUpdate,
@@ -270,6 +270,10 @@ func resourceOrchestratedVirtualMachineScaleSet() *pluginsdk.Resource {
 			"priority_mix": OrchestratedVirtualMachineScaleSetPriorityMixPolicySchema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.VirtualMachineScaleSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.VirtualMachineScaleSetId{})
 }
 
 func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
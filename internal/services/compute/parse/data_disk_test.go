@@ -21,6 +21,23 @@ func TestDataDiskIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataDiskIDSegments(t *testing.T) {
+	expected := NewDataDiskID("12345678-1234-9876-4563-123456789012", "group1", "machine1", "disk1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DataDiskId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DataDiskId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDataDiskID(t *testing.T) {
 	testData := []struct {
 		Input    string
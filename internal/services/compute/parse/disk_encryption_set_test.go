@@ -21,6 +21,23 @@ func TestDiskEncryptionSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDiskEncryptionSetIDSegments(t *testing.T) {
+	expected := NewDiskEncryptionSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "set1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DiskEncryptionSetId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DiskEncryptionSetId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDiskEncryptionSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
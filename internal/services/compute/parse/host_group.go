@@ -40,6 +40,20 @@ func (id HostGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this HostGroup ID
+func (id HostGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute", "Microsoft.Compute"),
+		resourceids.StaticSegment("staticHostGroups", "hostGroups", "hostGroups"),
+		resourceids.UserSpecifiedSegment("name", "hostgroup1"),
+	}
+}
+
 // HostGroupID parses a HostGroup ID into an HostGroupId struct
 func HostGroupID(input string) (*HostGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func HostGroupID(input string) (*HostGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the HostGroupId using the ParseResult provided in 'input'
+func (id *HostGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestHostGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostGroupIDSegments(t *testing.T) {
+	expected := NewHostGroupID("12345678-1234-9876-4563-123456789012", "group1", "hostgroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HostGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HostGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHostGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
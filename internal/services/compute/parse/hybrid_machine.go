@@ -40,6 +40,20 @@ func (id HybridMachineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MachineName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this HybridMachine ID
+func (id HybridMachineId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHybridCompute", "Microsoft.HybridCompute", "Microsoft.HybridCompute"),
+		resourceids.StaticSegment("staticMachines", "machines", "machines"),
+		resourceids.UserSpecifiedSegment("machineName", "machine1"),
+	}
+}
+
 // HybridMachineID parses a HybridMachine ID into an HybridMachineId struct
 func HybridMachineID(input string) (*HybridMachineId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func HybridMachineID(input string) (*HybridMachineId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the HybridMachineId using the ParseResult provided in 'input'
+func (id *HybridMachineId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.MachineName, ok = input.Parsed["machineName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "machineName", input)
+	}
+
+	return nil
+}
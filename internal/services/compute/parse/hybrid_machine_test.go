@@ -21,6 +21,23 @@ func TestHybridMachineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHybridMachineIDSegments(t *testing.T) {
+	expected := NewHybridMachineID("12345678-1234-9876-4563-123456789012", "resGroup1", "machine1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HybridMachineId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HybridMachineId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHybridMachineID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id PlanId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.AgreementName, id.OfferName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Plan ID
+func (id PlanId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplaceOrdering", "Microsoft.MarketplaceOrdering", "Microsoft.MarketplaceOrdering"),
+		resourceids.StaticSegment("staticAgreements", "agreements", "agreements"),
+		resourceids.UserSpecifiedSegment("agreementName", "agreement1"),
+		resourceids.StaticSegment("staticOffers", "offers", "offers"),
+		resourceids.UserSpecifiedSegment("offerName", "offer1"),
+		resourceids.StaticSegment("staticPlans", "plans", "plans"),
+		resourceids.UserSpecifiedSegment("name", "hourly"),
+	}
+}
+
 // PlanID parses a Plan ID into an PlanId struct
 func PlanID(input string) (*PlanId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -74,3 +90,26 @@ func PlanID(input string) (*PlanId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the PlanId using the ParseResult provided in 'input'
+func (id *PlanId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.AgreementName, ok = input.Parsed["agreementName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "agreementName", input)
+	}
+
+	if id.OfferName, ok = input.Parsed["offerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "offerName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
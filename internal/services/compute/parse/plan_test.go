@@ -21,6 +21,23 @@ func TestPlanIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPlanIDSegments(t *testing.T) {
+	expected := NewPlanID("12345678-1234-9876-4563-123456789012", "agreement1", "offer1", "hourly")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PlanId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PlanId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPlanID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSharedImageVersionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSharedImageVersionIDSegments(t *testing.T) {
+	expected := NewSharedImageVersionID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "image1", "version1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SharedImageVersionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SharedImageVersionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSharedImageVersionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,20 @@ func (id SSHPublicKeyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SSHPublicKey ID
+func (id SSHPublicKeyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute", "Microsoft.Compute"),
+		resourceids.StaticSegment("staticSshPublicKeys", "sshPublicKeys", "sshPublicKeys"),
+		resourceids.UserSpecifiedSegment("name", "sshpublickey1"),
+	}
+}
+
 // SSHPublicKeyID parses a SSHPublicKey ID into an SSHPublicKeyId struct
 func SSHPublicKeyID(input string) (*SSHPublicKeyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func SSHPublicKeyID(input string) (*SSHPublicKeyId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SSHPublicKeyId using the ParseResult provided in 'input'
+func (id *SSHPublicKeyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
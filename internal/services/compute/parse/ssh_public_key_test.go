@@ -21,6 +21,23 @@ func TestSSHPublicKeyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSSHPublicKeyIDSegments(t *testing.T) {
+	expected := NewSSHPublicKeyID("12345678-1234-9876-4563-123456789012", "resGroup1", "sshpublickey1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SSHPublicKeyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SSHPublicKeyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSSHPublicKeyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestVirtualMachineExtensionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualMachineExtensionIDSegments(t *testing.T) {
+	expected := NewVirtualMachineExtensionID("12345678-1234-9876-4563-123456789012", "resGroup1", "machine1", "extension1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VirtualMachineExtensionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VirtualMachineExtensionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVirtualMachineExtensionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestVirtualMachineScaleSetExtensionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualMachineScaleSetExtensionIDSegments(t *testing.T) {
+	expected := NewVirtualMachineScaleSetExtensionID("12345678-1234-9876-4563-123456789012", "resGroup1", "scaleSet1", "extension1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VirtualMachineScaleSetExtensionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VirtualMachineScaleSetExtensionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVirtualMachineScaleSetExtensionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestVMSSInstanceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVMSSInstanceIDSegments(t *testing.T) {
+	expected := NewVMSSInstanceID("12345678-1234-9876-4563-123456789012", "resGroup1", "vmss1", "vm1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VMSSInstanceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VMSSInstanceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVMSSInstanceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceProximityPlacementGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceProximityPlacementGroupCreateUpdate,
 		Read:   resourceProximityPlacementGroupRead,
 		Update: resourceProximityPlacementGroupCreateUpdate,
@@ -81,6 +81,10 @@ func resourceProximityPlacementGroup() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &proximityplacementgroups.ProximityPlacementGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &proximityplacementgroups.ProximityPlacementGroupId{})
 }
 
 func resourceProximityPlacementGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -141,16 +145,23 @@ func resourceProximityPlacementGroupRead(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id, proximityplacementgroups.DefaultGetOperationOptions())
-	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			d.SetId("")
-			return nil
+	// only the Resource Manager representation of the Proximity Placement Group is required, so this can be served
+	// from Resource Graph
+	// - the Resource Type and the API Version of this model must be supported by the snapshot
+	var model *proximityplacementgroups.ProximityPlacementGroup
+	if !meta.(*clients.Client).ResourceGraph.Lookup(ctx, id.ID(), &model) {
+		resp, err := client.Get(ctx, *id, proximityplacementgroups.DefaultGetOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
 		}
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
+		model = resp.Model
 	}
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("name", id.ProximityPlacementGroupName)
 		d.Set("resource_group_name", id.ResourceGroupName)
 
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,7 @@ type RestorePointCollectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = RestorePointCollectionResource{}
 var _ sdk.ResourceWithDeprecationReplacedBy = RestorePointCollectionResource{}
+var _ sdk.ResourceWithIdentity = RestorePointCollectionResource{}
 
 func (r RestorePointCollectionResource) DeprecatedInFavourOfResource() string {
 	return "azurerm_virtual_machine_restore_point_collection"
@@ -45,6 +47,10 @@ func (r RestorePointCollectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return restorepointcollections.ValidateRestorePointCollectionID
 }
 
+func (r RestorePointCollectionResource) Identity() resourceids.ResourceId {
+	return &restorepointcollections.RestorePointCollectionId{}
+}
+
 func (r RestorePointCollectionResource) ResourceType() string {
 	return "azurerm_restore_point_collection"
 }
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceSharedImageGallery() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSharedImageGalleryCreate,
 		Read:   resourceSharedImageGalleryRead,
 		Update: resourceSharedImageGalleryUpdate,
@@ -127,6 +127,10 @@ func resourceSharedImageGallery() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.SharedImageGalleryId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.SharedImageGalleryId{})
 }
 
 func resourceSharedImageGalleryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -28,7 +28,7 @@ import (
 )
 
 func resourceSharedImage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSharedImageCreate,
 		Read:   resourceSharedImageRead,
 		Update: resourceSharedImageUpdate,
@@ -267,6 +267,10 @@ func resourceSharedImage() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &galleryimages.GalleryImageId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &galleryimages.GalleryImageId{})
 }
 
 func resourceSharedImageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
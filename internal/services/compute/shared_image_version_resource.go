@@ -29,7 +29,7 @@ import (
 )
 
 func resourceSharedImageVersion() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSharedImageVersionCreate,
 		Read:   resourceSharedImageVersionRead,
 		Update: resourceSharedImageVersionUpdate,
@@ -199,6 +199,10 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &galleryimageversions.ImageVersionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &galleryimageversions.ImageVersionId{})
 }
 
 func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
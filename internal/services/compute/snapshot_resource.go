@@ -29,7 +29,7 @@ import (
 )
 
 func resourceSnapshot() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSnapshotCreateUpdate,
 		Read:   resourceSnapshotRead,
 		Update: resourceSnapshotCreateUpdate,
@@ -147,6 +147,10 @@ func resourceSnapshot() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &snapshots.SnapshotId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &snapshots.SnapshotId{})
 }
 
 func resourceSnapshotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceSshPublicKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSshPublicKeyCreate,
 		Read:   resourceSshPublicKeyRead,
 		Update: resourceSshPublicKeyUpdate,
@@ -67,6 +67,10 @@ func resourceSshPublicKey() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &sshpublickeys.SshPublicKeyId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &sshpublickeys.SshPublicKeyId{})
 }
 
 func resourceSshPublicKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceVirtualMachineDataDiskAttachment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualMachineDataDiskAttachmentCreateUpdate,
 		Read:   resourceVirtualMachineDataDiskAttachmentRead,
 		Update: resourceVirtualMachineDataDiskAttachmentCreateUpdate,
@@ -134,7 +134,7 @@ func resourceVirtualMachineDataDiskAttachment() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &parse.DataDiskId{})
 }
 
 func resourceVirtualMachineDataDiskAttachmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
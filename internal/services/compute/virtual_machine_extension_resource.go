@@ -24,7 +24,7 @@ import (
 )
 
 func resourceVirtualMachineExtension() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualMachineExtensionsCreateUpdate,
 		Read:   resourceVirtualMachineExtensionsRead,
 		Update: resourceVirtualMachineExtensionsCreateUpdate,
@@ -122,7 +122,7 @@ func resourceVirtualMachineExtension() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &virtualmachineextensions.ExtensionId{})
 }
 
 func resourceVirtualMachineExtensionsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
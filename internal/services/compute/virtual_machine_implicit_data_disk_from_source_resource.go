@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -47,6 +48,10 @@ func (r VirtualMachineImplicitDataDiskFromSourceResource) IDValidationFunc() plu
 	return validate.DataDiskID
 }
 
+func (r VirtualMachineImplicitDataDiskFromSourceResource) Identity() resourceids.ResourceId {
+	return &parse.DataDiskId{}
+}
+
 func (r VirtualMachineImplicitDataDiskFromSourceResource) ResourceType() string {
 	return "azurerm_virtual_machine_implicit_data_disk_from_source"
 }
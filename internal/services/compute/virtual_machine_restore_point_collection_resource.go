@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,7 @@ type VirtualMachineRestorePointCollectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = VirtualMachineRestorePointCollectionResource{}
 var _ sdk.ResourceWithDeprecationReplacedBy = VirtualMachineRestorePointCollectionResource{}
+var _ sdk.ResourceWithIdentity = VirtualMachineRestorePointCollectionResource{}
 
 func (r VirtualMachineRestorePointCollectionResource) DeprecatedInFavourOfResource() string {
 	return "azurerm_virtual_machine_restore_point_collection"
@@ -45,6 +47,10 @@ func (r VirtualMachineRestorePointCollectionResource) IDValidationFunc() plugins
 	return restorepointcollections.ValidateRestorePointCollectionID
 }
 
+func (r VirtualMachineRestorePointCollectionResource) Identity() resourceids.ResourceId {
+	return &restorepointcollections.RestorePointCollectionId{}
+}
+
 func (r VirtualMachineRestorePointCollectionResource) ResourceType() string {
 	return "azurerm_virtual_machine_restore_point_collection"
 }
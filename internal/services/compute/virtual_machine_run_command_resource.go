@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -81,6 +82,10 @@ func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaVal
 	return virtualmachineruncommands.ValidateVirtualMachineRunCommandID
 }
 
+func (r VirtualMachineRunCommandResource) Identity() resourceids.ResourceId {
+	return &virtualmachineruncommands.VirtualMachineRunCommandId{}
+}
+
 func (r VirtualMachineRunCommandResource) ResourceType() string {
 	return "azurerm_virtual_machine_run_command"
 }
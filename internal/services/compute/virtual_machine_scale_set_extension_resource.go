@@ -25,7 +25,7 @@ import (
 // NOTE (also in the docs): this is not intended to be used with the `azurerm_virtual_machine_scale_set` resource
 
 func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualMachineScaleSetExtensionCreate,
 		Read:   resourceVirtualMachineScaleSetExtensionRead,
 		Update: resourceVirtualMachineScaleSetExtensionUpdate,
@@ -128,7 +128,7 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 			},
 		},
-	}
+	}, &virtualmachinescalesetextensions.VirtualMachineScaleSetExtensionId{})
 }
 
 func resourceVirtualMachineScaleSetExtensionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
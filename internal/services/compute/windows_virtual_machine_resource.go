@@ -35,7 +35,7 @@ import (
 )
 
 func resourceWindowsVirtualMachine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceWindowsVirtualMachineCreate,
 		Read:   resourceWindowsVirtualMachineRead,
 		Update: resourceWindowsVirtualMachineUpdate,
@@ -65,16 +65,34 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 
 			"location": commonschema.Location(),
 
-			// Required
 			"admin_password": {
 				Type:             pluginsdk.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				Sensitive:        true,
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.WindowsAdminPassword,
+				ExactlyOneOf:     []string{"admin_password", "admin_password_wo"},
+			},
+
+			"admin_password_wo": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ValidateFunc: computeValidate.WindowsAdminPassword,
+				ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+				RequiredWith: []string{"admin_password_wo_version"},
+			},
+
+			"admin_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"admin_password_wo"},
 			},
 
+			// Required
 			"admin_username": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
@@ -435,6 +453,10 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.VirtualMachineId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.VirtualMachineId{})
 }
 
 func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -466,6 +488,14 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 	additionalUnattendContent := expandAdditionalUnattendContent(additionalUnattendContentRaw)
 
 	adminPassword := d.Get("admin_password").(string)
+	adminPasswordWriteOnly, err := pluginsdk.GetWriteOnlyString(d, "admin_password_wo")
+	if err != nil {
+		return err
+	}
+	if adminPasswordWriteOnly != nil {
+		adminPassword = *adminPasswordWriteOnly
+	}
+
 	adminUsername := d.Get("admin_username").(string)
 	allowExtensionOperations := d.Get("allow_extension_operations").(bool)
 
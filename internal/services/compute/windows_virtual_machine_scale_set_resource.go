@@ -35,7 +35,7 @@ import (
 )
 
 func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceWindowsVirtualMachineScaleSetCreate,
 		Read:   resourceWindowsVirtualMachineScaleSetRead,
 		Update: resourceWindowsVirtualMachineScaleSetUpdate,
@@ -58,6 +58,10 @@ func resourceWindowsVirtualMachineScaleSet() *pluginsdk.Resource {
 
 		Schema: resourceWindowsVirtualMachineScaleSetSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.VirtualMachineScaleSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.VirtualMachineScaleSetId{})
 }
 
 func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceConfidentialLedger() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceConfidentialLedgerCreate,
 		Read:   resourceConfidentialLedgerRead,
 		Update: resourceConfidentialLedgerUpdate,
@@ -130,6 +130,10 @@ func resourceConfidentialLedger() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &confidentialledger.LedgerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &confidentialledger.LedgerId{})
 }
 
 func resourceConfidentialLedgerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
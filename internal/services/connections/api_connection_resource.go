@@ -101,7 +101,7 @@ func resourceConnection() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &connections.ConnectionId{})
 }
 
 func resourceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -4,6 +4,7 @@
 package consumption
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	validateManagementGroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
@@ -109,6 +110,10 @@ func (r ManagementGroupConsumptionBudget) IDValidationFunc() pluginsdk.SchemaVal
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r ManagementGroupConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r ManagementGroupConsumptionBudget) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "management_group_id")
 }
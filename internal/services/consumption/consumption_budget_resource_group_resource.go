@@ -4,6 +4,7 @@
 package consumption
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	validateResourceGroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
@@ -54,6 +55,10 @@ func (r ResourceGroupConsumptionBudget) IDValidationFunc() pluginsdk.SchemaValid
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r ResourceGroupConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r ResourceGroupConsumptionBudget) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_group_id")
 }
@@ -5,6 +5,7 @@ package consumption
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/migration"
@@ -56,6 +57,10 @@ func (r SubscriptionConsumptionBudget) IDValidationFunc() pluginsdk.SchemaValida
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r SubscriptionConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r SubscriptionConsumptionBudget) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
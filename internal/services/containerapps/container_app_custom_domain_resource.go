@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -85,6 +86,10 @@ func (a ContainerAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaVal
 	return validate.ContainerAppCustomDomainId
 }
 
+func (a ContainerAppCustomDomainResource) Identity() resourceids.ResourceId {
+	return &parse.ContainerAppCustomDomainId{}
+}
+
 func (a ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
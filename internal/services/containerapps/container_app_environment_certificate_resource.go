@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
@@ -40,6 +41,7 @@ type ContainerAppCertificateModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentCertificateResource{}
 
 func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
 	return &ContainerAppCertificateModel{}
@@ -53,6 +55,10 @@ func (r ContainerAppEnvironmentCertificateResource) IDValidationFunc() pluginsdk
 	return certificates.ValidateCertificateID
 }
 
+func (r ContainerAppEnvironmentCertificateResource) Identity() resourceids.ResourceId {
+	return &certificates.CertificateId{}
+}
+
 func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
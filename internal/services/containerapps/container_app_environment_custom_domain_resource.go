@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -30,6 +31,7 @@ type ContainerAppEnvironmentCustomDomainModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCustomDomainResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentCustomDomainResource{}
 
 func (r ContainerAppEnvironmentCustomDomainResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentCustomDomainModel{}
@@ -43,6 +45,10 @@ func (r ContainerAppEnvironmentCustomDomainResource) IDValidationFunc() pluginsd
 	return managedenvironments.ValidateManagedEnvironmentID
 }
 
+func (r ContainerAppEnvironmentCustomDomainResource) Identity() resourceids.ResourceId {
+	return &managedenvironments.ManagedEnvironmentId{}
+}
+
 func (r ContainerAppEnvironmentCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/daprcomponents"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
@@ -33,6 +34,7 @@ type ContainerAppEnvironmentDaprComponentModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentDaprComponentResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentDaprComponentResource{}
 
 func (r ContainerAppEnvironmentDaprComponentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentDaprComponentModel{}
@@ -46,6 +48,10 @@ func (r ContainerAppEnvironmentDaprComponentResource) IDValidationFunc() plugins
 	return daprcomponents.ValidateDaprComponentID
 }
 
+func (r ContainerAppEnvironmentDaprComponentResource) Identity() resourceids.ResourceId {
+	return &daprcomponents.DaprComponentId{}
+}
+
 func (r ContainerAppEnvironmentDaprComponentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
@@ -52,6 +53,7 @@ type ContainerAppEnvironmentModel struct {
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppEnvironmentResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentResource{}
 
 func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentModel{}
@@ -65,6 +67,10 @@ func (r ContainerAppEnvironmentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return managedenvironments.ValidateManagedEnvironmentID
 }
 
+func (r ContainerAppEnvironmentResource) Identity() resourceids.ResourceId {
+	return &managedenvironments.ManagedEnvironmentId{}
+}
+
 func (r ContainerAppEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
@@ -52,6 +53,7 @@ type ContainerAppModel struct {
 var _ sdk.ResourceWithUpdate = ContainerAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppResource{}
 
 func (r ContainerAppResource) ModelObject() interface{} {
 	return &ContainerAppModel{}
@@ -65,6 +67,10 @@ func (r ContainerAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return containerapps.ValidateContainerAppID
 }
 
+func (r ContainerAppResource) Identity() resourceids.ResourceId {
+	return &containerapps.ContainerAppId{}
+}
+
 func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
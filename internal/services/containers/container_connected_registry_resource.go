@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/connectedregistries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/tokens"
@@ -25,6 +26,7 @@ import (
 type ContainerConnectedRegistryResource struct{}
 
 var _ sdk.ResourceWithUpdate = ContainerConnectedRegistryResource{}
+var _ sdk.ResourceWithIdentity = ContainerConnectedRegistryResource{}
 
 type ContainerConnectedRegistryModel struct {
 	Name                string                   `tfschema:"name"`
@@ -196,6 +198,10 @@ func (r ContainerConnectedRegistryResource) IDValidationFunc() pluginsdk.SchemaV
 	return connectedregistries.ValidateConnectedRegistryID
 }
 
+func (r ContainerConnectedRegistryResource) Identity() resourceids.ResourceId {
+	return &connectedregistries.ConnectedRegistryId{}
+}
+
 func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -580,7 +580,9 @@ func resourceContainerGroup() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &containerinstance.ContainerGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &containerinstance.ContainerGroupId{})
 }
 
 func containerVolumeSchema() *pluginsdk.Schema {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceContainerRegistryAgentPool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceContainerRegistryAgentPoolCreate,
 		Read:   resourceContainerRegistryAgentPoolRead,
 		Update: resourceContainerRegistryAgentPoolUpdate,
@@ -91,6 +91,10 @@ func resourceContainerRegistryAgentPool() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &agentpools.AgentPoolId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &agentpools.AgentPoolId{})
 }
 
 func resourceContainerRegistryAgentPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/operation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// containerRegistryPreflightValidation checks the name of the Container Registry is available and that Resource
+// Manager accepts the planned Container Registry, when Preflight Validation is enabled
+func containerRegistryPreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "sku") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	if err := preflight.ValidateName(containerValidate.ContainerRegistryName, "name", name); err != nil {
+		return err
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	availabilityRequest := operation.RegistryNameCheckRequest{
+		Name: name,
+		Type: "Microsoft.ContainerRegistry/registries",
+	}
+	resp, err := client.Containers.ContainerRegistryClient_v2021_08_01_preview.Operation.RegistriesCheckNameAvailability(ctx, subscriptionId, availabilityRequest)
+	if err != nil {
+		return fmt.Errorf("checking if the name %q was available: %+v", name, err)
+	}
+	if model := resp.Model; model != nil && model.NameAvailable != nil && !*model.NameAvailable {
+		return preflight.NameUnavailableError("Container Registry", name, model.Reason, model.Message)
+	}
+
+	resource := preflight.TemplateResource{
+		Type:       "Microsoft.ContainerRegistry/registries",
+		ApiVersion: "2021-08-01-preview",
+		Name:       name,
+		Location:   location.Normalize(d.Get("location").(string)),
+		Sku: map[string]interface{}{
+			"name": d.Get("sku").(string),
+		},
+		Properties: map[string]interface{}{
+			"adminUserEnabled": d.Get("admin_enabled").(bool),
+		},
+	}
+	if d.NewValueKnown("tags") {
+		resource.Tags = d.Get("tags").(map[string]interface{})
+	}
+
+	return preflight.ValidateDeployment(ctx, client, d.Get("resource_group_name").(string), resource)
+}
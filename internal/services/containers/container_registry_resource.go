@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -36,7 +37,7 @@ import (
 )
 
 func resourceContainerRegistry() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceContainerRegistryCreate,
 		Read:   resourceContainerRegistryRead,
 		Update: resourceContainerRegistryUpdate,
@@ -62,7 +63,7 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 
 		Schema: resourceContainerRegistrySchema(),
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
 			sku := d.Get("sku").(string)
 
 			geoReplications := d.Get("georeplications").([]interface{})
@@ -159,8 +160,12 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 			}
 
 			return nil
-		}),
+		}), preflight.CustomizeDiff(containerRegistryPreflightValidation)),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &registries.RegistryId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &registries.RegistryId{})
 }
 
 func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
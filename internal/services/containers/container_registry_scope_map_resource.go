@@ -22,7 +22,7 @@ import (
 )
 
 func resourceContainerRegistryScopeMap() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceContainerRegistryScopeMapCreate,
 		Read:   resourceContainerRegistryScopeMapRead,
 		Update: resourceContainerRegistryScopeMapUpdate,
@@ -73,7 +73,7 @@ func resourceContainerRegistryScopeMap() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &scopemaps.ScopeMapId{})
 }
 
 func resourceContainerRegistryScopeMapCreate(d *pluginsdk.ResourceData, meta interface{}) error {
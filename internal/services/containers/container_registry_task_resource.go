@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -658,6 +659,10 @@ func (r ContainerRegistryTaskResource) IDValidationFunc() pluginsdk.SchemaValida
 	return tasks.ValidateTaskID
 }
 
+func (r ContainerRegistryTaskResource) Identity() resourceids.ResourceId {
+	return &tasks.TaskId{}
+}
+
 func (r ContainerRegistryTaskResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
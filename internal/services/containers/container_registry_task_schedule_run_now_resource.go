@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/runs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
@@ -54,6 +55,10 @@ func (r ContainerRegistryTaskScheduleResource) IDValidationFunc() pluginsdk.Sche
 	return validate.ContainerRegistryTaskScheduleID
 }
 
+func (r ContainerRegistryTaskScheduleResource) Identity() resourceids.ResourceId {
+	return &parse.ContainerRegistryTaskScheduleId{}
+}
+
 func (r ContainerRegistryTaskScheduleResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
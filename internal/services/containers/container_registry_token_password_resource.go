@@ -11,6 +11,7 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	containterregistry_v2021_08_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/tokens"
@@ -115,6 +116,10 @@ func (r ContainerRegistryTokenPasswordResource) IDValidationFunc() pluginsdk.Sch
 	return validate.ContainerRegistryTokenPasswordID
 }
 
+func (r ContainerRegistryTokenPasswordResource) Identity() resourceids.ResourceId {
+	return &parse.ContainerRegistryTokenPasswordId{}
+}
+
 func (r ContainerRegistryTokenPasswordResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
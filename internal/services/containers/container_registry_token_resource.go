@@ -22,7 +22,7 @@ import (
 )
 
 func resourceContainerRegistryToken() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceContainerRegistryTokenCreate,
 		Read:   resourceContainerRegistryTokenRead,
 		Update: resourceContainerRegistryTokenUpdate,
@@ -69,7 +69,7 @@ func resourceContainerRegistryToken() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &tokens.TokenId{})
 }
 
 func resourceContainerRegistryTokenCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceContainerRegistryWebhook() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceContainerRegistryWebhookCreate,
 		Read:   resourceContainerRegistryWebhookRead,
 		Update: resourceContainerRegistryWebhookUpdate,
@@ -115,6 +115,10 @@ func resourceContainerRegistryWebhook() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &webhooks.WebHookId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &webhooks.WebHookId{})
 }
 
 func resourceContainerRegistryWebhookCreate(d *pluginsdk.ResourceData, meta interface{}) error {
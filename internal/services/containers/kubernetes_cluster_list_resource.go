@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterListResource struct{}
+
+var _ sdk.ListResource = KubernetesClusterListResource{}
+
+func (r KubernetesClusterListResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster"
+}
+
+func (r KubernetesClusterListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	client := metadata.Client.Containers.KubernetesClustersClient
+
+	var clusters []managedclusters.ManagedCluster
+	if request.ResourceGroupName != "" {
+		resourceGroupId := commonids.NewResourceGroupID(metadata.SubscriptionId, request.ResourceGroupName)
+		resp, err := client.ListByResourceGroupComplete(ctx, resourceGroupId)
+		if err != nil {
+			return nil, fmt.Errorf("listing Kubernetes Clusters within %s: %+v", resourceGroupId, err)
+		}
+		clusters = resp.Items
+	} else {
+		subscriptionId := commonids.NewSubscriptionID(metadata.SubscriptionId)
+		resp, err := client.ListComplete(ctx, subscriptionId)
+		if err != nil {
+			return nil, fmt.Errorf("listing Kubernetes Clusters within %s: %+v", subscriptionId, err)
+		}
+		clusters = resp.Items
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, item := range clusters {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := commonids.ParseKubernetesClusterIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, sdk.ListResult{
+			Id:   id,
+			Name: id.ManagedClusterName,
+			Tags: pointer.From(item.Tags),
+		})
+	}
+
+	return results, nil
+}
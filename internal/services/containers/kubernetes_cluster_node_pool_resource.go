@@ -41,7 +41,7 @@ import (
 )
 
 func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceKubernetesClusterNodePoolCreate,
 		Read:   resourceKubernetesClusterNodePoolRead,
 		Update: resourceKubernetesClusterNodePoolUpdate,
@@ -84,7 +84,7 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				return old != 0 && new == 0
 			}),
 		),
-	}
+	}, &parse.NodePoolId{})
 }
 
 func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
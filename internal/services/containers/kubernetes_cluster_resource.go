@@ -1793,7 +1793,9 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &commonids.KubernetesClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.KubernetesClusterId{})
 }
 
 func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,7 @@ import (
 
 var _ sdk.Resource = KubernetesFleetManagerResource{}
 var _ sdk.ResourceWithUpdate = KubernetesFleetManagerResource{}
+var _ sdk.ResourceWithIdentity = KubernetesFleetManagerResource{}
 
 type KubernetesFleetManagerResource struct{}
 
@@ -36,6 +38,10 @@ type KubernetesFleetManagerResourceSchema struct {
 func (r KubernetesFleetManagerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return fleets.ValidateFleetID
 }
+
+func (r KubernetesFleetManagerResource) Identity() resourceids.ResourceId {
+	return &fleets.FleetId{}
+}
 func (r KubernetesFleetManagerResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_manager"
 }
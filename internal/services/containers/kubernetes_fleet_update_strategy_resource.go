@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetupdatestrategies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -20,6 +21,7 @@ import (
 
 var _ sdk.Resource = KubernetesFleetUpdateStrategyResource{}
 var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+var _ sdk.ResourceWithIdentity = KubernetesFleetUpdateStrategyResource{}
 
 type KubernetesFleetUpdateStrategyResource struct{}
 
@@ -47,6 +49,10 @@ func (r KubernetesFleetUpdateStrategyResource) IDValidationFunc() pluginsdk.Sche
 	return fleetupdatestrategies.ValidateUpdateStrategyID
 }
 
+func (r KubernetesFleetUpdateStrategyResource) Identity() resourceids.ResourceId {
+	return &fleetupdatestrategies.UpdateStrategyId{}
+}
+
 func (r KubernetesFleetUpdateStrategyResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_update_strategy"
 }
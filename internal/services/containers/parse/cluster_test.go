@@ -21,6 +21,23 @@ func TestClusterIDFormatter(t *testing.T) {
 	}
 }
 
+func TestClusterIDSegments(t *testing.T) {
+	expected := NewClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ClusterId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ClusterId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestClusterID(t *testing.T) {
 	testData := []struct {
 		Input    string
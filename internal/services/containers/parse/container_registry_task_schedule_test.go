@@ -21,6 +21,23 @@ func TestContainerRegistryTaskScheduleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContainerRegistryTaskScheduleIDSegments(t *testing.T) {
+	expected := NewContainerRegistryTaskScheduleID("12345678-1234-9876-4563-123456789012", "group1", "registry1", "task1", "schedule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ContainerRegistryTaskScheduleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ContainerRegistryTaskScheduleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestContainerRegistryTaskScheduleID(t *testing.T) {
 	testData := []struct {
 		Input    string
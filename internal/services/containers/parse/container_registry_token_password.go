@@ -46,6 +46,24 @@ func (id ContainerRegistryTokenPasswordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TokenName, id.PasswordName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ContainerRegistryTokenPassword ID
+func (id ContainerRegistryTokenPasswordId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftContainerRegistry", "Microsoft.ContainerRegistry", "Microsoft.ContainerRegistry"),
+		resourceids.StaticSegment("staticRegistries", "registries", "registries"),
+		resourceids.UserSpecifiedSegment("registryName", "registry1"),
+		resourceids.StaticSegment("staticTokens", "tokens", "tokens"),
+		resourceids.UserSpecifiedSegment("tokenName", "token1"),
+		resourceids.StaticSegment("staticPasswords", "passwords", "passwords"),
+		resourceids.UserSpecifiedSegment("passwordName", "password"),
+	}
+}
+
 // ContainerRegistryTokenPasswordID parses a ContainerRegistryTokenPassword ID into an ContainerRegistryTokenPasswordId struct
 func ContainerRegistryTokenPasswordID(input string) (*ContainerRegistryTokenPasswordId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func ContainerRegistryTokenPasswordID(input string) (*ContainerRegistryTokenPass
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ContainerRegistryTokenPasswordId using the ParseResult provided in 'input'
+func (id *ContainerRegistryTokenPasswordId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.RegistryName, ok = input.Parsed["registryName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "registryName", input)
+	}
+
+	if id.TokenName, ok = input.Parsed["tokenName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tokenName", input)
+	}
+
+	if id.PasswordName, ok = input.Parsed["passwordName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "passwordName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestContainerRegistryTokenPasswordIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContainerRegistryTokenPasswordIDSegments(t *testing.T) {
+	expected := NewContainerRegistryTokenPasswordID("12345678-1234-9876-4563-123456789012", "resGroup1", "registry1", "token1", "password")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ContainerRegistryTokenPasswordId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ContainerRegistryTokenPasswordId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestContainerRegistryTokenPasswordID(t *testing.T) {
 	testData := []struct {
 		Input    string
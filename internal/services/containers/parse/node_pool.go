@@ -43,6 +43,22 @@ func (id NodePoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this NodePool ID
+func (id NodePoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftContainerService", "Microsoft.ContainerService", "Microsoft.ContainerService"),
+		resourceids.StaticSegment("staticManagedClusters", "managedClusters", "managedClusters"),
+		resourceids.UserSpecifiedSegment("managedClusterName", "cluster1"),
+		resourceids.StaticSegment("staticAgentPools", "agentPools", "agentPools"),
+		resourceids.UserSpecifiedSegment("agentPoolName", "pool1"),
+	}
+}
+
 // NodePoolID parses a NodePool ID into an NodePoolId struct
 func NodePoolID(input string) (*NodePoolId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func NodePoolID(input string) (*NodePoolId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the NodePoolId using the ParseResult provided in 'input'
+func (id *NodePoolId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedClusterName, ok = input.Parsed["managedClusterName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedClusterName", input)
+	}
+
+	if id.AgentPoolName, ok = input.Parsed["agentPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "agentPoolName", input)
+	}
+
+	return nil
+}
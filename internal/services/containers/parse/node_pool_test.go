@@ -21,6 +21,23 @@ func TestNodePoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNodePoolIDSegments(t *testing.T) {
+	expected := NewNodePoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "pool1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&NodePoolId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := NodePoolId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestNodePoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -13,8 +13,9 @@ type Registration struct {
 }
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration             = Registration{}
+	_ sdk.UntypedServiceRegistration           = Registration{}
+	_ sdk.ServiceRegistrationWithListResources = Registration{}
 )
 
 // Name is the name of this Service
@@ -83,3 +84,10 @@ func (r Registration) Resources() []sdk.Resource {
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
 }
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		KubernetesClusterListResource{},
+	}
+}
@@ -854,7 +854,9 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.DatabaseAccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.DatabaseAccountId{})
 }
 
 func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -29,7 +29,7 @@ import (
 )
 
 func resourceCassandraCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCassandraClusterCreate,
 		Read:   resourceCassandraClusterRead,
 		Update: resourceCassandraClusterUpdate,
@@ -139,6 +139,10 @@ func resourceCassandraCluster() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.CassandraClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.CassandraClusterId{})
 }
 
 func resourceCassandraClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -139,7 +139,9 @@ func resourceCassandraDatacenter() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &managedcassandras.DataCenterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &managedcassandras.DataCenterId{})
 }
 
 func resourceCassandraDatacenterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCosmosDbCassandraKeyspace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbCassandraKeyspaceCreate,
 		Read:   resourceCosmosDbCassandraKeyspaceRead,
 		Update: resourceCosmosDbCassandraKeyspaceUpdate,
@@ -72,7 +72,7 @@ func resourceCosmosDbCassandraKeyspace() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 		},
-	}
+	}, &parse.CassandraKeyspaceId{})
 }
 
 func resourceCosmosDbCassandraKeyspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceCosmosDbCassandraTable() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbCassandraTableCreate,
 		Read:   resourceCosmosDbCassandraTableRead,
 		Update: resourceCosmosDbCassandraTableUpdate,
@@ -82,7 +82,7 @@ func resourceCosmosDbCassandraTable() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 		},
-	}
+	}, &parse.CassandraTableId{})
 }
 
 func resourceCosmosDbCassandraTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
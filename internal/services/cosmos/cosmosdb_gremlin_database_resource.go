@@ -22,7 +22,7 @@ import (
 )
 
 func resourceCosmosGremlinDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosGremlinDatabaseCreate,
 		Update: resourceCosmosGremlinDatabaseUpdate,
 		Read:   resourceCosmosGremlinDatabaseRead,
@@ -71,7 +71,7 @@ func resourceCosmosGremlinDatabase() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 		},
-	}
+	}, &parse.GremlinDatabaseId{})
 }
 
 func resourceCosmosGremlinDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
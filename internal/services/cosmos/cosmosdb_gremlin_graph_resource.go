@@ -26,7 +26,7 @@ import (
 )
 
 func resourceCosmosDbGremlinGraph() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbGremlinGraphCreate,
 		Read:   resourceCosmosDbGremlinGraphRead,
 		Update: resourceCosmosDbGremlinGraphUpdate,
@@ -191,7 +191,7 @@ func resourceCosmosDbGremlinGraph() *pluginsdk.Resource {
 				return (old.(int) == -1 || (old.(int) >= 1 && old.(int) <= 2147483647)) && new.(int) == 0
 			}),
 		),
-	}
+	}, &parse.GremlinGraphId{})
 }
 
 func resourceCosmosDbGremlinGraphCreate(d *pluginsdk.ResourceData, meta interface{}) error {
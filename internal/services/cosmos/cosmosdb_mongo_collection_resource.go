@@ -26,7 +26,7 @@ import (
 )
 
 func resourceCosmosDbMongoCollection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbMongoCollectionCreate,
 		Read:   resourceCosmosDbMongoCollectionRead,
 		Update: resourceCosmosDbMongoCollectionUpdate,
@@ -145,7 +145,7 @@ func resourceCosmosDbMongoCollection() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.MongodbCollectionId{})
 }
 
 func resourceCosmosDbMongoCollectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCosmosDbMongoDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbMongoDatabaseCreate,
 		Update: resourceCosmosDbMongoDatabaseUpdate,
 		Read:   resourceCosmosDbMongoDatabaseRead,
@@ -72,7 +72,7 @@ func resourceCosmosDbMongoDatabase() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 		},
-	}
+	}, &parse.MongodbDatabaseId{})
 }
 
 func resourceCosmosDbMongoDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
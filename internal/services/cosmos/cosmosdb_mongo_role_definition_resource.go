@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -39,6 +40,7 @@ type Resource struct {
 type CosmosDbMongoRoleDefinitionResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbMongoRoleDefinitionResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbMongoRoleDefinitionResource{}
 
 func (r CosmosDbMongoRoleDefinitionResource) ResourceType() string {
 	return "azurerm_cosmosdb_mongo_role_definition"
@@ -52,6 +54,10 @@ func (r CosmosDbMongoRoleDefinitionResource) IDValidationFunc() pluginsdk.Schema
 	return mongorbacs.ValidateMongodbRoleDefinitionID
 }
 
+func (r CosmosDbMongoRoleDefinitionResource) Identity() resourceids.ResourceId {
+	return &mongorbacs.MongodbRoleDefinitionId{}
+}
+
 func (r CosmosDbMongoRoleDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"cosmos_mongo_database_id": {
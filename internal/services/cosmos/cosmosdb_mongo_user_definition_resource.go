@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,6 +30,7 @@ type CosmosDbMongoUserDefinitionResourceModel struct {
 type CosmosDbMongoUserDefinitionResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbMongoUserDefinitionResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbMongoUserDefinitionResource{}
 
 func (r CosmosDbMongoUserDefinitionResource) ResourceType() string {
 	return "azurerm_cosmosdb_mongo_user_definition"
@@ -42,6 +44,10 @@ func (r CosmosDbMongoUserDefinitionResource) IDValidationFunc() pluginsdk.Schema
 	return mongorbacs.ValidateMongodbUserDefinitionID
 }
 
+func (r CosmosDbMongoUserDefinitionResource) Identity() resourceids.ResourceId {
+	return &mongorbacs.MongodbUserDefinitionId{}
+}
+
 func (r CosmosDbMongoUserDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"cosmos_mongo_database_id": {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceCosmosDbNotebookWorkspace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbNotebookWorkspaceCreate,
 		Read:   resourceCosmosDbNotebookWorkspaceRead,
 		Delete: resourceCosmosDbNotebookWorkspaceDelete,
@@ -63,7 +63,7 @@ func resourceCosmosDbNotebookWorkspace() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.NotebookWorkspaceId{})
 }
 
 func resourceCosmosDbNotebookWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/clusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -63,6 +64,7 @@ type MaintenanceWindow struct {
 type CosmosDbPostgreSQLClusterResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLClusterResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLClusterResource{}
 
 func (r CosmosDbPostgreSQLClusterResource) ResourceType() string {
 	return CosmosDbPostgreSQLClusterResourceName
@@ -76,6 +78,10 @@ func (r CosmosDbPostgreSQLClusterResource) IDValidationFunc() pluginsdk.SchemaVa
 	return clusters.ValidateServerGroupsv2ID
 }
 
+func (r CosmosDbPostgreSQLClusterResource) Identity() resourceids.ResourceId {
+	return &clusters.ServerGroupsv2Id{}
+}
+
 func (r CosmosDbPostgreSQLClusterResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
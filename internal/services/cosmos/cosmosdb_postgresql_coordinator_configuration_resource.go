@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -25,6 +26,7 @@ type CosmosDbPostgreSQLCoordinatorConfigurationModel struct {
 type CosmosDbPostgreSQLCoordinatorConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLCoordinatorConfigurationResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLCoordinatorConfigurationResource{}
 
 func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) ResourceType() string {
 	return "azurerm_cosmosdb_postgresql_coordinator_configuration"
@@ -38,6 +40,10 @@ func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) IDValidationFunc() p
 	return configurations.ValidateCoordinatorConfigurationID
 }
 
+func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) Identity() resourceids.ResourceId {
+	return &configurations.CoordinatorConfigurationId{}
+}
+
 func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/firewallrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -26,6 +27,7 @@ type CosmosDbPostgreSQLFirewallRuleResourceModel struct {
 type CosmosDbPostgreSQLFirewallRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLFirewallRuleResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLFirewallRuleResource{}
 
 func (r CosmosDbPostgreSQLFirewallRuleResource) ResourceType() string {
 	return "azurerm_cosmosdb_postgresql_firewall_rule"
@@ -39,6 +41,10 @@ func (r CosmosDbPostgreSQLFirewallRuleResource) IDValidationFunc() pluginsdk.Sch
 	return firewallrules.ValidateFirewallRuleID
 }
 
+func (r CosmosDbPostgreSQLFirewallRuleResource) Identity() resourceids.ResourceId {
+	return &firewallrules.FirewallRuleId{}
+}
+
 func (r CosmosDbPostgreSQLFirewallRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -25,6 +26,7 @@ type CosmosDbPostgreSQLNodeConfigurationModel struct {
 type CosmosDbPostgreSQLNodeConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLNodeConfigurationResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbPostgreSQLNodeConfigurationResource{}
 
 func (r CosmosDbPostgreSQLNodeConfigurationResource) ResourceType() string {
 	return "azurerm_cosmosdb_postgresql_node_configuration"
@@ -38,6 +40,10 @@ func (r CosmosDbPostgreSQLNodeConfigurationResource) IDValidationFunc() pluginsd
 	return configurations.ValidateNodeConfigurationID
 }
 
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Identity() resourceids.ResourceId {
+	return &configurations.NodeConfigurationId{}
+}
+
 func (r CosmosDbPostgreSQLNodeConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -175,7 +175,7 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &parse.SqlContainerId{})
 }
 
 func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
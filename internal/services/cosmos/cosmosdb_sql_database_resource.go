@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCosmosDbSQLDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbSQLDatabaseCreate,
 		Read:   resourceCosmosDbSQLDatabaseRead,
 		Update: resourceCosmosDbSQLDatabaseUpdate,
@@ -72,7 +72,7 @@ func resourceCosmosDbSQLDatabase() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 		},
-	}
+	}, &parse.SqlDatabaseId{})
 }
 
 func resourceCosmosDbSQLDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/cosmosdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -25,6 +26,7 @@ type CosmosDbSqlDedicatedGatewayModel struct {
 type CosmosDbSqlDedicatedGatewayResource struct{}
 
 var _ sdk.ResourceWithUpdate = CosmosDbSqlDedicatedGatewayResource{}
+var _ sdk.ResourceWithIdentity = CosmosDbSqlDedicatedGatewayResource{}
 
 func (r CosmosDbSqlDedicatedGatewayResource) ResourceType() string {
 	return "azurerm_cosmosdb_sql_dedicated_gateway"
@@ -38,6 +40,10 @@ func (r CosmosDbSqlDedicatedGatewayResource) IDValidationFunc() pluginsdk.Schema
 	return sqldedicatedgateway.ValidateServiceID
 }
 
+func (r CosmosDbSqlDedicatedGatewayResource) Identity() resourceids.ResourceId {
+	return &sqldedicatedgateway.ServiceId{}
+}
+
 func (r CosmosDbSqlDedicatedGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"cosmosdb_account_id": {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceCosmosDbSQLFunction() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbSQLFunctionCreateUpdate,
 		Read:   resourceCosmosDbSQLFunctionRead,
 		Update: resourceCosmosDbSQLFunctionCreateUpdate,
@@ -58,7 +58,7 @@ func resourceCosmosDbSQLFunction() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.SqlFunctionId{})
 }
 
 func resourceCosmosDbSQLFunctionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCosmosDbSQLRoleAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbSQLRoleAssignmentCreate,
 		Read:   resourceCosmosDbSQLRoleAssignmentRead,
 		Update: resourceCosmosDbSQLRoleAssignmentUpdate,
@@ -79,7 +79,7 @@ func resourceCosmosDbSQLRoleAssignment() *pluginsdk.Resource {
 				ValidateFunc: validate.SqlRoleDefinitionID,
 			},
 		},
-	}
+	}, &parse.SqlRoleAssignmentId{})
 }
 
 func resourceCosmosDbSQLRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCosmosDbSQLRoleDefinition() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbSQLRoleDefinitionCreate,
 		Read:   resourceCosmosDbSQLRoleDefinitionRead,
 		Update: resourceCosmosDbSQLRoleDefinitionUpdate,
@@ -102,7 +102,7 @@ func resourceCosmosDbSQLRoleDefinition() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.SqlRoleDefinitionId{})
 }
 
 func resourceCosmosDbSQLRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceCosmosDbSQLStoredProcedure() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbSQLStoredProcedureCreate,
 		Read:   resourceCosmosDbSQLStoredProcedureRead,
 		Update: resourceCosmosDbSQLStoredProcedureUpdate,
@@ -77,7 +77,7 @@ func resourceCosmosDbSQLStoredProcedure() *pluginsdk.Resource {
 				ValidateFunc: validate.CosmosEntityName,
 			},
 		},
-	}
+	}, &parse.SqlStoredProcedureId{})
 }
 
 func resourceCosmosDbSQLStoredProcedureCreate(d *pluginsdk.ResourceData, meta interface{}) error {
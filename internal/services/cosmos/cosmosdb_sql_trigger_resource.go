@@ -20,7 +20,7 @@ import (
 )
 
 func resourceCosmosDbSQLTrigger() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbSQLTriggerCreateUpdate,
 		Read:   resourceCosmosDbSQLTriggerRead,
 		Update: resourceCosmosDbSQLTriggerCreateUpdate,
@@ -80,7 +80,7 @@ func resourceCosmosDbSQLTrigger() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &parse.SqlTriggerId{})
 }
 
 func resourceCosmosDbSQLTriggerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
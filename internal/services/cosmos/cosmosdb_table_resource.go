@@ -23,7 +23,7 @@ import (
 )
 
 func resourceCosmosDbTable() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceCosmosDbTableCreate,
 		Read:   resourceCosmosDbTableRead,
 		Update: resourceCosmosDbTableUpdate,
@@ -72,7 +72,7 @@ func resourceCosmosDbTable() *pluginsdk.Resource {
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
 		},
-	}
+	}, &parse.TableId{})
 }
 
 func resourceCosmosDbTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,23 @@ func TestCassandraClusterIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCassandraClusterIDSegments(t *testing.T) {
+	expected := NewCassandraClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CassandraClusterId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CassandraClusterId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCassandraClusterID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestCassandraKeyspaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCassandraKeyspaceIDSegments(t *testing.T) {
+	expected := NewCassandraKeyspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "keyspace1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CassandraKeyspaceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CassandraKeyspaceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCassandraKeyspaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id CassandraTableId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this CassandraTable ID
+func (id CassandraTableId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticDatabaseAccounts", "databaseAccounts", "databaseAccounts"),
+		resourceids.UserSpecifiedSegment("databaseAccountName", "acc1"),
+		resourceids.StaticSegment("staticCassandraKeyspaces", "cassandraKeyspaces", "cassandraKeyspaces"),
+		resourceids.UserSpecifiedSegment("cassandraKeyspaceName", "keyspace1"),
+		resourceids.StaticSegment("staticTables", "tables", "tables"),
+		resourceids.UserSpecifiedSegment("tableName", "table1"),
+	}
+}
+
 // CassandraTableID parses a CassandraTable ID into an CassandraTableId struct
 func CassandraTableID(input string) (*CassandraTableId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func CassandraTableID(input string) (*CassandraTableId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the CassandraTableId using the ParseResult provided in 'input'
+func (id *CassandraTableId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DatabaseAccountName, ok = input.Parsed["databaseAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseAccountName", input)
+	}
+
+	if id.CassandraKeyspaceName, ok = input.Parsed["cassandraKeyspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "cassandraKeyspaceName", input)
+	}
+
+	if id.TableName, ok = input.Parsed["tableName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tableName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestCassandraTableIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCassandraTableIDSegments(t *testing.T) {
+	expected := NewCassandraTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "keyspace1", "table1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CassandraTableId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CassandraTableId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCassandraTableID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestDatabaseAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabaseAccountIDSegments(t *testing.T) {
+	expected := NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DatabaseAccountId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DatabaseAccountId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDatabaseAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
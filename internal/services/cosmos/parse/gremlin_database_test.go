@@ -21,6 +21,23 @@ func TestGremlinDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGremlinDatabaseIDSegments(t *testing.T) {
+	expected := NewGremlinDatabaseID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "database1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&GremlinDatabaseId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := GremlinDatabaseId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestGremlinDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id GremlinGraphId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this GremlinGraph ID
+func (id GremlinGraphId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticDatabaseAccounts", "databaseAccounts", "databaseAccounts"),
+		resourceids.UserSpecifiedSegment("databaseAccountName", "acc1"),
+		resourceids.StaticSegment("staticGremlinDatabases", "gremlinDatabases", "gremlinDatabases"),
+		resourceids.UserSpecifiedSegment("gremlinDatabaseName", "database1"),
+		resourceids.StaticSegment("staticGraphs", "graphs", "graphs"),
+		resourceids.UserSpecifiedSegment("graphName", "graph1"),
+	}
+}
+
 // GremlinGraphID parses a GremlinGraph ID into an GremlinGraphId struct
 func GremlinGraphID(input string) (*GremlinGraphId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func GremlinGraphID(input string) (*GremlinGraphId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the GremlinGraphId using the ParseResult provided in 'input'
+func (id *GremlinGraphId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DatabaseAccountName, ok = input.Parsed["databaseAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseAccountName", input)
+	}
+
+	if id.GremlinDatabaseName, ok = input.Parsed["gremlinDatabaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "gremlinDatabaseName", input)
+	}
+
+	if id.GraphName, ok = input.Parsed["graphName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "graphName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestGremlinGraphIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGremlinGraphIDSegments(t *testing.T) {
+	expected := NewGremlinGraphID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "database1", "graph1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&GremlinGraphId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := GremlinGraphId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestGremlinGraphID(t *testing.T) {
 	testData := []struct {
 		Input    string
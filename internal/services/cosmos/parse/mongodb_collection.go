@@ -46,6 +46,24 @@ func (id MongodbCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this MongodbCollection ID
+func (id MongodbCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticDatabaseAccounts", "databaseAccounts", "databaseAccounts"),
+		resourceids.UserSpecifiedSegment("databaseAccountName", "acc1"),
+		resourceids.StaticSegment("staticMongodbDatabases", "mongodbDatabases", "mongodbDatabases"),
+		resourceids.UserSpecifiedSegment("mongodbDatabaseName", "db1"),
+		resourceids.StaticSegment("staticCollections", "collections", "collections"),
+		resourceids.UserSpecifiedSegment("collectionName", "coll1"),
+	}
+}
+
 // MongodbCollectionID parses a MongodbCollection ID into an MongodbCollectionId struct
 func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the MongodbCollectionId using the ParseResult provided in 'input'
+func (id *MongodbCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DatabaseAccountName, ok = input.Parsed["databaseAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseAccountName", input)
+	}
+
+	if id.MongodbDatabaseName, ok = input.Parsed["mongodbDatabaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "mongodbDatabaseName", input)
+	}
+
+	if id.CollectionName, ok = input.Parsed["collectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "collectionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestMongodbDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestMongodbDatabaseIDSegments(t *testing.T) {
+	expected := NewMongodbDatabaseID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "db1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&MongodbDatabaseId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := MongodbDatabaseId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestMongodbDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestNotebookWorkspaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNotebookWorkspaceIDSegments(t *testing.T) {
+	expected := NewNotebookWorkspaceID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "account1", "notebookWorkspace1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&NotebookWorkspaceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := NotebookWorkspaceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestNotebookWorkspaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,20 @@ func (id RestorableDatabaseAccountId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.LocationName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this RestorableDatabaseAccount ID
+func (id RestorableDatabaseAccountId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticLocations", "locations", "locations"),
+		resourceids.UserSpecifiedSegment("locationName", "location1"),
+		resourceids.StaticSegment("staticRestorableDatabaseAccounts", "restorableDatabaseAccounts", "restorableDatabaseAccounts"),
+		resourceids.UserSpecifiedSegment("name", "account1"),
+	}
+}
+
 // RestorableDatabaseAccountID parses a RestorableDatabaseAccount ID into an RestorableDatabaseAccountId struct
 func RestorableDatabaseAccountID(input string) (*RestorableDatabaseAccountId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -68,3 +82,22 @@ func RestorableDatabaseAccountID(input string) (*RestorableDatabaseAccountId, er
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the RestorableDatabaseAccountId using the ParseResult provided in 'input'
+func (id *RestorableDatabaseAccountId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.LocationName, ok = input.Parsed["locationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "locationName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
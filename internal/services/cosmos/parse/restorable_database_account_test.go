@@ -21,6 +21,23 @@ func TestRestorableDatabaseAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRestorableDatabaseAccountIDSegments(t *testing.T) {
+	expected := NewRestorableDatabaseAccountID("12345678-1234-9876-4563-123456789012", "location1", "account1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&RestorableDatabaseAccountId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := RestorableDatabaseAccountId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestRestorableDatabaseAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
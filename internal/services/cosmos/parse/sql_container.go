@@ -46,6 +46,24 @@ func (id SqlContainerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SqlContainer ID
+func (id SqlContainerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB", "Microsoft.DocumentDB"),
+		resourceids.StaticSegment("staticDatabaseAccounts", "databaseAccounts", "databaseAccounts"),
+		resourceids.UserSpecifiedSegment("databaseAccountName", "acc1"),
+		resourceids.StaticSegment("staticSqlDatabases", "sqlDatabases", "sqlDatabases"),
+		resourceids.UserSpecifiedSegment("sqlDatabaseName", "db1"),
+		resourceids.StaticSegment("staticContainers", "containers", "containers"),
+		resourceids.UserSpecifiedSegment("containerName", "container1"),
+	}
+}
+
 // SqlContainerID parses a SqlContainer ID into an SqlContainerId struct
 func SqlContainerID(input string) (*SqlContainerId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func SqlContainerID(input string) (*SqlContainerId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SqlContainerId using the ParseResult provided in 'input'
+func (id *SqlContainerId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DatabaseAccountName, ok = input.Parsed["databaseAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseAccountName", input)
+	}
+
+	if id.SqlDatabaseName, ok = input.Parsed["sqlDatabaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "sqlDatabaseName", input)
+	}
+
+	if id.ContainerName, ok = input.Parsed["containerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "containerName", input)
+	}
+
+	return nil
+}
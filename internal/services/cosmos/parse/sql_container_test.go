@@ -21,6 +21,23 @@ func TestSqlContainerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlContainerIDSegments(t *testing.T) {
+	expected := NewSqlContainerID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "db1", "container1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlContainerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlContainerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlContainerID(t *testing.T) {
 	testData := []struct {
 		Input    string
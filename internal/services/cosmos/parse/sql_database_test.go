@@ -21,6 +21,23 @@ func TestSqlDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlDatabaseIDSegments(t *testing.T) {
+	expected := NewSqlDatabaseID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "db1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlDatabaseId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlDatabaseId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
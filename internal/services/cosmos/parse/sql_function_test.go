@@ -21,6 +21,23 @@ func TestSqlFunctionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlFunctionIDSegments(t *testing.T) {
+	expected := NewSqlFunctionID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "account1", "database1", "container1", "userDefinedFunction1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlFunctionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlFunctionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlFunctionID(t *testing.T) {
 	testData := []struct {
 		Input    string
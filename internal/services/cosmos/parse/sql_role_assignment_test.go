@@ -21,6 +21,23 @@ func TestSqlRoleAssignmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlRoleAssignmentIDSegments(t *testing.T) {
+	expected := NewSqlRoleAssignmentID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "account1", "roleAssignment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlRoleAssignmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlRoleAssignmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlRoleAssignmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSqlRoleDefinitionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlRoleDefinitionIDSegments(t *testing.T) {
+	expected := NewSqlRoleDefinitionID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "account1", "def1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlRoleDefinitionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlRoleDefinitionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlRoleDefinitionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSqlTriggerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlTriggerIDSegments(t *testing.T) {
+	expected := NewSqlTriggerID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "account1", "database1", "container1", "trigger1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlTriggerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlTriggerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlTriggerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestTableIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTableIDSegments(t *testing.T) {
+	expected := NewTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "table1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&TableId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := TableId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestTableID(t *testing.T) {
 	testData := []struct {
 		Input    string
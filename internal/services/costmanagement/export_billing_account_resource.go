@@ -4,7 +4,9 @@
 package costmanagement
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -51,6 +53,10 @@ func (r BillingAccountCostManagementExportResource) IDValidationFunc() pluginsdk
 	return validate.BillingAccountCostManagementExportID
 }
 
+func (r BillingAccountCostManagementExportResource) Identity() resourceids.ResourceId {
+	return &parse.BillingAccountCostManagementExportId{}
+}
+
 func (r BillingAccountCostManagementExportResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "billing_account_id")
 }
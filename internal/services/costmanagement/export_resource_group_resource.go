@@ -4,7 +4,9 @@
 package costmanagement
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -51,6 +53,10 @@ func (r ResourceGroupCostManagementExportResource) IDValidationFunc() pluginsdk.
 	return validate.ResourceGroupCostManagementExportID
 }
 
+func (r ResourceGroupCostManagementExportResource) Identity() resourceids.ResourceId {
+	return &parse.ResourceGroupCostManagementExportId{}
+}
+
 func (r ResourceGroupCostManagementExportResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "resource_group_id")
 }
@@ -5,7 +5,9 @@ package costmanagement
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -51,6 +53,10 @@ func (r SubscriptionCostManagementExportResource) IDValidationFunc() pluginsdk.S
 	return validate.SubscriptionCostManagementExportID
 }
 
+func (r SubscriptionCostManagementExportResource) Identity() resourceids.ResourceId {
+	return &parse.SubscriptionCostManagementExportId{}
+}
+
 func (r SubscriptionCostManagementExportResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
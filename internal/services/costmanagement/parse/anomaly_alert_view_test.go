@@ -21,6 +21,23 @@ func TestAnomalyAlertViewIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAnomalyAlertViewIDSegments(t *testing.T) {
+	expected := NewAnomalyAlertViewID("12345678-1234-9876-4563-123456789012", "ms:DailyAnomalyByResourceGroup")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AnomalyAlertViewId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AnomalyAlertViewId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAnomalyAlertViewID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 )
 
@@ -38,6 +39,35 @@ func (id BillingAccountCostManagementExportId) ID() string {
 	return fmt.Sprintf(fmtString, id.BillingAccountName, id.ExportName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Billing Account Cost Management Export ID
+func (id BillingAccountCostManagementExportId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftBilling", "Microsoft.Billing", "Microsoft.Billing"),
+		resourceids.StaticSegment("staticBillingAccounts", "billingAccounts", "billingAccounts"),
+		resourceids.UserSpecifiedSegment("billingAccountName", "12345678"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCostManagement", "Microsoft.CostManagement", "Microsoft.CostManagement"),
+		resourceids.StaticSegment("staticExports", "exports", "exports"),
+		resourceids.UserSpecifiedSegment("exportName", "export1"),
+	}
+}
+
+// FromParseResult populates the BillingAccountCostManagementExportId using the ParseResult provided in 'input'
+func (id *BillingAccountCostManagementExportId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.BillingAccountName, ok = input.Parsed["billingAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "billingAccountName", input)
+	}
+
+	if id.ExportName, ok = input.Parsed["exportName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "exportName", input)
+	}
+
+	return nil
+}
+
 // BillingAccountCostManagementExportID parses a BillingAccountCostManagementExport ID into an BillingAccountCostManagementExportId struct
 func BillingAccountCostManagementExportID(input string) (*BillingAccountCostManagementExportId, error) {
 	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
@@ -97,3 +97,20 @@ func TestBillingAccountCostManagementExportID(t *testing.T) {
 		}
 	}
 }
+
+func TestBillingAccountCostManagementExportIDSegments(t *testing.T) {
+	expected := NewBillingAccountCostManagementExportID("12345678", "export1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BillingAccountCostManagementExportId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BillingAccountCostManagementExportId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
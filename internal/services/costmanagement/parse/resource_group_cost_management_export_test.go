@@ -21,6 +21,23 @@ func TestResourceGroupCostManagementExportIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupCostManagementExportIDSegments(t *testing.T) {
+	expected := NewResourceGroupCostManagementExportID("12345678-1234-9876-4563-123456789012", "resGroup1", "export1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupCostManagementExportId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupCostManagementExportId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupCostManagementExportID(t *testing.T) {
 	testData := []struct {
 		Input    string
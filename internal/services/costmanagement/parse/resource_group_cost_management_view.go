@@ -40,6 +40,20 @@ func (id ResourceGroupCostManagementViewId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ViewName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ResourceGroupCostManagementView ID
+func (id ResourceGroupCostManagementViewId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCostManagement", "Microsoft.CostManagement", "Microsoft.CostManagement"),
+		resourceids.StaticSegment("staticViews", "views", "views"),
+		resourceids.UserSpecifiedSegment("viewName", "view1"),
+	}
+}
+
 // ResourceGroupCostManagementViewID parses a ResourceGroupCostManagementView ID into an ResourceGroupCostManagementViewId struct
 func ResourceGroupCostManagementViewID(input string) (*ResourceGroupCostManagementViewId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func ResourceGroupCostManagementViewID(input string) (*ResourceGroupCostManageme
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ResourceGroupCostManagementViewId using the ParseResult provided in 'input'
+func (id *ResourceGroupCostManagementViewId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ViewName, ok = input.Parsed["viewName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "viewName", input)
+	}
+
+	return nil
+}
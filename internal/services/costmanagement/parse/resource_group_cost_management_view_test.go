@@ -21,6 +21,23 @@ func TestResourceGroupCostManagementViewIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupCostManagementViewIDSegments(t *testing.T) {
+	expected := NewResourceGroupCostManagementViewID("12345678-1234-9876-4563-123456789012", "resGroup1", "view1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupCostManagementViewId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupCostManagementViewId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupCostManagementViewID(t *testing.T) {
 	testData := []struct {
 		Input    string
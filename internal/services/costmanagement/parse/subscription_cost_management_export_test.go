@@ -21,6 +21,23 @@ func TestSubscriptionCostManagementExportIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionCostManagementExportIDSegments(t *testing.T) {
+	expected := NewSubscriptionCostManagementExportID("12345678-1234-9876-4563-123456789012", "export1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionCostManagementExportId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionCostManagementExportId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionCostManagementExportID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSubscriptionCostManagementViewIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionCostManagementViewIDSegments(t *testing.T) {
+	expected := NewSubscriptionCostManagementViewID("12345678-1234-9876-4563-123456789012", "view1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionCostManagementViewId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionCostManagementViewId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionCostManagementViewID(t *testing.T) {
 	testData := []struct {
 		Input    string
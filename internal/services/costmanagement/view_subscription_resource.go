@@ -5,7 +5,9 @@ package costmanagement
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -51,6 +53,10 @@ func (r SubscriptionCostManagementViewResource) IDValidationFunc() pluginsdk.Sch
 	return validate.SubscriptionCostManagementViewID
 }
 
+func (r SubscriptionCostManagementViewResource) Identity() resourceids.ResourceId {
+	return &parse.SubscriptionCostManagementViewId{}
+}
+
 func (r SubscriptionCostManagementViewResource) Create() sdk.ResourceFunc {
 	return r.base.createFunc(r.ResourceType(), "subscription_id")
 }
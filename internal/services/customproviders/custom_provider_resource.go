@@ -22,7 +22,7 @@ import (
 )
 
 func resourceCustomProvider() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCustomProviderCreateUpdate,
 		Read:   resourceCustomProviderRead,
 		Update: resourceCustomProviderCreateUpdate,
@@ -117,6 +117,10 @@ func resourceCustomProvider() *pluginsdk.Resource {
 			"tags": commonschema.TagsForceNew(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &customresourceprovider.ResourceProviderId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &customresourceprovider.ResourceProviderId{})
 }
 
 func resourceCustomProviderCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -60,6 +61,7 @@ type SMTPConfigurationModel struct {
 type DashboardGrafanaResource struct{}
 
 var _ sdk.ResourceWithUpdate = DashboardGrafanaResource{}
+var _ sdk.ResourceWithIdentity = DashboardGrafanaResource{}
 
 func (r DashboardGrafanaResource) ResourceType() string {
 	return "azurerm_dashboard_grafana"
@@ -73,6 +75,10 @@ func (r DashboardGrafanaResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return grafanaresource.ValidateGrafanaID
 }
 
+func (r DashboardGrafanaResource) Identity() resourceids.ResourceId {
+	return &grafanaresource.GrafanaId{}
+}
+
 func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
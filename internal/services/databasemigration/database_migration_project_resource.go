@@ -24,7 +24,7 @@ import (
 )
 
 func resourceDatabaseMigrationProject() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDatabaseMigrationProjectCreateUpdate,
 		Read:   resourceDatabaseMigrationProjectRead,
 		Update: resourceDatabaseMigrationProjectCreateUpdate,
@@ -91,6 +91,10 @@ func resourceDatabaseMigrationProject() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &projectresource.ProjectId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &projectresource.ProjectId{})
 }
 
 func resourceDatabaseMigrationProjectCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
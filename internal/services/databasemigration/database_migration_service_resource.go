@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDatabaseMigrationService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDatabaseMigrationServiceCreate,
 		Read:   resourceDatabaseMigrationServiceRead,
 		Update: resourceDatabaseMigrationServiceUpdate,
@@ -79,6 +79,10 @@ func resourceDatabaseMigrationService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &serviceresource.ServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &serviceresource.ServiceId{})
 }
 
 func resourceDatabaseMigrationServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
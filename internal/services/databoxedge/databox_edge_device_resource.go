@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -47,6 +48,7 @@ type EdgeDeviceModel struct {
 type EdgeDeviceResource struct{}
 
 var _ sdk.ResourceWithUpdate = EdgeDeviceResource{}
+var _ sdk.ResourceWithIdentity = EdgeDeviceResource{}
 
 func (r EdgeDeviceResource) ModelObject() interface{} {
 	return &EdgeDeviceModel{}
@@ -60,6 +62,10 @@ func (r EdgeDeviceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return devices.ValidateDataBoxEdgeDeviceID
 }
 
+func (r EdgeDeviceResource) Identity() resourceids.ResourceId {
+	return &devices.DataBoxEdgeDeviceId{}
+}
+
 func (r EdgeDeviceResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceOrder() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceOrderCreateUpdate,
 		Read:   resourceOrderRead,
 		Update: resourceOrderCreateUpdate,
@@ -266,7 +266,7 @@ func resourceOrder() *pluginsdk.Resource {
 		},
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(databoxEdgeCustomizeDiff),
-	}
+	}, &orders.DataBoxEdgeDeviceId{})
 }
 
 func resourceOrderCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/databricks/validate"
@@ -23,6 +24,7 @@ type AccessConnectorResource struct {
 }
 
 var _ sdk.ResourceWithUpdate = AccessConnectorResource{}
+var _ sdk.ResourceWithIdentity = AccessConnectorResource{}
 
 type AccessConnectorResourceModel struct {
 	Name          string            `tfschema:"name"`
@@ -66,6 +68,10 @@ func (r AccessConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return accessconnector.ValidateAccessConnectorID
 }
 
+func (r AccessConnectorResource) Identity() resourceids.ResourceId {
+	return &accessconnector.AccessConnectorId{}
+}
+
 func (r AccessConnectorResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDatabricksWorkspaceCustomerManagedKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: databricksWorkspaceCustomerManagedKeyCreateUpdate,
 		Read:   databricksWorkspaceCustomerManagedKeyRead,
 		Update: databricksWorkspaceCustomerManagedKeyCreateUpdate,
@@ -76,7 +76,7 @@ func resourceDatabricksWorkspaceCustomerManagedKey() *pluginsdk.Resource {
 				ValidateFunc: keyVaultValidate.KeyVaultChildID,
 			},
 		},
-	}
+	}, &workspaces.WorkspaceId{})
 }
 
 func databricksWorkspaceCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 const databricksVnetPeeringsResourceType string = "azurerm_databricks_virtual_network_peering"
 
 func resourceDatabricksVirtualNetworkPeering() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDatabricksVirtualNetworkPeeringCreate,
 		Read:   resourceDatabricksVirtualNetworkPeeringRead,
 		Update: resourceDatabricksVirtualNetworkPeeringUpdate,
@@ -116,7 +116,7 @@ func resourceDatabricksVirtualNetworkPeering() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &vnetpeering.VirtualNetworkPeeringId{})
 }
 
 func resourceDatabricksVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interface{}) error {
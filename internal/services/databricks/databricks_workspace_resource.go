@@ -391,7 +391,9 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &workspaces.WorkspaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &workspaces.WorkspaceId{})
 }
 
 func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 // since this appears to be a 1:1 with it (given the name defaults to `default`)
 
 func resourceDatadogSingleSignOnConfigurations() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDatadogSingleSignOnConfigurationsCreate,
 		Read:   resourceDatadogSingleSignOnConfigurationsRead,
 		Update: resourceDatadogSingleSignOnConfigurationsUpdate,
@@ -79,7 +79,7 @@ func resourceDatadogSingleSignOnConfigurations() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &singlesignon.SingleSignOnConfigurationId{})
 }
 
 func resourceDatadogSingleSignOnConfigurationsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 // since this appears to be a 1:1 with it (given the name defaults to `default`)
 
 func resourceDatadogTagRules() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDatadogTagRulesCreate,
 		Read:   resourceDatadogTagRulesRead,
 		Update: resourceDatadogTagRulesUpdate,
@@ -131,7 +131,7 @@ func resourceDatadogTagRules() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &rules.TagRuleId{})
 }
 
 func resourceDatadogTagRulesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDatadogMonitor() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDatadogMonitorCreate,
 		Read:   resourceDatadogMonitorRead,
 		Update: resourceDatadogMonitorUpdate,
@@ -164,6 +164,10 @@ func resourceDatadogMonitor() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &monitorsresource.MonitorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &monitorsresource.MonitorId{})
 }
 
 func resourceDatadogMonitorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -87,6 +88,10 @@ func (DataFactoryCredentialUserAssignedManagedIdentityResource) IDValidationFunc
 	return credentials.ValidateCredentialID
 }
 
+func (DataFactoryCredentialUserAssignedManagedIdentityResource) Identity() resourceids.ResourceId {
+	return &credentials.CredentialId{}
+}
+
 func (DataFactoryCredentialUserAssignedManagedIdentityResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
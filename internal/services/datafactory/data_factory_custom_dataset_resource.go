@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryCustomDatasetCreateUpdate,
 		Read:   resourceDataFactoryCustomDatasetRead,
 		Update: resourceDataFactoryCustomDatasetCreateUpdate,
@@ -133,7 +133,7 @@ func resourceDataFactoryCustomDataset() *pluginsdk.Resource {
 				DiffSuppressFunc: suppressJsonOrderingDifference,
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryCustomDatasetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
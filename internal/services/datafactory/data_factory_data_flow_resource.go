@@ -19,7 +19,7 @@ import (
 )
 
 func resourceDataFactoryDataFlow() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDataFlowCreateUpdate,
 		Read:   resourceDataFactoryDataFlowRead,
 		Update: resourceDataFactoryDataFlowCreateUpdate,
@@ -94,7 +94,7 @@ func resourceDataFactoryDataFlow() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.DataFlowId{})
 }
 
 func resourceDataFactoryDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryDatasetAzureBlob() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetAzureBlobCreateUpdate,
 		Read:   resourceDataFactoryDatasetAzureBlobRead,
 		Update: resourceDataFactoryDatasetAzureBlobCreateUpdate,
@@ -161,7 +161,7 @@ func resourceDataFactoryDatasetAzureBlob() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetAzureBlobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
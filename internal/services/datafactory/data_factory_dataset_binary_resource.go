@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetBinaryCreateUpdate,
 		Read:   resourceDataFactoryDatasetBinaryRead,
 		Update: resourceDataFactoryDatasetBinaryCreateUpdate,
@@ -235,7 +235,7 @@ func resourceDataFactoryDatasetBinary() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetBinaryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
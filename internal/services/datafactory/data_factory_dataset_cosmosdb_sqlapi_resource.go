@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetCosmosDbSQLAPI() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate,
 		Read:   resourceDataFactoryDatasetCosmosDbSQLAPIRead,
 		Update: resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate,
@@ -143,7 +143,7 @@ func resourceDataFactoryDatasetCosmosDbSQLAPI() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetCosmosDbSQLAPICreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
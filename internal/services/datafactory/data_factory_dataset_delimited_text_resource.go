@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetDelimitedText() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetDelimitedTextCreateUpdate,
 		Read:   resourceDataFactoryDatasetDelimitedTextRead,
 		Update: resourceDataFactoryDatasetDelimitedTextCreateUpdate,
@@ -328,7 +328,7 @@ func resourceDataFactoryDatasetDelimitedText() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetDelimitedTextCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
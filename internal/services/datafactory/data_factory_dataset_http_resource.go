@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetHTTP() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetHTTPCreateUpdate,
 		Read:   resourceDataFactoryDatasetHTTPRead,
 		Update: resourceDataFactoryDatasetHTTPCreateUpdate,
@@ -157,7 +157,7 @@ func resourceDataFactoryDatasetHTTP() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetHTTPCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetJSON() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetJSONCreateUpdate,
 		Read:   resourceDataFactoryDatasetJSONRead,
 		Update: resourceDataFactoryDatasetJSONCreateUpdate,
@@ -222,7 +222,7 @@ func resourceDataFactoryDatasetJSON() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetJSONCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
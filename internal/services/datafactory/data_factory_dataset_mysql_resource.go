@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetMySQL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetMySQLCreateUpdate,
 		Read:   resourceDataFactoryDatasetMySQLRead,
 		Update: resourceDataFactoryDatasetMySQLCreateUpdate,
@@ -142,7 +142,7 @@ func resourceDataFactoryDatasetMySQL() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetMySQLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetParquet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetParquetCreateUpdate,
 		Read:   resourceDataFactoryDatasetParquetRead,
 		Update: resourceDataFactoryDatasetParquetCreateUpdate,
@@ -281,7 +281,7 @@ func resourceDataFactoryDatasetParquet() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetParquetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
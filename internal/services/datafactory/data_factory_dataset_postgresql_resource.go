@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetPostgreSQL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetPostgreSQLCreateUpdate,
 		Read:   resourceDataFactoryDatasetPostgreSQLRead,
 		Update: resourceDataFactoryDatasetPostgreSQLCreateUpdate,
@@ -142,7 +142,7 @@ func resourceDataFactoryDatasetPostgreSQL() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetPostgreSQLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
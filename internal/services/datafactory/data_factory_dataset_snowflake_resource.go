@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetSnowflake() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetSnowflakeCreateUpdate,
 		Read:   resourceDataFactoryDatasetSnowflakeRead,
 		Update: resourceDataFactoryDatasetSnowflakeCreateUpdate,
@@ -170,7 +170,7 @@ func resourceDataFactoryDatasetSnowflake() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetSnowflakeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryDatasetSQLServerTable() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryDatasetSQLServerTableCreateUpdate,
 		Read:   resourceDataFactoryDatasetSQLServerTableRead,
 		Update: resourceDataFactoryDatasetSQLServerTableCreateUpdate,
@@ -142,7 +142,7 @@ func resourceDataFactoryDatasetSQLServerTable() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.DataSetId{})
 }
 
 func resourceDataFactoryDatasetSQLServerTableCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceDataFactoryFlowletDataFlow() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryFlowletDataFlowCreateUpdate,
 		Read:   resourceDataFactoryFlowletDataFlowRead,
 		Update: resourceDataFactoryFlowletDataFlowCreateUpdate,
@@ -94,7 +94,7 @@ func resourceDataFactoryFlowletDataFlow() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.DataFlowId{})
 }
 
 func resourceDataFactoryFlowletDataFlowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -124,7 +124,9 @@ func resourceDataFactoryIntegrationRuntimeAzure() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.IntegrationRuntimeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.IntegrationRuntimeId{})
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
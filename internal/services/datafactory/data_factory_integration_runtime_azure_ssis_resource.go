@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDataFactoryIntegrationRuntimeAzureSsisCreateUpdate,
 		Read:   resourceDataFactoryIntegrationRuntimeAzureSsisRead,
 		Update: resourceDataFactoryIntegrationRuntimeAzureSsisCreateUpdate,
@@ -502,6 +502,10 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.IntegrationRuntimeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.IntegrationRuntimeId{})
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureSsisCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceDataFactoryIntegrationRuntimeManaged() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDataFactoryIntegrationRuntimeManagedCreateUpdate,
 		Read:   resourceDataFactoryIntegrationRuntimeManagedRead,
 		Update: resourceDataFactoryIntegrationRuntimeManagedCreateUpdate,
@@ -214,6 +214,10 @@ func resourceDataFactoryIntegrationRuntimeManaged() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.IntegrationRuntimeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.IntegrationRuntimeId{})
 }
 
 func resourceDataFactoryIntegrationRuntimeManagedCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceDataFactoryIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate,
 		Read:   resourceDataFactoryIntegrationRuntimeSelfHostedRead,
 		Update: resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate,
@@ -93,7 +93,7 @@ func resourceDataFactoryIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.IntegrationRuntimeId{})
 }
 
 func resourceDataFactoryIntegrationRuntimeSelfHostedCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedCustomServiceCreateUpdate,
 		Read:   resourceDataFactoryLinkedCustomServiceRead,
 		Update: resourceDataFactoryLinkedCustomServiceCreateUpdate,
@@ -120,7 +120,7 @@ func resourceDataFactoryLinkedCustomService() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedCustomServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureBlobStorage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceBlobStorageCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceBlobStorageRead,
 		Update: resourceDataFactoryLinkedServiceBlobStorageCreateUpdate,
@@ -211,7 +211,7 @@ func resourceDataFactoryLinkedServiceAzureBlobStorage() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceBlobStorageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureDatabricks() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceDatabricksCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceDatabricksRead,
 		Update: resourceDataFactoryLinkedServiceDatabricksCreateUpdate,
@@ -252,7 +252,7 @@ func resourceDataFactoryLinkedServiceAzureDatabricks() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceDatabricksCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
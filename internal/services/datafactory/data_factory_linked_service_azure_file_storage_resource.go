@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureFileStorage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceAzureFileStorageRead,
 		Update: resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate,
@@ -142,7 +142,7 @@ func resourceDataFactoryLinkedServiceAzureFileStorage() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceAzureFileStorageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
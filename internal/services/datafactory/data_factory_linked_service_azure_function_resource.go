@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureFunction() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceAzureFunctionRead,
 		Update: resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate,
@@ -126,7 +126,7 @@ func resourceDataFactoryLinkedServiceAzureFunction() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceAzureFunctionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
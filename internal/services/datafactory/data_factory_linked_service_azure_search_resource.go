@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureSearch() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceAzureSearchCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceAzureSearchRead,
 		Update: resourceDataFactoryLinkedServiceAzureSearchCreateUpdate,
@@ -106,7 +106,7 @@ func resourceDataFactoryLinkedServiceAzureSearch() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceAzureSearchCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureSQLDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceAzureSQLDatabaseRead,
 		Update: resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate,
@@ -172,7 +172,7 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceAzureTableStorage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceTableStorageCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceTableStorageRead,
 		Update: resourceDataFactoryLinkedServiceTableStorageCreateUpdate,
@@ -96,7 +96,7 @@ func resourceDataFactoryLinkedServiceAzureTableStorage() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceTableStorageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceCosmosDbMongoAPI() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceCosmosDbMongoAPICreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceCosmosDbMongoAPIRead,
 		Update: resourceDataFactoryLinkedServiceCosmosDbMongoAPICreateUpdate,
@@ -109,7 +109,7 @@ func resourceDataFactoryLinkedServiceCosmosDbMongoAPI() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceCosmosDbMongoAPICreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
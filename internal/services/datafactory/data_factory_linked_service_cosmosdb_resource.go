@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceCosmosDb() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceCosmosDbCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceCosmosDbRead,
 		Update: resourceDataFactoryLinkedServiceCosmosDbCreateUpdate,
@@ -119,7 +119,7 @@ func resourceDataFactoryLinkedServiceCosmosDb() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceCosmosDbCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
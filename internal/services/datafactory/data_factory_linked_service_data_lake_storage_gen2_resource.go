@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceDataLakeStorageGen2Read,
 		Update: resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate,
@@ -137,7 +137,7 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
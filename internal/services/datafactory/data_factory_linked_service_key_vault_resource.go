@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceKeyVault() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceKeyVaultCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceKeyVaultRead,
 		Update: resourceDataFactoryLinkedServiceKeyVaultCreateUpdate,
@@ -94,7 +94,7 @@ func resourceDataFactoryLinkedServiceKeyVault() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceKeyVaultCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
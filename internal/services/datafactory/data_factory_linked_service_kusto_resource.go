@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceKusto() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceKustoCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceKustoRead,
 		Update: resourceDataFactoryLinkedServiceKustoCreateUpdate,
@@ -131,7 +131,7 @@ func resourceDataFactoryLinkedServiceKusto() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceKustoCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceMySQL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceMySQLCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceMySQLRead,
 		Update: resourceDataFactoryLinkedServiceMySQLCreateUpdate,
@@ -96,7 +96,7 @@ func resourceDataFactoryLinkedServiceMySQL() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceMySQLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceArmDataFactoryLinkedServiceOData() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmDataFactoryLinkedServiceODataCreateUpdate,
 		Read:   resourceArmDataFactoryLinkedServiceODataRead,
 		Update: resourceArmDataFactoryLinkedServiceODataCreateUpdate,
@@ -120,7 +120,7 @@ func resourceArmDataFactoryLinkedServiceOData() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceArmDataFactoryLinkedServiceODataCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceOdbc() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceOdbcCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceOdbcRead,
 		Update: resourceDataFactoryLinkedServiceOdbcCreateUpdate,
@@ -120,7 +120,7 @@ func resourceDataFactoryLinkedServiceOdbc() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceOdbcCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
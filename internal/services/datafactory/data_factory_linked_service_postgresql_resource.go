@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServicePostgreSQL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServicePostgreSQLCreateUpdate,
 		Read:   resourceDataFactoryLinkedServicePostgreSQLRead,
 		Update: resourceDataFactoryLinkedServicePostgreSQLCreateUpdate,
@@ -96,7 +96,7 @@ func resourceDataFactoryLinkedServicePostgreSQL() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServicePostgreSQLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceSFTP() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceSFTPCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceSFTPRead,
 		Update: resourceDataFactoryLinkedServiceSFTPCreateUpdate,
@@ -130,7 +130,7 @@ func resourceDataFactoryLinkedServiceSFTP() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceSFTPCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
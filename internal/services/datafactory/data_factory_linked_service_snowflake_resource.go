@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceSnowflake() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceSnowflakeCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceSnowflakeRead,
 		Update: resourceDataFactoryLinkedServiceSnowflakeCreateUpdate,
@@ -121,7 +121,7 @@ func resourceDataFactoryLinkedServiceSnowflake() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceSnowflakeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceSQLServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceSQLServerCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceSQLServerRead,
 		Update: resourceDataFactoryLinkedServiceSQLServerCreateUpdate,
@@ -148,7 +148,7 @@ func resourceDataFactoryLinkedServiceSQLServer() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceSQLServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
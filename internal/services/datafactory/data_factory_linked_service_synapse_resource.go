@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceSynapse() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceSynapseCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceSynapseRead,
 		Update: resourceDataFactoryLinkedServiceSynapseCreateUpdate,
@@ -121,7 +121,7 @@ func resourceDataFactoryLinkedServiceSynapse() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceSynapseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
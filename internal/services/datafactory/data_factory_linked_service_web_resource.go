@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataFactoryLinkedServiceWeb() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryLinkedServiceWebCreateUpdate,
 		Read:   resourceDataFactoryLinkedServiceWebRead,
 		Update: resourceDataFactoryLinkedServiceWebCreateUpdate,
@@ -114,7 +114,7 @@ func resourceDataFactoryLinkedServiceWeb() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceDataFactoryLinkedServiceWebCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceDataFactoryManagedPrivateEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryManagedPrivateEndpointCreate,
 		Read:   resourceDataFactoryManagedPrivateEndpointRead,
 		Delete: resourceDataFactoryManagedPrivateEndpointDelete,
@@ -82,7 +82,7 @@ func resourceDataFactoryManagedPrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.ManagedPrivateEndpointId{})
 }
 
 func resourceDataFactoryManagedPrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -32,7 +32,7 @@ import (
 )
 
 func resourceDataFactory() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDataFactoryCreateUpdate,
 		Read:   resourceDataFactoryRead,
 		Update: resourceDataFactoryCreateUpdate,
@@ -221,6 +221,10 @@ func resourceDataFactory() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &factories.FactoryId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &factories.FactoryId{})
 }
 
 func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
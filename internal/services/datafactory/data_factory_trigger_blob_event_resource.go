@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryTriggerBlobEvent() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryTriggerBlobEventCreateUpdate,
 		Read:   resourceDataFactoryTriggerBlobEventRead,
 		Update: resourceDataFactoryTriggerBlobEventCreateUpdate,
@@ -144,7 +144,7 @@ func resourceDataFactoryTriggerBlobEvent() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &parse.TriggerId{})
 }
 
 func resourceDataFactoryTriggerBlobEventCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataFactoryTriggerCustomEvent() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryTriggerCustomEventCreateUpdate,
 		Read:   resourceDataFactoryTriggerCustomEventRead,
 		Update: resourceDataFactoryTriggerCustomEventCreateUpdate,
@@ -133,7 +133,7 @@ func resourceDataFactoryTriggerCustomEvent() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.TriggerId{})
 }
 
 func resourceDataFactoryTriggerCustomEventCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryTriggerScheduleCreate,
 		Read:   resourceDataFactoryTriggerScheduleRead,
 		Update: resourceDataFactoryTriggerScheduleUpdate,
@@ -241,7 +241,7 @@ func resourceDataFactoryTriggerSchedule() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.TriggerId{})
 }
 
 func resourceDataFactoryTriggerScheduleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceDataFactoryTriggerTumblingWindow() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataFactoryTriggerTumblingWindowCreateUpdate,
 		Read:   resourceDataFactoryTriggerTumblingWindowRead,
 		Update: resourceDataFactoryTriggerTumblingWindowCreateUpdate,
@@ -200,7 +200,7 @@ func resourceDataFactoryTriggerTumblingWindow() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.TriggerId{})
 }
 
 func resourceDataFactoryTriggerTumblingWindowCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -43,6 +43,22 @@ func (id DataFlowId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DataFlow ID
+func (id DataFlowId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDataFactory", "Microsoft.DataFactory", "Microsoft.DataFactory"),
+		resourceids.StaticSegment("staticFactories", "factories", "factories"),
+		resourceids.UserSpecifiedSegment("factoryName", "facName1"),
+		resourceids.StaticSegment("staticDataflows", "dataflows", "dataflows"),
+		resourceids.UserSpecifiedSegment("name", "dataflow1"),
+	}
+}
+
 // DataFlowID parses a DataFlow ID into an DataFlowId struct
 func DataFlowID(input string) (*DataFlowId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func DataFlowID(input string) (*DataFlowId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the DataFlowId using the ParseResult provided in 'input'
+func (id *DataFlowId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FactoryName, ok = input.Parsed["factoryName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "factoryName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestDataFlowIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataFlowIDSegments(t *testing.T) {
+	expected := NewDataFlowID("12345678-1234-9876-4563-123456789012", "resGroup1", "facName1", "dataflow1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DataFlowId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DataFlowId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDataFlowID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestDataSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataSetIDSegments(t *testing.T) {
+	expected := NewDataSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "facName1", "dataSet1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DataSetId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DataSetId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDataSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
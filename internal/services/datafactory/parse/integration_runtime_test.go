@@ -21,6 +21,23 @@ func TestIntegrationRuntimeIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIntegrationRuntimeIDSegments(t *testing.T) {
+	expected := NewIntegrationRuntimeID("12345678-1234-9876-4563-123456789012", "resGroup1", "factory1", "runtime1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&IntegrationRuntimeId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := IntegrationRuntimeId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestIntegrationRuntimeID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestLinkedServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLinkedServiceIDSegments(t *testing.T) {
+	expected := NewLinkedServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "factory1", "linkedService1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&LinkedServiceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := LinkedServiceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestLinkedServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
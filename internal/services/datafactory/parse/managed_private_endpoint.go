@@ -46,6 +46,24 @@ func (id ManagedPrivateEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FactoryName, id.ManagedVirtualNetworkName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedPrivateEndpoint ID
+func (id ManagedPrivateEndpointId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDataFactory", "Microsoft.DataFactory", "Microsoft.DataFactory"),
+		resourceids.StaticSegment("staticFactories", "factories", "factories"),
+		resourceids.UserSpecifiedSegment("factoryName", "factory1"),
+		resourceids.StaticSegment("staticManagedVirtualNetworks", "managedVirtualNetworks", "managedVirtualNetworks"),
+		resourceids.UserSpecifiedSegment("managedVirtualNetworkName", "vnet1"),
+		resourceids.StaticSegment("staticManagedPrivateEndpoints", "managedPrivateEndpoints", "managedPrivateEndpoints"),
+		resourceids.UserSpecifiedSegment("name", "endpoint1"),
+	}
+}
+
 // ManagedPrivateEndpointID parses a ManagedPrivateEndpoint ID into an ManagedPrivateEndpointId struct
 func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ManagedPrivateEndpointId using the ParseResult provided in 'input'
+func (id *ManagedPrivateEndpointId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FactoryName, ok = input.Parsed["factoryName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "factoryName", input)
+	}
+
+	if id.ManagedVirtualNetworkName, ok = input.Parsed["managedVirtualNetworkName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedVirtualNetworkName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
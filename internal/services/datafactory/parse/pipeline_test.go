@@ -21,6 +21,23 @@ func TestPipelineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPipelineIDSegments(t *testing.T) {
+	expected := NewPipelineID("12345678-1234-9876-4563-123456789012", "resGroup1", "factory1", "pipeline1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PipelineId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PipelineId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPipelineID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestTriggerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTriggerIDSegments(t *testing.T) {
+	expected := NewTriggerID("12345678-1234-9876-4563-123456789012", "resGroup1", "factory1", "trigger1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&TriggerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := TriggerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestTriggerID(t *testing.T) {
 	testData := []struct {
 		Input    string
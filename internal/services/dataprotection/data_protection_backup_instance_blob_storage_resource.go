@@ -26,7 +26,7 @@ import (
 )
 
 func resourceDataProtectionBackupInstanceBlobStorage() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceDataProtectionBackupInstanceBlobStorageCreateUpdate,
 		Read:   resourceDataProtectionBackupInstanceBlobStorageRead,
 		Update: resourceDataProtectionBackupInstanceBlobStorageCreateUpdate,
@@ -89,6 +89,10 @@ func resourceDataProtectionBackupInstanceBlobStorage() *schema.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &backupinstances.BackupInstanceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &backupinstances.BackupInstanceId{})
 }
 
 func resourceDataProtectionBackupInstanceBlobStorageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDataProtectionBackupInstanceDisk() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceDataProtectionBackupInstanceDiskCreateUpdate,
 		Read:   resourceDataProtectionBackupInstanceDiskRead,
 		Update: resourceDataProtectionBackupInstanceDiskCreateUpdate,
@@ -75,6 +75,10 @@ func resourceDataProtectionBackupInstanceDisk() *schema.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &backupinstances.BackupInstanceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &backupinstances.BackupInstanceId{})
 }
 
 func resourceDataProtectionBackupInstanceDiskCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -44,6 +45,7 @@ type BackupDatasourceParameters struct {
 type DataProtectionBackupInstanceKubernatesClusterResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstanceKubernatesClusterResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupInstanceKubernatesClusterResource{}
 
 func (r DataProtectionBackupInstanceKubernatesClusterResource) ResourceType() string {
 	return "azurerm_data_protection_backup_instance_kubernetes_cluster"
@@ -57,6 +59,10 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) IDValidationFunc(
 	return backupinstances.ValidateBackupInstanceID
 }
 
+func (r DataProtectionBackupInstanceKubernatesClusterResource) Identity() resourceids.ResourceId {
+	return &backupinstances.BackupInstanceId{}
+}
+
 func (r DataProtectionBackupInstanceKubernatesClusterResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
@@ -31,6 +32,7 @@ type BackupInstancePostgreSQLFlexibleServerModel struct {
 type DataProtectionBackupInstancePostgreSQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstancePostgreSQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupInstancePostgreSQLFlexibleServerResource{}
 
 func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_instance_postgresql_flexible_server"
@@ -44,6 +46,10 @@ func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) IDValidati
 	return backupinstances.ValidateBackupInstanceID
 }
 
+func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backupinstances.BackupInstanceId{}
+}
+
 func (r DataProtectionBackupInstancePostgreSQLFlexibleServerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
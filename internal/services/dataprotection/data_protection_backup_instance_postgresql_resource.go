@@ -27,7 +27,7 @@ import (
 )
 
 func resourceDataProtectionBackupInstancePostgreSQL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDataProtectionBackupInstancePostgreSQLCreateUpdate,
 		Read:   resourceDataProtectionBackupInstancePostgreSQLRead,
 		Update: resourceDataProtectionBackupInstancePostgreSQLCreateUpdate,
@@ -81,6 +81,10 @@ func resourceDataProtectionBackupInstancePostgreSQL() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &backupinstances.BackupInstanceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &backupinstances.BackupInstanceId{})
 }
 
 func resourceDataProtectionBackupInstancePostgreSQLCreateUpdate(d *schema.ResourceData, meta interface{}) error {
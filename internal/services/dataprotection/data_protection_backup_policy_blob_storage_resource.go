@@ -252,7 +252,7 @@ func resourceDataProtectionBackupPolicyBlobStorage() *schema.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &backuppolicies.BackupPolicyId{})
 }
 
 func resourceDataProtectionBackupPolicyBlobStorageCreate(d *schema.ResourceData, meta interface{}) error {
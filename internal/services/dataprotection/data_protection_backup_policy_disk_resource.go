@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDataProtectionBackupPolicyDisk() *schema.Resource {
-	return &schema.Resource{
+	return pluginsdk.WithResourceIdentity(&schema.Resource{
 		Create: resourceDataProtectionBackupPolicyDiskCreate,
 		Read:   resourceDataProtectionBackupPolicyDiskRead,
 		Delete: resourceDataProtectionBackupPolicyDiskDelete,
@@ -131,7 +131,7 @@ func resourceDataProtectionBackupPolicyDisk() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &backuppolicies.BackupPolicyId{})
 }
 
 func resourceDataProtectionBackupPolicyDiskCreate(d *schema.ResourceData, meta interface{}) error {
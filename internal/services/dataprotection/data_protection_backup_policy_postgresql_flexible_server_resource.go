@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -57,6 +58,7 @@ type BackupPolicyPostgreSQLFlexibleServerCriteria struct {
 type DataProtectionBackupPolicyPostgreSQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupPolicyPostgreSQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupPolicyPostgreSQLFlexibleServerResource{}
 
 func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_policy_postgresql_flexible_server"
@@ -70,6 +72,10 @@ func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) IDValidation
 	return backuppolicies.ValidateBackupPolicyID
 }
 
+func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backuppolicies.BackupPolicyId{}
+}
+
 func (r DataProtectionBackupPolicyPostgreSQLFlexibleServerResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceDataProtectionBackupPolicyPostgreSQL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataProtectionBackupPolicyPostgreSQLCreate,
 		Read:   resourceDataProtectionBackupPolicyPostgreSQLRead,
 		Delete: resourceDataProtectionBackupPolicyPostgreSQLDelete,
@@ -184,7 +184,7 @@ func resourceDataProtectionBackupPolicyPostgreSQL() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &backuppolicies.BackupPolicyId{})
 }
 
 func resourceDataProtectionBackupPolicyPostgreSQLCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -122,7 +122,10 @@ func resourceDataProtectionBackupVault() *pluginsdk.Resource {
 			}, false),
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &backupvaults.BackupVaultId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &backupvaults.BackupVaultId{})
 }
 
 func resourceDataProtectionBackupVaultCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDataProtectionResourceGuard() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDataProtectionResourceGuardCreateUpdate,
 		Read:   resourceDataProtectionResourceGuardRead,
 		Update: resourceDataProtectionResourceGuardCreateUpdate,
@@ -65,6 +65,10 @@ func resourceDataProtectionResourceGuard() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &resourceguards.ResourceGuardId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &resourceguards.ResourceGuardId{})
 }
 
 func resourceDataProtectionResourceGuardCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceDataShareAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDataShareAccountCreate,
 		Read:   resourceDataShareAccountRead,
 		Update: resourceDataShareAccountUpdate,
@@ -61,6 +61,10 @@ func resourceDataShareAccount() *pluginsdk.Resource {
 			"tags": tags.SchemaEnforceLowerCaseKeys(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &account.AccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &account.AccountId{})
 }
 
 func resourceDataShareAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceDataShareDataSetBlobStorage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataShareDataSetBlobStorageCreate,
 		Read:   resourceDataShareDataSetBlobStorageRead,
 		Delete: resourceDataShareDataSetBlobStorageDelete,
@@ -108,7 +108,7 @@ func resourceDataShareDataSetBlobStorage() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &dataset.DataSetId{})
 }
 
 func resourceDataShareDataSetBlobStorageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDataShareDataSetDataLakeGen2() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataShareDataSetDataLakeGen2Create,
 		Read:   resourceDataShareDataSetDataLakeGen2Read,
 		Delete: resourceDataShareDataSetDataLakeGen2Delete,
@@ -87,7 +87,7 @@ func resourceDataShareDataSetDataLakeGen2() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &dataset.DataSetId{})
 }
 
 func resourceDataShareDataSetDataLakeGen2Create(d *pluginsdk.ResourceData, meta interface{}) error {
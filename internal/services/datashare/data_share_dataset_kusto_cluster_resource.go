@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataShareDataSetKustoCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataShareDataSetKustoClusterCreate,
 		Read:   resourceDataShareDataSetKustoClusterRead,
 		Delete: resourceDataShareDataSetKustoClusterDelete,
@@ -68,7 +68,7 @@ func resourceDataShareDataSetKustoCluster() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &dataset.DataSetId{})
 }
 
 func resourceDataShareDataSetKustoClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
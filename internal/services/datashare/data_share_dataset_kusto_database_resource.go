@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDataShareDataSetKustoDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataShareDataSetKustoDatabaseCreate,
 		Read:   resourceDataShareDataSetKustoDatabaseRead,
 		Delete: resourceDataShareDataSetKustoDatabaseDelete,
@@ -68,7 +68,7 @@ func resourceDataShareDataSetKustoDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &dataset.DataSetId{})
 }
 
 func resourceDataShareDataSetKustoDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDataShare() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDataShareCreateUpdate,
 		Read:   resourceDataShareRead,
 		Update: resourceDataShareCreateUpdate,
@@ -109,7 +109,7 @@ func resourceDataShare() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &share.ShareId{})
 }
 
 func resourceDataShareCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
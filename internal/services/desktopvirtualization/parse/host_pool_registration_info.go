@@ -43,6 +43,22 @@ func (id HostPoolRegistrationInfoId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostPoolName, id.RegistrationInfoName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this HostPoolRegistrationInfo ID
+func (id HostPoolRegistrationInfoId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDesktopVirtualization", "Microsoft.DesktopVirtualization", "Microsoft.DesktopVirtualization"),
+		resourceids.StaticSegment("staticHostPools", "hostPools", "hostPools"),
+		resourceids.UserSpecifiedSegment("hostPoolName", "pool1"),
+		resourceids.StaticSegment("staticRegistrationInfo", "registrationInfo", "registrationInfo"),
+		resourceids.UserSpecifiedSegment("registrationInfoName", "default"),
+	}
+}
+
 // HostPoolRegistrationInfoID parses a HostPoolRegistrationInfo ID into an HostPoolRegistrationInfoId struct
 func HostPoolRegistrationInfoID(input string) (*HostPoolRegistrationInfoId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func HostPoolRegistrationInfoIDInsensitively(input string) (*HostPoolRegistratio
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the HostPoolRegistrationInfoId using the ParseResult provided in 'input'
+func (id *HostPoolRegistrationInfoId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.HostPoolName, ok = input.Parsed["hostPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "hostPoolName", input)
+	}
+
+	if id.RegistrationInfoName, ok = input.Parsed["registrationInfoName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "registrationInfoName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestHostPoolRegistrationInfoIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostPoolRegistrationInfoIDSegments(t *testing.T) {
+	expected := NewHostPoolRegistrationInfoID("12345678-1234-9876-4563-123456789012", "resGroup1", "pool1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HostPoolRegistrationInfoId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HostPoolRegistrationInfoId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHostPoolRegistrationInfoID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -30,7 +30,7 @@ import (
 var applicationGroupType = "azurerm_virtual_desktop_application_group"
 
 func resourceVirtualDesktopApplicationGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualDesktopApplicationGroupCreateUpdate,
 		Read:   resourceVirtualDesktopApplicationGroupRead,
 		Update: resourceVirtualDesktopApplicationGroupCreateUpdate,
@@ -103,6 +103,10 @@ func resourceVirtualDesktopApplicationGroup() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &applicationgroup.ApplicationGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &applicationgroup.ApplicationGroupId{})
 }
 
 func resourceVirtualDesktopApplicationGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 var applicationType = "azurerm_virtual_desktop_application"
 
 func resourceVirtualDesktopApplication() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualDesktopApplicationCreateUpdate,
 		Read:   resourceVirtualDesktopApplicationRead,
 		Update: resourceVirtualDesktopApplicationCreateUpdate,
@@ -116,7 +116,7 @@ func resourceVirtualDesktopApplication() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &application.ApplicationId{})
 }
 
 func resourceVirtualDesktopApplicationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
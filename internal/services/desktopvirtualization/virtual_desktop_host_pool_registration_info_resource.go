@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVirtualDesktopHostPoolRegistrationInfo() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualDesktopHostPoolRegistrationInfoCreateUpdate,
 		Read:   resourceVirtualDesktopHostPoolRegistrationInfoRead,
 		Update: resourceVirtualDesktopHostPoolRegistrationInfoCreateUpdate,
@@ -62,7 +62,7 @@ func resourceVirtualDesktopHostPoolRegistrationInfo() *pluginsdk.Resource {
 				Computed:  true,
 			},
 		},
-	}
+	}, &parse.HostPoolRegistrationInfoId{})
 }
 
 func hostpoolRegistrationInfoCustomDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
@@ -27,7 +27,7 @@ import (
 var hostPoolResourceType = "azurerm_virtual_desktop_host_pool"
 
 func resourceVirtualDesktopHostPool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualDesktopHostPoolCreate,
 		Read:   resourceVirtualDesktopHostPoolRead,
 		Update: resourceVirtualDesktopHostPoolUpdate,
@@ -213,6 +213,10 @@ func resourceVirtualDesktopHostPool() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &hostpool.HostPoolId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &hostpool.HostPoolId{})
 }
 
 func resourceVirtualDesktopHostPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -28,7 +28,7 @@ import (
 var scalingPlanResourceType = "azurerm_virtual_desktop_scaling_plan"
 
 func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualDesktopScalingPlanCreate,
 		Read:   resourceVirtualDesktopScalingPlanRead,
 		Update: resourceVirtualDesktopScalingPlanUpdate,
@@ -242,6 +242,10 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &scalingplan.ScalingPlanId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &scalingplan.ScalingPlanId{})
 }
 
 func validateTime() pluginsdk.SchemaValidateFunc {
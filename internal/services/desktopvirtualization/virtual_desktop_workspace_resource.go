@@ -29,7 +29,7 @@ import (
 var workspaceResourceType = "azurerm_virtual_desktop_workspace"
 
 func resourceArmDesktopVirtualizationWorkspace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmDesktopVirtualizationWorkspaceCreateUpdate,
 		Read:   resourceArmDesktopVirtualizationWorkspaceRead,
 		Update: resourceArmDesktopVirtualizationWorkspaceCreateUpdate,
@@ -85,6 +85,10 @@ func resourceArmDesktopVirtualizationWorkspace() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &workspace.WorkspaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &workspace.WorkspaceId{})
 }
 
 func resourceArmDesktopVirtualizationWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/catalogs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -36,6 +37,7 @@ type CatalogPropertiesModel struct {
 type DevCenterCatalogsResource struct{}
 
 var _ sdk.Resource = DevCenterCatalogsResource{}
+var _ sdk.ResourceWithIdentity = DevCenterCatalogsResource{}
 
 func (r DevCenterCatalogsResource) ModelObject() interface{} {
 	return &DevCenterCatalogsResourceModel{}
@@ -45,6 +47,10 @@ func (r DevCenterCatalogsResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return catalogs.ValidateCatalogID
 }
 
+func (r DevCenterCatalogsResource) Identity() resourceids.ResourceId {
+	return &catalogs.CatalogId{}
+}
+
 func (r DevCenterCatalogsResource) ResourceType() string {
 	return "azurerm_dev_center_catalog"
 }
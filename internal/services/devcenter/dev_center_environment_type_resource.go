@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devcenters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/environmenttypes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,7 @@ import (
 
 var _ sdk.Resource = DevCenterEnvironmentTypeResource{}
 var _ sdk.ResourceWithUpdate = DevCenterEnvironmentTypeResource{}
+var _ sdk.ResourceWithIdentity = DevCenterEnvironmentTypeResource{}
 
 type DevCenterEnvironmentTypeResource struct{}
 
@@ -37,6 +39,10 @@ func (r DevCenterEnvironmentTypeResource) IDValidationFunc() pluginsdk.SchemaVal
 	return environmenttypes.ValidateDevCenterEnvironmentTypeID
 }
 
+func (r DevCenterEnvironmentTypeResource) Identity() resourceids.ResourceId {
+	return &environmenttypes.DevCenterEnvironmentTypeId{}
+}
+
 func (r DevCenterEnvironmentTypeResource) ResourceType() string {
 	return "azurerm_dev_center_environment_type"
 }
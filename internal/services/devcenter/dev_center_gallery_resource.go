@@ -9,12 +9,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/galleries"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 var _ sdk.Resource = DevCenterGalleryResource{}
+var _ sdk.ResourceWithIdentity = DevCenterGalleryResource{}
 
 type DevCenterGalleryResource struct{}
 
@@ -31,6 +33,10 @@ type DevCenterGalleryResourceSchema struct {
 func (r DevCenterGalleryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return galleries.ValidateGalleryID
 }
+
+func (r DevCenterGalleryResource) Identity() resourceids.ResourceId {
+	return &galleries.GalleryId{}
+}
 func (r DevCenterGalleryResource) ResourceType() string {
 	return "azurerm_dev_center_gallery"
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/networkconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
@@ -22,6 +23,7 @@ import (
 
 var _ sdk.Resource = DevCenterNetworkConnectionResource{}
 var _ sdk.ResourceWithUpdate = DevCenterNetworkConnectionResource{}
+var _ sdk.ResourceWithIdentity = DevCenterNetworkConnectionResource{}
 
 type DevCenterNetworkConnectionResource struct{}
 
@@ -46,6 +48,10 @@ func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return networkconnections.ValidateNetworkConnectionID
 }
 
+func (r DevCenterNetworkConnectionResource) Identity() resourceids.ResourceId {
+	return &networkconnections.NetworkConnectionId{}
+}
+
 func (r DevCenterNetworkConnectionResource) ResourceType() string {
 	return "azurerm_dev_center_network_connection"
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/environmenttypes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,7 @@ import (
 
 var _ sdk.Resource = DevCenterProjectEnvironmentTypeResource{}
 var _ sdk.ResourceWithUpdate = DevCenterProjectEnvironmentTypeResource{}
+var _ sdk.ResourceWithIdentity = DevCenterProjectEnvironmentTypeResource{}
 
 type DevCenterProjectEnvironmentTypeResource struct{}
 
@@ -51,6 +53,10 @@ func (r DevCenterProjectEnvironmentTypeResource) IDValidationFunc() pluginsdk.Sc
 	return environmenttypes.ValidateEnvironmentTypeID
 }
 
+func (r DevCenterProjectEnvironmentTypeResource) Identity() resourceids.ResourceId {
+	return &environmenttypes.EnvironmentTypeId{}
+}
+
 func (r DevCenterProjectEnvironmentTypeResource) ResourceType() string {
 	return "azurerm_dev_center_project_environment_type"
 }
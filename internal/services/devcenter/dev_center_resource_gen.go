@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devcenters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,7 @@ import (
 
 var _ sdk.Resource = DevCenterResource{}
 var _ sdk.ResourceWithUpdate = DevCenterResource{}
+var _ sdk.ResourceWithIdentity = DevCenterResource{}
 
 type DevCenterResource struct{}
 
@@ -40,6 +42,10 @@ type DevCenterResourceSchema struct {
 func (r DevCenterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return devcenters.ValidateDevCenterID
 }
+
+func (r DevCenterResource) Identity() resourceids.ResourceId {
+	return &devcenters.DevCenterId{}
+}
 func (r DevCenterResource) ResourceType() string {
 	return "azurerm_dev_center"
 }
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDevTestGlobalVMShutdownSchedule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
 		Read:   resourceDevTestGlobalVMShutdownScheduleRead,
 		Update: resourceDevTestGlobalVMShutdownScheduleCreateUpdate,
@@ -102,6 +102,10 @@ func resourceDevTestGlobalVMShutdownSchedule() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &globalschedules.ScheduleId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &globalschedules.ScheduleId{})
 }
 
 func resourceDevTestGlobalVMShutdownScheduleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
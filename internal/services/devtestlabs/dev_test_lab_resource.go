@@ -110,7 +110,9 @@ func resourceDevTestLab() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &labs.LabId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &labs.LabId{})
 }
 
 func resourceDevTestLabCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceDevTestLabSchedules() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDevTestLabSchedulesCreateUpdate,
 		Read:   resourceDevTestLabSchedulesRead,
 		Update: resourceDevTestLabSchedulesCreateUpdate,
@@ -188,6 +188,10 @@ func resourceDevTestLabSchedules() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &schedules.LabScheduleId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &schedules.LabScheduleId{})
 }
 
 func resourceDevTestLabSchedulesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmDevTestLinuxVirtualMachine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
 		Read:   resourceArmDevTestLinuxVirtualMachineRead,
 		Update: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
@@ -153,6 +153,10 @@ func resourceArmDevTestLinuxVirtualMachine() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualmachines.VirtualMachineId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualmachines.VirtualMachineId{})
 }
 
 func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
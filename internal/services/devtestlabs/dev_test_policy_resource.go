@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmDevTestPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmDevTestPolicyCreateUpdate,
 		Read:   resourceArmDevTestPolicyRead,
 		Update: resourceArmDevTestPolicyCreateUpdate,
@@ -108,7 +108,7 @@ func resourceArmDevTestPolicy() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
-	}
+	}, &policies.PolicyId{})
 }
 
 func resourceArmDevTestPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceArmDevTestVirtualNetwork() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmDevTestVirtualNetworkCreate,
 		Read:   resourceArmDevTestVirtualNetworkRead,
 		Update: resourceArmDevTestVirtualNetworkUpdate,
@@ -141,7 +141,7 @@ func resourceArmDevTestVirtualNetwork() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &virtualnetworks.VirtualNetworkId{})
 }
 
 func resourceArmDevTestVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
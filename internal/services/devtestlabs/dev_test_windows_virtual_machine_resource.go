@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmDevTestWindowsVirtualMachine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
 		Read:   resourceArmDevTestWindowsVirtualMachineRead,
 		Update: resourceArmDevTestWindowsVirtualMachineCreateUpdate,
@@ -146,6 +146,10 @@ func resourceArmDevTestWindowsVirtualMachine() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualmachines.VirtualMachineId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualmachines.VirtualMachineId{})
 }
 
 func resourceArmDevTestWindowsVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
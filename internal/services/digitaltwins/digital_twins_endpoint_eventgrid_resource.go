@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDigitalTwinsEndpointEventGrid() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDigitalTwinsEndpointEventGridCreateUpdate,
 		Read:   resourceDigitalTwinsEndpointEventGridRead,
 		Update: resourceDigitalTwinsEndpointEventGridCreateUpdate,
@@ -83,7 +83,7 @@ func resourceDigitalTwinsEndpointEventGrid() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &endpoints.EndpointId{})
 }
 
 func resourceDigitalTwinsEndpointEventGridCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
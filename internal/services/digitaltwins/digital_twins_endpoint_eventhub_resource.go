@@ -22,7 +22,7 @@ import (
 )
 
 func resourceDigitalTwinsEndpointEventHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDigitalTwinsEndpointEventHubCreateUpdate,
 		Read:   resourceDigitalTwinsEndpointEventHubRead,
 		Update: resourceDigitalTwinsEndpointEventHubCreateUpdate,
@@ -81,7 +81,7 @@ func resourceDigitalTwinsEndpointEventHub() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &endpoints.EndpointId{})
 }
 
 func resourceDigitalTwinsEndpointEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
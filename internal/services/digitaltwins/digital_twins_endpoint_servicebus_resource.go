@@ -22,7 +22,7 @@ import (
 )
 
 func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDigitalTwinsEndpointServiceBusCreateUpdate,
 		Read:   resourceDigitalTwinsEndpointServiceBusRead,
 		Update: resourceDigitalTwinsEndpointServiceBusCreateUpdate,
@@ -81,7 +81,7 @@ func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &endpoints.EndpointId{})
 }
 
 func resourceDigitalTwinsEndpointServiceBusCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
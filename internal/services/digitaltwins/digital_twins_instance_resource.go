@@ -22,7 +22,7 @@ import (
 )
 
 func resourceDigitalTwinsInstance() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDigitalTwinsInstanceCreate,
 		Read:   resourceDigitalTwinsInstanceRead,
 		Update: resourceDigitalTwinsInstanceUpdate,
@@ -62,6 +62,10 @@ func resourceDigitalTwinsInstance() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &digitaltwinsinstance.DigitalTwinsInstanceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &digitaltwinsinstance.DigitalTwinsInstanceId{})
 }
 
 func resourceDigitalTwinsInstanceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -141,6 +142,10 @@ func (m TimeSeriesDatabaseConnectionResource) IDValidationFunc() pluginsdk.Schem
 	return timeseriesdatabaseconnections.ValidateTimeSeriesDatabaseConnectionID
 }
 
+func (m TimeSeriesDatabaseConnectionResource) Identity() resourceids.ResourceId {
+	return &timeseriesdatabaseconnections.TimeSeriesDatabaseConnectionId{}
+}
+
 func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagepool/2021-08-01/iscsitargets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -24,6 +25,7 @@ type DisksPoolIscsiTargetResource struct{}
 
 var _ sdk.Resource = DisksPoolIscsiTargetResource{}
 var _ sdk.ResourceWithDeprecationAndNoReplacement = DisksPoolIscsiTargetResource{}
+var _ sdk.ResourceWithIdentity = DisksPoolIscsiTargetResource{}
 
 type DiskPoolIscsiTargetModel struct {
 	ACLMode     string   `tfschema:"acl_mode"`
@@ -232,6 +234,10 @@ func (d DisksPoolIscsiTargetResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return iscsitargets.ValidateIscsiTargetID
 }
 
+func (d DisksPoolIscsiTargetResource) Identity() resourceids.ResourceId {
+	return &iscsitargets.IscsiTargetId{}
+}
+
 func (DisksPoolIscsiTargetResource) retryError(action string, id string, err error) *pluginsdk.RetryError {
 	if err == nil {
 		return nil
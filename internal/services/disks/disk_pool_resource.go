@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagepool/2021-08-01/diskpools"
@@ -211,6 +212,10 @@ func (DiskPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return diskpools.ValidateDiskPoolID
 }
 
+func (DiskPoolResource) Identity() resourceids.ResourceId {
+	return &diskpools.DiskPoolId{}
+}
+
 func (r DiskPoolResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDnsARecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsARecordCreateUpdate,
 		Read:   resourceDnsARecordRead,
 		Update: resourceDnsARecordCreateUpdate,
@@ -93,7 +93,7 @@ func resourceDnsARecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
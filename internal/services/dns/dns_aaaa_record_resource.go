@@ -23,7 +23,7 @@ import (
 )
 
 func resourceDnsAAAARecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsAaaaRecordCreateUpdate,
 		Read:   resourceDnsAaaaRecordRead,
 		Update: resourceDnsAaaaRecordCreateUpdate,
@@ -97,7 +97,7 @@ func resourceDnsAAAARecord() *pluginsdk.Resource {
 				ConflictsWith: []string{"records"},
 			},
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
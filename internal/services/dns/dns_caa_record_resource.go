@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDnsCaaRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsCaaRecordCreateUpdate,
 		Read:   resourceDnsCaaRecordRead,
 		Update: resourceDnsCaaRecordCreateUpdate,
@@ -106,7 +106,7 @@ func resourceDnsCaaRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsCaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceDnsCNameRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsCNameRecordCreate,
 		Read:   resourceDnsCNameRecordRead,
 		Update: resourceDnsCNameRecordUpdate,
@@ -93,7 +93,7 @@ func resourceDnsCNameRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsCNameRecordCreate(d *pluginsdk.ResourceData, meta interface{}) error {
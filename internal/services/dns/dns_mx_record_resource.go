@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDnsMxRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsMxRecordCreateUpdate,
 		Read:   resourceDnsMxRecordRead,
 		Update: resourceDnsMxRecordCreateUpdate,
@@ -98,7 +98,7 @@ func resourceDnsMxRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
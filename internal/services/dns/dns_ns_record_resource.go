@@ -20,7 +20,7 @@ import (
 )
 
 func resourceDnsNsRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsNsRecordCreate,
 		Read:   resourceDnsNsRecordRead,
 		Update: resourceDnsNsRecordUpdate,
@@ -83,7 +83,7 @@ func resourceDnsNsRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsNsRecordCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceDnsPtrRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsPtrRecordCreateUpdate,
 		Read:   resourceDnsPtrRecordRead,
 		Update: resourceDnsPtrRecordCreateUpdate,
@@ -82,7 +82,7 @@ func resourceDnsPtrRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
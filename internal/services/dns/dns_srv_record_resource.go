@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDnsSrvRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsSrvRecordCreate,
 		Read:   resourceDnsSrvRecordRead,
 		Update: resourceDnsSrvRecordUpdate,
@@ -106,7 +106,7 @@ func resourceDnsSrvRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsSrvRecordCreate(d *pluginsdk.ResourceData, meta interface{}) error {
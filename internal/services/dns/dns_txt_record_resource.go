@@ -21,7 +21,7 @@ import (
 )
 
 func resourceDnsTxtRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsTxtRecordCreateUpdate,
 		Read:   resourceDnsTxtRecordRead,
 		Update: resourceDnsTxtRecordCreateUpdate,
@@ -91,7 +91,7 @@ func resourceDnsTxtRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourceDnsTxtRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceDnsZone() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceDnsZoneCreateUpdate,
 		Read:   resourceDnsZoneRead,
 		Update: resourceDnsZoneCreateUpdate,
@@ -50,6 +50,7 @@ func resourceDnsZone() *pluginsdk.Resource {
 			_, err := zones.ParseDnsZoneID(id)
 			return err
 		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -150,7 +151,7 @@ func resourceDnsZone() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &zones.DnsZoneId{})
 }
 
 func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
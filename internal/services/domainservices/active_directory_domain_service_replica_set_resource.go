@@ -24,7 +24,7 @@ import (
 )
 
 func resourceActiveDirectoryDomainServiceReplicaSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceActiveDirectoryDomainServiceReplicaSetCreate,
 		Read:   resourceActiveDirectoryDomainServiceReplicaSetRead,
 		Delete: resourceActiveDirectoryDomainServiceReplicaSetDelete,
@@ -76,6 +76,10 @@ func resourceActiveDirectoryDomainServiceReplicaSet() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.DomainServiceReplicaSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.DomainServiceReplicaSetId{})
 }
 
 func resourceActiveDirectoryDomainServiceReplicaSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -31,7 +31,7 @@ import (
 const DomainServiceResourceName = "azurerm_active_directory_domain_service"
 
 func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceActiveDirectoryDomainServiceCreateUpdate,
 		Read:   resourceActiveDirectoryDomainServiceRead,
 		Update: resourceActiveDirectoryDomainServiceCreateUpdate,
@@ -297,6 +297,10 @@ func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.DomainServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.DomainServiceId{})
 }
 
 func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/aad/2021-05-01/domainservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -85,6 +86,10 @@ func (r DomainServiceTrustResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.DomainServiceTrustID
 }
 
+func (r DomainServiceTrustResource) Identity() resourceids.ResourceId {
+	return &parse.DomainServiceTrustId{}
+}
+
 func (r DomainServiceTrustResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -43,6 +43,22 @@ func (id DomainServiceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name, id.InitialReplicaSetIdName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DomainService ID
+func (id DomainServiceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAAD", "Microsoft.AAD", "Microsoft.AAD"),
+		resourceids.StaticSegment("staticDomainServices", "domainServices", "domainServices"),
+		resourceids.UserSpecifiedSegment("domainServiceName", "DomainService1"),
+		resourceids.StaticSegment("staticInitialReplicaSetId", "initialReplicaSetId", "initialReplicaSetId"),
+		resourceids.UserSpecifiedSegment("initialReplicaSetIdName", "replicaSetID"),
+	}
+}
+
 // DomainServiceID parses a DomainService ID into an DomainServiceId struct
 func DomainServiceID(input string) (*DomainServiceId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func DomainServiceID(input string) (*DomainServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the DomainServiceId using the ParseResult provided in 'input'
+func (id *DomainServiceId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.Name, ok = input.Parsed["domainServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "domainServiceName", input)
+	}
+
+	if id.InitialReplicaSetIdName, ok = input.Parsed["initialReplicaSetIdName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "initialReplicaSetIdName", input)
+	}
+
+	return nil
+}
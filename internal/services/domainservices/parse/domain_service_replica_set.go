@@ -43,6 +43,22 @@ func (id DomainServiceReplicaSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DomainServiceName, id.ReplicaSetName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DomainServiceReplicaSet ID
+func (id DomainServiceReplicaSetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAAD", "Microsoft.AAD", "Microsoft.AAD"),
+		resourceids.StaticSegment("staticDomainServices", "domainServices", "domainServices"),
+		resourceids.UserSpecifiedSegment("domainServiceName", "DomainService1"),
+		resourceids.StaticSegment("staticReplicaSets", "replicaSets", "replicaSets"),
+		resourceids.UserSpecifiedSegment("replicaSetName", "replicaSetID"),
+	}
+}
+
 // DomainServiceReplicaSetID parses a DomainServiceReplicaSet ID into an DomainServiceReplicaSetId struct
 func DomainServiceReplicaSetID(input string) (*DomainServiceReplicaSetId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func DomainServiceReplicaSetID(input string) (*DomainServiceReplicaSetId, error)
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the DomainServiceReplicaSetId using the ParseResult provided in 'input'
+func (id *DomainServiceReplicaSetId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DomainServiceName, ok = input.Parsed["domainServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "domainServiceName", input)
+	}
+
+	if id.ReplicaSetName, ok = input.Parsed["replicaSetName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "replicaSetName", input)
+	}
+
+	return nil
+}
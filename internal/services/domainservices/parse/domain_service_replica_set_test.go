@@ -21,6 +21,23 @@ func TestDomainServiceReplicaSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainServiceReplicaSetIDSegments(t *testing.T) {
+	expected := NewDomainServiceReplicaSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "DomainService1", "replicaSetID")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DomainServiceReplicaSetId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DomainServiceReplicaSetId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDomainServiceReplicaSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestDomainServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainServiceIDSegments(t *testing.T) {
+	expected := NewDomainServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "DomainService1", "replicaSetID")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DomainServiceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DomainServiceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDomainServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
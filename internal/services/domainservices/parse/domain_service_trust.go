@@ -43,6 +43,22 @@ func (id DomainServiceTrustId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DomainServiceName, id.TrustName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DomainServiceTrust ID
+func (id DomainServiceTrustId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAAD", "Microsoft.AAD", "Microsoft.AAD"),
+		resourceids.StaticSegment("staticDomainServices", "domainServices", "domainServices"),
+		resourceids.UserSpecifiedSegment("domainServiceName", "DomainService1"),
+		resourceids.StaticSegment("staticTrusts", "trusts", "trusts"),
+		resourceids.UserSpecifiedSegment("trustName", "trust1"),
+	}
+}
+
 // DomainServiceTrustID parses a DomainServiceTrust ID into an DomainServiceTrustId struct
 func DomainServiceTrustID(input string) (*DomainServiceTrustId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func DomainServiceTrustID(input string) (*DomainServiceTrustId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the DomainServiceTrustId using the ParseResult provided in 'input'
+func (id *DomainServiceTrustId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DomainServiceName, ok = input.Parsed["domainServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "domainServiceName", input)
+	}
+
+	if id.TrustName, ok = input.Parsed["trustName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "trustName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestDomainServiceTrustIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainServiceTrustIDSegments(t *testing.T) {
+	expected := NewDomainServiceTrustID("12345678-1234-9876-4563-123456789012", "resGroup1", "DomainService1", "trust1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DomainServiceTrustId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DomainServiceTrustId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDomainServiceTrustID(t *testing.T) {
 	testData := []struct {
 		Input    string
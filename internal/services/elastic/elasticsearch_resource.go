@@ -25,7 +25,7 @@ import (
 )
 
 func resourceElasticsearch() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceElasticsearchCreate,
 		Read:   resourceElasticsearchRead,
 		Update: resourceElasticsearchUpdate,
@@ -158,6 +158,10 @@ func resourceElasticsearch() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &monitorsresource.MonitorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &monitorsresource.MonitorId{})
 }
 
 func resourceElasticsearchCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/elasticsans"
@@ -58,6 +59,10 @@ func (r ElasticSANResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return elasticsans.ValidateElasticSanID
 }
 
+func (r ElasticSANResource) Identity() resourceids.ResourceId {
+	return &elasticsans.ElasticSanId{}
+}
+
 func (r ElasticSANResource) ResourceType() string {
 	return "azurerm_elastic_san"
 }
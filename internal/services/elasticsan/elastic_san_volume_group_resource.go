@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumegroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
@@ -25,6 +26,7 @@ import (
 var _ sdk.Resource = ElasticSANVolumeGroupResource{}
 var _ sdk.ResourceWithUpdate = ElasticSANVolumeGroupResource{}
 var _ sdk.ResourceWithCustomizeDiff = ElasticSANVolumeGroupResource{}
+var _ sdk.ResourceWithIdentity = ElasticSANVolumeGroupResource{}
 
 type ElasticSANVolumeGroupResource struct{}
 
@@ -32,6 +34,10 @@ func (r ElasticSANVolumeGroupResource) IDValidationFunc() pluginsdk.SchemaValida
 	return volumegroups.ValidateVolumeGroupID
 }
 
+func (r ElasticSANVolumeGroupResource) Identity() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
+
 func (r ElasticSANVolumeGroupResource) ResourceType() string {
 	return "azurerm_elastic_san_volume_group"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	diskSnapshots "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/restorepoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/snapshots"
@@ -54,6 +55,10 @@ func (r ElasticSANVolumeResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return volumes.ValidateVolumeID
 }
 
+func (r ElasticSANVolumeResource) Identity() resourceids.ResourceId {
+	return &volumes.VolumeId{}
+}
+
 func (r ElasticSANVolumeResource) ResourceType() string {
 	return "azurerm_elastic_san_volume"
 }
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceEventGridDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceEventGridDomainCreate,
 		Read:   resourceEventGridDomainRead,
 		Update: resourceEventGridDomainUpdate,
@@ -207,6 +207,10 @@ func resourceEventGridDomain() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &domains.DomainId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &domains.DomainId{})
 }
 
 func resourceEventGridDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceEventGridDomainTopic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventGridDomainTopicCreate,
 		Read:   resourceEventGridDomainTopicRead,
 		Delete: resourceEventGridDomainTopicDelete,
@@ -65,7 +65,7 @@ func resourceEventGridDomainTopic() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 		},
-	}
+	}, &domaintopics.DomainTopicId{})
 }
 
 func resourceEventGridDomainTopicCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -33,7 +33,7 @@ func possibleEventSubscriptionEndpointTypes() []string {
 }
 
 func resourceEventGridEventSubscription() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventGridEventSubscriptionCreateUpdate,
 		Read:   resourceEventGridEventSubscriptionRead,
 		Update: resourceEventGridEventSubscriptionCreateUpdate,
@@ -157,7 +157,7 @@ func resourceEventGridEventSubscription() *pluginsdk.Resource {
 
 			"delivery_property": eventSubscriptionSchemaDeliveryProperty(),
 		},
-	}
+	}, &eventsubscriptions.ScopedEventSubscriptionId{})
 }
 
 func resourceEventGridEventSubscriptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -33,7 +33,7 @@ func possibleSystemTopicEventSubscriptionEndpointTypes() []string {
 }
 
 func resourceEventGridSystemTopicEventSubscription() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventGridSystemTopicEventSubscriptionCreateUpdate,
 		Read:   resourceEventGridSystemTopicEventSubscriptionRead,
 		Update: resourceEventGridSystemTopicEventSubscriptionCreateUpdate,
@@ -139,7 +139,7 @@ func resourceEventGridSystemTopicEventSubscription() *pluginsdk.Resource {
 
 			"delivery_property": eventSubscriptionSchemaDeliveryProperty(),
 		},
-	}
+	}, &eventsubscriptions.SystemTopicEventSubscriptionId{})
 }
 
 func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
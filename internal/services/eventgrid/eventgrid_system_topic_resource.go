@@ -26,7 +26,7 @@ import (
 )
 
 func resourceEventGridSystemTopic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceEventGridSystemTopicCreateUpdate,
 		Read:   resourceEventGridSystemTopicRead,
 		Update: resourceEventGridSystemTopicCreateUpdate,
@@ -91,6 +91,10 @@ func resourceEventGridSystemTopic() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &systemtopics.SystemTopicId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &systemtopics.SystemTopicId{})
 }
 
 func resourceEventGridSystemTopicCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
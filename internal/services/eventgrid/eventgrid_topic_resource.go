@@ -25,7 +25,7 @@ import (
 )
 
 func resourceEventGridTopic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceEventGridTopicCreate,
 		Read:   resourceEventGridTopicRead,
 		Update: resourceEventGridTopicUpdate,
@@ -195,6 +195,10 @@ func resourceEventGridTopic() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &topics.TopicId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &topics.TopicId{})
 }
 
 func resourceEventGridTopicCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceEventHubAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventHubAuthorizationRuleCreateUpdate,
 		Read:   resourceEventHubAuthorizationRuleRead,
 		Update: resourceEventHubAuthorizationRuleCreateUpdate,
@@ -71,7 +71,7 @@ func resourceEventHubAuthorizationRule() *pluginsdk.Resource {
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubAuthorizationRuleCustomizeDiff),
-	}
+	}, &eventhubs.EventhubAuthorizationRuleId{})
 }
 
 func resourceEventHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
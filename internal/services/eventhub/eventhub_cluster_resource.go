@@ -27,7 +27,7 @@ import (
 )
 
 func resourceEventHubCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceEventHubClusterCreateUpdate,
 		Read:   resourceEventHubClusterRead,
 		Update: resourceEventHubClusterCreateUpdate,
@@ -72,6 +72,10 @@ func resourceEventHubCluster() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &eventhubsclusters.ClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &eventhubsclusters.ClusterId{})
 }
 
 func resourceEventHubClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
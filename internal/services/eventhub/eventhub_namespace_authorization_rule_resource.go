@@ -21,7 +21,7 @@ import (
 )
 
 func resourceEventHubNamespaceAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventHubNamespaceAuthorizationRuleCreateUpdate,
 		Read:   resourceEventHubNamespaceAuthorizationRuleRead,
 		Update: resourceEventHubNamespaceAuthorizationRuleCreateUpdate,
@@ -64,7 +64,7 @@ func resourceEventHubNamespaceAuthorizationRule() *pluginsdk.Resource {
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventHubAuthorizationRuleCustomizeDiff),
-	}
+	}, &authorizationrulesnamespaces.AuthorizationRuleId{})
 }
 
 func resourceEventHubNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceEventHubNamespaceCustomerManagedKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventHubNamespaceCustomerManagedKeyCreateUpdate,
 		Read:   resourceEventHubNamespaceCustomerManagedKeyRead,
 		Update: resourceEventHubNamespaceCustomerManagedKeyCreateUpdate,
@@ -93,7 +93,7 @@ func resourceEventHubNamespaceCustomerManagedKey() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 			},
 		},
-	}
+	}, &namespaces.NamespaceId{})
 }
 
 func resourceEventHubNamespaceCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceEventHubNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventHubNamespaceDisasterRecoveryConfigCreate,
 		Read:   resourceEventHubNamespaceDisasterRecoveryConfigRead,
 		Update: resourceEventHubNamespaceDisasterRecoveryConfigUpdate,
@@ -66,7 +66,7 @@ func resourceEventHubNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 		},
-	}
+	}, &disasterrecoveryconfigs.DisasterRecoveryConfigId{})
 }
 
 func resourceEventHubNamespaceDisasterRecoveryConfigCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -292,7 +292,9 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &namespaces.NamespaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &namespaces.NamespaceId{})
 }
 
 func resourceEventHubNamespaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceEventHubNamespaceSchemaRegistry() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventHubNamespaceSchemaRegistryCreate,
 		Read:   resourceEventHubNamespaceSchemaRegistryRead,
 		Delete: resourceEventHubNamespaceSchemaRegistryDelete,
@@ -72,7 +72,7 @@ func resourceEventHubNamespaceSchemaRegistry() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &schemaregistry.SchemaGroupId{})
 }
 
 func resourceEventHubNamespaceSchemaRegistryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
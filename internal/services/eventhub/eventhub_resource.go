@@ -25,7 +25,7 @@ import (
 var eventHubResourceName = "azurerm_eventhub"
 
 func resourceEventHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceEventHubCreate,
 		Read:   resourceEventHubRead,
 		Update: resourceEventHubUpdate,
@@ -162,7 +162,7 @@ func resourceEventHub() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &eventhubs.EventhubId{})
 }
 
 func resourceEventHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	arckubernetes "github.com/hashicorp/go-azure-sdk/resource-manager/hybridkubernetes/2021-10-01/connectedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
@@ -309,3 +310,7 @@ func (r CustomLocationResource) Update() sdk.ResourceFunc {
 func (r CustomLocationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return customlocations.ValidateCustomLocationID
 }
+
+func (r CustomLocationResource) Identity() resourceids.ResourceId {
+	return &customlocations.CustomLocationId{}
+}
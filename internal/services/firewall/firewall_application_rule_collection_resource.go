@@ -25,7 +25,7 @@ import (
 )
 
 func resourceFirewallApplicationRuleCollection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceFirewallApplicationRuleCollectionCreateUpdate,
 		Read:   resourceFirewallApplicationRuleCollectionRead,
 		Update: resourceFirewallApplicationRuleCollectionCreateUpdate,
@@ -136,7 +136,7 @@ func resourceFirewallApplicationRuleCollection() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FirewallApplicationRuleCollectionId{})
 }
 
 func resourceFirewallApplicationRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
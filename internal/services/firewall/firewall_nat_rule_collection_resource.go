@@ -24,7 +24,7 @@ import (
 )
 
 func resourceFirewallNatRuleCollection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceFirewallNatRuleCollectionCreateUpdate,
 		Read:   resourceFirewallNatRuleCollectionRead,
 		Update: resourceFirewallNatRuleCollectionCreateUpdate,
@@ -133,7 +133,7 @@ func resourceFirewallNatRuleCollection() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FirewallNatRuleCollectionId{})
 }
 
 func resourceFirewallNatRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceFirewallNetworkRuleCollection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceFirewallNetworkRuleCollectionCreateUpdate,
 		Read:   resourceFirewallNetworkRuleCollectionRead,
 		Update: resourceFirewallNetworkRuleCollectionCreateUpdate,
@@ -135,7 +135,7 @@ func resourceFirewallNetworkRuleCollection() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.FirewallNetworkRuleCollectionId{})
 }
 
 func resourceFirewallNetworkRuleCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
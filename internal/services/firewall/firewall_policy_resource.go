@@ -32,7 +32,7 @@ import (
 const AzureFirewallPolicyResourceName = "azurerm_firewall_policy"
 
 func resourceFirewallPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFirewallPolicyCreateUpdate,
 		Read:   resourceFirewallPolicyRead,
 		Update: resourceFirewallPolicyCreateUpdate,
@@ -52,6 +52,10 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 
 		Schema: resourceFirewallPolicySchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &firewallpolicies.FirewallPolicyId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &firewallpolicies.FirewallPolicyId{})
 }
 
 func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
 		Read:   resourceFirewallPolicyRuleCollectionGroupRead,
 		Update: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
@@ -451,7 +451,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &firewallpolicyrulecollectiongroups.RuleCollectionGroupId{})
 }
 
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
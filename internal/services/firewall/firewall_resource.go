@@ -227,7 +227,9 @@ func resourceFirewall() *pluginsdk.Resource {
 		},
 	}
 
-	return &resource
+	pluginsdk.EnableLocationValidation(&resource, &azurefirewalls.AzureFirewallId{})
+
+	return pluginsdk.WithResourceIdentity(&resource, &azurefirewalls.AzureFirewallId{})
 }
 
 func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -43,6 +43,22 @@ func (id FirewallApplicationRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.ApplicationRuleCollectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FirewallApplicationRuleCollection ID
+func (id FirewallApplicationRuleCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "mygroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticAzureFirewalls", "azureFirewalls", "azureFirewalls"),
+		resourceids.UserSpecifiedSegment("azureFirewallName", "myfirewall"),
+		resourceids.StaticSegment("staticApplicationRuleCollections", "applicationRuleCollections", "applicationRuleCollections"),
+		resourceids.UserSpecifiedSegment("applicationRuleCollectionName", "applicationRuleCollection1"),
+	}
+}
+
 // FirewallApplicationRuleCollectionID parses a FirewallApplicationRuleCollection ID into an FirewallApplicationRuleCollectionId struct
 func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRule
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FirewallApplicationRuleCollectionId using the ParseResult provided in 'input'
+func (id *FirewallApplicationRuleCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.AzureFirewallName, ok = input.Parsed["azureFirewallName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "azureFirewallName", input)
+	}
+
+	if id.ApplicationRuleCollectionName, ok = input.Parsed["applicationRuleCollectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationRuleCollectionName", input)
+	}
+
+	return nil
+}
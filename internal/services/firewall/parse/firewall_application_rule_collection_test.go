@@ -21,6 +21,23 @@ func TestFirewallApplicationRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallApplicationRuleCollectionIDSegments(t *testing.T) {
+	expected := NewFirewallApplicationRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "applicationRuleCollection1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FirewallApplicationRuleCollectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FirewallApplicationRuleCollectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFirewallApplicationRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
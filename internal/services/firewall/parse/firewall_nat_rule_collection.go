@@ -43,6 +43,22 @@ func (id FirewallNatRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NatRuleCollectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FirewallNatRuleCollection ID
+func (id FirewallNatRuleCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "mygroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticAzureFirewalls", "azureFirewalls", "azureFirewalls"),
+		resourceids.UserSpecifiedSegment("azureFirewallName", "myfirewall"),
+		resourceids.StaticSegment("staticNatRuleCollections", "natRuleCollections", "natRuleCollections"),
+		resourceids.UserSpecifiedSegment("natRuleCollectionName", "natRuleCollection1"),
+	}
+}
+
 // FirewallNatRuleCollectionID parses a FirewallNatRuleCollection ID into an FirewallNatRuleCollectionId struct
 func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, er
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FirewallNatRuleCollectionId using the ParseResult provided in 'input'
+func (id *FirewallNatRuleCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.AzureFirewallName, ok = input.Parsed["azureFirewallName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "azureFirewallName", input)
+	}
+
+	if id.NatRuleCollectionName, ok = input.Parsed["natRuleCollectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "natRuleCollectionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestFirewallNatRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallNatRuleCollectionIDSegments(t *testing.T) {
+	expected := NewFirewallNatRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "natRuleCollection1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FirewallNatRuleCollectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FirewallNatRuleCollectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFirewallNatRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
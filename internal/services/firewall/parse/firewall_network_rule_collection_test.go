@@ -21,6 +21,23 @@ func TestFirewallNetworkRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallNetworkRuleCollectionIDSegments(t *testing.T) {
+	expected := NewFirewallNetworkRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "networkRuleCollection1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FirewallNetworkRuleCollectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FirewallNetworkRuleCollectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFirewallNetworkRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceFrontDoorCustomHTTPSConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceFrontDoorCustomHTTPSConfigurationCreateUpdate,
 		Read:   resourceFrontDoorCustomHTTPSConfigurationRead,
 		Update: resourceFrontDoorCustomHTTPSConfigurationCreateUpdate,
@@ -91,7 +91,7 @@ func resourceFrontDoorCustomHTTPSConfiguration() *pluginsdk.Resource {
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.CustomHttpsConfigurationV0ToV1{},
 		}),
-	}
+	}, &parse.CustomHttpsConfigurationId{})
 }
 
 func resourceFrontDoorCustomHTTPSConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
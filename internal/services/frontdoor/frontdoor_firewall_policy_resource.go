@@ -25,7 +25,7 @@ import (
 )
 
 func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFrontDoorFirewallPolicyCreateUpdate,
 		Read:   resourceFrontDoorFirewallPolicyRead,
 		Update: resourceFrontDoorFirewallPolicyCreateUpdate,
@@ -429,6 +429,10 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.WebApplicationFirewallPolicyId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.WebApplicationFirewallPolicyId{})
 }
 
 func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
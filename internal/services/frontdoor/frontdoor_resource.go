@@ -28,7 +28,7 @@ import (
 )
 
 func resourceFrontDoor() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFrontDoorCreate,
 		Read:   resourceFrontDoorRead,
 		Update: resourceFrontDoorUpdate,
@@ -56,6 +56,10 @@ func resourceFrontDoor() *pluginsdk.Resource {
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(frontDoorCustomizeDiff),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &frontdoors.FrontDoorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &frontdoors.FrontDoorId{})
 }
 
 func resourceFrontDoorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
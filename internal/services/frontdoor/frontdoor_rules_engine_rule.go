@@ -22,7 +22,7 @@ import (
 )
 
 func resourceFrontDoorRulesEngine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFrontDoorRulesEngineCreateUpdate,
 		Read:   resourceFrontDoorRulesEngineRead,
 		Update: resourceFrontDoorRulesEngineCreateUpdate,
@@ -245,6 +245,10 @@ func resourceFrontDoorRulesEngine() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.RulesEngineId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.RulesEngineId{})
 }
 
 func resourceFrontDoorRulesEngineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -43,6 +43,22 @@ func (id BackendPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BackendPool ID
+func (id BackendPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticFrontDoors", "frontDoors", "frontDoors"),
+		resourceids.UserSpecifiedSegment("frontDoorName", "frontdoor1"),
+		resourceids.StaticSegment("staticBackendPools", "backendPools", "backendPools"),
+		resourceids.UserSpecifiedSegment("name", "pool1"),
+	}
+}
+
 // BackendPoolID parses a BackendPool ID into an BackendPoolId struct
 func BackendPoolID(input string) (*BackendPoolId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func BackendPoolIDInsensitively(input string) (*BackendPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the BackendPoolId using the ParseResult provided in 'input'
+func (id *BackendPoolId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FrontDoorName, ok = input.Parsed["frontDoorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "frontDoorName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
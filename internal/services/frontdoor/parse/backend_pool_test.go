@@ -21,6 +21,23 @@ func TestBackendPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendPoolIDSegments(t *testing.T) {
+	expected := NewBackendPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "pool1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BackendPoolId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BackendPoolId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBackendPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestCustomHttpsConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCustomHttpsConfigurationIDSegments(t *testing.T) {
+	expected := NewCustomHttpsConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "endpoint1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CustomHttpsConfigurationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CustomHttpsConfigurationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCustomHttpsConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,20 @@ func (id FrontDoorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FrontDoor ID
+func (id FrontDoorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticFrontDoors", "frontDoors", "frontDoors"),
+		resourceids.UserSpecifiedSegment("name", "frontdoor1"),
+	}
+}
+
 // FrontDoorID parses a FrontDoor ID into an FrontDoorId struct
 func FrontDoorID(input string) (*FrontDoorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -114,3 +128,22 @@ func FrontDoorIDInsensitively(input string) (*FrontDoorId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FrontDoorId using the ParseResult provided in 'input'
+func (id *FrontDoorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
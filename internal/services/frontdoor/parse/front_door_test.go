@@ -21,6 +21,23 @@ func TestFrontDoorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorIDSegments(t *testing.T) {
+	expected := NewFrontDoorID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontDoorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontDoorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontDoorID(t *testing.T) {
 	testData := []struct {
 		Input    string
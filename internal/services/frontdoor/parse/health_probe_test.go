@@ -21,6 +21,23 @@ func TestHealthProbeIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHealthProbeIDSegments(t *testing.T) {
+	expected := NewHealthProbeID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "probe1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HealthProbeId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HealthProbeId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHealthProbeID(t *testing.T) {
 	testData := []struct {
 		Input    string
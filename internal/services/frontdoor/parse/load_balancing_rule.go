@@ -43,6 +43,22 @@ func (id LoadBalancingRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LoadBalancingRule ID
+func (id LoadBalancingRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticLoadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancer1"),
+		resourceids.StaticSegment("staticLoadBalancingRules", "loadBalancingRules", "loadBalancingRules"),
+		resourceids.UserSpecifiedSegment("name", "rule1"),
+	}
+}
+
 // LoadBalancingRuleID parses a LoadBalancingRule ID into an LoadBalancingRuleId struct
 func LoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func LoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the LoadBalancingRuleId using the ParseResult provided in 'input'
+func (id *LoadBalancingRuleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadBalancerName, ok = input.Parsed["loadBalancerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancerName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestLoadBalancingRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancingRuleIDSegments(t *testing.T) {
+	expected := NewLoadBalancingRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadBalancer1", "rule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&LoadBalancingRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := LoadBalancingRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestLoadBalancingRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestLoadBalancingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancingIDSegments(t *testing.T) {
+	expected := NewLoadBalancingID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "setting1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&LoadBalancingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := LoadBalancingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestLoadBalancingID(t *testing.T) {
 	testData := []struct {
 		Input    string
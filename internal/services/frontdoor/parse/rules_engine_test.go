@@ -21,6 +21,23 @@ func TestRulesEngineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRulesEngineIDSegments(t *testing.T) {
+	expected := NewRulesEngineID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "rule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&RulesEngineId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := RulesEngineId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestRulesEngineID(t *testing.T) {
 	testData := []struct {
 		Input    string
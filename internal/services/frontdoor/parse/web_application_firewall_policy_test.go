@@ -21,6 +21,23 @@ func TestWebApplicationFirewallPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWebApplicationFirewallPolicyIDSegments(t *testing.T) {
+	expected := NewWebApplicationFirewallPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WebApplicationFirewallPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WebApplicationFirewallPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWebApplicationFirewallPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -58,7 +58,7 @@ var hdInsightHadoopClusterZookeeperNodeDefinition = HDInsightNodeDefinition{
 }
 
 func resourceHDInsightHadoopCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHDInsightHadoopClusterCreate,
 		Read:   resourceHDInsightHadoopClusterRead,
 		Update: hdinsightClusterUpdate("Hadoop", resourceHDInsightHadoopClusterRead),
@@ -204,6 +204,10 @@ func resourceHDInsightHadoopCluster() *pluginsdk.Resource {
 			"extension": SchemaHDInsightsExtension(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.HDInsightClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.HDInsightClusterId{})
 }
 
 func resourceHDInsightHadoopClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -49,7 +49,7 @@ var hdInsightHBaseClusterZookeeperNodeDefinition = HDInsightNodeDefinition{
 }
 
 func resourceHDInsightHBaseCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHDInsightHBaseClusterCreate,
 		Read:   resourceHDInsightHBaseClusterRead,
 		Update: hdinsightClusterUpdate("HBase", resourceHDInsightHBaseClusterRead),
@@ -145,6 +145,10 @@ func resourceHDInsightHBaseCluster() *pluginsdk.Resource {
 			"extension": SchemaHDInsightsExtension(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.HDInsightClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.HDInsightClusterId{})
 }
 
 func resourceHDInsightHBaseClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -172,7 +172,9 @@ func resourceHDInsightInteractiveQueryCluster() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &commonids.HDInsightClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.HDInsightClusterId{})
 }
 
 func resourceHDInsightInteractiveQueryClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
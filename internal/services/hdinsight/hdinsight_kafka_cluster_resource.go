@@ -213,7 +213,9 @@ func resourceHDInsightKafkaCluster() *pluginsdk.Resource {
 		resource.Schema["roles"].Elem.(*pluginsdk.Resource).Schema["kafka_management_node"].Elem.(*pluginsdk.Resource).Schema["username"].Deprecated = "`username` will become Computed only in version 4.0 of the AzureRM Provider as the service auto-generates a value for this property"
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &commonids.HDInsightClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.HDInsightClusterId{})
 }
 
 func resourceHDInsightKafkaClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -50,7 +50,7 @@ var hdInsightSparkClusterZookeeperNodeDefinition = HDInsightNodeDefinition{
 }
 
 func resourceHDInsightSparkCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHDInsightSparkClusterCreate,
 		Read:   resourceHDInsightSparkClusterRead,
 		Update: hdinsightClusterUpdate("Spark", resourceHDInsightSparkClusterRead),
@@ -152,6 +152,10 @@ func resourceHDInsightSparkCluster() *pluginsdk.Resource {
 			"extension": SchemaHDInsightsExtension(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.HDInsightClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.HDInsightClusterId{})
 }
 
 func resourceHDInsightSparkClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
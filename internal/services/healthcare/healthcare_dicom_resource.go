@@ -26,7 +26,7 @@ import (
 )
 
 func resourceHealthcareApisDicomService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthcareApisDicomServiceCreate,
 		Read:   resourceHealthcareApisDicomServiceRead,
 		Update: resourceHealthcareApisDicomServiceUpdate,
@@ -118,6 +118,10 @@ func resourceHealthcareApisDicomService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dicomservices.DicomServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dicomservices.DicomServiceId{})
 }
 
 func resourceHealthcareApisDicomServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -28,7 +28,7 @@ import (
 )
 
 func resourceHealthcareApisFhirService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthcareApisFhirServiceCreate,
 		Read:   resourceHealthcareApisFhirServiceRead,
 		Update: resourceHealthcareApisFhirServiceUpdate,
@@ -227,6 +227,10 @@ func resourceHealthcareApisFhirService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &fhirservices.FhirServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &fhirservices.FhirServiceId{})
 }
 
 func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
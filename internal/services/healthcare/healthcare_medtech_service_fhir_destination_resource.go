@@ -27,7 +27,7 @@ import (
 )
 
 func resourceHealthcareApisMedTechServiceFhirDestination() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthcareApisMedTechServiceFhirDestinationCreate,
 		Read:   resourceHealthcareApisMedTechServiceFhirDestinationRead,
 		Update: resourceHealthcareApisMedTechServiceFhirDestinationUpdate,
@@ -90,6 +90,10 @@ func resourceHealthcareApisMedTechServiceFhirDestination() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &iotconnectors.FhirDestinationId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &iotconnectors.FhirDestinationId{})
 }
 
 func resourceHealthcareApisMedTechServiceFhirDestinationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -30,7 +30,7 @@ import (
 )
 
 func resourceHealthcareApisMedTechService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthcareApisMedTechServiceCreate,
 		Read:   resourceHealthcareApisMedTechServiceRead,
 		Update: resourceHealthcareApisMedTechServiceUpdate,
@@ -100,6 +100,10 @@ func resourceHealthcareApisMedTechService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &iotconnectors.IotConnectorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &iotconnectors.IotConnectorId{})
 }
 
 func resourceHealthcareApisMedTechServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceHealthcareService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthcareServiceCreateUpdate,
 		Read:   resourceHealthcareServiceRead,
 		Update: resourceHealthcareServiceCreateUpdate,
@@ -216,6 +216,10 @@ func resourceHealthcareService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &service.ServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &service.ServiceId{})
 }
 
 func resourceHealthcareServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
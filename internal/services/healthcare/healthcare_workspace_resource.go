@@ -21,7 +21,7 @@ import (
 )
 
 func resourceHealthcareApisWorkspace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHealthcareApisWorkspaceCreate,
 		Read:   resourceHealthcareApisWorkspaceRead,
 		Update: resourceHealthcareApisWorkspaceUpdate,
@@ -72,6 +72,10 @@ func resourceHealthcareApisWorkspace() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &workspaces.WorkspaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &workspaces.WorkspaceId{})
 }
 
 func resourceHealthcareApisWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
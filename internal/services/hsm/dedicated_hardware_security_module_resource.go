@@ -26,7 +26,7 @@ import (
 )
 
 func resourceDedicatedHardwareSecurityModule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceDedicatedHardwareSecurityModuleCreate,
 		Read:   resourceDedicatedHardwareSecurityModuleRead,
 		Update: resourceDedicatedHardwareSecurityModuleUpdate,
@@ -138,6 +138,10 @@ func resourceDedicatedHardwareSecurityModule() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dedicatedhsms.DedicatedHSMId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dedicatedhsms.DedicatedHSMId{})
 }
 
 func resourceDedicatedHardwareSecurityModuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +39,7 @@ type MachineExtensionModel struct {
 type ArcMachineExtensionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ArcMachineExtensionResource{}
+var _ sdk.ResourceWithIdentity = ArcMachineExtensionResource{}
 
 func (r ArcMachineExtensionResource) ResourceType() string {
 	return "azurerm_arc_machine_extension"
@@ -51,6 +53,10 @@ func (r ArcMachineExtensionResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return machineextensions.ValidateExtensionID
 }
 
+func (r ArcMachineExtensionResource) Identity() resourceids.ResourceId {
+	return &machineextensions.ExtensionId{}
+}
+
 func (r ArcMachineExtensionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -28,6 +29,7 @@ type PrivateLinkScopeModel struct {
 }
 
 var _ sdk.Resource = ArcPrivateLinkScopeResource{}
+var _ sdk.ResourceWithIdentity = ArcPrivateLinkScopeResource{}
 
 // ArcPrivateLinkScopeResource is a Resource implementation for the Azure Arc Private Link Scope resource
 type ArcPrivateLinkScopeResource struct{}
@@ -220,3 +222,7 @@ func (a ArcPrivateLinkScopeResource) Delete() sdk.ResourceFunc {
 func (a ArcPrivateLinkScopeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return privatelinkscopes.ValidateProviderPrivateLinkScopeID
 }
+
+func (a ArcPrivateLinkScopeResource) Identity() resourceids.ResourceId {
+	return &privatelinkscopes.ProviderPrivateLinkScopeId{}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -100,6 +101,10 @@ func (r IotCentralApplicationNetworkRuleSetResource) IDValidationFunc() pluginsd
 	return apps.ValidateIotAppID
 }
 
+func (r IotCentralApplicationNetworkRuleSetResource) Identity() resourceids.ResourceId {
+	return &apps.IotAppId{}
+}
+
 func (r IotCentralApplicationNetworkRuleSetResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -115,7 +115,9 @@ func resourceIotCentralApplication() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &apps.IotAppId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &apps.IotAppId{})
 }
 
 func resourceIotCentralAppCreate(d *pluginsdk.ResourceData, meta interface{}) error {
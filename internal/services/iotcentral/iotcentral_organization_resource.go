@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
@@ -72,6 +73,10 @@ func (r IotCentralOrganizationResource) IDValidationFunc() pluginsdk.SchemaValid
 	return validate.OrganizationID
 }
 
+func (r IotCentralOrganizationResource) Identity() resourceids.ResourceId {
+	return &parse.OrganizationId{}
+}
+
 func (r IotCentralOrganizationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -21,6 +21,23 @@ func TestOrganizationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOrganizationIDSegments(t *testing.T) {
+	expected := NewOrganizationID("12345678-1234-9876-4563-123456789012", "resGroup1", "application1", "organization1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&OrganizationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := OrganizationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestOrganizationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceIotHubCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubCertificateCreate,
 		Read:   resourceIotHubCertificateRead,
 		Update: resourceIotHubCertificateUpdate,
@@ -74,7 +74,7 @@ func resourceIotHubCertificate() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &parse.IotHubCertificateId{})
 }
 
 func resourceIotHubCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceIotHubConsumerGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubConsumerGroupCreate,
 		Read:   resourceIotHubConsumerGroupRead,
 		Delete: resourceIotHubConsumerGroupDelete,
@@ -66,7 +66,7 @@ func resourceIotHubConsumerGroup() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 		},
-	}
+	}, &parse.ConsumerGroupId{})
 }
 
 func resourceIotHubConsumerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
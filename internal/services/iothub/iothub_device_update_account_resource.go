@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
@@ -22,6 +23,7 @@ import (
 type IotHubDeviceUpdateAccountResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotHubDeviceUpdateAccountResource{}
+var _ sdk.ResourceWithIdentity = IotHubDeviceUpdateAccountResource{}
 
 type IotHubDeviceUpdateAccountModel struct {
 	Name                       string            `tfschema:"name"`
@@ -90,6 +92,10 @@ func (r IotHubDeviceUpdateAccountResource) IDValidationFunc() pluginsdk.SchemaVa
 	return deviceupdates.ValidateAccountID
 }
 
+func (r IotHubDeviceUpdateAccountResource) Identity() resourceids.ResourceId {
+	return &deviceupdates.AccountId{}
+}
+
 func (r IotHubDeviceUpdateAccountResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
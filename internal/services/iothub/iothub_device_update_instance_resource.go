@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
@@ -110,6 +111,10 @@ func (r IotHubDeviceUpdateInstanceResource) IDValidationFunc() pluginsdk.SchemaV
 	return deviceupdates.ValidateInstanceID
 }
 
+func (r IotHubDeviceUpdateInstanceResource) Identity() resourceids.ResourceId {
+	return &deviceupdates.InstanceId{}
+}
+
 func (r IotHubDeviceUpdateInstanceResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
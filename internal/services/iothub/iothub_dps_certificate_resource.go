@@ -20,7 +20,7 @@ import (
 )
 
 func resourceIotHubDPSCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubDPSCertificateCreate,
 		Read:   resourceIotHubDPSCertificateRead,
 		Update: resourceIotHubDPSCertificateUpdate,
@@ -69,7 +69,7 @@ func resourceIotHubDPSCertificate() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &dpscertificate.CertificateId{})
 }
 
 func resourceIotHubDPSCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
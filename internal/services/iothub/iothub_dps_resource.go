@@ -30,7 +30,7 @@ import (
 )
 
 func resourceIotHubDPS() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIotHubDPSCreate,
 		Read:   resourceIotHubDPSRead,
 		Update: resourceIotHubDPSUpdate,
@@ -203,6 +203,10 @@ func resourceIotHubDPS() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.ProvisioningServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.ProvisioningServiceId{})
 }
 
 func resourceIotHubDPSCreate(d *pluginsdk.ResourceData, meta interface{}) error {
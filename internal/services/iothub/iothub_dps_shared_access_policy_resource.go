@@ -23,7 +23,7 @@ import (
 )
 
 func resourceIotHubDPSSharedAccessPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubDPSSharedAccessPolicyCreateUpdate,
 		Read:   resourceIotHubDPSSharedAccessPolicyRead,
 		Update: resourceIotHubDPSSharedAccessPolicyCreateUpdate,
@@ -112,7 +112,7 @@ func resourceIotHubDPSSharedAccessPolicy() *pluginsdk.Resource {
 				Computed:  true,
 			},
 		},
-	}
+	}, &iotdpsresource.KeyId{})
 }
 
 func resourceIotHubDPSSharedAccessPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -150,6 +151,10 @@ func (r IotHubEndpointCosmosDBAccountResource) IDValidationFunc() pluginsdk.Sche
 	return validate.EndpointCosmosDBAccountID
 }
 
+func (r IotHubEndpointCosmosDBAccountResource) Identity() resourceids.ResourceId {
+	return &parse.EndpointCosmosDBAccountId{}
+}
+
 func (r IotHubEndpointCosmosDBAccountResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
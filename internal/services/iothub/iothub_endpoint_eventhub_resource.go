@@ -26,7 +26,7 @@ import (
 )
 
 func resourceIotHubEndpointEventHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubEndpointEventHubCreateUpdate,
 		Read:   resourceIotHubEndpointEventHubRead,
 		Update: resourceIotHubEndpointEventHubCreateUpdate,
@@ -50,7 +50,7 @@ func resourceIotHubEndpointEventHub() *pluginsdk.Resource {
 		},
 
 		Schema: resourceIothubEndpointEventHubSchema(),
-	}
+	}, &parse.EndpointEventhubId{})
 }
 
 func resourceIothubEndpointEventHubSchema() map[string]*pluginsdk.Schema {
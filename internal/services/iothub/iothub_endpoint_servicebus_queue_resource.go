@@ -26,7 +26,7 @@ import (
 )
 
 func resourceIotHubEndpointServiceBusQueue() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubEndpointServiceBusQueueCreateUpdate,
 		Read:   resourceIotHubEndpointServiceBusQueueRead,
 		Update: resourceIotHubEndpointServiceBusQueueCreateUpdate,
@@ -50,7 +50,7 @@ func resourceIotHubEndpointServiceBusQueue() *pluginsdk.Resource {
 		},
 
 		Schema: resourceIothubEndpointServicebusQueue(),
-	}
+	}, &parse.EndpointServiceBusQueueId{})
 }
 
 func resourceIothubEndpointServicebusQueue() map[string]*pluginsdk.Schema {
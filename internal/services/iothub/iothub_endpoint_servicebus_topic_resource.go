@@ -26,7 +26,7 @@ import (
 )
 
 func resourceIotHubEndpointServiceBusTopic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubEndpointServiceBusTopicCreateUpdate,
 		Read:   resourceIotHubEndpointServiceBusTopicRead,
 		Update: resourceIotHubEndpointServiceBusTopicCreateUpdate,
@@ -50,7 +50,7 @@ func resourceIotHubEndpointServiceBusTopic() *pluginsdk.Resource {
 		},
 
 		Schema: resourceIothubEndpointServicebusTopicSchema(),
-	}
+	}, &parse.EndpointServiceBusTopicId{})
 }
 
 func resourceIothubEndpointServicebusTopicSchema() map[string]*pluginsdk.Schema {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubEndpointStorageContainerCreateUpdate,
 		Read:   resourceIotHubEndpointStorageContainerRead,
 		Update: resourceIotHubEndpointStorageContainerCreateUpdate,
@@ -51,7 +51,7 @@ func resourceIotHubEndpointStorageContainer() *pluginsdk.Resource {
 		},
 
 		Schema: resourceIothubEndpointStorageContainerSchema(),
-	}
+	}, &parse.EndpointStorageContainerId{})
 }
 
 func resourceIothubEndpointStorageContainerSchema() map[string]*pluginsdk.Schema {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceIotHubEnrichment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmIotHubEnrichmentCreateUpdate,
 		Read:   resourceArmIotHubEnrichmentRead,
 		Update: resourceArmIotHubEnrichmentCreateUpdate,
@@ -84,7 +84,7 @@ func resourceIotHubEnrichment() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.EnrichmentId{})
 }
 
 func resourceArmIotHubEnrichmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceIotHubFallbackRoute() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubFallbackRouteCreateUpdate,
 		Read:   resourceIotHubFallbackRouteRead,
 		Update: resourceIotHubFallbackRouteCreateUpdate,
@@ -93,7 +93,7 @@ func resourceIotHubFallbackRoute() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.FallbackRouteId{})
 }
 
 func resourceIotHubFallbackRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -131,6 +132,10 @@ func (r IotHubFileUploadResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return validate.IotHubID
 }
 
+func (r IotHubFileUploadResource) Identity() resourceids.ResourceId {
+	return &parse.IotHubId{}
+}
+
 func (r IotHubFileUploadResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -71,7 +71,7 @@ func suppressWhenAny(fs ...pluginsdk.SchemaDiffSuppressFunc) pluginsdk.SchemaDif
 }
 
 func resourceIotHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIotHubCreate,
 		Read:   resourceIotHubRead,
 		Update: resourceIotHubUpdate,
@@ -670,6 +670,10 @@ func resourceIotHub() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.IotHubId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.IotHubId{})
 }
 
 func resourceIotHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceIotHubRoute() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubRouteCreateUpdate,
 		Read:   resourceIotHubRouteRead,
 		Update: resourceIotHubRouteCreateUpdate,
@@ -102,7 +102,7 @@ func resourceIotHubRoute() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.RouteId{})
 }
 
 func resourceIotHubRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceIotHubSharedAccessPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotHubSharedAccessPolicyCreateUpdate,
 		Read:   resourceIotHubSharedAccessPolicyRead,
 		Update: resourceIotHubSharedAccessPolicyCreateUpdate,
@@ -114,7 +114,7 @@ func resourceIotHubSharedAccessPolicy() *pluginsdk.Resource {
 			},
 		},
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(iothubSharedAccessPolicyCustomizeDiff),
-	}
+	}, &parse.SharedAccessPolicyId{})
 }
 
 func iothubSharedAccessPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) (err error) {
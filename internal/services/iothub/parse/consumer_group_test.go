@@ -21,6 +21,23 @@ func TestConsumerGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestConsumerGroupIDSegments(t *testing.T) {
+	expected := NewConsumerGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "events", "group1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ConsumerGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ConsumerGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestConsumerGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestEndpointCosmosDBAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointCosmosDBAccountIDSegments(t *testing.T) {
+	expected := NewEndpointCosmosDBAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "cosmosDBAccountEndpoint1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&EndpointCosmosDBAccountId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := EndpointCosmosDBAccountId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestEndpointCosmosDBAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
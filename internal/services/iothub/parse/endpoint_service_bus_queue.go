@@ -43,6 +43,22 @@ func (id EndpointServiceBusQueueId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.EndpointName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this EndpointServiceBusQueue ID
+func (id EndpointServiceBusQueueId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticIotHubs", "iotHubs", "iotHubs"),
+		resourceids.UserSpecifiedSegment("iotHubName", "hub1"),
+		resourceids.StaticSegment("staticEndpoints", "endpoints", "endpoints"),
+		resourceids.UserSpecifiedSegment("endpointName", "serviceBusQueueEndpoint1"),
+	}
+}
+
 // EndpointServiceBusQueueID parses a EndpointServiceBusQueue ID into an EndpointServiceBusQueueId struct
 func EndpointServiceBusQueueID(input string) (*EndpointServiceBusQueueId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func EndpointServiceBusQueueIDInsensitively(input string) (*EndpointServiceBusQu
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the EndpointServiceBusQueueId using the ParseResult provided in 'input'
+func (id *EndpointServiceBusQueueId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.IotHubName, ok = input.Parsed["iotHubName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotHubName", input)
+	}
+
+	if id.EndpointName, ok = input.Parsed["endpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "endpointName", input)
+	}
+
+	return nil
+}
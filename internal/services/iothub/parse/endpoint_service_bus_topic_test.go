@@ -21,6 +21,23 @@ func TestEndpointServiceBusTopicIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointServiceBusTopicIDSegments(t *testing.T) {
+	expected := NewEndpointServiceBusTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "serviceBusTopicEndpoint1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&EndpointServiceBusTopicId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := EndpointServiceBusTopicId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestEndpointServiceBusTopicID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestEndpointStorageContainerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointStorageContainerIDSegments(t *testing.T) {
+	expected := NewEndpointStorageContainerID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "storageContainerEndpoint1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&EndpointStorageContainerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := EndpointStorageContainerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestEndpointStorageContainerID(t *testing.T) {
 	testData := []struct {
 		Input    string
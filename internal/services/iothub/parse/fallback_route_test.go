@@ -21,6 +21,23 @@ func TestFallbackRouteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFallbackRouteIDSegments(t *testing.T) {
+	expected := NewFallbackRouteID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FallbackRouteId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FallbackRouteId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFallbackRouteID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id IotHubCertificateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.CertificateName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this IotHubCertificate ID
+func (id IotHubCertificateId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticIotHubs", "iotHubs", "iotHubs"),
+		resourceids.UserSpecifiedSegment("iotHubName", "hub1"),
+		resourceids.StaticSegment("staticCertificates", "certificates", "certificates"),
+		resourceids.UserSpecifiedSegment("certificateName", "cert1"),
+	}
+}
+
 // IotHubCertificateID parses a IotHubCertificate ID into an IotHubCertificateId struct
 func IotHubCertificateID(input string) (*IotHubCertificateId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func IotHubCertificateIDInsensitively(input string) (*IotHubCertificateId, error
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the IotHubCertificateId using the ParseResult provided in 'input'
+func (id *IotHubCertificateId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.IotHubName, ok = input.Parsed["iotHubName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotHubName", input)
+	}
+
+	if id.CertificateName, ok = input.Parsed["certificateName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "certificateName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestIotHubCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIotHubCertificateIDSegments(t *testing.T) {
+	expected := NewIotHubCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "cert1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&IotHubCertificateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := IotHubCertificateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestIotHubCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
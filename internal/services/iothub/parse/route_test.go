@@ -21,6 +21,23 @@ func TestRouteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRouteIDSegments(t *testing.T) {
+	expected := NewRouteID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "route1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&RouteId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := RouteId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestRouteID(t *testing.T) {
 	testData := []struct {
 		Input    string
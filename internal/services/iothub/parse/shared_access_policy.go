@@ -43,6 +43,22 @@ func (id SharedAccessPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.IotHubKeyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SharedAccessPolicy ID
+func (id SharedAccessPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices", "Microsoft.Devices"),
+		resourceids.StaticSegment("staticIotHubs", "iotHubs", "iotHubs"),
+		resourceids.UserSpecifiedSegment("iotHubName", "hub1"),
+		resourceids.StaticSegment("staticIotHubKeys", "iotHubKeys", "iotHubKeys"),
+		resourceids.UserSpecifiedSegment("iotHubKeyName", "sharedAccessPolicy1"),
+	}
+}
+
 // SharedAccessPolicyID parses a SharedAccessPolicy ID into an SharedAccessPolicyId struct
 func SharedAccessPolicyID(input string) (*SharedAccessPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SharedAccessPolicyIDInsensitively(input string) (*SharedAccessPolicyId, err
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SharedAccessPolicyId using the ParseResult provided in 'input'
+func (id *SharedAccessPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.IotHubName, ok = input.Parsed["iotHubName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotHubName", input)
+	}
+
+	if id.IotHubKeyName, ok = input.Parsed["iotHubKeyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "iotHubKeyName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSharedAccessPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSharedAccessPolicyIDSegments(t *testing.T) {
+	expected := NewSharedAccessPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "sharedAccessPolicy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SharedAccessPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SharedAccessPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSharedAccessPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceIoTTimeSeriesInsightsAccessPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIoTTimeSeriesInsightsAccessPolicyCreateUpdate,
 		Read:   resourceIoTTimeSeriesInsightsAccessPolicyRead,
 		Update: resourceIoTTimeSeriesInsightsAccessPolicyCreateUpdate,
@@ -88,7 +88,7 @@ func resourceIoTTimeSeriesInsightsAccessPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &accesspolicies.AccessPolicyId{})
 }
 
 func resourceIoTTimeSeriesInsightsAccessPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
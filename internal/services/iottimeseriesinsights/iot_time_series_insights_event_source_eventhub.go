@@ -24,7 +24,7 @@ import (
 )
 
 func resourceIoTTimeSeriesInsightsEventSourceEventhub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIoTTimeSeriesInsightsEventSourceEventhubCreateUpdate,
 		Read:   resourceIoTTimeSeriesInsightsEventSourceEventhubRead,
 		Update: resourceIoTTimeSeriesInsightsEventSourceEventhubCreateUpdate,
@@ -109,6 +109,10 @@ func resourceIoTTimeSeriesInsightsEventSourceEventhub() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &eventsources.EventSourceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &eventsources.EventSourceId{})
 }
 
 func resourceIoTTimeSeriesInsightsEventSourceEventhubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceIoTTimeSeriesInsightsEventSourceIoTHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate,
 		Read:   resourceIoTTimeSeriesInsightsEventSourceIoTHubRead,
 		Update: resourceIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate,
@@ -104,6 +104,10 @@ func resourceIoTTimeSeriesInsightsEventSourceIoTHub() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &eventsources.EventSourceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &eventsources.EventSourceId{})
 }
 
 func resourceIoTTimeSeriesInsightsEventSourceIoTHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceIoTTimeSeriesInsightsGen2Environment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIoTTimeSeriesInsightsGen2EnvironmentCreateUpdate,
 		Read:   resourceIoTTimeSeriesInsightsGen2EnvironmentRead,
 		Update: resourceIoTTimeSeriesInsightsGen2EnvironmentCreateUpdate,
@@ -110,6 +110,10 @@ func resourceIoTTimeSeriesInsightsGen2Environment() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &environments.EnvironmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &environments.EnvironmentId{})
 }
 
 func resourceIoTTimeSeriesInsightsGen2EnvironmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
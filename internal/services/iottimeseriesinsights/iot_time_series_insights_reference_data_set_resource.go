@@ -24,7 +24,7 @@ import (
 )
 
 func resourceIoTTimeSeriesInsightsReferenceDataSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIoTTimeSeriesInsightsReferenceDataSetCreateUpdate,
 		Read:   resourceIoTTimeSeriesInsightsReferenceDataSetRead,
 		Update: resourceIoTTimeSeriesInsightsReferenceDataSetCreateUpdate,
@@ -104,6 +104,10 @@ func resourceIoTTimeSeriesInsightsReferenceDataSet() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &referencedatasets.ReferenceDataSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &referencedatasets.ReferenceDataSetId{})
 }
 
 func resourceIoTTimeSeriesInsightsReferenceDataSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
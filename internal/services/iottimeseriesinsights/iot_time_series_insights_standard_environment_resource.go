@@ -26,7 +26,7 @@ import (
 )
 
 func resourceIoTTimeSeriesInsightsStandardEnvironment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate,
 		Read:   resourceIoTTimeSeriesInsightsStandardEnvironmentRead,
 		Update: resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate,
@@ -115,6 +115,10 @@ func resourceIoTTimeSeriesInsightsStandardEnvironment() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &environments.EnvironmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &environments.EnvironmentId{})
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -5,11 +5,13 @@ package client
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
 	vaults20230701 "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/vaults"
 	resources20151101 "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2015-11-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/metadatacache"
 	dataplane "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
 )
 
@@ -35,6 +37,13 @@ type Client struct {
 	// for regular operations, and we can remove this internal client one the newer API version is used
 	// across the Provider.
 	vaults20230701Client *vaults20230701.VaultsClient
+
+	// metadataCache optionally persists the Data Plane URIs and Resource IDs of Key Vaults between runs of the
+	// Provider, see metadata_cache.go
+	metadataCache      *metadatacache.Cache
+	metadataCacheKey   metadatacache.Key
+	persistedKeyVaults map[string]persistedKeyVault
+	persistedLock      *sync.Mutex
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -64,5 +73,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		// intentionally internal to this package for now, see above.
 		resources20151101Client: resources20151101Client,
 		vaults20230701Client:    updatedVaultsClient,
+
+		metadataCache: o.MetadataCache,
+		metadataCacheKey: metadatacache.Key{
+			TenantId:       o.TenantId,
+			SubscriptionId: o.SubscriptionId,
+			Name:           "key-vaults",
+		},
+		persistedLock: &sync.Mutex{},
 	}, nil
 }
@@ -50,9 +50,15 @@ func (c *Client) BaseUriForKeyVault(ctx context.Context, keyVaultId commonids.Ke
 	if v, ok := keyVaultsCache[cacheKey]; ok {
 		return &v.dataPlaneBaseUri, nil
 	}
+	if v, ok := c.lookupPersisted(cacheKey); ok {
+		return &v.dataPlaneBaseUri, nil
+	}
 
 	resp, err := c.VaultsClient.Get(ctx, keyVaultId)
 	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) || response.WasForbidden(resp.HttpResponse) {
+			c.invalidatePersisted(cacheKey)
+		}
 		if response.WasNotFound(resp.HttpResponse) {
 			return nil, fmt.Errorf("%s was not found", keyVaultId)
 		}
@@ -70,6 +76,7 @@ func (c *Client) BaseUriForKeyVault(ctx context.Context, keyVaultId commonids.Ke
 	}
 
 	c.AddToCache(keyVaultId, vaultUri)
+	c.persist(keyVaultId, vaultUri)
 	return &vaultUri, nil
 }
 
@@ -83,12 +90,17 @@ func (c *Client) Exists(ctx context.Context, keyVaultId commonids.KeyVaultId) (b
 	lock[cacheKey].Lock()
 	defer lock[cacheKey].Unlock()
 
+	// Key Vaults in the in-memory cache have been retrieved from the API during this run, whereas those persisted in
+	// the metadata cache aren't added to it - so these are checked against the API, since they may have been deleted
 	if _, ok := keyVaultsCache[cacheKey]; ok {
 		return true, nil
 	}
 
 	resp, err := c.VaultsClient.Get(ctx, keyVaultId)
 	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) || response.WasForbidden(resp.HttpResponse) {
+			c.invalidatePersisted(cacheKey)
+		}
 		if response.WasNotFound(resp.HttpResponse) {
 			return false, nil
 		}
@@ -105,6 +117,7 @@ func (c *Client) Exists(ctx context.Context, keyVaultId commonids.KeyVaultId) (b
 		return false, fmt.Errorf("retrieving %s: `properties.VaultUri` was nil", keyVaultId)
 	}
 	c.AddToCache(keyVaultId, vaultUri)
+	c.persist(keyVaultId, vaultUri)
 
 	return true, nil
 }
@@ -129,10 +142,17 @@ func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, subscriptionId commo
 		return &v.keyVaultId, nil
 	}
 
+	// Then the metadata cache, when enabled - if the Key Vault has since been deleted this is detected when checking
+	// whether the Key Vault exists, which removes it from the metadata cache
+	if v, ok := c.lookupPersisted(cacheKey); ok {
+		return &v.keyVaultId, nil
+	}
+
 	// Populate the cache
 	if err := c.populateCache(ctx, subscriptionId); err != nil {
 		return nil, fmt.Errorf("populating the Key Vaults cache for %s: %+v", subscriptionId, err)
 	}
+	c.persistAll()
 
 	// Now that the cache has been repopulated, check if we have the key vault or not
 	if v, ok := keyVaultsCache[cacheKey]; ok {
@@ -153,6 +173,8 @@ func (c *Client) Purge(keyVaultId commonids.KeyVaultId) {
 	lock[cacheKey].Lock()
 	delete(keyVaultsCache, cacheKey)
 	lock[cacheKey].Unlock()
+
+	c.invalidatePersisted(cacheKey)
 }
 
 func (c *Client) cacheKeyForKeyVault(name string) string {
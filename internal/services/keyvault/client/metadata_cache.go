@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// persistedKeyVault is the form in which a Key Vault is persisted in the metadata cache
+type persistedKeyVault struct {
+	KeyVaultId       string `json:"keyVaultId"`
+	DataPlaneBaseUri string `json:"dataPlaneBaseUri"`
+}
+
+// lookupPersisted returns the Key Vault persisted in the metadata cache (when enabled) for the cache key.
+//
+// Persisted Key Vaults are only used to resolve the Data Plane URI or Resource ID of a Key Vault and aren't added to
+// the in-memory cache - so Exists checks whether a persisted Key Vault still exists against the API, removing it from
+// the metadata cache when it's not found.
+func (c *Client) lookupPersisted(cacheKey string) (*keyVaultDetails, bool) {
+	if c.metadataCache == nil {
+		return nil, false
+	}
+
+	c.persistedLock.Lock()
+	defer c.persistedLock.Unlock()
+
+	c.loadPersisted()
+
+	v, ok := c.persistedKeyVaults[cacheKey]
+	if !ok {
+		return nil, false
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultIDInsensitively(v.KeyVaultId)
+	if err != nil {
+		log.Printf("[DEBUG] Ignoring the Key Vault %q from the metadata cache since the ID couldn't be parsed: %+v", v.KeyVaultId, err)
+		return nil, false
+	}
+
+	return &keyVaultDetails{
+		keyVaultId:       keyVaultId.ID(),
+		dataPlaneBaseUri: v.DataPlaneBaseUri,
+		resourceGroup:    keyVaultId.ResourceGroupName,
+	}, true
+}
+
+// persist adds the Key Vault to the metadata cache (when enabled)
+func (c *Client) persist(keyVaultId commonids.KeyVaultId, dataPlaneUri string) {
+	if c.metadataCache == nil {
+		return
+	}
+
+	c.persistedLock.Lock()
+	defer c.persistedLock.Unlock()
+
+	c.loadPersisted()
+
+	c.persistedKeyVaults[c.cacheKeyForKeyVault(keyVaultId.VaultName)] = persistedKeyVault{
+		KeyVaultId:       keyVaultId.ID(),
+		DataPlaneBaseUri: dataPlaneUri,
+	}
+	c.metadataCache.Set(c.metadataCacheKey, c.persistedKeyVaults)
+}
+
+// persistAll replaces the Key Vaults in the metadata cache (when enabled) with those in the in-memory cache, which is
+// called once the in-memory cache has been populated from the API
+func (c *Client) persistAll() {
+	if c.metadataCache == nil {
+		return
+	}
+
+	c.persistedLock.Lock()
+	defer c.persistedLock.Unlock()
+
+	c.persistedKeyVaults = make(map[string]persistedKeyVault)
+	keysmith.Lock()
+	for cacheKey, v := range keyVaultsCache {
+		c.persistedKeyVaults[cacheKey] = persistedKeyVault{
+			KeyVaultId:       v.keyVaultId,
+			DataPlaneBaseUri: v.dataPlaneBaseUri,
+		}
+	}
+	keysmith.Unlock()
+
+	c.metadataCache.Set(c.metadataCacheKey, c.persistedKeyVaults)
+}
+
+// invalidatePersisted removes the Key Vault from the metadata cache (when enabled), for example when it's been
+// deleted or is no longer accessible
+func (c *Client) invalidatePersisted(cacheKey string) {
+	if c.metadataCache == nil {
+		return
+	}
+
+	c.persistedLock.Lock()
+	defer c.persistedLock.Unlock()
+
+	if c.persistedKeyVaults == nil {
+		// nothing has been read from the metadata cache, so the Key Vaults are re-populated from the API next time
+		c.metadataCache.Invalidate(c.metadataCacheKey)
+		return
+	}
+
+	if _, ok := c.persistedKeyVaults[cacheKey]; !ok {
+		return
+	}
+	delete(c.persistedKeyVaults, cacheKey)
+	c.metadataCache.Set(c.metadataCacheKey, c.persistedKeyVaults)
+}
+
+// loadPersisted reads the Key Vaults from the metadata cache the first time they're required - the caller must hold
+// the persistedLock
+func (c *Client) loadPersisted() {
+	if c.persistedKeyVaults != nil {
+		return
+	}
+
+	c.persistedKeyVaults = make(map[string]persistedKeyVault)
+	if !c.metadataCache.Get(c.metadataCacheKey, &c.persistedKeyVaults) {
+		c.persistedKeyVaults = make(map[string]persistedKeyVault)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// ParseCertificateChain parses the PEM encoded certificates within `input`, returning them in the order they appear
+// (which for a chain is the leaf certificate followed by the certificate of each issuer)
+func ParseCertificateChain(input string) ([]*x509.Certificate, error) {
+	certificates := make([]*x509.Certificate, 0)
+
+	rest := []byte(strings.TrimSpace(input))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("expected the remaining content to be PEM encoded")
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected only PEM blocks of type `CERTIFICATE` but got `%s`", block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate %d: %+v", len(certificates)+1, err)
+		}
+		certificates = append(certificates, certificate)
+
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("expected at least one PEM encoded certificate")
+	}
+
+	return certificates, nil
+}
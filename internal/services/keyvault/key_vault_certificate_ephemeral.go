@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultCertificateEphemeralResource{}
+
+func NewKeyVaultCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultCertificateEphemeralResource{}
+}
+
+type KeyVaultCertificateEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KeyVaultCertificateEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultID     types.String `tfsdk:"key_vault_id"`
+	Version        types.String `tfsdk:"version"`
+	Hex            types.String `tfsdk:"hex"`
+	PEM            types.String `tfsdk:"pem"`
+	Key            types.String `tfsdk:"key"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_key_vault_certificate"
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.NestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"hex": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"pem": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	keyVaultsClient := e.Client.KeyVault
+	client := e.Client.KeyVault.ManagementClient
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultCertificateEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parsing `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("looking up Base URI for Certificate %q in %s", data.Name.ValueString(), keyVaultId), err.Error())
+		return
+	}
+
+	cert, err := client.GetCertificate(ctx, *keyVaultBaseUri, data.Name.ValueString(), data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			resp.Diagnostics.AddError(fmt.Sprintf("retrieving Certificate %q", data.Name.ValueString()), fmt.Sprintf("the Certificate %q was not found in Key Vault at URI %q", data.Name.ValueString(), *keyVaultBaseUri))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving Certificate %q", data.Name.ValueString()), err.Error())
+		return
+	}
+
+	if cert.ID == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving Certificate %q", data.Name.ValueString()), "`id` was nil")
+		return
+	}
+
+	// the version may have changed, so parse the updated id
+	id, err := parse.ParseNestedItemID(*cert.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("parsing Certificate ID", err.Error())
+		return
+	}
+
+	// the private key is only available from the Secret backing this Certificate
+	pfx, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving the Secret backing Certificate %q", id.Name), err.Error())
+		return
+	}
+
+	if pfx.ContentType == nil || pfx.Value == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving the Secret backing Certificate %q", id.Name), "`contentType` or `value` was nil")
+		return
+	}
+
+	certs, key, _, err := decodeCertificateData(id.Name, *pfx.ContentType, *pfx.Value)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("decoding Certificate %q", id.Name), err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.Hex = types.StringNull()
+	if contents := cert.Cer; contents != nil {
+		data.Hex = types.StringValue(strings.ToUpper(hex.EncodeToString(*contents)))
+	}
+	data.PEM = types.StringValue(certs)
+	data.Key = types.StringValue(key)
+	data.ExpirationDate = types.StringNull()
+	data.NotBeforeDate = types.StringNull()
+	if attributes := cert.Attributes; attributes != nil {
+		if expires := attributes.Expires; expires != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*expires).Format(time.RFC3339))
+		}
+		if notBefore := attributes.NotBefore; notBefore != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*notBefore).Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+type KeyVaultCertificateMergeResource struct{}
+
+var (
+	_ sdk.ResourceWithCustomizeDiff  = KeyVaultCertificateMergeResource{}
+	_ sdk.ResourceWithCustomImporter = KeyVaultCertificateMergeResource{}
+)
+
+type KeyVaultCertificateMergeResourceModel struct {
+	KeyVaultCertificateId string `tfschema:"key_vault_certificate_id"`
+	CertificateChain      string `tfschema:"certificate_chain"`
+	Version               string `tfschema:"version"`
+	SecretId              string `tfschema:"secret_id"`
+	VersionlessSecretId   string `tfschema:"versionless_secret_id"`
+	Thumbprint            string `tfschema:"thumbprint"`
+	CertificateDataBase64 string `tfschema:"certificate_data_base64"`
+	NotBefore             string `tfschema:"not_before"`
+	Expires               string `tfschema:"expires"`
+}
+
+func (r KeyVaultCertificateMergeResource) ResourceType() string {
+	return "azurerm_key_vault_certificate_merge"
+}
+
+func (r KeyVaultCertificateMergeResource) ModelObject() interface{} {
+	return &KeyVaultCertificateMergeResourceModel{}
+}
+
+func (r KeyVaultCertificateMergeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return keyVaultValidate.NestedItemId
+}
+
+func (r KeyVaultCertificateMergeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"key_vault_certificate_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+		},
+
+		"certificate_chain": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.CertificateChain,
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"secret_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"versionless_secret_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"thumbprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"certificate_data_base64": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"not_before": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expires": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.KeyVault.ManagementClient
+			diff := metadata.ResourceDiff
+
+			if diff.Id() != "" && !diff.HasChanges("key_vault_certificate_id", "certificate_chain") {
+				return nil
+			}
+
+			// the certificate signing request can only be retrieved once it exists, which won't be the case when
+			// it's being created within the same plan - in which case the chain is validated during creation
+			if !diff.NewValueKnown("key_vault_certificate_id") || !diff.NewValueKnown("certificate_chain") {
+				return nil
+			}
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(diff.Get("key_vault_certificate_id").(string))
+			if err != nil {
+				return err
+			}
+
+			operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(operation.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving Certificate Operation for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+			if !strings.EqualFold(pointer.From(operation.Status), "inProgress") || operation.Csr == nil {
+				return nil
+			}
+
+			if err := keyVaultValidate.CertificateChainMatchesSigningRequest(diff.Get("certificate_chain").(string), *operation.Csr); err != nil {
+				return fmt.Errorf("validating `certificate_chain` against the Certificate Signing Request for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.KeyVault.ManagementClient
+
+			var config KeyVaultCertificateMergeResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			certificateId, err := parse.ParseOptionallyVersionedNestedItemID(config.KeyVaultCertificateId)
+			if err != nil {
+				return err
+			}
+
+			operation, err := client.GetCertificateOperation(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving Certificate Operation for Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+			}
+
+			if !strings.EqualFold(pointer.From(operation.Status), "inProgress") {
+				// since this resource can't be imported, a certificate which has already been merged can't be managed
+				if strings.EqualFold(pointer.From(operation.Status), "completed") {
+					return fmt.Errorf("a signed certificate has already been merged into Certificate %q (Key Vault %q) - a certificate can only be merged once, a new Certificate Signing Request must be created to merge another signed certificate", certificateId.Name, certificateId.KeyVaultBaseUrl)
+				}
+				return fmt.Errorf("the Certificate Operation for Certificate %q (Key Vault %q) must be pending to merge a signed certificate but got status %q", certificateId.Name, certificateId.KeyVaultBaseUrl, pointer.From(operation.Status))
+			}
+
+			if operation.Csr == nil {
+				return fmt.Errorf("the Certificate Operation for Certificate %q (Key Vault %q) didn't contain a Certificate Signing Request", certificateId.Name, certificateId.KeyVaultBaseUrl)
+			}
+			if err := keyVaultValidate.CertificateChainMatchesSigningRequest(config.CertificateChain, *operation.Csr); err != nil {
+				return fmt.Errorf("validating `certificate_chain` against the Certificate Signing Request for Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+			}
+
+			certificates, err := helpers.ParseCertificateChain(config.CertificateChain)
+			if err != nil {
+				return fmt.Errorf("parsing `certificate_chain`: %+v", err)
+			}
+			x509Certificates := make([][]byte, 0)
+			for _, certificate := range certificates {
+				x509Certificates = append(x509Certificates, certificate.Raw)
+			}
+
+			parameters := keyvault.CertificateMergeParameters{
+				X509Certificates: &x509Certificates,
+			}
+			result, err := client.MergeCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("merging the signed certificate into Certificate %q (Key Vault %q): %+v", certificateId.Name, certificateId.KeyVaultBaseUrl, err)
+			}
+			if result.ID == nil {
+				return fmt.Errorf("merging the signed certificate into Certificate %q (Key Vault %q): `id` was nil", certificateId.Name, certificateId.KeyVaultBaseUrl)
+			}
+
+			id, err := parse.ParseNestedItemID(*result.ID)
+			if err != nil {
+				return err
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			metadata.Logger.Infof("Waiting for the Certificate Operation for Certificate %q (Key Vault %q) to complete", id.Name, id.KeyVaultBaseUrl)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{"inProgress"},
+				Target:     []string{"completed"},
+				Refresh:    keyVaultCertificateMergeRefreshFunc(ctx, client, id.KeyVaultBaseUrl, id.Name),
+				MinTimeout: 5 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for the Certificate Operation for Certificate %q (Key Vault %q) to complete: %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.KeyVault.ManagementClient
+
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state KeyVaultCertificateMergeResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+			if err != nil {
+				if utils.ResponseWasNotFound(cert.Response) {
+					metadata.Logger.Infof("Certificate %q (Version %q) was not found in Key Vault at URI %q - removing from state", id.Name, id.Version, id.KeyVaultBaseUrl)
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Certificate %q (Version %q / Key Vault %q): %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+			}
+
+			// the signed certificate chain can't be retrieved from Key Vault, so `certificate_chain` is retained from the state
+			state.KeyVaultCertificateId = id.VersionlessID()
+			state.Version = id.Version
+
+			state.SecretId = pointer.From(cert.Sid)
+			state.VersionlessSecretId = ""
+			if cert.Sid != nil {
+				secretId, err := parse.ParseNestedItemID(*cert.Sid)
+				if err != nil {
+					return err
+				}
+				state.VersionlessSecretId = secretId.VersionlessID()
+			}
+
+			state.CertificateDataBase64 = ""
+			if contents := cert.Cer; contents != nil {
+				state.CertificateDataBase64 = base64.StdEncoding.EncodeToString(*contents)
+			}
+
+			state.Thumbprint = ""
+			if v := cert.X509Thumbprint; v != nil {
+				x509Thumbprint, err := base64.RawURLEncoding.DecodeString(*v)
+				if err != nil {
+					return err
+				}
+				state.Thumbprint = strings.ToUpper(hex.EncodeToString(x509Thumbprint))
+			}
+
+			state.NotBefore = ""
+			state.Expires = ""
+			if attributes := cert.Attributes; attributes != nil {
+				if v := attributes.NotBefore; v != nil {
+					state.NotBefore = time.Time(*v).Format(time.RFC3339)
+				}
+				if v := attributes.Expires; v != nil {
+					state.Expires = time.Time(*v).Format(time.RFC3339)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a merged certificate can't be un-merged, the certificate is removed along with the
+			// `azurerm_key_vault_certificate_signing_request` which created it
+			metadata.Logger.Infof("%s can't be un-merged - removing from state only", id)
+
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultCertificateMergeResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("%s doesn't support being imported, since the signed `certificate_chain` can't be retrieved from Key Vault - define the resource in the configuration to merge the certificate instead", r.ResourceType())
+	}
+}
+
+func keyVaultCertificateMergeRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		operation, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
+		if err != nil {
+			// Key Vault can remove the Certificate Operation once it's completed
+			if utils.ResponseWasNotFound(operation.Response) {
+				return operation, "completed", nil
+			}
+			return nil, "", fmt.Errorf("retrieving Certificate Operation for Certificate %q (Key Vault %q): %+v", name, keyVaultBaseUrl, err)
+		}
+		if operation.Status == nil {
+			return nil, "", fmt.Errorf("retrieving Certificate Operation for Certificate %q (Key Vault %q): `status` was nil", name, keyVaultBaseUrl)
+		}
+
+		status := *operation.Status
+		switch {
+		case strings.EqualFold(status, "inProgress"):
+			return operation, "inProgress", nil
+		case strings.EqualFold(status, "completed"):
+			return operation, "completed", nil
+		}
+
+		message := ""
+		if operation.Error != nil {
+			message = pointer.From(operation.Error.Message)
+		}
+		return nil, "", fmt.Errorf("the Certificate Operation for Certificate %q (Key Vault %q) failed with status %q: %s", name, keyVaultBaseUrl, status, message)
+	}
+}
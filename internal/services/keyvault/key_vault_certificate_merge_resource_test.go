@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultCertificateMergeResource struct{}
+
+func TestAccKeyVaultCertificateMerge_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").Exists(),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That(data.ResourceName).Key("certificate_data_base64").Exists(),
+				check.That(data.ResourceName).Key("versionless_secret_id").HasValue(fmt.Sprintf("https://acctestkeyvault%s.vault.azure.net/secrets/acctestcert%s", data.RandomString, data.RandomString)),
+			),
+		},
+	})
+}
+
+func TestAccKeyVaultCertificateMerge_chainNotMatchingSigningRequest(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_merge", "test")
+	r := KeyVaultCertificateMergeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.signingRequest(data),
+		},
+		{
+			Config:      r.chainNotMatchingSigningRequest(data),
+			ExpectError: regexp.MustCompile("doesn't match the public key of the certificate signing request"),
+		},
+	})
+}
+
+func (r KeyVaultCertificateMergeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Certificate %q (Version %q / Key Vault %q): %+v", id.Name, id.Version, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(cert.Cer != nil), nil
+}
+
+func (r KeyVaultCertificateMergeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "tls_locally_signed_cert" "test" {
+  cert_request_pem      = azurerm_key_vault_certificate_signing_request.test.certificate_signing_request
+  ca_private_key_pem    = tls_private_key.ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.ca.cert_pem
+  validity_period_hours = 24
+
+  allowed_uses = [
+    "digital_signature",
+    "key_encipherment",
+    "server_auth",
+  ]
+}
+
+resource "azurerm_key_vault_certificate_merge" "test" {
+  key_vault_certificate_id = azurerm_key_vault_certificate_signing_request.test.id
+  certificate_chain        = "${tls_locally_signed_cert.test.cert_pem}${tls_self_signed_cert.ca.cert_pem}"
+}
+`, r.signingRequest(data))
+}
+
+func (r KeyVaultCertificateMergeResource) chainNotMatchingSigningRequest(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "tls_private_key" "other" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_cert_request" "other" {
+  private_key_pem = tls_private_key.other.private_key_pem
+
+  subject {
+    common_name = "hello-world"
+  }
+}
+
+resource "tls_locally_signed_cert" "other" {
+  cert_request_pem      = tls_cert_request.other.cert_request_pem
+  ca_private_key_pem    = tls_private_key.ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.ca.cert_pem
+  validity_period_hours = 24
+
+  allowed_uses = [
+    "digital_signature",
+    "key_encipherment",
+    "server_auth",
+  ]
+}
+
+resource "azurerm_key_vault_certificate_merge" "test" {
+  key_vault_certificate_id = azurerm_key_vault_certificate_signing_request.test.id
+  certificate_chain        = "${tls_locally_signed_cert.other.cert_pem}${tls_self_signed_cert.ca.cert_pem}"
+}
+`, r.signingRequest(data))
+}
+
+func (r KeyVaultCertificateMergeResource) signingRequest(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_certificate_signing_request" "test" {
+  name         = "acctestcert%s"
+  key_vault_id = azurerm_key_vault.test.id
+  subject      = "CN=hello-world"
+
+  key_properties {
+    key_type = "RSA"
+    key_size = 2048
+  }
+}
+
+resource "tls_private_key" "ca" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "tls_self_signed_cert" "ca" {
+  private_key_pem       = tls_private_key.ca.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 48
+
+  subject {
+    common_name  = "Acceptance Test CA"
+    organization = "Acceptance Test"
+  }
+
+  allowed_uses = [
+    "cert_signing",
+    "crl_signing",
+  ]
+}
+`, KeyVaultCertificateResource{}.template(data), data.RandomString)
+}
@@ -0,0 +1,630 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+// the `Unknown` issuer tells Key Vault that the certificate will be issued by a Certificate Authority outside
+// of Key Vault, in which case the Certificate Operation remains pending until a signed certificate is merged
+const keyVaultCertificateUnknownIssuerName = "Unknown"
+
+type KeyVaultCertificateSigningRequestResource struct{}
+
+var _ sdk.Resource = KeyVaultCertificateSigningRequestResource{}
+
+type KeyVaultCertificateSigningRequestResourceModel struct {
+	Name                      string                                                     `tfschema:"name"`
+	KeyVaultId                string                                                     `tfschema:"key_vault_id"`
+	Subject                   string                                                     `tfschema:"subject"`
+	SubjectAlternativeNames   []KeyVaultCertificateSigningRequestSubjectAlternativeNames `tfschema:"subject_alternative_names"`
+	KeyProperties             []KeyVaultCertificateSigningRequestKeyProperties           `tfschema:"key_properties"`
+	KeyUsage                  []string                                                   `tfschema:"key_usage"`
+	ExtendedKeyUsage          []string                                                   `tfschema:"extended_key_usage"`
+	ValidityInMonths          int64                                                      `tfschema:"validity_in_months"`
+	ContentType               string                                                     `tfschema:"content_type"`
+	Tags                      map[string]string                                          `tfschema:"tags"`
+	CertificateSigningRequest string                                                     `tfschema:"certificate_signing_request"`
+	Status                    string                                                     `tfschema:"status"`
+}
+
+type KeyVaultCertificateSigningRequestSubjectAlternativeNames struct {
+	DnsNames []string `tfschema:"dns_names"`
+	Emails   []string `tfschema:"emails"`
+	Upns     []string `tfschema:"upns"`
+}
+
+type KeyVaultCertificateSigningRequestKeyProperties struct {
+	KeyType    string `tfschema:"key_type"`
+	KeySize    int64  `tfschema:"key_size"`
+	Curve      string `tfschema:"curve"`
+	Exportable bool   `tfschema:"exportable"`
+	ReuseKey   bool   `tfschema:"reuse_key"`
+}
+
+func (r KeyVaultCertificateSigningRequestResource) ResourceType() string {
+	return "azurerm_key_vault_certificate_signing_request"
+}
+
+func (r KeyVaultCertificateSigningRequestResource) ModelObject() interface{} {
+	return &KeyVaultCertificateSigningRequestResourceModel{}
+}
+
+func (r KeyVaultCertificateSigningRequestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return keyVaultValidate.VersionlessNestedItemId
+}
+
+func (r KeyVaultCertificateSigningRequestResource) Arguments() map[string]*pluginsdk.Schema {
+	subjectAlternativeNames := []string{
+		"subject_alternative_names.0.dns_names",
+		"subject_alternative_names.0.emails",
+		"subject_alternative_names.0.upns",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.NestedItemName,
+		},
+
+		"key_vault_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KeyVaultId{}),
+
+		"subject": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"key_properties": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(keyvault.JSONWebKeyTypeEC),
+							string(keyvault.JSONWebKeyTypeECHSM),
+							string(keyvault.JSONWebKeyTypeRSA),
+							string(keyvault.JSONWebKeyTypeRSAHSM),
+						}, false),
+					},
+
+					"key_size": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+						ValidateFunc: validation.IntInSlice([]int{
+							256,
+							384,
+							521,
+							2048,
+							3072,
+							4096,
+						}),
+					},
+
+					"curve": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(keyvault.JSONWebKeyCurveNameP256),
+							string(keyvault.JSONWebKeyCurveNameP256K),
+							string(keyvault.JSONWebKeyCurveNameP384),
+							string(keyvault.JSONWebKeyCurveNameP521),
+						}, false),
+					},
+
+					"exportable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"reuse_key": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"subject_alternative_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_names": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						AtLeastOneOf: subjectAlternativeNames,
+					},
+
+					"emails": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						AtLeastOneOf: subjectAlternativeNames,
+					},
+
+					"upns": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						AtLeastOneOf: subjectAlternativeNames,
+					},
+				},
+			},
+		},
+
+		"key_usage": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.KeyUsageTypeCRLSign),
+					string(keyvault.KeyUsageTypeDataEncipherment),
+					string(keyvault.KeyUsageTypeDecipherOnly),
+					string(keyvault.KeyUsageTypeDigitalSignature),
+					string(keyvault.KeyUsageTypeEncipherOnly),
+					string(keyvault.KeyUsageTypeKeyAgreement),
+					string(keyvault.KeyUsageTypeKeyCertSign),
+					string(keyvault.KeyUsageTypeKeyEncipherment),
+					string(keyvault.KeyUsageTypeNonRepudiation),
+				}, false),
+			},
+		},
+
+		"extended_key_usage": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"validity_in_months": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      12,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "application/x-pkcs12",
+			ValidateFunc: validation.StringInSlice([]string{
+				"application/x-pkcs12",
+				"application/x-pem-file",
+			}, false),
+		},
+
+		"tags": tags.ForceNewSchema(),
+	}
+}
+
+func (r KeyVaultCertificateSigningRequestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"certificate_signing_request": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultCertificateSigningRequestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			keyVaultsClient := metadata.Client.KeyVault
+			client := metadata.Client.KeyVault.ManagementClient
+
+			var config KeyVaultCertificateSigningRequestResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			keyVaultId, err := commonids.ParseKeyVaultID(config.KeyVaultId)
+			if err != nil {
+				return err
+			}
+
+			keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+			if err != nil {
+				return fmt.Errorf("looking up Base URI for Certificate %q in %s: %+v", config.Name, *keyVaultId, err)
+			}
+
+			id, err := parse.NewNestedItemID(*keyVaultBaseUri, parse.NestedItemTypeCertificate, config.Name, "")
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			existingOperation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existingOperation.Response) {
+					return fmt.Errorf("checking for presence of an existing Certificate Operation for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existingOperation.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			policy, err := expandKeyVaultCertificateSigningRequestPolicy(config)
+			if err != nil {
+				return fmt.Errorf("expanding certificate policy: %+v", err)
+			}
+
+			parameters := keyvault.CertificateCreateParameters{
+				CertificatePolicy: policy,
+				Tags:              tags.FromTypedObject(config.Tags),
+			}
+			if _, err := client.CreateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+				return fmt.Errorf("creating Certificate Signing Request for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			// the pending Certificate Operation is `Ready` as soon as the certificate signing request has been generated
+			metadata.Logger.Infof("Waiting for the Certificate Signing Request for Certificate %q (Key Vault %q) to be generated", id.Name, id.KeyVaultBaseUrl)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{"Provisioning"},
+				Target:     []string{"Ready"},
+				Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, id.KeyVaultBaseUrl, id.Name),
+				MinTimeout: 15 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for the Certificate Signing Request for Certificate %q (Key Vault %q) to be generated: %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeyVaultCertificateSigningRequestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			keyVaultsClient := metadata.Client.KeyVault
+			client := metadata.Client.KeyVault.ManagementClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			subscriptionResourceId := commonids.NewSubscriptionID(subscriptionId)
+			keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, subscriptionResourceId, id.KeyVaultBaseUrl)
+			if err != nil {
+				return fmt.Errorf("retrieving resource ID of the Key Vault at URL %s: %+v", id.KeyVaultBaseUrl, err)
+			}
+			if keyVaultIdRaw == nil {
+				metadata.Logger.Infof("Unable to determine the Resource ID for the Key Vault at URL %s - removing from state!", id.KeyVaultBaseUrl)
+				return metadata.MarkAsGone(id)
+			}
+			keyVaultId, err := commonids.ParseKeyVaultID(*keyVaultIdRaw)
+			if err != nil {
+				return fmt.Errorf("parsing Key Vault ID: %+v", err)
+			}
+
+			var state KeyVaultCertificateSigningRequestResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.Name
+			state.KeyVaultId = keyVaultId.ID()
+
+			operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(operation.Response) {
+					return fmt.Errorf("retrieving Certificate Operation for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+				}
+
+				// once a signed certificate has been merged Key Vault can remove the Certificate Operation, in which
+				// case the certificate signing request is retained from the state
+				certificate, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+				if err != nil {
+					if utils.ResponseWasNotFound(certificate.Response) {
+						metadata.Logger.Infof("Certificate %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+				}
+
+				state.Status = "completed"
+				state.Tags = tags.ToTypedObject(certificate.Tags)
+				flattenKeyVaultCertificateSigningRequestPolicy(certificate.Policy, &state)
+
+				return metadata.Encode(&state)
+			}
+
+			state.Status = pointer.From(operation.Status)
+			if operation.Csr != nil {
+				state.CertificateSigningRequest = string(pem.EncodeToMemory(&pem.Block{
+					Type:  "CERTIFICATE REQUEST",
+					Bytes: *operation.Csr,
+				}))
+			}
+
+			policy, err := client.GetCertificatePolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(policy.Response) {
+					return fmt.Errorf("retrieving Certificate Policy for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+				}
+			} else {
+				flattenKeyVaultCertificateSigningRequestPolicy(&policy, &state)
+			}
+
+			// the tags are only returned alongside the certificate, which may not be available until it has been merged
+			certificate, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+			if err != nil {
+				if !utils.ResponseWasNotFound(certificate.Response) {
+					return fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+				}
+			} else {
+				state.Tags = tags.ToTypedObject(certificate.Tags)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyVaultCertificateSigningRequestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			keyVaultsClient := metadata.Client.KeyVault
+			client := metadata.Client.KeyVault.ManagementClient
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KeyVaultCertificateSigningRequestResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			keyVaultId, err := commonids.ParseKeyVaultID(config.KeyVaultId)
+			if err != nil {
+				return err
+			}
+
+			kv, err := keyVaultsClient.VaultsClient.Get(ctx, *keyVaultId)
+			if err != nil {
+				if response.WasNotFound(kv.HttpResponse) {
+					metadata.Logger.Infof("%s for Certificate %q was not found - assuming removed", *keyVaultId, id.Name)
+					return nil
+				}
+				return fmt.Errorf("retrieving %s for Certificate %q: %+v", *keyVaultId, id.Name, err)
+			}
+
+			shouldPurge := metadata.Client.Features.KeyVault.PurgeSoftDeletedCertsOnDestroy
+			if shouldPurge && kv.Model != nil && utils.NormaliseNilableBool(kv.Model.Properties.EnablePurgeProtection) {
+				metadata.Logger.Infof("cannot purge Certificate %q because %s has purge protection enabled", id.Name, *keyVaultId)
+				shouldPurge = false
+			}
+
+			description := fmt.Sprintf("Certificate %q (Key Vault %q)", id.Name, id.KeyVaultBaseUrl)
+			deleter := deleteAndPurgeCertificate{
+				client:      client,
+				keyVaultUri: id.KeyVaultBaseUrl,
+				name:        id.Name,
+			}
+			if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKeyVaultCertificateSigningRequestPolicy(input KeyVaultCertificateSigningRequestResourceModel) (*keyvault.CertificatePolicy, error) {
+	policy := keyvault.CertificatePolicy{
+		IssuerParameters: &keyvault.IssuerParameters{
+			Name: pointer.To(keyVaultCertificateUnknownIssuerName),
+		},
+		SecretProperties: &keyvault.SecretProperties{
+			ContentType: pointer.To(input.ContentType),
+		},
+		X509CertificateProperties: &keyvault.X509CertificateProperties{
+			Subject:          pointer.To(input.Subject),
+			ValidityInMonths: pointer.To(int32(input.ValidityInMonths)),
+		},
+	}
+
+	if len(input.KeyProperties) > 0 {
+		props := input.KeyProperties[0]
+
+		keySize := props.KeySize
+		switch keyvault.JSONWebKeyType(props.KeyType) {
+		case keyvault.JSONWebKeyTypeEC, keyvault.JSONWebKeyTypeECHSM:
+			if props.Curve == "" {
+				return nil, fmt.Errorf("`curve` is required when creating an EC key")
+			}
+			// determine key_size if not specified
+			if keySize == 0 {
+				switch keyvault.JSONWebKeyCurveName(props.Curve) {
+				case keyvault.JSONWebKeyCurveNameP256, keyvault.JSONWebKeyCurveNameP256K:
+					keySize = 256
+				case keyvault.JSONWebKeyCurveNameP384:
+					keySize = 384
+				case keyvault.JSONWebKeyCurveNameP521:
+					keySize = 521
+				}
+			}
+		case keyvault.JSONWebKeyTypeRSA, keyvault.JSONWebKeyTypeRSAHSM:
+			if props.Curve != "" {
+				return nil, fmt.Errorf("`curve` can only be specified when creating an EC key")
+			}
+			if keySize == 0 {
+				return nil, fmt.Errorf("`key_size` is required when creating an RSA key")
+			}
+		}
+
+		policy.KeyProperties = &keyvault.KeyProperties{
+			Curve:      keyvault.JSONWebKeyCurveName(props.Curve),
+			Exportable: pointer.To(props.Exportable),
+			KeySize:    pointer.To(int32(keySize)),
+			KeyType:    keyvault.JSONWebKeyType(props.KeyType),
+			ReuseKey:   pointer.To(props.ReuseKey),
+		}
+	}
+
+	if len(input.KeyUsage) > 0 {
+		keyUsage := make([]keyvault.KeyUsageType, 0)
+		for _, v := range input.KeyUsage {
+			keyUsage = append(keyUsage, keyvault.KeyUsageType(v))
+		}
+		policy.X509CertificateProperties.KeyUsage = &keyUsage
+	}
+
+	if len(input.ExtendedKeyUsage) > 0 {
+		policy.X509CertificateProperties.Ekus = pointer.To(input.ExtendedKeyUsage)
+	}
+
+	if len(input.SubjectAlternativeNames) > 0 {
+		sans := input.SubjectAlternativeNames[0]
+		subjectAlternativeNames := keyvault.SubjectAlternativeNames{}
+		if len(sans.DnsNames) > 0 {
+			subjectAlternativeNames.DNSNames = pointer.To(sans.DnsNames)
+		}
+		if len(sans.Emails) > 0 {
+			subjectAlternativeNames.Emails = pointer.To(sans.Emails)
+		}
+		if len(sans.Upns) > 0 {
+			subjectAlternativeNames.Upns = pointer.To(sans.Upns)
+		}
+		policy.X509CertificateProperties.SubjectAlternativeNames = &subjectAlternativeNames
+	}
+
+	return &policy, nil
+}
+
+func flattenKeyVaultCertificateSigningRequestPolicy(input *keyvault.CertificatePolicy, state *KeyVaultCertificateSigningRequestResourceModel) {
+	if input == nil {
+		return
+	}
+
+	if props := input.KeyProperties; props != nil {
+		state.KeyProperties = []KeyVaultCertificateSigningRequestKeyProperties{
+			{
+				KeyType:    string(props.KeyType),
+				KeySize:    int64(pointer.From(props.KeySize)),
+				Curve:      string(props.Curve),
+				Exportable: pointer.From(props.Exportable),
+				ReuseKey:   pointer.From(props.ReuseKey),
+			},
+		}
+	}
+
+	if secret := input.SecretProperties; secret != nil && secret.ContentType != nil {
+		state.ContentType = *secret.ContentType
+	}
+
+	if props := input.X509CertificateProperties; props != nil {
+		state.Subject = pointer.From(props.Subject)
+		state.ValidityInMonths = int64(pointer.From(props.ValidityInMonths))
+		state.ExtendedKeyUsage = pointer.From(props.Ekus)
+
+		keyUsage := make([]string, 0)
+		if props.KeyUsage != nil {
+			for _, v := range *props.KeyUsage {
+				keyUsage = append(keyUsage, string(v))
+			}
+		}
+		state.KeyUsage = keyUsage
+
+		subjectAlternativeNames := make([]KeyVaultCertificateSigningRequestSubjectAlternativeNames, 0)
+		if sans := props.SubjectAlternativeNames; sans != nil {
+			if len(pointer.From(sans.DNSNames))+len(pointer.From(sans.Emails))+len(pointer.From(sans.Upns)) > 0 {
+				subjectAlternativeNames = append(subjectAlternativeNames, KeyVaultCertificateSigningRequestSubjectAlternativeNames{
+					DnsNames: pointer.From(sans.DNSNames),
+					Emails:   pointer.From(sans.Emails),
+					Upns:     pointer.From(sans.Upns),
+				})
+			}
+		}
+		state.SubjectAlternativeNames = subjectAlternativeNames
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultCertificateSigningRequestResource struct{}
+
+func TestAccKeyVaultCertificateSigningRequest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_signing_request", "test")
+	r := KeyVaultCertificateSigningRequestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_signing_request").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("inProgress"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultCertificateSigningRequest_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_signing_request", "test")
+	r := KeyVaultCertificateSigningRequestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKeyVaultCertificateSigningRequest_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_certificate_signing_request", "test")
+	r := KeyVaultCertificateSigningRequestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_signing_request").Exists(),
+				check.That(data.ResourceName).Key("key_properties.0.key_size").HasValue("256"),
+				check.That(data.ResourceName).Key("subject_alternative_names.0.dns_names.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KeyVaultCertificateSigningRequestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.KeyVault.ManagementClient
+
+	id, err := parse.ParseOptionallyVersionedNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := client.GetCertificateOperation(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(operation.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Certificate Operation for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return utils.Bool(operation.Csr != nil), nil
+}
+
+func (r KeyVaultCertificateSigningRequestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_certificate_signing_request" "test" {
+  name         = "acctestcert%s"
+  key_vault_id = azurerm_key_vault.test.id
+  subject      = "CN=hello-world"
+
+  key_properties {
+    key_type = "RSA"
+    key_size = 2048
+  }
+}
+`, KeyVaultCertificateResource{}.template(data), data.RandomString)
+}
+
+func (r KeyVaultCertificateSigningRequestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_certificate_signing_request" "import" {
+  name         = azurerm_key_vault_certificate_signing_request.test.name
+  key_vault_id = azurerm_key_vault_certificate_signing_request.test.key_vault_id
+  subject      = azurerm_key_vault_certificate_signing_request.test.subject
+
+  key_properties {
+    key_type = "RSA"
+    key_size = 2048
+  }
+}
+`, r.basic(data))
+}
+
+func (r KeyVaultCertificateSigningRequestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_certificate_signing_request" "test" {
+  name         = "acctestcert%s"
+  key_vault_id = azurerm_key_vault.test.id
+  subject      = "CN=hello-world"
+
+  key_properties {
+    key_type   = "EC"
+    curve      = "P-256"
+    exportable = false
+    reuse_key  = true
+  }
+
+  subject_alternative_names {
+    dns_names = ["hello-world.example.com", "www.hello-world.example.com"]
+    emails    = ["hello-world@example.com"]
+  }
+
+  key_usage = [
+    "digitalSignature",
+    "keyAgreement",
+  ]
+
+  extended_key_usage = ["1.3.6.1.5.5.7.3.1"]
+  validity_in_months = 6
+  content_type       = "application/x-pem-file"
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, KeyVaultCertificateResource{}.template(data), data.RandomString)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KeyVaultListResource struct{}
+
+var _ sdk.ListResource = KeyVaultListResource{}
+
+func (r KeyVaultListResource) ResourceType() string {
+	return "azurerm_key_vault"
+}
+
+func (r KeyVaultListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	client := metadata.Client.KeyVault.VaultsClient
+
+	var keyVaults []vaults.Vault
+	if request.ResourceGroupName != "" {
+		resourceGroupId := commonids.NewResourceGroupID(metadata.SubscriptionId, request.ResourceGroupName)
+		resp, err := client.ListByResourceGroupComplete(ctx, resourceGroupId, vaults.DefaultListByResourceGroupOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Key Vaults within %s: %+v", resourceGroupId, err)
+		}
+		keyVaults = resp.Items
+	} else {
+		subscriptionId := commonids.NewSubscriptionID(metadata.SubscriptionId)
+		resp, err := client.ListBySubscriptionComplete(ctx, subscriptionId, vaults.DefaultListBySubscriptionOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Key Vaults within %s: %+v", subscriptionId, err)
+		}
+		keyVaults = resp.Items
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, item := range keyVaults {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := commonids.ParseKeyVaultIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, sdk.ListResult{
+			Id:   id,
+			Name: id.VaultName,
+			Tags: pointer.From(item.Tags),
+		})
+	}
+
+	return results, nil
+}
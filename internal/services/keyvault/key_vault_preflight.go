@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// keyVaultPreflightValidation checks the name of the Key Vault is available and that Resource Manager accepts the
+// planned Key Vault, when Preflight Validation is enabled
+func keyVaultPreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "sku_name", "tenant_id") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	if err := preflight.ValidateName(validate.VaultName, "name", name); err != nil {
+		return err
+	}
+
+	vaultsClient := client.KeyVault.VaultsClient
+	vaultLocation := location.Normalize(d.Get("location").(string))
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	availabilityRequest := vaults.VaultCheckNameAvailabilityParameters{
+		Name: name,
+		Type: vaults.TypeMicrosoftPointKeyVaultVaults,
+	}
+	resp, err := vaultsClient.CheckNameAvailability(ctx, subscriptionId, availabilityRequest)
+	if err != nil {
+		return fmt.Errorf("checking if the name %q was available: %+v", name, err)
+	}
+	if model := resp.Model; model != nil && model.NameAvailable != nil && !*model.NameAvailable {
+		// the name of a soft-deleted Key Vault is unavailable, however this is recovered during the apply when the
+		// `features` block allows it - in which case there's nothing further to validate
+		deletedVaultId := vaults.NewDeletedVaultID(client.Account.SubscriptionId, vaultLocation, name)
+		softDeleted, err := vaultsClient.GetDeleted(ctx, deletedVaultId)
+		if err != nil && !response.WasNotFound(softDeleted.HttpResponse) && !response.WasStatusCode(softDeleted.HttpResponse, http.StatusForbidden) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted Key Vault %q (Location %q): %+v", name, vaultLocation, err)
+		}
+		if err == nil {
+			if !client.Features.KeyVault.RecoverSoftDeletedKeyVaults {
+				return fmt.Errorf("%s", optedOutOfRecoveringSoftDeletedKeyVaultErrorFmt(name, vaultLocation))
+			}
+
+			log.Printf("[DEBUG] Skipping the preflight validation for Key Vault %q since the Soft-Deleted Key Vault will be recovered", name)
+			return nil
+		}
+
+		return preflight.NameUnavailableError("Key Vault", name, (*string)(model.Reason), model.Message)
+	}
+
+	resource := preflight.TemplateResource{
+		Type:       "Microsoft.KeyVault/vaults",
+		ApiVersion: "2023-02-01",
+		Name:       name,
+		Location:   vaultLocation,
+		Properties: map[string]interface{}{
+			"tenantId": d.Get("tenant_id").(string),
+			"sku": map[string]interface{}{
+				"family": string(vaults.SkuFamilyA),
+				"name":   d.Get("sku_name").(string),
+			},
+			"accessPolicies":          []interface{}{},
+			"enableRbacAuthorization": d.Get("enable_rbac_authorization").(bool),
+		},
+	}
+	if d.NewValueKnown("tags") {
+		resource.Tags = d.Get("tags").(map[string]interface{})
+	}
+
+	return preflight.ValidateDeployment(ctx, client, d.Get("resource_group_name").(string), resource)
+}
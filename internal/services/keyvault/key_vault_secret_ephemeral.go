@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultSecretEphemeralResource{}
+
+func NewKeyVaultSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultSecretEphemeralResource{}
+}
+
+type KeyVaultSecretEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KeyVaultSecretEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultID     types.String `tfsdk:"key_vault_id"`
+	Version        types.String `tfsdk:"version"`
+	Value          types.String `tfsdk:"value"`
+	ContentType    types.String `tfsdk:"content_type"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+}
+
+func (e *KeyVaultSecretEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_key_vault_secret"
+}
+
+func (e *KeyVaultSecretEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KeyVaultSecretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.NestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KeyVaultSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	keyVaultsClient := e.Client.KeyVault
+	client := e.Client.KeyVault.ManagementClient
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultSecretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parsing `key_vault_id`", err.Error())
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("looking up Base URI for Secret %q in %s", data.Name.ValueString(), keyVaultId), err.Error())
+		return
+	}
+
+	response, err := client.GetSecret(ctx, *keyVaultBaseUri, data.Name.ValueString(), data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(response.Response) {
+			resp.Diagnostics.AddError(fmt.Sprintf("retrieving Secret %q", data.Name.ValueString()), fmt.Sprintf("the Secret %q was not found in Key Vault at URI %q", data.Name.ValueString(), *keyVaultBaseUri))
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving Secret %q", data.Name.ValueString()), err.Error())
+		return
+	}
+
+	if response.ID == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving Secret %q", data.Name.ValueString()), "`id` was nil")
+		return
+	}
+
+	// the version may have changed, so parse the updated id
+	id, err := parse.ParseNestedItemID(*response.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("parsing Secret ID", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.Value = types.StringPointerValue(response.Value)
+	data.ContentType = types.StringPointerValue(response.ContentType)
+	data.ExpirationDate = types.StringNull()
+	data.NotBeforeDate = types.StringNull()
+	if attributes := response.Attributes; attributes != nil {
+		if expires := attributes.Expires; expires != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*expires).Format(time.RFC3339))
+		}
+		if notBefore := attributes.NotBefore; notBefore != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*notBefore).Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
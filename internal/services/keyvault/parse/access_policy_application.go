@@ -46,6 +46,24 @@ func (id AccessPolicyApplicationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.ObjectIdName, id.ApplicationIdName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AccessPolicyApplication ID
+func (id AccessPolicyApplicationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault", "Microsoft.KeyVault"),
+		resourceids.StaticSegment("staticVaults", "vaults", "vaults"),
+		resourceids.UserSpecifiedSegment("vaultName", "vault1"),
+		resourceids.StaticSegment("staticObjectId", "objectId", "objectId"),
+		resourceids.UserSpecifiedSegment("objectIdName", "object1"),
+		resourceids.StaticSegment("staticApplicationId", "applicationId", "applicationId"),
+		resourceids.UserSpecifiedSegment("applicationIdName", "application1"),
+	}
+}
+
 // AccessPolicyApplicationID parses a AccessPolicyApplication ID into an AccessPolicyApplicationId struct
 func AccessPolicyApplicationID(input string) (*AccessPolicyApplicationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func AccessPolicyApplicationID(input string) (*AccessPolicyApplicationId, error)
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AccessPolicyApplicationId using the ParseResult provided in 'input'
+func (id *AccessPolicyApplicationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	if id.ObjectIdName, ok = input.Parsed["objectIdName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "objectIdName", input)
+	}
+
+	if id.ApplicationIdName, ok = input.Parsed["applicationIdName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationIdName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestAccessPolicyApplicationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAccessPolicyApplicationIDSegments(t *testing.T) {
+	expected := NewAccessPolicyApplicationID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "object1", "application1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AccessPolicyApplicationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AccessPolicyApplicationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAccessPolicyApplicationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateIDSegments(t *testing.T) {
+	expected := NewCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "cert1", "version1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CertificateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CertificateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
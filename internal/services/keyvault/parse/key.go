@@ -46,6 +46,24 @@ func (id KeyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.Name, id.VersionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Key ID
+func (id KeyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault", "Microsoft.KeyVault"),
+		resourceids.StaticSegment("staticVaults", "vaults", "vaults"),
+		resourceids.UserSpecifiedSegment("vaultName", "vault1"),
+		resourceids.StaticSegment("staticKeys", "keys", "keys"),
+		resourceids.UserSpecifiedSegment("keyName", "key1"),
+		resourceids.StaticSegment("staticVersions", "versions", "versions"),
+		resourceids.UserSpecifiedSegment("versionName", "version1"),
+	}
+}
+
 // KeyID parses a Key ID into an KeyId struct
 func KeyID(input string) (*KeyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func KeyID(input string) (*KeyId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the KeyId using the ParseResult provided in 'input'
+func (id *KeyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	if id.Name, ok = input.Parsed["keyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "keyName", input)
+	}
+
+	if id.VersionName, ok = input.Parsed["versionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "versionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestKeyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestKeyIDSegments(t *testing.T) {
+	expected := NewKeyID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "key1", "version1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&KeyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := KeyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestKeyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSecretIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSecretIDSegments(t *testing.T) {
+	expected := NewSecretID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "secret1", "version1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SecretId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SecretId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSecretID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id SecretVersionlessId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.SecretName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SecretVersionless ID
+func (id SecretVersionlessId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault", "Microsoft.KeyVault"),
+		resourceids.StaticSegment("staticVaults", "vaults", "vaults"),
+		resourceids.UserSpecifiedSegment("vaultName", "vault1"),
+		resourceids.StaticSegment("staticSecrets", "secrets", "secrets"),
+		resourceids.UserSpecifiedSegment("secretName", "secret1"),
+	}
+}
+
 // SecretVersionlessID parses a SecretVersionless ID into an SecretVersionlessId struct
 func SecretVersionlessID(input string) (*SecretVersionlessId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func SecretVersionlessID(input string) (*SecretVersionlessId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SecretVersionlessId using the ParseResult provided in 'input'
+func (id *SecretVersionlessId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	if id.SecretName, ok = input.Parsed["secretName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "secretName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSecretVersionlessIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSecretVersionlessIDSegments(t *testing.T) {
+	expected := NewSecretVersionlessID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1", "secret1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SecretVersionlessId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SecretVersionlessId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSecretVersionlessID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -4,6 +4,7 @@
 package keyvault
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,6 +14,8 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkServiceRegistration               = Registration{}
+	_ sdk.ServiceRegistrationWithListResources       = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -69,5 +72,22 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyVaultCertificateContactsResource{},
+		KeyVaultCertificateMergeResource{},
+		KeyVaultCertificateSigningRequestResource{},
+	}
+}
+
+// EphemeralResources returns the Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKeyVaultCertificateEphemeralResource,
+		NewKeyVaultSecretEphemeralResource,
+	}
+}
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		KeyVaultListResource{},
 	}
 }
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"crypto"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/helpers"
+)
+
+// CertificateChain validates that the value is one or more PEM encoded certificates, where each certificate was
+// issued by the certificate which follows it
+func CertificateChain(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	certificates, err := helpers.ParseCertificateChain(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("parsing %q: %+v", k, err))
+		return warnings, errors
+	}
+
+	for i := 0; i < len(certificates)-1; i++ {
+		if err := certificates[i].CheckSignatureFrom(certificates[i+1]); err != nil {
+			errors = append(errors, fmt.Errorf("%q must be ordered from the leaf certificate to the root, but certificate %d (%q) wasn't issued by certificate %d (%q): %+v", k, i+1, certificates[i].Subject.String(), i+2, certificates[i+1].Subject.String(), err))
+		}
+	}
+
+	return warnings, errors
+}
+
+// CertificateChainMatchesSigningRequest validates that the leaf (first) certificate within the PEM encoded chain
+// contains the public key from the DER encoded certificate signing request
+func CertificateChainMatchesSigningRequest(chain string, certificateSigningRequest []byte) error {
+	certificates, err := helpers.ParseCertificateChain(chain)
+	if err != nil {
+		return err
+	}
+
+	request, err := x509.ParseCertificateRequest(certificateSigningRequest)
+	if err != nil {
+		return fmt.Errorf("parsing the certificate signing request: %+v", err)
+	}
+
+	publicKey, ok := certificates[0].PublicKey.(interface {
+		Equal(crypto.PublicKey) bool
+	})
+	if !ok {
+		return fmt.Errorf("unsupported public key type %T in the leaf certificate", certificates[0].PublicKey)
+	}
+	if !publicKey.Equal(request.PublicKey) {
+		return fmt.Errorf("the public key of the leaf certificate (%q) doesn't match the public key of the certificate signing request - the first certificate within the chain must be the certificate issued for the certificate signing request", certificates[0].Subject.String())
+	}
+
+	return nil
+}
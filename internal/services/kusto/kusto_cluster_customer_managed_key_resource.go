@@ -23,7 +23,7 @@ import (
 )
 
 func resourceKustoClusterCustomerManagedKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceKustoClusterCustomerManagedKeyCreateUpdate,
 		Read:   resourceKustoClusterCustomerManagedKeyRead,
 		Update: resourceKustoClusterCustomerManagedKeyCreateUpdate,
@@ -74,7 +74,7 @@ func resourceKustoClusterCustomerManagedKey() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 			},
 		},
-	}
+	}, &commonids.KustoClusterId{})
 }
 
 func resourceKustoClusterCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceKustoClusterManagedPrivateEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceKustoClusterManagedPrivateEndpointCreateUpdate,
 		Read:   resourceKustoClusterManagedPrivateEndpointRead,
 		Update: resourceKustoClusterManagedPrivateEndpointCreateUpdate,
@@ -91,7 +91,7 @@ func resourceKustoClusterManagedPrivateEndpoint() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &managedprivateendpoints.ManagedPrivateEndpointId{})
 }
 
 func resourceKustoClusterManagedPrivateEndpointCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceKustoClusterPrincipalAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceKustoClusterPrincipalAssignmentCreate,
 		Read:   resourceKustoClusterPrincipalAssignmentRead,
 		Delete: resourceKustoClusterPrincipalAssignmentDelete,
@@ -97,7 +97,7 @@ func resourceKustoClusterPrincipalAssignment() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(clusterprincipalassignments.PossibleValuesForClusterPrincipalRole(), false),
 			},
 		},
-	}
+	}, &clusterprincipalassignments.PrincipalAssignmentId{})
 }
 
 func resourceKustoClusterPrincipalAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -270,7 +270,9 @@ func resourceKustoCluster() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &commonids.KustoClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.KustoClusterId{})
 }
 
 func resourceKustoClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
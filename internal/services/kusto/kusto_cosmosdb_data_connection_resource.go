@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-05-15/cosmosdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/dataconnections"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +36,7 @@ type CosmosDBDataConnectionModel struct {
 }
 
 var _ sdk.Resource = CosmosDBDataConnectionResource{}
+var _ sdk.ResourceWithIdentity = CosmosDBDataConnectionResource{}
 
 type CosmosDBDataConnectionResource struct{}
 
@@ -239,3 +241,7 @@ func (r CosmosDBDataConnectionResource) Delete() sdk.ResourceFunc {
 func (r CosmosDBDataConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return dataconnections.ValidateDataConnectionID
 }
+
+func (r CosmosDBDataConnectionResource) Identity() resourceids.ResourceId {
+	return &dataconnections.DataConnectionId{}
+}
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceKustoDatabasePrincipalAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceKustoDatabasePrincipalAssignmentCreate,
 		Read:   resourceKustoDatabasePrincipalAssignmentRead,
 		Delete: resourceKustoDatabasePrincipalAssignmentDelete,
@@ -104,7 +104,7 @@ func resourceKustoDatabasePrincipalAssignment() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(databaseprincipalassignments.PossibleValuesForDatabasePrincipalRole(), false),
 			},
 		},
-	}
+	}, &databaseprincipalassignments.DatabasePrincipalAssignmentId{})
 }
 
 func resourceKustoDatabasePrincipalAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
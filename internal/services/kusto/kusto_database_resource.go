@@ -23,7 +23,7 @@ import (
 )
 
 func resourceKustoDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceKustoDatabaseCreateUpdate,
 		Read:   resourceKustoDatabaseRead,
 		Update: resourceKustoDatabaseCreateUpdate,
@@ -83,6 +83,10 @@ func resourceKustoDatabase() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.KustoDatabaseId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.KustoDatabaseId{})
 }
 
 func resourceKustoDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceKustoDatabaseScript() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceKustoDatabaseScriptCreateUpdate,
 		Read:   resourceKustoDatabaseScriptRead,
 		Update: resourceKustoDatabaseScriptCreateUpdate,
@@ -99,7 +99,7 @@ func resourceKustoDatabaseScript() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &scripts.ScriptId{})
 }
 
 func resourceKustoDatabaseScriptCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -29,7 +29,7 @@ import (
 )
 
 func resourceKustoEventGridDataConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceKustoEventGridDataConnectionCreateUpdate,
 		Update: resourceKustoEventGridDataConnectionCreateUpdate,
 		Read:   resourceKustoEventGridDataConnectionRead,
@@ -156,6 +156,10 @@ func resourceKustoEventGridDataConnection() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dataconnections.DataConnectionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dataconnections.DataConnectionId{})
 }
 
 func resourceKustoEventGridDataConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
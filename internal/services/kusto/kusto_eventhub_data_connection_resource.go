@@ -27,7 +27,7 @@ import (
 )
 
 func resourceKustoEventHubDataConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceKustoEventHubDataConnectionCreateUpdate,
 		Read:   resourceKustoEventHubDataConnectionRead,
 		Update: resourceKustoEventHubDataConnectionCreateUpdate,
@@ -146,6 +146,10 @@ func resourceKustoEventHubDataConnection() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dataconnections.DataConnectionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dataconnections.DataConnectionId{})
 }
 
 func resourceKustoEventHubDataConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
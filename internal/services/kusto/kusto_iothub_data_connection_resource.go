@@ -27,7 +27,7 @@ import (
 )
 
 func resourceKustoIotHubDataConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceKustoIotHubDataConnectionCreate,
 		Read:   resourceKustoIotHubDataConnectionRead,
 		Delete: resourceKustoIotHubDataConnectionDelete,
@@ -147,6 +147,10 @@ func resourceKustoIotHubDataConnection() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dataconnections.DataConnectionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dataconnections.DataConnectionId{})
 }
 
 func resourceKustoIotHubDataConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,23 @@ func TestDatabasePrincipalIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabasePrincipalIDSegments(t *testing.T) {
+	expected := NewDatabasePrincipalID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "database1", "Viewer", "aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DatabasePrincipalId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DatabasePrincipalId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDatabasePrincipalID(t *testing.T) {
 	testData := []struct {
 		Input    string
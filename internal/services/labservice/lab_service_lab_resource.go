@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/lab"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/labplan"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -102,6 +103,7 @@ type Roster struct {
 type LabServiceLabResource struct{}
 
 var _ sdk.ResourceWithUpdate = LabServiceLabResource{}
+var _ sdk.ResourceWithIdentity = LabServiceLabResource{}
 
 func (r LabServiceLabResource) ResourceType() string {
 	return "azurerm_lab_service_lab"
@@ -119,6 +121,10 @@ func (r LabServiceLabResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return lab.ValidateLabID
 }
 
+func (r LabServiceLabResource) Identity() resourceids.ResourceId {
+	return &lab.LabId{}
+}
+
 func (r LabServiceLabResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
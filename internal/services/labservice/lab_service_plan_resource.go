@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/labplan"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -58,6 +59,7 @@ type Support struct {
 type LabServicePlanResource struct{}
 
 var _ sdk.ResourceWithUpdate = LabServicePlanResource{}
+var _ sdk.ResourceWithIdentity = LabServicePlanResource{}
 
 func (r LabServicePlanResource) ResourceType() string {
 	return "azurerm_lab_service_plan"
@@ -75,6 +77,10 @@ func (r LabServicePlanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return labplan.ValidateLabPlanID
 }
 
+func (r LabServicePlanResource) Identity() resourceids.ResourceId {
+	return &labplan.LabPlanId{}
+}
+
 func (r LabServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/lab"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/schedule"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -41,6 +42,7 @@ type Recurrence struct {
 type LabServiceScheduleResource struct{}
 
 var _ sdk.ResourceWithUpdate = LabServiceScheduleResource{}
+var _ sdk.ResourceWithIdentity = LabServiceScheduleResource{}
 
 func (r LabServiceScheduleResource) ResourceType() string {
 	return "azurerm_lab_service_schedule"
@@ -58,6 +60,10 @@ func (r LabServiceScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return schedule.ValidateScheduleID
 }
 
+func (r LabServiceScheduleResource) Identity() resourceids.ResourceId {
+	return &schedule.ScheduleId{}
+}
+
 func (r LabServiceScheduleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/lab"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/user"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -29,6 +30,7 @@ type LabServiceUserModel struct {
 type LabServiceUserResource struct{}
 
 var _ sdk.ResourceWithUpdate = LabServiceUserResource{}
+var _ sdk.ResourceWithIdentity = LabServiceUserResource{}
 
 func (r LabServiceUserResource) ResourceType() string {
 	return "azurerm_lab_service_user"
@@ -46,6 +48,10 @@ func (r LabServiceUserResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return user.ValidateUserID
 }
 
+func (r LabServiceUserResource) Identity() resourceids.ResourceId {
+	return &user.UserId{}
+}
+
 func (r LabServiceUserResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
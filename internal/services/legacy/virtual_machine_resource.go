@@ -58,7 +58,7 @@ func userDataStateFunc(v interface{}) string {
 //	`azurerm_windows_virtual_machine` resources - as such this resource is feature-frozen and new
 //	functionality will be added to these new resources instead.
 func resourceVirtualMachine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualMachineCreateUpdate,
 		Read:   resourceVirtualMachineRead,
 		Update: resourceVirtualMachineCreateUpdate,
@@ -606,6 +606,10 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualmachines.VirtualMachineId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualmachines.VirtualMachineId{})
 }
 
 func resourceVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -37,7 +37,7 @@ import (
 //	`azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set` resources
 //	and as such this resource is feature-frozen and new functionality will be added to these new resources instead.
 func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualMachineScaleSetCreateUpdate,
 		Read:   resourceVirtualMachineScaleSetRead,
 		Update: resourceVirtualMachineScaleSetCreateUpdate,
@@ -776,6 +776,10 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(azureRmVirtualMachineScaleSetCustomizeDiff),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualmachinescalesets.VirtualMachineScaleSetId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualmachinescalesets.VirtualMachineScaleSetId{})
 }
 
 func resourceVirtualMachineScaleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceLighthouseAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLighthouseAssignmentCreate,
 		Read:   resourceLighthouseAssignmentRead,
 		Delete: resourceLighthouseAssignmentDelete,
@@ -62,7 +62,7 @@ func resourceLighthouseAssignment() *pluginsdk.Resource {
 				ValidateFunc: validation.Any(commonids.ValidateSubscriptionID, commonids.ValidateResourceGroupID),
 			},
 		},
-	}
+	}, &registrationassignments.ScopedRegistrationAssignmentId{})
 }
 
 func resourceLighthouseAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceLighthouseDefinition() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLighthouseDefinitionCreateUpdate,
 		Read:   resourceLighthouseDefinitionRead,
 		Update: resourceLighthouseDefinitionCreateUpdate,
@@ -214,7 +214,7 @@ func resourceLighthouseDefinition() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &registrationdefinitions.ScopedRegistrationDefinitionId{})
 }
 
 func resourceLighthouseDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -403,6 +404,10 @@ func (r BackendAddressPoolAddressResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.BackendAddressPoolAddressID
 }
 
+func (r BackendAddressPoolAddressResource) Identity() resourceids.ResourceId {
+	return &parse.BackendAddressPoolAddressId{}
+}
+
 func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
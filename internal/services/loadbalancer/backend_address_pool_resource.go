@@ -23,7 +23,7 @@ import (
 var backendAddressPoolResourceName = "azurerm_lb_backend_address_pool"
 
 func resourceArmLoadBalancerBackendAddressPool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmLoadBalancerBackendAddressPoolCreateUpdate,
 		Update: resourceArmLoadBalancerBackendAddressPoolCreateUpdate,
 		Read:   resourceArmLoadBalancerBackendAddressPoolRead,
@@ -150,7 +150,7 @@ func resourceArmLoadBalancerBackendAddressPool() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &loadbalancers.LoadBalancerBackendAddressPoolId{})
 }
 
 func resourceArmLoadBalancerBackendAddressPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
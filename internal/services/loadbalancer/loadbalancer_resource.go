@@ -33,7 +33,7 @@ import (
 )
 
 func resourceArmLoadBalancer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmLoadBalancerCreateUpdate,
 		Read:   resourceArmLoadBalancerRead,
 		Update: resourceArmLoadBalancerCreateUpdate,
@@ -77,6 +77,10 @@ func resourceArmLoadBalancer() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &loadbalancers.LoadBalancerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &loadbalancers.LoadBalancerId{})
 }
 
 func resourceArmLoadBalancerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
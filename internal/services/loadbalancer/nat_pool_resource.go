@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmLoadBalancerNatPool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmLoadBalancerNatPoolCreateUpdate,
 		Read:   resourceArmLoadBalancerNatPoolRead,
 		Update: resourceArmLoadBalancerNatPoolCreateUpdate,
@@ -120,7 +120,7 @@ func resourceArmLoadBalancerNatPool() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.LoadBalancerInboundNatPoolId{})
 }
 
 func resourceArmLoadBalancerNatPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -157,7 +157,7 @@ func resourceArmLoadBalancerNatRule() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &loadbalancers.InboundNatRuleId{})
 }
 
 func resourceArmLoadBalancerNatRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
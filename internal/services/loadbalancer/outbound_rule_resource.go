@@ -20,7 +20,7 @@ import (
 )
 
 func resourceArmLoadBalancerOutboundRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmLoadBalancerOutboundRuleCreateUpdate,
 		Read:   resourceArmLoadBalancerOutboundRuleRead,
 		Update: resourceArmLoadBalancerOutboundRuleCreateUpdate,
@@ -113,7 +113,7 @@ func resourceArmLoadBalancerOutboundRule() *pluginsdk.Resource {
 				Default:  4,
 			},
 		},
-	}
+	}, &loadbalancers.OutboundRuleId{})
 }
 
 func resourceArmLoadBalancerOutboundRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,23 @@ func TestBackendAddressPoolAddressIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendAddressPoolAddressIDSegments(t *testing.T) {
+	expected := NewBackendAddressPoolAddressID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadBalancer1", "backendAddressPool1", "address1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BackendAddressPoolAddressId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BackendAddressPoolAddressId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBackendAddressPoolAddressID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id LoadBalancerBackendAddressPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LoadBalancerBackendAddressPool ID
+func (id LoadBalancerBackendAddressPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticLoadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancer1"),
+		resourceids.StaticSegment("staticBackendAddressPools", "backendAddressPools", "backendAddressPools"),
+		resourceids.UserSpecifiedSegment("backendAddressPoolName", "backendAddressPool1"),
+	}
+}
+
 // LoadBalancerBackendAddressPoolID parses a LoadBalancerBackendAddressPool ID into an LoadBalancerBackendAddressPoolId struct
 func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func LoadBalancerBackendAddressPoolIDInsensitively(input string) (*LoadBalancerB
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the LoadBalancerBackendAddressPoolId using the ParseResult provided in 'input'
+func (id *LoadBalancerBackendAddressPoolId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadBalancerName, ok = input.Parsed["loadBalancerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancerName", input)
+	}
+
+	if id.BackendAddressPoolName, ok = input.Parsed["backendAddressPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "backendAddressPoolName", input)
+	}
+
+	return nil
+}
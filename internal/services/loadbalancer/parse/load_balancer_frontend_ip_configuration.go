@@ -43,6 +43,22 @@ func (id LoadBalancerFrontendIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.FrontendIPConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LoadBalancerFrontendIpConfiguration ID
+func (id LoadBalancerFrontendIpConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticLoadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancer1"),
+		resourceids.StaticSegment("staticFrontendIPConfigurations", "frontendIPConfigurations", "frontendIPConfigurations"),
+		resourceids.UserSpecifiedSegment("frontendIPConfigurationName", "frontendIPConfig1"),
+	}
+}
+
 // LoadBalancerFrontendIpConfigurationID parses a LoadBalancerFrontendIpConfiguration ID into an LoadBalancerFrontendIpConfigurationId struct
 func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendIpConfigurationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func LoadBalancerFrontendIpConfigurationIDInsensitively(input string) (*LoadBala
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the LoadBalancerFrontendIpConfigurationId using the ParseResult provided in 'input'
+func (id *LoadBalancerFrontendIpConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadBalancerName, ok = input.Parsed["loadBalancerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancerName", input)
+	}
+
+	if id.FrontendIPConfigurationName, ok = input.Parsed["frontendIPConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "frontendIPConfigurationName", input)
+	}
+
+	return nil
+}
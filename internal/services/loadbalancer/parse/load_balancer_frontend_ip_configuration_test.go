@@ -21,6 +21,23 @@ func TestLoadBalancerFrontendIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancerFrontendIpConfigurationIDSegments(t *testing.T) {
+	expected := NewLoadBalancerFrontendIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadBalancer1", "frontendIPConfig1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&LoadBalancerFrontendIpConfigurationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := LoadBalancerFrontendIpConfigurationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestLoadBalancerFrontendIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
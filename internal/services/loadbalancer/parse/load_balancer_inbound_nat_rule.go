@@ -43,6 +43,22 @@ func (id LoadBalancerInboundNatRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.InboundNatRuleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LoadBalancerInboundNatRule ID
+func (id LoadBalancerInboundNatRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticLoadBalancers", "loadBalancers", "loadBalancers"),
+		resourceids.UserSpecifiedSegment("loadBalancerName", "loadBalancer1"),
+		resourceids.StaticSegment("staticInboundNatRules", "inboundNatRules", "inboundNatRules"),
+		resourceids.UserSpecifiedSegment("inboundNatRuleName", "rule1"),
+	}
+}
+
 // LoadBalancerInboundNatRuleID parses a LoadBalancerInboundNatRule ID into an LoadBalancerInboundNatRuleId struct
 func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId,
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the LoadBalancerInboundNatRuleId using the ParseResult provided in 'input'
+func (id *LoadBalancerInboundNatRuleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LoadBalancerName, ok = input.Parsed["loadBalancerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "loadBalancerName", input)
+	}
+
+	if id.InboundNatRuleName, ok = input.Parsed["inboundNatRuleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "inboundNatRuleName", input)
+	}
+
+	return nil
+}
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceArmLoadBalancerProbe() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmLoadBalancerProbeCreateUpdate,
 		Read:   resourceArmLoadBalancerProbeRead,
 		Update: resourceArmLoadBalancerProbeCreateUpdate,
@@ -111,7 +111,7 @@ func resourceArmLoadBalancerProbe() *pluginsdk.Resource {
 				Set: pluginsdk.HashString,
 			},
 		},
-	}
+	}, &loadbalancers.ProbeId{})
 }
 
 func resourceArmLoadBalancerProbeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
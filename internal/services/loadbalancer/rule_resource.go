@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmLoadBalancerRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmLoadBalancerRuleCreateUpdate,
 		Read:   resourceArmLoadBalancerRuleRead,
 		Update: resourceArmLoadBalancerRuleCreateUpdate,
@@ -48,7 +48,7 @@ func resourceArmLoadBalancerRule() *pluginsdk.Resource {
 		},
 
 		Schema: resourceArmLoadBalancerRuleSchema(),
-	}
+	}, &loadbalancers.LoadBalancingRuleId{})
 }
 
 func resourceArmLoadBalancerRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
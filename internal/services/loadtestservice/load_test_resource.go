@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +24,7 @@ import (
 
 var _ sdk.Resource = LoadTestResource{}
 var _ sdk.ResourceWithUpdate = LoadTestResource{}
+var _ sdk.ResourceWithIdentity = LoadTestResource{}
 
 type LoadTestResource struct{}
 
@@ -54,6 +56,10 @@ type LoadTestEncryptionIdentity struct {
 func (r LoadTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return loadtests.ValidateLoadTestID
 }
+
+func (r LoadTestResource) Identity() resourceids.ResourceId {
+	return &loadtests.LoadTestId{}
+}
 func (r LoadTestResource) ResourceType() string {
 	return "azurerm_load_test"
 }
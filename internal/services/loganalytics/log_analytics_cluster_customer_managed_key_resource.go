@@ -22,7 +22,7 @@ import (
 )
 
 func resourceLogAnalyticsClusterCustomerManagedKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogAnalyticsClusterCustomerManagedKeyCreate,
 		Read:   resourceLogAnalyticsClusterCustomerManagedKeyRead,
 		Update: resourceLogAnalyticsClusterCustomerManagedKeyUpdate,
@@ -59,7 +59,7 @@ func resourceLogAnalyticsClusterCustomerManagedKey() *pluginsdk.Resource {
 				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 			},
 		},
-	}
+	}, &clusters.ClusterId{})
 }
 
 func resourceLogAnalyticsClusterCustomerManagedKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/clusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -38,6 +39,7 @@ type LogAnalyticsClusterModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsClusterResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsClusterResource{}
 
 func (l LogAnalyticsClusterResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -91,6 +93,10 @@ func (r LogAnalyticsClusterResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return clusters.ValidateClusterID
 }
 
+func (r LogAnalyticsClusterResource) Identity() resourceids.ResourceId {
+	return &clusters.ClusterId{}
+}
+
 func (r LogAnalyticsClusterResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceOperationalinsightsDataExportCreateUpdate,
 		Read:   resourceOperationalinsightsDataExportRead,
 		Update: resourceOperationalinsightsDataExportCreateUpdate,
@@ -95,7 +95,7 @@ func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &dataexport.DataExportId{})
 }
 
 func resourceOperationalinsightsDataExportCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
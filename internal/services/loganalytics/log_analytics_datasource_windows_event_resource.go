@@ -24,7 +24,7 @@ import (
 )
 
 func resourceLogAnalyticsDataSourceWindowsEvent() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogAnalyticsDataSourceWindowsEventCreateUpdate,
 		Read:   resourceLogAnalyticsDataSourceWindowsEventRead,
 		Update: resourceLogAnalyticsDataSourceWindowsEventCreateUpdate,
@@ -83,7 +83,7 @@ func resourceLogAnalyticsDataSourceWindowsEvent() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &datasources.DataSourceId{})
 }
 
 // this should not have been merged, needs to be fixed once https://github.com/Azure/azure-rest-api-specs/issues/9072 has been addressed
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceLogAnalyticsDataSourceWindowsPerformanceCounter() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate,
 		Read:   resourceLogAnalyticsDataSourceWindowsPerformanceCounterRead,
 		Update: resourceLogAnalyticsDataSourceWindowsPerformanceCounterCreateUpdate,
@@ -89,7 +89,7 @@ func resourceLogAnalyticsDataSourceWindowsPerformanceCounter() *pluginsdk.Resour
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &datasources.DataSourceId{})
 }
 
 // this should not have been merged, needs to be fixed once https://github.com/Azure/azure-rest-api-specs/issues/9072 has been addressed
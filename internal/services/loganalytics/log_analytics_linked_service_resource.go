@@ -23,7 +23,7 @@ import (
 )
 
 func resourceLogAnalyticsLinkedService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogAnalyticsLinkedServiceCreateUpdate,
 		Read:   resourceLogAnalyticsLinkedServiceRead,
 		Update: resourceLogAnalyticsLinkedServiceCreateUpdate,
@@ -61,7 +61,7 @@ func resourceLogAnalyticsLinkedService() *pluginsdk.Resource {
 
 			return nil
 		}),
-	}
+	}, &linkedservices.LinkedServiceId{})
 }
 
 func resourceLogAnalyticsLinkedServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
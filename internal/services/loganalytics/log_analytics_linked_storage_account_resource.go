@@ -87,7 +87,7 @@ func resourceLogAnalyticsLinkedStorageAccount() *pluginsdk.Resource {
 		resource.Schema["data_source_type"].DiffSuppressFunc = suppress.CaseDifference
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &linkedstorageaccounts.DataSourceTypeId{})
 }
 
 func resourceLogAnalyticsLinkedStorageAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
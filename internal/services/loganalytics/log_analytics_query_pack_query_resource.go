@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypackqueries"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -37,6 +38,7 @@ type LogAnalyticsQueryPackQueryModel struct {
 type LogAnalyticsQueryPackQueryResource struct{}
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsQueryPackQueryResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsQueryPackQueryResource{}
 
 func (r LogAnalyticsQueryPackQueryResource) ResourceType() string {
 	return "azurerm_log_analytics_query_pack_query"
@@ -50,6 +52,10 @@ func (r LogAnalyticsQueryPackQueryResource) IDValidationFunc() pluginsdk.SchemaV
 	return querypackqueries.ValidateQueryID
 }
 
+func (r LogAnalyticsQueryPackQueryResource) Identity() resourceids.ResourceId {
+	return &querypackqueries.QueryId{}
+}
+
 func (r LogAnalyticsQueryPackQueryResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,6 +30,7 @@ type LogAnalyticsQueryPackModel struct {
 type LogAnalyticsQueryPackResource struct{}
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsQueryPackResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsQueryPackResource{}
 
 func (r LogAnalyticsQueryPackResource) ResourceType() string {
 	return "azurerm_log_analytics_query_pack"
@@ -42,6 +44,10 @@ func (r LogAnalyticsQueryPackResource) IDValidationFunc() pluginsdk.SchemaValida
 	return querypacks.ValidateQueryPackID
 }
 
+func (r LogAnalyticsQueryPackResource) Identity() resourceids.ResourceId {
+	return &querypacks.QueryPackId{}
+}
+
 func (r LogAnalyticsQueryPackResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
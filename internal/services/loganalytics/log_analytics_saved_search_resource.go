@@ -22,7 +22,7 @@ import (
 )
 
 func resourceLogAnalyticsSavedSearch() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogAnalyticsSavedSearchCreate,
 		Read:   resourceLogAnalyticsSavedSearchRead,
 		Delete: resourceLogAnalyticsSavedSearchDelete,
@@ -101,7 +101,7 @@ func resourceLogAnalyticsSavedSearch() *pluginsdk.Resource {
 
 			"tags": tags.ForceNewSchema(),
 		},
-	}
+	}, &savedsearches.SavedSearchId{})
 }
 
 func resourceLogAnalyticsSavedSearchCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceLogAnalyticsStorageInsights() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogAnalyticsStorageInsightsCreateUpdate,
 		Read:   resourceLogAnalyticsStorageInsightsRead,
 		Update: resourceLogAnalyticsStorageInsightsCreateUpdate,
@@ -49,7 +49,7 @@ func resourceLogAnalyticsStorageInsights() *pluginsdk.Resource {
 		}),
 
 		Schema: resourceLogAnalyticsStorageInsightsSchema(),
-	}
+	}, &storageinsights.StorageInsightConfigId{})
 }
 
 func resourceLogAnalyticsStorageInsightsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
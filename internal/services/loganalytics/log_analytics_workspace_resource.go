@@ -197,7 +197,10 @@ func resourceLogAnalyticsWorkspace() *pluginsdk.Resource {
 			}, false),
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &workspaces.WorkspaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &workspaces.WorkspaceId{})
 }
 
 func resourceLogAnalyticsWorkspaceCustomDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
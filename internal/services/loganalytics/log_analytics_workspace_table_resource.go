@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,7 @@ type LogAnalyticsWorkspaceTableResource struct {
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsWorkspaceTableResource{}
 var _ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsWorkspaceTableResource{}
 
 type LogAnalyticsWorkspaceTableResourceModel struct {
 	Name                 string `tfschema:"name"`
@@ -103,6 +105,10 @@ func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaV
 	return tables.ValidateTableID
 }
 
+func (r LogAnalyticsWorkspaceTableResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
 func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
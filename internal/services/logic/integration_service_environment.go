@@ -30,7 +30,7 @@ import (
 )
 
 func resourceIntegrationServiceEnvironment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create:             resourceIntegrationServiceEnvironmentCreateUpdate,
 		Read:               resourceIntegrationServiceEnvironmentRead,
 		Update:             resourceIntegrationServiceEnvironmentCreateUpdate,
@@ -141,6 +141,10 @@ func resourceIntegrationServiceEnvironment() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &integrationserviceenvironments.IntegrationServiceEnvironmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &integrationserviceenvironments.IntegrationServiceEnvironmentId{})
 }
 
 func resourceIntegrationServiceEnvironmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -16,7 +16,7 @@ import (
 )
 
 func resourceLogicAppActionCustom() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppActionCustomCreateUpdate,
 		Read:   resourceLogicAppActionCustomRead,
 		Update: resourceLogicAppActionCustomCreateUpdate,
@@ -55,7 +55,7 @@ func resourceLogicAppActionCustom() *pluginsdk.Resource {
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 			},
 		},
-	}
+	}, &parse.ActionId{})
 }
 
 func resourceLogicAppActionCustomCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
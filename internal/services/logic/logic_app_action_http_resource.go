@@ -21,7 +21,7 @@ import (
 )
 
 func resourceLogicAppActionHTTP() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppActionHTTPCreateUpdate,
 		Read:   resourceLogicAppActionHTTPRead,
 		Update: resourceLogicAppActionHTTPCreateUpdate,
@@ -121,7 +121,7 @@ func resourceLogicAppActionHTTP() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.ActionId{})
 }
 
 func resourceLogicAppActionHTTPCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
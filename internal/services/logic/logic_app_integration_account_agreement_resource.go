@@ -21,7 +21,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountAgreement() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountAgreementCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountAgreementRead,
 		Update: resourceLogicAppIntegrationAccountAgreementCreateUpdate,
@@ -136,7 +136,7 @@ func resourceLogicAppIntegrationAccountAgreement() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &integrationaccountagreements.AgreementId{})
 }
 
 func resourceLogicAppIntegrationAccountAgreementCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountAssembly() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountAssemblyCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountAssemblyRead,
 		Update: resourceLogicAppIntegrationAccountAssemblyCreateUpdate,
@@ -92,7 +92,7 @@ func resourceLogicAppIntegrationAccountAssembly() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &integrationaccountassemblies.AssemblyId{})
 }
 
 func resourceLogicAppIntegrationAccountAssemblyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountBatchConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountBatchConfigurationCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountBatchConfigurationRead,
 		Update: resourceLogicAppIntegrationAccountBatchConfigurationCreateUpdate,
@@ -235,7 +235,7 @@ func resourceLogicAppIntegrationAccountBatchConfiguration() *pluginsdk.Resource
 
 			return nil
 		}),
-	}
+	}, &integrationaccountbatchconfigurations.BatchConfigurationId{})
 }
 
 func resourceLogicAppIntegrationAccountBatchConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountCertificateCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountCertificateRead,
 		Update: resourceLogicAppIntegrationAccountCertificateCreateUpdate,
@@ -96,7 +96,7 @@ func resourceLogicAppIntegrationAccountCertificate() *pluginsdk.Resource {
 				AtLeastOneOf: []string{"key_vault_key"},
 			},
 		},
-	}
+	}, &integrationaccountcertificates.CertificateId{})
 }
 
 func resourceLogicAppIntegrationAccountCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
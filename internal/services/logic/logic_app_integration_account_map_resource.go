@@ -21,7 +21,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountMap() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountMapCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountMapRead,
 		Update: resourceLogicAppIntegrationAccountMapCreateUpdate,
@@ -77,7 +77,7 @@ func resourceLogicAppIntegrationAccountMap() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &integrationaccountmaps.MapId{})
 }
 
 func resourceLogicAppIntegrationAccountMapCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountPartner() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountPartnerCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountPartnerRead,
 		Update: resourceLogicAppIntegrationAccountPartnerCreateUpdate,
@@ -82,7 +82,7 @@ func resourceLogicAppIntegrationAccountPartner() *pluginsdk.Resource {
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 			},
 		},
-	}
+	}, &integrationaccountpartners.PartnerId{})
 }
 
 func resourceLogicAppIntegrationAccountPartnerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
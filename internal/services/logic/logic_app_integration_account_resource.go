@@ -22,7 +22,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountRead,
 		Update: resourceLogicAppIntegrationAccountCreateUpdate,
@@ -72,6 +72,10 @@ func resourceLogicAppIntegrationAccount() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &integrationaccounts.IntegrationAccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &integrationaccounts.IntegrationAccountId{})
 }
 
 func resourceLogicAppIntegrationAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountSchema() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountSchemaCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountSchemaRead,
 		Update: resourceLogicAppIntegrationAccountSchemaCreateUpdate,
@@ -75,7 +75,7 @@ func resourceLogicAppIntegrationAccountSchema() *pluginsdk.Resource {
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 			},
 		},
-	}
+	}, &integrationaccountschemas.SchemaId{})
 }
 
 func resourceLogicAppIntegrationAccountSchemaCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceLogicAppIntegrationAccountSession() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountSessionCreateUpdate,
 		Read:   resourceLogicAppIntegrationAccountSessionRead,
 		Update: resourceLogicAppIntegrationAccountSessionCreateUpdate,
@@ -61,7 +61,7 @@ func resourceLogicAppIntegrationAccountSession() *pluginsdk.Resource {
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 			},
 		},
-	}
+	}, &integrationaccountsessions.SessionId{})
 }
 
 func resourceLogicAppIntegrationAccountSessionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -31,7 +31,7 @@ import (
 )
 
 func resourceLogicAppStandard() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLogicAppStandardCreate,
 		Read:   resourceLogicAppStandardRead,
 		Update: resourceLogicAppStandardUpdate,
@@ -239,6 +239,10 @@ func resourceLogicAppStandard() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.LogicAppStandardId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.LogicAppStandardId{})
 }
 
 func resourceLogicAppStandardCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -17,7 +17,7 @@ import (
 )
 
 func resourceLogicAppTriggerCustom() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppTriggerCustomCreateUpdate,
 		Read:   resourceLogicAppTriggerCustomRead,
 		Update: resourceLogicAppTriggerCustomCreateUpdate,
@@ -60,7 +60,7 @@ func resourceLogicAppTriggerCustom() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &workflowtriggers.TriggerId{})
 }
 
 func resourceLogicAppTriggerCustomCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceLogicAppTriggerHttpRequest() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppTriggerHttpRequestCreateUpdate,
 		Read:   resourceLogicAppTriggerHttpRequestRead,
 		Update: resourceLogicAppTriggerHttpRequestCreateUpdate,
@@ -93,7 +93,7 @@ func resourceLogicAppTriggerHttpRequest() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &workflowtriggers.TriggerId{})
 }
 
 func resourceLogicAppTriggerHttpRequestCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
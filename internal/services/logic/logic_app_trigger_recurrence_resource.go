@@ -16,7 +16,7 @@ import (
 )
 
 func resourceLogicAppTriggerRecurrence() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogicAppTriggerRecurrenceCreateUpdate,
 		Read:   resourceLogicAppTriggerRecurrenceRead,
 		Update: resourceLogicAppTriggerRecurrenceCreateUpdate,
@@ -125,7 +125,7 @@ func resourceLogicAppTriggerRecurrence() *pluginsdk.Resource {
 				ValidateFunc: validate.TriggerRecurrenceTimeZone(),
 			},
 		},
-	}
+	}, &workflowtriggers.TriggerId{})
 }
 
 func resourceLogicAppTriggerRecurrenceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -31,7 +31,7 @@ import (
 var logicAppResourceName = "azurerm_logic_app"
 
 func resourceLogicAppWorkflow() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLogicAppWorkflowCreate,
 		Read:   resourceLogicAppWorkflowRead,
 		Update: resourceLogicAppWorkflowUpdate,
@@ -275,6 +275,10 @@ func resourceLogicAppWorkflow() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &workflows.WorkflowId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &workflows.WorkflowId{})
 }
 
 func resourceLogicAppWorkflowCreate(d *pluginsdk.ResourceData, meta interface{}) error {
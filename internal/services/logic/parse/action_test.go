@@ -21,6 +21,23 @@ func TestActionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestActionIDSegments(t *testing.T) {
+	expected := NewActionID("12345678-1234-9876-4563-123456789012", "group1", "workflow1", "action1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ActionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ActionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestActionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,20 @@ func (id LogicAppStandardId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this LogicAppStandard ID
+func (id LogicAppStandardId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "site1"),
+	}
+}
+
 // LogicAppStandardID parses a LogicAppStandard ID into an LogicAppStandardId struct
 func LogicAppStandardID(input string) (*LogicAppStandardId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func LogicAppStandardID(input string) (*LogicAppStandardId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the LogicAppStandardId using the ParseResult provided in 'input'
+func (id *LogicAppStandardId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SiteName, ok = input.Parsed["siteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "siteName", input)
+	}
+
+	return nil
+}
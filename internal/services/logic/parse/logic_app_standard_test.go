@@ -21,6 +21,23 @@ func TestLogicAppStandardIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLogicAppStandardIDSegments(t *testing.T) {
+	expected := NewLogicAppStandardID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&LogicAppStandardId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := LogicAppStandardId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestLogicAppStandardID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceLogzMonitor() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLogzMonitorCreate,
 		Read:   resourceLogzMonitorRead,
 		Update: resourceLogzMonitorUpdate,
@@ -176,6 +176,10 @@ func resourceLogzMonitor() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &monitors.MonitorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &monitors.MonitorId{})
 }
 
 func resourceLogzMonitorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
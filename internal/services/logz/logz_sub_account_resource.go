@@ -26,7 +26,7 @@ import (
 )
 
 func resourceLogzSubAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogzSubAccountCreate,
 		Read:   resourceLogzSubAccountRead,
 		Update: resourceLogzSubAccountUpdate,
@@ -107,7 +107,7 @@ func resourceLogzSubAccount() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &subaccount.AccountId{})
 }
 func resourceLogzSubAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Logz.SubAccountClient
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceLogzSubAccountTagRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogzSubAccountTagRuleCreateUpdate,
 		Read:   resourceLogzSubAccountTagRuleRead,
 		Update: resourceLogzSubAccountTagRuleCreateUpdate,
@@ -94,7 +94,7 @@ func resourceLogzSubAccountTagRule() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &tagrules.AccountTagRuleId{})
 }
 func resourceLogzSubAccountTagRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Logz.TagRuleClient
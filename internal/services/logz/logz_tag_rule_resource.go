@@ -21,7 +21,7 @@ import (
 )
 
 func resourceLogzTagRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogzTagRuleCreateUpdate,
 		Read:   resourceLogzTagRuleRead,
 		Update: resourceLogzTagRuleCreateUpdate,
@@ -96,7 +96,7 @@ func resourceLogzTagRule() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &tagrules.TagRuleId{})
 }
 
 func resourceLogzTagRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -173,7 +173,10 @@ func resourceComputeCluster() *pluginsdk.Resource {
 			Computed: true,
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &machinelearningcomputes.ComputeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &machinelearningcomputes.ComputeId{})
 }
 
 func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
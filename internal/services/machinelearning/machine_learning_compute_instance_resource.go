@@ -171,7 +171,9 @@ func resourceComputeInstance() *pluginsdk.Resource {
 		}
 	}
 
-	return &resource
+	pluginsdk.EnableLocationValidation(&resource, &machinelearningcomputes.ComputeId{})
+
+	return pluginsdk.WithResourceIdentity(&resource, &machinelearningcomputes.ComputeId{})
 }
 
 func resourceComputeInstanceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -52,7 +53,12 @@ func (r MachineLearningDataStoreBlobStorage) IDValidationFunc() pluginsdk.Schema
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreBlobStorage) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreBlobStorage{}
+var _ sdk.ResourceWithIdentity = MachineLearningDataStoreBlobStorage{}
 
 func (r MachineLearningDataStoreBlobStorage) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -48,7 +49,12 @@ func (r MachineLearningDataStoreFileShare) IDValidationFunc() pluginsdk.SchemaVa
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreFileShare) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreFileShare{}
+var _ sdk.ResourceWithIdentity = MachineLearningDataStoreFileShare{}
 
 func (r MachineLearningDataStoreFileShare) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
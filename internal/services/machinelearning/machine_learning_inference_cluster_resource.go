@@ -26,7 +26,7 @@ import (
 )
 
 func resourceAksInferenceCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAksInferenceClusterCreate,
 		Read:   resourceAksInferenceClusterRead,
 		Delete: resourceAksInferenceClusterDelete,
@@ -135,6 +135,10 @@ func resourceAksInferenceCluster() *pluginsdk.Resource {
 			"tags": commonschema.TagsForceNew(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &machinelearningcomputes.ComputeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &machinelearningcomputes.ComputeId{})
 }
 
 func resourceAksInferenceClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceSynapseSpark() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSynapseSparkCreate,
 		Read:   resourceSynapseSparkRead,
 		Delete: resourceSynapseSparkDelete,
@@ -87,6 +87,10 @@ func resourceSynapseSpark() *pluginsdk.Resource {
 			"tags": commonschema.TagsForceNew(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &machinelearningcomputes.ComputeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &machinelearningcomputes.ComputeId{})
 }
 
 func resourceSynapseSparkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -277,7 +277,9 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &workspaces.WorkspaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &workspaces.WorkspaceId{})
 }
 
 func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
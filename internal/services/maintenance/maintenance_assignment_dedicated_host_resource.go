@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmMaintenanceAssignmentDedicatedHost() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmMaintenanceAssignmentDedicatedHostCreate,
 		Read:   resourceArmMaintenanceAssignmentDedicatedHostRead,
 		Delete: resourceArmMaintenanceAssignmentDedicatedHostDelete,
@@ -72,6 +72,10 @@ func resourceArmMaintenanceAssignmentDedicatedHost() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &configurationassignments.ScopedConfigurationAssignmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &configurationassignments.ScopedConfigurationAssignmentId{})
 }
 
 func resourceArmMaintenanceAssignmentDedicatedHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceArmMaintenanceAssignmentVirtualMachine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmMaintenanceAssignmentVirtualMachineCreate,
 		Read:   resourceArmMaintenanceAssignmentVirtualMachineRead,
 		Delete: resourceArmMaintenanceAssignmentVirtualMachineDelete,
@@ -70,6 +70,10 @@ func resourceArmMaintenanceAssignmentVirtualMachine() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &configurationassignments.ScopedConfigurationAssignmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &configurationassignments.ScopedConfigurationAssignmentId{})
 }
 
 func resourceArmMaintenanceAssignmentVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceArmMaintenanceAssignmentVirtualMachineScaleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmMaintenanceAssignmentVirtualMachineScaleSetCreate,
 		Read:   resourceArmMaintenanceAssignmentVirtualMachineScaleSetRead,
 		Delete: resourceArmMaintenanceAssignmentVirtualMachineScaleSetDelete,
@@ -72,6 +72,10 @@ func resourceArmMaintenanceAssignmentVirtualMachineScaleSet() *pluginsdk.Resourc
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &configurationassignments.ScopedConfigurationAssignmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &configurationassignments.ScopedConfigurationAssignmentId{})
 }
 
 func resourceArmMaintenanceAssignmentVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
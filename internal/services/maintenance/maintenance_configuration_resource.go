@@ -28,7 +28,7 @@ import (
 )
 
 func resourceMaintenanceConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMaintenanceConfigurationCreate,
 		Read:   resourceMaintenanceConfigurationRead,
 		Update: resourceMaintenanceConfigurationUpdate,
@@ -235,6 +235,10 @@ func resourceMaintenanceConfiguration() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &maintenanceconfigurations.MaintenanceConfigurationId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &maintenanceconfigurations.MaintenanceConfigurationId{})
 }
 
 func resourceMaintenanceConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
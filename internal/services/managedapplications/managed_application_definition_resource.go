@@ -23,7 +23,7 @@ import (
 )
 
 func resourceManagedApplicationDefinition() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceManagedApplicationDefinitionCreate,
 		Read:   resourceManagedApplicationDefinitionRead,
 		Update: resourceManagedApplicationDefinitionUpdate,
@@ -129,6 +129,10 @@ func resourceManagedApplicationDefinition() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &applicationdefinitions.ApplicationDefinitionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &applicationdefinitions.ApplicationDefinitionId{})
 }
 
 func resourceManagedApplicationDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -29,7 +29,7 @@ import (
 )
 
 func resourceManagedApplication() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceManagedApplicationCreate,
 		Read:   resourceManagedApplicationRead,
 		Update: resourceManagedApplicationUpdate,
@@ -49,6 +49,10 @@ func resourceManagedApplication() *pluginsdk.Resource {
 
 		Schema: resourceManagedApplicationSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &applications.ApplicationId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &applications.ApplicationId{})
 }
 
 func resourceManagedApplicationSchema() map[string]*pluginsdk.Schema {
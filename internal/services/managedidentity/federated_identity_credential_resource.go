@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,7 @@ import (
 )
 
 var _ sdk.Resource = FederatedIdentityCredentialResource{}
+var _ sdk.ResourceWithIdentity = FederatedIdentityCredentialResource{}
 
 type FederatedIdentityCredentialResource struct{}
 
@@ -38,6 +40,10 @@ func (r FederatedIdentityCredentialResource) IDValidationFunc() pluginsdk.Schema
 	return managedidentities.ValidateFederatedIdentityCredentialID
 }
 
+func (r FederatedIdentityCredentialResource) Identity() resourceids.ResourceId {
+	return &managedidentities.FederatedIdentityCredentialId{}
+}
+
 func (r FederatedIdentityCredentialResource) ResourceType() string {
 	return "azurerm_federated_identity_credential"
 }
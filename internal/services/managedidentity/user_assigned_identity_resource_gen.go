@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,7 @@ import (
 
 var _ sdk.Resource = UserAssignedIdentityResource{}
 var _ sdk.ResourceWithUpdate = UserAssignedIdentityResource{}
+var _ sdk.ResourceWithIdentity = UserAssignedIdentityResource{}
 
 type UserAssignedIdentityResource struct{}
 
@@ -41,6 +43,10 @@ type UserAssignedIdentityResourceSchema struct {
 func (r UserAssignedIdentityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateUserAssignedIdentityID
 }
+
+func (r UserAssignedIdentityResource) Identity() resourceids.ResourceId {
+	return &commonids.UserAssignedIdentityId{}
+}
 func (r UserAssignedIdentityResource) ResourceType() string {
 	return "azurerm_user_assigned_identity"
 }
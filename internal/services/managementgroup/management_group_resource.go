@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-05-01/managementgroups" // nolint: staticcheck
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
@@ -26,7 +27,7 @@ import (
 var managementGroupCacheControl = "no-cache"
 
 func resourceManagementGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceManagementGroupCreateUpdate,
 		Update: resourceManagementGroupCreateUpdate,
 		Read:   resourceManagementGroupRead,
@@ -82,7 +83,7 @@ func resourceManagementGroup() *pluginsdk.Resource {
 				Set: pluginsdk.HashString,
 			},
 		},
-	}
+	}, &commonids.ManagementGroupId{})
 }
 
 func resourceManagementGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
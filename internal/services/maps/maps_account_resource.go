@@ -143,7 +143,10 @@ func resourceMapsAccount() *pluginsdk.Resource {
 			DiffSuppressFunc: location.DiffSuppressFunc,
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &accounts.AccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &accounts.AccountId{})
 }
 
 func resourceMapsAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceMapsCreator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMapsCreatorCreateUpdate,
 		Read:   resourceMapsCreatorRead,
 		Update: resourceMapsCreatorUpdate,
@@ -64,6 +64,10 @@ func resourceMapsCreator() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &creators.CreatorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &creators.CreatorId{})
 }
 
 func resourceMapsCreatorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
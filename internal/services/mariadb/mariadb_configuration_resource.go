@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMariaDbConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMariaDbConfigurationCreate,
 		Read:   resourceMariaDbConfigurationRead,
 		Delete: resourceMariaDbConfigurationDelete,
@@ -60,7 +60,7 @@ func resourceMariaDbConfiguration() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &configurations.ConfigurationId{})
 }
 
 func resourceMariaDbConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
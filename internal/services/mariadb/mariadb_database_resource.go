@@ -22,7 +22,7 @@ import (
 )
 
 func resourceMariaDbDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMariaDbDatabaseCreate,
 		Read:   resourceMariaDbDatabaseRead,
 		Delete: resourceMariaDbDatabaseDelete,
@@ -80,7 +80,7 @@ func resourceMariaDbDatabase() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &databases.DatabaseId{})
 }
 
 func resourceMariaDbDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceArmMariaDBFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmMariaDBFirewallRuleCreateUpdate,
 		Read:   resourceArmMariaDBFirewallRuleRead,
 		Update: resourceArmMariaDBFirewallRuleCreateUpdate,
@@ -68,7 +68,7 @@ func resourceArmMariaDBFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: azValidate.IPv4Address,
 			},
 		},
-	}
+	}, &firewallrules.FirewallRuleId{})
 }
 
 func resourceArmMariaDBFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
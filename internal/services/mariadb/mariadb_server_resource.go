@@ -27,7 +27,7 @@ import (
 )
 
 func resourceMariaDbServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMariaDbServerCreate,
 		Read:   resourceMariaDbServerRead,
 		Update: resourceMariaDbServerUpdate,
@@ -184,6 +184,10 @@ func resourceMariaDbServer() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &servers.ServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &servers.ServerId{})
 }
 
 func resourceMariaDbServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
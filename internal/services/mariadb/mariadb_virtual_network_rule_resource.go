@@ -23,7 +23,7 @@ import (
 )
 
 func resourceMariaDbVirtualNetworkRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMariaDbVirtualNetworkRuleCreateUpdate,
 		Read:   resourceMariaDbVirtualNetworkRuleRead,
 		Update: resourceMariaDbVirtualNetworkRuleCreateUpdate,
@@ -65,7 +65,7 @@ func resourceMariaDbVirtualNetworkRule() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateSubnetID,
 			},
 		},
-	}
+	}, &virtualnetworkrules.VirtualNetworkRuleId{})
 }
 
 func resourceMariaDbVirtualNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
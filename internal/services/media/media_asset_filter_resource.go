@@ -21,7 +21,7 @@ import (
 )
 
 func resourceMediaAssetFilter() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaAssetFilterCreateUpdate,
 		Read:   resourceMediaAssetFilterRead,
 		Update: resourceMediaAssetFilterCreateUpdate,
@@ -183,7 +183,7 @@ func resourceMediaAssetFilter() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &assetsandassetfilters.AssetFilterId{})
 }
 
 func resourceMediaAssetFilterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceMediaAsset() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaAssetCreateUpdate,
 		Read:   resourceMediaAssetRead,
 		Update: resourceMediaAssetCreateUpdate,
@@ -102,7 +102,7 @@ func resourceMediaAsset() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &assetsandassetfilters.AssetId{})
 }
 
 func resourceMediaAssetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceMediaContentKeyPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaContentKeyPolicyCreateUpdate,
 		Read:   resourceMediaContentKeyPolicyRead,
 		Update: resourceMediaContentKeyPolicyCreateUpdate,
@@ -474,7 +474,7 @@ func resourceMediaContentKeyPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &contentkeypolicies.ContentKeyPolicyId{})
 }
 
 func resourceMediaContentKeyPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
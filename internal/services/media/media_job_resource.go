@@ -23,7 +23,7 @@ import (
 )
 
 func resourceMediaJob() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaJobCreate,
 		Read:   resourceMediaJobRead,
 		Update: resourceMediaJobUpdate,
@@ -151,7 +151,7 @@ func resourceMediaJob() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &encodings.JobId{})
 }
 
 func resourceMediaJobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceMediaLiveOutput() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaLiveOutputCreate,
 		Read:   resourceMediaLiveOutputRead,
 		Delete: resourceMediaLiveOutputDelete,
@@ -114,7 +114,7 @@ func resourceMediaLiveOutput() *pluginsdk.Resource {
 				ValidateFunc: validate.ISO8601DurationBetween("PT1M", "PT25H"),
 			},
 		},
-	}
+	}, &liveoutputs.LiveOutputId{})
 }
 
 func resourceMediaLiveOutputCreate(d *pluginsdk.ResourceData, meta interface{}) error {
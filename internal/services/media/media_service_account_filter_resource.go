@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/accountfilters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -19,6 +20,7 @@ import (
 
 var _ sdk.ResourceWithUpdate = AccountFilterResource{}
 var _ sdk.ResourceWithDeprecationAndNoReplacement = AccountFilterResource{}
+var _ sdk.ResourceWithIdentity = AccountFilterResource{}
 
 type AccountFilterModel struct {
 	Name                     string                  `tfschema:"name"`
@@ -199,6 +201,10 @@ func (r AccountFilterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return accountfilters.ValidateAccountFilterID
 }
 
+func (r AccountFilterResource) Identity() resourceids.ResourceId {
+	return &accountfilters.AccountFilterId{}
+}
+
 func (r AccountFilterResource) ModelObject() interface{} {
 	return &AccountFilterModel{}
 }
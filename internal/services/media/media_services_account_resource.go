@@ -29,7 +29,7 @@ import (
 )
 
 func resourceMediaServicesAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMediaServicesAccountCreateUpdate,
 		Read:   resourceMediaServicesAccountRead,
 		Update: resourceMediaServicesAccountCreateUpdate,
@@ -170,6 +170,10 @@ func resourceMediaServicesAccount() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &accounts.MediaServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &accounts.MediaServiceId{})
 }
 
 func resourceMediaServicesAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceMediaStreamingEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMediaStreamingEndpointCreate,
 		Read:   resourceMediaStreamingEndpointRead,
 		Update: resourceMediaStreamingEndpointUpdate,
@@ -236,6 +236,10 @@ func resourceMediaStreamingEndpoint() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &streamingendpoints.StreamingEndpointId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &streamingendpoints.StreamingEndpointId{})
 }
 
 func resourceMediaStreamingEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
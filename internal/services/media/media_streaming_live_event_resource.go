@@ -25,7 +25,7 @@ import (
 )
 
 func resourceMediaLiveEvent() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMediaLiveEventCreate,
 		Read:   resourceMediaLiveEventRead,
 		Update: resourceMediaLiveEventUpdate,
@@ -367,6 +367,10 @@ func resourceMediaLiveEvent() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &liveevents.LiveEventId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &liveevents.LiveEventId{})
 }
 
 func resourceMediaLiveEventCreate(d *pluginsdk.ResourceData, meta interface{}) error {
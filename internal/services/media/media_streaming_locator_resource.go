@@ -24,7 +24,7 @@ import (
 )
 
 func resourceMediaStreamingLocator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaStreamingLocatorCreate,
 		Read:   resourceMediaStreamingLocatorRead,
 		Delete: resourceMediaStreamingLocatorDelete,
@@ -180,7 +180,7 @@ func resourceMediaStreamingLocator() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &streamingpoliciesandstreaminglocators.StreamingLocatorId{})
 }
 
 func resourceMediaStreamingLocatorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
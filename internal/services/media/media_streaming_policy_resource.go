@@ -24,7 +24,7 @@ import (
 )
 
 func resourceMediaStreamingPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMediaStreamingPolicyCreate,
 		Read:   resourceMediaStreamingPolicyRead,
 		Delete: resourceMediaStreamingPolicyDelete,
@@ -339,7 +339,7 @@ func resourceMediaStreamingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &streamingpoliciesandstreaminglocators.StreamingPolicyId{})
 }
 
 func resourceMediaStreamingPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSpatialAnchorsAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	r := &pluginsdk.Resource{
 		Create: resourceSpatialAnchorsAccountCreate,
 		Read:   resourceSpatialAnchorsAccountRead,
 		Update: resourceSpatialAnchorsAccountUpdate,
@@ -66,6 +66,10 @@ func resourceSpatialAnchorsAccount() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(r, &resource.SpatialAnchorsAccountId{})
+
+	return pluginsdk.WithResourceIdentity(r, &resource.SpatialAnchorsAccountId{})
 }
 
 func resourceSpatialAnchorsAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
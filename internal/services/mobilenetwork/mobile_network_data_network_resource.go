@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,6 +30,7 @@ type DataNetworkResourceModel struct {
 type DataNetworkResource struct{}
 
 var _ sdk.ResourceWithUpdate = DataNetworkResource{}
+var _ sdk.ResourceWithIdentity = DataNetworkResource{}
 
 func (r DataNetworkResource) ResourceType() string {
 	return "azurerm_mobile_network_data_network"
@@ -42,6 +44,10 @@ func (r DataNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return datanetwork.ValidateDataNetworkID
 }
 
+func (r DataNetworkResource) Identity() resourceids.ResourceId {
+	return &datanetwork.DataNetworkId{}
+}
+
 func (r DataNetworkResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
@@ -65,6 +66,7 @@ type PlatformConfigurationModel struct {
 type PacketCoreControlPlaneResource struct{}
 
 var _ sdk.ResourceWithUpdate = PacketCoreControlPlaneResource{}
+var _ sdk.ResourceWithIdentity = PacketCoreControlPlaneResource{}
 
 func (r PacketCoreControlPlaneResource) ResourceType() string {
 	return "azurerm_mobile_network_packet_core_control_plane"
@@ -78,6 +80,10 @@ func (r PacketCoreControlPlaneResource) IDValidationFunc() pluginsdk.SchemaValid
 	return packetcorecontrolplane.ValidatePacketCoreControlPlaneID
 }
 
+func (r PacketCoreControlPlaneResource) Identity() resourceids.ResourceId {
+	return &packetcorecontrolplane.PacketCoreControlPlaneId{}
+}
+
 func (r PacketCoreControlPlaneResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
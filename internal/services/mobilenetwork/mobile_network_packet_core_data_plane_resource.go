@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -35,6 +36,7 @@ type PacketCoreDataPlaneModel struct {
 type PacketCoreDataPlaneResource struct{}
 
 var _ sdk.ResourceWithUpdate = PacketCoreDataPlaneResource{}
+var _ sdk.ResourceWithIdentity = PacketCoreDataPlaneResource{}
 
 func (r PacketCoreDataPlaneResource) ResourceType() string {
 	return "azurerm_mobile_network_packet_core_data_plane"
@@ -48,6 +50,10 @@ func (r PacketCoreDataPlaneResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return packetcoredataplane.ValidatePacketCoreDataPlaneID
 }
 
+func (r PacketCoreDataPlaneResource) Identity() resourceids.ResourceId {
+	return &packetcoredataplane.PacketCoreDataPlaneId{}
+}
+
 func (r PacketCoreDataPlaneResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -32,6 +33,7 @@ type MobileNetworkResourceModel struct {
 type MobileNetworkResource struct{}
 
 var _ sdk.ResourceWithUpdate = MobileNetworkResource{}
+var _ sdk.ResourceWithIdentity = MobileNetworkResource{}
 
 func (r MobileNetworkResource) ResourceType() string {
 	return "azurerm_mobile_network"
@@ -45,6 +47,10 @@ func (r MobileNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return mobilenetwork.ValidateMobileNetworkID
 }
 
+func (r MobileNetworkResource) Identity() resourceids.ResourceId {
+	return &mobilenetwork.MobileNetworkId{}
+}
+
 func (r MobileNetworkResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
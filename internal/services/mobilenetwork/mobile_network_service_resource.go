@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -70,6 +71,7 @@ type ServiceResourceQosPolicyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ServiceResource{}
+var _ sdk.ResourceWithIdentity = ServiceResource{}
 
 func (r ServiceResource) ResourceType() string {
 	return "azurerm_mobile_network_service"
@@ -83,6 +85,10 @@ func (r ServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return service.ValidateServiceID
 }
 
+func (r ServiceResource) Identity() resourceids.ResourceId {
+	return &service.ServiceId{}
+}
+
 func (r ServiceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
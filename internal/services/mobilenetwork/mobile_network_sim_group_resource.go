@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -31,6 +32,7 @@ type SimGroupResourceModel struct {
 type SimGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = SimGroupResource{}
+var _ sdk.ResourceWithIdentity = SimGroupResource{}
 
 func (r SimGroupResource) ResourceType() string {
 	return "azurerm_mobile_network_sim_group"
@@ -44,6 +46,10 @@ func (r SimGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return simgroup.ValidateSimGroupID
 }
 
+func (r SimGroupResource) Identity() resourceids.ResourceId {
+	return &simgroup.SimGroupId{}
+}
+
 func (r SimGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
@@ -61,6 +62,7 @@ type AmbrResourceModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = SimPolicyResource{}
+var _ sdk.ResourceWithIdentity = SimPolicyResource{}
 
 func (r SimPolicyResource) ResourceType() string {
 	return "azurerm_mobile_network_sim_policy"
@@ -74,6 +76,10 @@ func (r SimPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return simpolicy.ValidateSimPolicyID
 }
 
+func (r SimPolicyResource) Identity() resourceids.ResourceId {
+	return &simpolicy.SimPolicyId{}
+}
+
 func (r SimPolicyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
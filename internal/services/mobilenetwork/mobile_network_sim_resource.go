@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sim"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
@@ -47,6 +48,7 @@ type SimResource struct{}
 
 var _ sdk.ResourceWithUpdate = SimResource{}
 var _ sdk.ResourceWithCustomImporter = SimResource{}
+var _ sdk.ResourceWithIdentity = SimResource{}
 
 func (r SimResource) ResourceType() string {
 	return "azurerm_mobile_network_sim"
@@ -60,6 +62,10 @@ func (r SimResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return sim.ValidateSimID
 }
 
+func (r SimResource) Identity() resourceids.ResourceId {
+	return &sim.SimId{}
+}
+
 func (r SimResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,6 +30,7 @@ type SiteResourceModel struct {
 type SiteResource struct{}
 
 var _ sdk.ResourceWithUpdate = SiteResource{}
+var _ sdk.ResourceWithIdentity = SiteResource{}
 
 func (r SiteResource) ResourceType() string {
 	return "azurerm_mobile_network_site"
@@ -42,6 +44,10 @@ func (r SiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return site.ValidateSiteID
 }
 
+func (r SiteResource) Identity() resourceids.ResourceId {
+	return &site.SiteId{}
+}
+
 func (r SiteResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -37,6 +38,7 @@ type SingleNetworkSliceSelectionAssistanceInformationResourceModel struct {
 type SliceResource struct{}
 
 var _ sdk.ResourceWithUpdate = SliceResource{}
+var _ sdk.ResourceWithIdentity = SliceResource{}
 
 func (r SliceResource) ResourceType() string {
 	return "azurerm_mobile_network_slice"
@@ -50,6 +52,10 @@ func (r SliceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return slice.ValidateSliceID
 }
 
+func (r SliceResource) Identity() resourceids.ResourceId {
+	return &slice.SliceId{}
+}
+
 func (r SliceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -172,7 +172,7 @@ func resourceMonitorAADDiagnosticSetting() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &diagnosticsettings.DiagnosticSettingId{})
 }
 
 func resourceMonitorAADDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
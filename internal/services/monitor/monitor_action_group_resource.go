@@ -490,7 +490,10 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 			},
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &actiongroupsapis.ActionGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &actiongroupsapis.ActionGroupId{})
 }
 
 func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceMonitorActionRuleActionGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMonitorActionRuleActionGroupCreateUpdate,
 		Read:   resourceMonitorActionRuleActionGroupRead,
 		Update: resourceMonitorActionRuleActionGroupCreateUpdate,
@@ -103,7 +103,7 @@ func resourceMonitorActionRuleActionGroup() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &actionrules.ActionRuleId{})
 }
 
 func resourceMonitorActionRuleActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceMonitorActionRuleSuppression() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMonitorActionRuleSuppressionCreateUpdate,
 		Read:   resourceMonitorActionRuleSuppressionRead,
 		Update: resourceMonitorActionRuleSuppressionCreateUpdate,
@@ -161,7 +161,7 @@ func resourceMonitorActionRuleSuppression() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &actionrules.ActionRuleId{})
 }
 
 func resourceMonitorActionRuleSuppressionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -28,7 +28,7 @@ import (
 )
 
 func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMonitorActivityLogAlertCreateUpdate,
 		Read:   resourceMonitorActivityLogAlertRead,
 		Update: resourceMonitorActivityLogAlertCreateUpdate,
@@ -409,6 +409,10 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &activitylogalertsapis.ActivityLogAlertId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &activitylogalertsapis.ActivityLogAlertId{})
 }
 
 func resourceMonitorActivityLogAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
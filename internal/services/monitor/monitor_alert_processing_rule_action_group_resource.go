@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
@@ -31,6 +32,7 @@ type AlertProcessingRuleActionGroupModel struct {
 type AlertProcessingRuleActionGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleActionGroupResource{}
+var _ sdk.ResourceWithIdentity = AlertProcessingRuleActionGroupResource{}
 
 func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_action_group"
@@ -44,6 +46,10 @@ func (r AlertProcessingRuleActionGroupResource) IDValidationFunc() pluginsdk.Sch
 	return alertprocessingrules.ValidateActionRuleID
 }
 
+func (r AlertProcessingRuleActionGroupResource) Identity() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
+
 func (r AlertProcessingRuleActionGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := schemaAlertProcessingRule()
 	arguments["add_action_group_ids"] = &pluginsdk.Schema{
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,6 +30,7 @@ type AlertProcessingRuleSuppressionModel struct {
 type AlertProcessingRuleSuppressionResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleSuppressionResource{}
+var _ sdk.ResourceWithIdentity = AlertProcessingRuleSuppressionResource{}
 
 func (r AlertProcessingRuleSuppressionResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_suppression"
@@ -42,6 +44,10 @@ func (r AlertProcessingRuleSuppressionResource) IDValidationFunc() pluginsdk.Sch
 	return alertprocessingrules.ValidateActionRuleID
 }
 
+func (r AlertProcessingRuleSuppressionResource) Identity() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
+
 func (r AlertProcessingRuleSuppressionResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := schemaAlertProcessingRule()
 	return arguments
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2023-03-01/prometheusrulegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -59,6 +60,7 @@ type PrometheusRuleAlertResolutionModel struct {
 type AlertPrometheusRuleGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+var _ sdk.ResourceWithIdentity = AlertPrometheusRuleGroupResource{}
 
 func (r AlertPrometheusRuleGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_prometheus_rule_group"
@@ -104,6 +106,10 @@ func (r AlertPrometheusRuleGroupResource) IDValidationFunc() pluginsdk.SchemaVal
 	return prometheusrulegroups.ValidatePrometheusRuleGroupID
 }
 
+func (r AlertPrometheusRuleGroupResource) Identity() resourceids.ResourceId {
+	return &prometheusrulegroups.PrometheusRuleGroupId{}
+}
+
 func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
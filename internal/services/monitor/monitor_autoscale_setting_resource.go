@@ -27,7 +27,7 @@ import (
 )
 
 func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMonitorAutoScaleSettingCreateUpdate,
 		Read:   resourceMonitorAutoScaleSettingRead,
 		Update: resourceMonitorAutoScaleSettingCreateUpdate,
@@ -433,6 +433,10 @@ func resourceMonitorAutoScaleSetting() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &autoscalesettings.AutoScaleSettingId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &autoscalesettings.AutoScaleSettingId{})
 }
 
 func resourceMonitorAutoScaleSettingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -96,6 +97,10 @@ func (r DataCollectionEndpointResource) IDValidationFunc() pluginsdk.SchemaValid
 	return datacollectionendpoints.ValidateDataCollectionEndpointID
 }
 
+func (r DataCollectionEndpointResource) Identity() resourceids.ResourceId {
+	return &datacollectionendpoints.DataCollectionEndpointId{}
+}
+
 func (r DataCollectionEndpointResource) ModelObject() interface{} {
 	return &DataCollectionEndpoint{}
 }
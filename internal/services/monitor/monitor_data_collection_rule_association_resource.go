@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionruleassociations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
@@ -81,6 +82,10 @@ func (r DataCollectionRuleAssociationResource) IDValidationFunc() pluginsdk.Sche
 	return datacollectionruleassociations.ValidateScopedDataCollectionRuleAssociationID
 }
 
+func (r DataCollectionRuleAssociationResource) Identity() resourceids.ResourceId {
+	return &datacollectionruleassociations.ScopedDataCollectionRuleAssociationId{}
+}
+
 func (r DataCollectionRuleAssociationResource) ModelObject() interface{} {
 	return &DataCollectionRuleAssociationModel{}
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
@@ -29,6 +30,7 @@ import (
 
 var _ sdk.ResourceWithUpdate = DataCollectionRuleResource{}
 var _ sdk.ResourceWithCustomizeDiff = DataCollectionRuleResource{}
+var _ sdk.ResourceWithIdentity = DataCollectionRuleResource{}
 
 type DataCollectionRule struct {
 	DataCollectionEndpointId string                 `tfschema:"data_collection_endpoint_id"`
@@ -924,6 +926,10 @@ func (r DataCollectionRuleResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return datacollectionrules.ValidateDataCollectionRuleID
 }
 
+func (r DataCollectionRuleResource) Identity() resourceids.ResourceId {
+	return &datacollectionrules.DataCollectionRuleId{}
+}
+
 func (r DataCollectionRuleResource) ModelObject() interface{} {
 	return &DataCollectionRule{}
 }
@@ -26,7 +26,7 @@ import (
 )
 
 func resourceMonitorLogProfile() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceLogProfileCreateUpdate,
 		Read:   resourceLogProfileRead,
 		Update: resourceLogProfileCreateUpdate,
@@ -108,7 +108,7 @@ func resourceMonitorLogProfile() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &logprofiles.LogProfileId{})
 }
 
 func resourceLogProfileCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -31,7 +31,7 @@ import (
 )
 
 func resourceMonitorMetricAlert() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMonitorMetricAlertCreateUpdate,
 		Read:   resourceMonitorMetricAlertRead,
 		Update: resourceMonitorMetricAlertCreateUpdate,
@@ -385,7 +385,7 @@ func resourceMonitorMetricAlert() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
-	}
+	}, &metricalerts.MetricAlertId{})
 }
 
 func resourceMonitorMetricAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMonitorPrivateLinkScopeCreateUpdate,
 		Read:   resourceMonitorPrivateLinkScopeRead,
 		Update: resourceMonitorPrivateLinkScopeCreateUpdate,
@@ -66,7 +66,7 @@ func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
-	}
+	}, &privatelinkscopesapis.PrivateLinkScopeId{})
 }
 
 func resourceMonitorPrivateLinkScopeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceMonitorPrivateLinkScopedService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMonitorPrivateLinkScopedServiceCreate,
 		Read:   resourceMonitorPrivateLinkScopedServiceRead,
 		Delete: resourceMonitorPrivateLinkScopedServiceDelete,
@@ -70,7 +70,7 @@ func resourceMonitorPrivateLinkScopedService() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &privatelinkscopedresources.ScopedResourceId{})
 }
 
 func resourceMonitorPrivateLinkScopedServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceMonitorScheduledQueryRulesAlert() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMonitorScheduledQueryRulesAlertCreateUpdate,
 		Read:   resourceMonitorScheduledQueryRulesAlertRead,
 		Update: resourceMonitorScheduledQueryRulesAlertCreateUpdate,
@@ -222,6 +222,10 @@ func resourceMonitorScheduledQueryRulesAlert() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &scheduledqueryrules.ScheduledQueryRuleId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &scheduledqueryrules.ScheduledQueryRuleId{})
 }
 
 func resourceMonitorScheduledQueryRulesAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -76,6 +77,7 @@ type ScheduledQueryRulesAlertV2FailingPeriodsModel struct {
 type ScheduledQueryRulesAlertV2Resource struct{}
 
 var _ sdk.ResourceWithUpdate = ScheduledQueryRulesAlertV2Resource{}
+var _ sdk.ResourceWithIdentity = ScheduledQueryRulesAlertV2Resource{}
 
 func (r ScheduledQueryRulesAlertV2Resource) ResourceType() string {
 	return "azurerm_monitor_scheduled_query_rules_alert_v2"
@@ -89,6 +91,10 @@ func (r ScheduledQueryRulesAlertV2Resource) IDValidationFunc() pluginsdk.SchemaV
 	return scheduledqueryrules.ValidateScheduledQueryRuleID
 }
 
+func (r ScheduledQueryRulesAlertV2Resource) Identity() resourceids.ResourceId {
+	return &scheduledqueryrules.ScheduledQueryRuleId{}
+}
+
 func (r ScheduledQueryRulesAlertV2Resource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceMonitorScheduledQueryRulesLog() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMonitorScheduledQueryRulesLogCreateUpdate,
 		Read:   resourceMonitorScheduledQueryRulesLogRead,
 		Update: resourceMonitorScheduledQueryRulesLogCreateUpdate,
@@ -131,6 +131,10 @@ func resourceMonitorScheduledQueryRulesLog() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &scheduledqueryrules.ScheduledQueryRuleId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &scheduledqueryrules.ScheduledQueryRuleId{})
 }
 
 func resourceMonitorScheduledQueryRulesLogCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
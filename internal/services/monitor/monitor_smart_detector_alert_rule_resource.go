@@ -29,7 +29,7 @@ import (
 )
 
 func resourceMonitorSmartDetectorAlertRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMonitorSmartDetectorAlertRuleCreateUpdate,
 		Read:   resourceMonitorSmartDetectorAlertRuleRead,
 		Update: resourceMonitorSmartDetectorAlertRuleCreateUpdate,
@@ -155,7 +155,7 @@ func resourceMonitorSmartDetectorAlertRule() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &smartdetectoralertrules.SmartDetectorAlertRuleId{})
 }
 
 func resourceMonitorSmartDetectorAlertRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
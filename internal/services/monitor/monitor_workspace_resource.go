@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -32,6 +33,7 @@ type WorkspaceResourceModel struct {
 type WorkspaceResource struct{}
 
 var _ sdk.ResourceWithUpdate = WorkspaceResource{}
+var _ sdk.ResourceWithIdentity = WorkspaceResource{}
 
 func (r WorkspaceResource) ResourceType() string {
 	return "azurerm_monitor_workspace"
@@ -45,6 +47,10 @@ func (r WorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return azuremonitorworkspaces.ValidateAccountID
 }
 
+func (r WorkspaceResource) Identity() resourceids.ResourceId {
+	return &azuremonitorworkspaces.AccountId{}
+}
+
 func (r WorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
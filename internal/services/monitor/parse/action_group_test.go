@@ -21,6 +21,23 @@ func TestActionGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestActionGroupIDSegments(t *testing.T) {
+	expected := NewActionGroupID("12345678-1234-9876-4563-123456789012", "group1", "actionGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ActionGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ActionGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestActionGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
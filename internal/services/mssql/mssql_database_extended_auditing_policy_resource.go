@@ -21,7 +21,7 @@ import (
 )
 
 func resourceMsSqlDatabaseExtendedAuditingPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlDatabaseExtendedAuditingPolicyCreateUpdate,
 		Read:   resourceMsSqlDatabaseExtendedAuditingPolicyRead,
 		Update: resourceMsSqlDatabaseExtendedAuditingPolicyCreateUpdate,
@@ -85,7 +85,7 @@ func resourceMsSqlDatabaseExtendedAuditingPolicy() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &parse.DatabaseExtendedAuditingPolicyId{})
 }
 
 func resourceMsSqlDatabaseExtendedAuditingPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -43,7 +43,7 @@ import (
 )
 
 func resourceMsSqlDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlDatabaseCreate,
 		Read:   resourceMsSqlDatabaseRead,
 		Update: resourceMsSqlDatabaseUpdate,
@@ -113,7 +113,7 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 
 				return nil
 			}),
-	}
+	}, &commonids.SqlDatabaseId{})
 }
 
 func resourceMsSqlDatabaseImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
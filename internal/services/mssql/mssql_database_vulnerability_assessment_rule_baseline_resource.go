@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineCreateUpdate,
 		Read:   resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineRead,
 		Update: resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineCreateUpdate,
@@ -86,7 +86,7 @@ func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline() *pluginsdk.Resou
 				},
 			},
 		},
-	}
+	}, &parse.DatabaseVulnerabilityAssessmentRuleBaselineId{})
 }
 
 func resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaselineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -32,7 +32,7 @@ import (
 )
 
 func resourceMsSqlElasticPool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMsSqlElasticPoolCreateUpdate,
 		Read:   resourceMsSqlElasticPoolRead,
 		Update: resourceMsSqlElasticPoolCreateUpdate,
@@ -225,6 +225,10 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ElasticPoolId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ElasticPoolId{})
 }
 
 func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
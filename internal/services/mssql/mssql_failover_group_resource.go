@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -64,6 +65,10 @@ func (r MsSqlFailoverGroupResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.FailoverGroupID
 }
 
+func (r MsSqlFailoverGroupResource) Identity() resourceids.ResourceId {
+	return &parse.FailoverGroupId{}
+}
+
 func (r MsSqlFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
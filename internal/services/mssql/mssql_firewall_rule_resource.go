@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMsSqlFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlFirewallRuleCreateUpdate,
 		Read:   resourceMsSqlFirewallRuleRead,
 		Update: resourceMsSqlFirewallRuleCreateUpdate,
@@ -70,7 +70,7 @@ func resourceMsSqlFirewallRule() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &parse.FirewallRuleId{})
 }
 
 func resourceMsSqlFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceMsSqlJobAgent() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMsSqlJobAgentCreateUpdate,
 		Read:   resourceMsSqlJobAgentRead,
 		Update: resourceMsSqlJobAgentCreateUpdate,
@@ -61,6 +61,10 @@ func resourceMsSqlJobAgent() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.JobAgentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.JobAgentId{})
 }
 
 func resourceMsSqlJobAgentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
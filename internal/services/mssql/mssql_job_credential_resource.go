@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMsSqlJobCredential() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlJobCredentialCreateUpdate,
 		Read:   resourceMsSqlJobCredentialRead,
 		Update: resourceMsSqlJobCredentialCreateUpdate,
@@ -62,7 +62,7 @@ func resourceMsSqlJobCredential() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	}, &parse.JobCredentialId{})
 }
 
 func resourceMsSqlJobCredentialCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMsSqlOutboundFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlOutboundFirewallRuleCreate,
 		Read:   resourceMsSqlOutboundFirewallRuleRead,
 		Delete: resourceMsSqlOutboundFirewallRuleDelete,
@@ -49,7 +49,7 @@ func resourceMsSqlOutboundFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: validate.ServerID,
 			},
 		},
-	}
+	}, &parse.OutboundFirewallRuleId{})
 }
 
 func resourceMsSqlOutboundFirewallRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
@@ -153,3 +154,7 @@ func (m ServerDNSAliasResource) Delete() sdk.ResourceFunc {
 func (m ServerDNSAliasResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ServerDNSAliasID
 }
+
+func (m ServerDNSAliasResource) Identity() resourceids.ResourceId {
+	return &parse.ServerDNSAliasId{}
+}
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceMsSqlServerExtendedAuditingPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlServerExtendedAuditingPolicyCreateUpdate,
 		Read:   resourceMsSqlServerExtendedAuditingPolicyRead,
 		Update: resourceMsSqlServerExtendedAuditingPolicyCreateUpdate,
@@ -112,7 +112,7 @@ func resourceMsSqlServerExtendedAuditingPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.ServerExtendedAuditingPolicyId{})
 }
 
 func resourceMsSqlServerExtendedAuditingPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
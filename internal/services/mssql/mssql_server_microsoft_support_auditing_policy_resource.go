@@ -22,7 +22,7 @@ import (
 )
 
 func resourceMsSqlServerMicrosoftSupportAuditingPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlServerMicrosoftSupportAuditingPolicyCreateUpdate,
 		Read:   resourceMsSqlServerMicrosoftSupportAuditingPolicyRead,
 		Update: resourceMsSqlServerMicrosoftSupportAuditingPolicyCreateUpdate,
@@ -80,7 +80,7 @@ func resourceMsSqlServerMicrosoftSupportAuditingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.ServerMicrosoftSupportAuditingPolicyId{})
 }
 
 func resourceMsSqlServerMicrosoftSupportAuditingPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -37,7 +37,7 @@ import (
 )
 
 func resourceMsSqlServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMsSqlServerCreate,
 		Read:   resourceMsSqlServerRead,
 		Update: resourceMsSqlServerUpdate,
@@ -83,15 +83,30 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				AtLeastOneOf: []string{"administrator_login", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
 			},
 
 			"administrator_login_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				AtLeastOneOf:  []string{"administrator_login_password", "administrator_login_password_wo", "azuread_administrator.0.azuread_authentication_only"},
+				ConflictsWith: []string{"administrator_login_password_wo"},
+				RequiredWith:  []string{"administrator_login", "administrator_login_password"},
+			},
+
+			"administrator_login_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"administrator_login_password"},
+				RequiredWith:  []string{"administrator_login", "administrator_login_password_wo_version"},
+			},
+
+			"administrator_login_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				AtLeastOneOf: []string{"administrator_login_password", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
+				RequiredWith: []string{"administrator_login_password_wo"},
 			},
 
 			"azuread_administrator": {
@@ -202,6 +217,10 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 			pluginsdk.CustomizeDiffShim(msSqlPasswordChangeWhenAADAuthOnly),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ServerId{})
 }
 
 func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -245,6 +264,14 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		props.Properties.AdministratorLoginPassword = utils.String(v.(string))
 	}
 
+	passwordWriteOnly, err := pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+	if err != nil {
+		return err
+	}
+	if passwordWriteOnly != nil && *passwordWriteOnly != "" {
+		props.Properties.AdministratorLoginPassword = passwordWriteOnly
+	}
+
 	// NOTE: You must set the admin before setting the values of the admin...
 	if azureADAdministrator, ok := d.GetOk("azuread_administrator"); ok {
 		props.Properties.Administrators = expandMsSqlServerAdministrators(azureADAdministrator.([]interface{}))
@@ -377,6 +404,14 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
 		}
 
+		if d.HasChange("administrator_login_password_wo_version") {
+			adminPassword, err := pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+			if err != nil {
+				return err
+			}
+			payload.Properties.AdministratorLoginPassword = adminPassword
+		}
+
 		if d.HasChange("minimum_tls_version") {
 			payload.Properties.MinimalTlsVersion = pointer.To(d.Get("minimum_tls_version").(string))
 		}
@@ -707,5 +742,8 @@ func msSqlPasswordChangeWhenAADAuthOnly(ctx context.Context, d *pluginsdk.Resour
 	if old.(bool) && d.HasChange("administrator_login_password") {
 		err = fmt.Errorf("`administrator_login_password` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
 	}
+	if old.(bool) && d.HasChange("administrator_login_password_wo_version") {
+		err = fmt.Errorf("`administrator_login_password_wo_version` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
+	}
 	return
 }
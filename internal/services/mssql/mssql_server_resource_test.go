@@ -117,6 +117,28 @@ func TestAccMsSqlServer_update(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlServer_writeOnlyPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
+	r := MsSqlServerResource{}
+
+	data.ResourceTestWithMinimumTerraformVersion(t, r, "1.11.0", []acceptance.TestStep{
+		{
+			Config: r.writeOnlyPassword(data, "thisIsKat11", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password_wo", "administrator_login_password_wo_version"),
+		{
+			Config: r.writeOnlyPassword(data, "thisIsKat12", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password_wo", "administrator_login_password_wo_version"),
+	})
+}
+
 func TestAccMsSqlServer_systemAssignedIdentity(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_server", "test")
 	r := MsSqlServerResource{}
@@ -344,6 +366,29 @@ resource "azurerm_mssql_server" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (MsSqlServerResource) writeOnlyPassword(data acceptance.TestData, password string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                                    = "acctestsqlserver%[1]d"
+  resource_group_name                     = azurerm_resource_group.test.name
+  location                                = azurerm_resource_group.test.location
+  version                                 = "12.0"
+  administrator_login                     = "missadministrator"
+  administrator_login_password_wo         = "%[3]s"
+  administrator_login_password_wo_version = %[4]d
+}
+`, data.RandomInteger, data.Locations.Primary, password, version)
+}
+
 func (MsSqlServerResource) basicWithMinimumTLSVersionDisabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
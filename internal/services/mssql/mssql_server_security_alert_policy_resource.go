@@ -22,7 +22,7 @@ import (
 // TODO 4.0 - consider/investigate inlining this within the mssql_server resource now that it exists.
 
 func resourceMsSqlServerSecurityAlertPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlServerSecurityAlertPolicyCreateUpdate,
 		Read:   resourceMsSqlServerSecurityAlertPolicyRead,
 		Update: resourceMsSqlServerSecurityAlertPolicyCreateUpdate,
@@ -111,7 +111,7 @@ func resourceMsSqlServerSecurityAlertPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.ServerSecurityAlertPolicyId{})
 }
 
 func resourceMsSqlServerSecurityAlertPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
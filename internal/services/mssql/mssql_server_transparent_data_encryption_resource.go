@@ -26,7 +26,7 @@ import (
 )
 
 func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlTransparentDataEncryptionCreateUpdate,
 		Read:   resourceMsSqlTransparentDataEncryptionRead,
 		Update: resourceMsSqlTransparentDataEncryptionCreateUpdate,
@@ -78,7 +78,7 @@ func resourceMsSqlTransparentDataEncryption() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &parse.EncryptionProtectorId{})
 }
 
 func resourceMsSqlTransparentDataEncryptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
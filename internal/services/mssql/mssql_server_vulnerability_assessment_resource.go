@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMsSqlServerVulnerabilityAssessment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlServerVulnerabilityAssessmentCreateUpdate,
 		Read:   resourceMsSqlServerVulnerabilityAssessmentRead,
 		Update: resourceMsSqlServerVulnerabilityAssessmentCreateUpdate,
@@ -96,7 +96,7 @@ func resourceMsSqlServerVulnerabilityAssessment() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.ServerVulnerabilityAssessmentId{})
 }
 
 func resourceMsSqlServerVulnerabilityAssessmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMsSqlVirtualNetworkRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlVirtualNetworkRuleCreateUpdate,
 		Read:   resourceMsSqlVirtualNetworkRuleRead,
 		Update: resourceMsSqlVirtualNetworkRuleCreateUpdate,
@@ -65,7 +65,7 @@ func resourceMsSqlVirtualNetworkRule() *pluginsdk.Resource {
 				Default:  false, // When not provided, Azure defaults to false
 			},
 		},
-	}
+	}, &parse.VirtualNetworkRuleId{})
 }
 
 func resourceMsSqlVirtualNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
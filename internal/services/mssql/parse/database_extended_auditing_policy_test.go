@@ -21,6 +21,23 @@ func TestDatabaseExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabaseExtendedAuditingPolicyIDSegments(t *testing.T) {
+	expected := NewDatabaseExtendedAuditingPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DatabaseExtendedAuditingPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DatabaseExtendedAuditingPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDatabaseExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -52,6 +52,28 @@ func (id DatabaseVulnerabilityAssessmentRuleBaselineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.VulnerabilityAssessmentName, id.RuleName, id.BaselineName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this DatabaseVulnerabilityAssessmentRuleBaseline ID
+func (id DatabaseVulnerabilityAssessmentRuleBaselineId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("databaseName", "database1"),
+		resourceids.StaticSegment("staticVulnerabilityAssessments", "vulnerabilityAssessments", "vulnerabilityAssessments"),
+		resourceids.UserSpecifiedSegment("vulnerabilityAssessmentName", "default"),
+		resourceids.StaticSegment("staticRules", "rules", "rules"),
+		resourceids.UserSpecifiedSegment("ruleName", "rule1"),
+		resourceids.StaticSegment("staticBaselines", "baselines", "baselines"),
+		resourceids.UserSpecifiedSegment("baselineName", "baseline1"),
+	}
+}
+
 // DatabaseVulnerabilityAssessmentRuleBaselineID parses a DatabaseVulnerabilityAssessmentRuleBaseline ID into an DatabaseVulnerabilityAssessmentRuleBaselineId struct
 func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -94,3 +116,38 @@ func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulne
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the DatabaseVulnerabilityAssessmentRuleBaselineId using the ParseResult provided in 'input'
+func (id *DatabaseVulnerabilityAssessmentRuleBaselineId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.DatabaseName, ok = input.Parsed["databaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "databaseName", input)
+	}
+
+	if id.VulnerabilityAssessmentName, ok = input.Parsed["vulnerabilityAssessmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vulnerabilityAssessmentName", input)
+	}
+
+	if id.RuleName, ok = input.Parsed["ruleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ruleName", input)
+	}
+
+	if id.BaselineName, ok = input.Parsed["baselineName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "baselineName", input)
+	}
+
+	return nil
+}
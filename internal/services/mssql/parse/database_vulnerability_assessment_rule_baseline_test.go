@@ -21,6 +21,23 @@ func TestDatabaseVulnerabilityAssessmentRuleBaselineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabaseVulnerabilityAssessmentRuleBaselineIDSegments(t *testing.T) {
+	expected := NewDatabaseVulnerabilityAssessmentRuleBaselineID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1", "default", "rule1", "baseline1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DatabaseVulnerabilityAssessmentRuleBaselineId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DatabaseVulnerabilityAssessmentRuleBaselineId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDatabaseVulnerabilityAssessmentRuleBaselineID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestEncryptionProtectorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEncryptionProtectorIDSegments(t *testing.T) {
+	expected := NewEncryptionProtectorID("12345678-1234-9876-4563-123456789012", "group1", "server1", "current")
+	parsed, err := resourceids.NewParserFromResourceIdType(&EncryptionProtectorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := EncryptionProtectorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestEncryptionProtectorID(t *testing.T) {
 	testData := []struct {
 		Input    string
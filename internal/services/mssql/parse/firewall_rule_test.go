@@ -21,6 +21,23 @@ func TestFirewallRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallRuleIDSegments(t *testing.T) {
+	expected := NewFirewallRuleID("12345678-1234-9876-4563-123456789012", "group1", "server1", "rule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FirewallRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FirewallRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFirewallRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
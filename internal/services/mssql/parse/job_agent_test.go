@@ -21,6 +21,23 @@ func TestJobAgentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestJobAgentIDSegments(t *testing.T) {
+	expected := NewJobAgentID("12345678-1234-9876-4563-123456789012", "group1", "server1", "jobagent1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&JobAgentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := JobAgentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestJobAgentID(t *testing.T) {
 	testData := []struct {
 		Input    string
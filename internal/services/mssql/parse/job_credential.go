@@ -46,6 +46,24 @@ func (id JobCredentialId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.CredentialName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this JobCredential ID
+func (id JobCredentialId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticJobAgents", "jobAgents", "jobAgents"),
+		resourceids.UserSpecifiedSegment("jobAgentName", "jobagent1"),
+		resourceids.StaticSegment("staticCredentials", "credentials", "credentials"),
+		resourceids.UserSpecifiedSegment("credentialName", "credential1"),
+	}
+}
+
 // JobCredentialID parses a JobCredential ID into an JobCredentialId struct
 func JobCredentialID(input string) (*JobCredentialId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func JobCredentialID(input string) (*JobCredentialId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the JobCredentialId using the ParseResult provided in 'input'
+func (id *JobCredentialId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.JobAgentName, ok = input.Parsed["jobAgentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "jobAgentName", input)
+	}
+
+	if id.CredentialName, ok = input.Parsed["credentialName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "credentialName", input)
+	}
+
+	return nil
+}
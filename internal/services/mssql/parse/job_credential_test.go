@@ -21,6 +21,23 @@ func TestJobCredentialIDFormatter(t *testing.T) {
 	}
 }
 
+func TestJobCredentialIDSegments(t *testing.T) {
+	expected := NewJobCredentialID("12345678-1234-9876-4563-123456789012", "group1", "server1", "jobagent1", "credential1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&JobCredentialId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := JobCredentialId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestJobCredentialID(t *testing.T) {
 	testData := []struct {
 		Input    string
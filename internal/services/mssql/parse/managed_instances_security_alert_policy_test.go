@@ -21,6 +21,23 @@ func TestManagedInstancesSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedInstancesSecurityAlertPolicyIDSegments(t *testing.T) {
+	expected := NewManagedInstancesSecurityAlertPolicyID("12345678-1234-9876-4563-123456789012", "group1", "instance1", "Default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedInstancesSecurityAlertPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedInstancesSecurityAlertPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedInstancesSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
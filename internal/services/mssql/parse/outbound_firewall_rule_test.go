@@ -21,6 +21,23 @@ func TestOutboundFirewallRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOutboundFirewallRuleIDSegments(t *testing.T) {
+	expected := NewOutboundFirewallRuleID("12345678-1234-9876-4563-123456789012", "group1", "server1", "fqdn1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&OutboundFirewallRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := OutboundFirewallRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestOutboundFirewallRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id ServerDNSAliasId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DnsAliaseName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ServerDNSAlias ID
+func (id ServerDNSAliasId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticDnsAliases", "dnsAliases", "dnsAliases"),
+		resourceids.UserSpecifiedSegment("dnsAliaseName", "default"),
+	}
+}
+
 // ServerDNSAliasID parses a ServerDNSAlias ID into an ServerDNSAliasId struct
 func ServerDNSAliasID(input string) (*ServerDNSAliasId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ServerDNSAliasID(input string) (*ServerDNSAliasId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ServerDNSAliasId using the ParseResult provided in 'input'
+func (id *ServerDNSAliasId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.DnsAliaseName, ok = input.Parsed["dnsAliaseName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dnsAliaseName", input)
+	}
+
+	return nil
+}
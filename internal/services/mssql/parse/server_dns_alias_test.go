@@ -21,6 +21,23 @@ func TestServerDNSAliasIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerDNSAliasIDSegments(t *testing.T) {
+	expected := NewServerDNSAliasID("12345678-1234-9876-4563-123456789012", "group1", "server1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ServerDNSAliasId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ServerDNSAliasId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestServerDNSAliasID(t *testing.T) {
 	testData := []struct {
 		Input    string
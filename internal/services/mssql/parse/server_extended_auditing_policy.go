@@ -43,6 +43,22 @@ func (id ServerExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.ExtendedAuditingSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ServerExtendedAuditingPolicy ID
+func (id ServerExtendedAuditingPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticServers", "servers", "servers"),
+		resourceids.UserSpecifiedSegment("serverName", "server1"),
+		resourceids.StaticSegment("staticExtendedAuditingSettings", "extendedAuditingSettings", "extendedAuditingSettings"),
+		resourceids.UserSpecifiedSegment("extendedAuditingSettingName", "default"),
+	}
+}
+
 // ServerExtendedAuditingPolicyID parses a ServerExtendedAuditingPolicy ID into an ServerExtendedAuditingPolicyId struct
 func ServerExtendedAuditingPolicyID(input string) (*ServerExtendedAuditingPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ServerExtendedAuditingPolicyID(input string) (*ServerExtendedAuditingPolicy
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ServerExtendedAuditingPolicyId using the ParseResult provided in 'input'
+func (id *ServerExtendedAuditingPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerName, ok = input.Parsed["serverName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverName", input)
+	}
+
+	if id.ExtendedAuditingSettingName, ok = input.Parsed["extendedAuditingSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "extendedAuditingSettingName", input)
+	}
+
+	return nil
+}
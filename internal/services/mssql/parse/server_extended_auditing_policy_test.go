@@ -21,6 +21,23 @@ func TestServerExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerExtendedAuditingPolicyIDSegments(t *testing.T) {
+	expected := NewServerExtendedAuditingPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ServerExtendedAuditingPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ServerExtendedAuditingPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestServerExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestServerSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerSecurityAlertPolicyIDSegments(t *testing.T) {
+	expected := NewServerSecurityAlertPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "Default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ServerSecurityAlertPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ServerSecurityAlertPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestServerSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestServerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerIDSegments(t *testing.T) {
+	expected := NewServerID("12345678-1234-9876-4563-123456789012", "group1", "server1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ServerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ServerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestServerID(t *testing.T) {
 	testData := []struct {
 		Input    string
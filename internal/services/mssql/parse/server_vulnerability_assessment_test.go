@@ -21,6 +21,23 @@ func TestServerVulnerabilityAssessmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerVulnerabilityAssessmentIDSegments(t *testing.T) {
+	expected := NewServerVulnerabilityAssessmentID("12345678-1234-9876-4563-123456789012", "group1", "server1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ServerVulnerabilityAssessmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ServerVulnerabilityAssessmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestServerVulnerabilityAssessmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSqlVirtualMachineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlVirtualMachineIDSegments(t *testing.T) {
+	expected := NewSqlVirtualMachineID("12345678-1234-9876-4563-123456789012", "group1", "virtualMachine1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlVirtualMachineId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlVirtualMachineId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlVirtualMachineID(t *testing.T) {
 	testData := []struct {
 		Input    string
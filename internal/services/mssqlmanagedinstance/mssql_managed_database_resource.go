@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
@@ -60,6 +61,10 @@ func (r MsSqlManagedDatabaseResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return validate.ManagedDatabaseID
 }
 
+func (r MsSqlManagedDatabaseResource) Identity() resourceids.ResourceId {
+	return &miParse.ManagedDatabaseId{}
+}
+
 func (r MsSqlManagedDatabaseResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,8 +10,10 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
 	"github.com/gofrs/uuid"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	miParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -44,6 +46,10 @@ func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) IDValidationFu
 	return validate.ManagedInstanceAzureActiveDirectoryAdministratorID
 }
 
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Identity() resourceids.ResourceId {
+	return &miParse.ManagedInstanceAzureActiveDirectoryAdministratorId{}
+}
+
 func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"managed_instance_id": {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMsSqlManagedInstanceSecurityAlertPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlManagedInstanceSecurityAlertPolicyCreate,
 		Read:   resourceMsSqlManagedInstanceSecurityAlertPolicyRead,
 		Update: resourceMsSqlManagedInstanceSecurityAlertPolicyUpdate,
@@ -105,7 +105,7 @@ func resourceMsSqlManagedInstanceSecurityAlertPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.ManagedInstancesSecurityAlertPolicyId{})
 }
 
 func resourceMsSqlManagedInstanceSecurityAlertPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate,
 		Read:   resourceMsSqlManagedInstanceTransparentDataEncryptionRead,
 		Update: resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate,
@@ -59,7 +59,7 @@ func resourceMsSqlManagedInstanceTransparentDataEncryption() *pluginsdk.Resource
 				Default:  false,
 			},
 		},
-	}
+	}, &parse.ManagedInstanceEncryptionProtectorId{})
 }
 
 func resourceMsSqlManagedInstanceTransparentDataEncryptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
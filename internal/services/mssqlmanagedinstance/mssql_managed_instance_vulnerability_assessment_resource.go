@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMsSqlManagedInstanceVulnerabilityAssessment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMsSqlManagedInstanceVulnerabilityAssessmentCreate,
 		Read:   resourceMsSqlManagedInstanceVulnerabilityAssessmentRead,
 		Update: resourceMsSqlManagedInstanceVulnerabilityAssessmentUpdate,
@@ -96,7 +96,7 @@ func resourceMsSqlManagedInstanceVulnerabilityAssessment() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.ManagedInstanceVulnerabilityAssessmentId{})
 }
 
 func resourceMsSqlManagedInstanceVulnerabilityAssessmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
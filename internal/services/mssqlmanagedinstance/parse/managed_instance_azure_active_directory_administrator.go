@@ -43,6 +43,22 @@ func (id ManagedInstanceAzureActiveDirectoryAdministratorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.AdministratorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedInstanceAzureActiveDirectoryAdministrator ID
+func (id ManagedInstanceAzureActiveDirectoryAdministratorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "instance1"),
+		resourceids.StaticSegment("staticAdministrators", "administrators", "administrators"),
+		resourceids.UserSpecifiedSegment("administratorName", "activeDirectory"),
+	}
+}
+
 // ManagedInstanceAzureActiveDirectoryAdministratorID parses a ManagedInstanceAzureActiveDirectoryAdministrator ID into an ManagedInstanceAzureActiveDirectoryAdministratorId struct
 func ManagedInstanceAzureActiveDirectoryAdministratorID(input string) (*ManagedInstanceAzureActiveDirectoryAdministratorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ManagedInstanceAzureActiveDirectoryAdministratorID(input string) (*ManagedI
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ManagedInstanceAzureActiveDirectoryAdministratorId using the ParseResult provided in 'input'
+func (id *ManagedInstanceAzureActiveDirectoryAdministratorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedInstanceName, ok = input.Parsed["managedInstanceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedInstanceName", input)
+	}
+
+	if id.AdministratorName, ok = input.Parsed["administratorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "administratorName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestManagedInstanceAzureActiveDirectoryAdministratorIDFormatter(t *testing.
 	}
 }
 
+func TestManagedInstanceAzureActiveDirectoryAdministratorIDSegments(t *testing.T) {
+	expected := NewManagedInstanceAzureActiveDirectoryAdministratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "instance1", "activeDirectory")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedInstanceAzureActiveDirectoryAdministratorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedInstanceAzureActiveDirectoryAdministratorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedInstanceAzureActiveDirectoryAdministratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
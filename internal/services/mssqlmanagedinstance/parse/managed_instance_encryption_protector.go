@@ -43,6 +43,22 @@ func (id ManagedInstanceEncryptionProtectorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.EncryptionProtectorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedInstanceEncryptionProtector ID
+func (id ManagedInstanceEncryptionProtectorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "instance1"),
+		resourceids.StaticSegment("staticEncryptionProtector", "encryptionProtector", "encryptionProtector"),
+		resourceids.UserSpecifiedSegment("encryptionProtectorName", "current"),
+	}
+}
+
 // ManagedInstanceEncryptionProtectorID parses a ManagedInstanceEncryptionProtector ID into an ManagedInstanceEncryptionProtectorId struct
 func ManagedInstanceEncryptionProtectorID(input string) (*ManagedInstanceEncryptionProtectorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ManagedInstanceEncryptionProtectorID(input string) (*ManagedInstanceEncrypt
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ManagedInstanceEncryptionProtectorId using the ParseResult provided in 'input'
+func (id *ManagedInstanceEncryptionProtectorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedInstanceName, ok = input.Parsed["managedInstanceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedInstanceName", input)
+	}
+
+	if id.EncryptionProtectorName, ok = input.Parsed["encryptionProtectorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "encryptionProtectorName", input)
+	}
+
+	return nil
+}
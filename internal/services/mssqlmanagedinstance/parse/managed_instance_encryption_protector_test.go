@@ -21,6 +21,23 @@ func TestManagedInstanceEncryptionProtectorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedInstanceEncryptionProtectorIDSegments(t *testing.T) {
+	expected := NewManagedInstanceEncryptionProtectorID("12345678-1234-9876-4563-123456789012", "group1", "instance1", "current")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedInstanceEncryptionProtectorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedInstanceEncryptionProtectorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedInstanceEncryptionProtectorID(t *testing.T) {
 	testData := []struct {
 		Input    string
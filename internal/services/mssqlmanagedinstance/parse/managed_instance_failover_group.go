@@ -43,6 +43,22 @@ func (id ManagedInstanceFailoverGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LocationName, id.InstanceFailoverGroupName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedInstanceFailoverGroup ID
+func (id ManagedInstanceFailoverGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticLocations", "locations", "locations"),
+		resourceids.UserSpecifiedSegment("locationName", "Location"),
+		resourceids.StaticSegment("staticInstanceFailoverGroups", "instanceFailoverGroups", "instanceFailoverGroups"),
+		resourceids.UserSpecifiedSegment("instanceFailoverGroupName", "failoverGroup1"),
+	}
+}
+
 // ManagedInstanceFailoverGroupID parses a ManagedInstanceFailoverGroup ID into an ManagedInstanceFailoverGroupId struct
 func ManagedInstanceFailoverGroupID(input string) (*ManagedInstanceFailoverGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ManagedInstanceFailoverGroupID(input string) (*ManagedInstanceFailoverGroup
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ManagedInstanceFailoverGroupId using the ParseResult provided in 'input'
+func (id *ManagedInstanceFailoverGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.LocationName, ok = input.Parsed["locationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "locationName", input)
+	}
+
+	if id.InstanceFailoverGroupName, ok = input.Parsed["instanceFailoverGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "instanceFailoverGroupName", input)
+	}
+
+	return nil
+}
@@ -43,6 +43,22 @@ func (id ManagedInstanceVulnerabilityAssessmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedInstanceName, id.VulnerabilityAssessmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ManagedInstanceVulnerabilityAssessment ID
+func (id ManagedInstanceVulnerabilityAssessmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql", "Microsoft.Sql"),
+		resourceids.StaticSegment("staticManagedInstances", "managedInstances", "managedInstances"),
+		resourceids.UserSpecifiedSegment("managedInstanceName", "instance1"),
+		resourceids.StaticSegment("staticVulnerabilityAssessments", "vulnerabilityAssessments", "vulnerabilityAssessments"),
+		resourceids.UserSpecifiedSegment("vulnerabilityAssessmentName", "assessment1"),
+	}
+}
+
 // ManagedInstanceVulnerabilityAssessmentID parses a ManagedInstanceVulnerabilityAssessment ID into an ManagedInstanceVulnerabilityAssessmentId struct
 func ManagedInstanceVulnerabilityAssessmentID(input string) (*ManagedInstanceVulnerabilityAssessmentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func ManagedInstanceVulnerabilityAssessmentID(input string) (*ManagedInstanceVul
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ManagedInstanceVulnerabilityAssessmentId using the ParseResult provided in 'input'
+func (id *ManagedInstanceVulnerabilityAssessmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ManagedInstanceName, ok = input.Parsed["managedInstanceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managedInstanceName", input)
+	}
+
+	if id.VulnerabilityAssessmentName, ok = input.Parsed["vulnerabilityAssessmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vulnerabilityAssessmentName", input)
+	}
+
+	return nil
+}
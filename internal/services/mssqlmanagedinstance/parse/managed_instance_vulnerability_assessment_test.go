@@ -21,6 +21,23 @@ func TestManagedInstanceVulnerabilityAssessmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedInstanceVulnerabilityAssessmentIDSegments(t *testing.T) {
+	expected := NewManagedInstanceVulnerabilityAssessmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "instance1", "assessment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedInstanceVulnerabilityAssessmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedInstanceVulnerabilityAssessmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedInstanceVulnerabilityAssessmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMySQLAdministrator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySQLAdministratorCreateUpdate,
 		Read:   resourceMySQLAdministratorRead,
 		Update: resourceMySQLAdministratorCreateUpdate,
@@ -66,7 +66,7 @@ func resourceMySQLAdministrator() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.AzureActiveDirectoryAdministratorId{})
 }
 
 func resourceMySQLAdministratorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMySQLConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySQLConfigurationCreate,
 		Read:   resourceMySQLConfigurationRead,
 		Delete: resourceMySQLConfigurationDelete,
@@ -59,7 +59,7 @@ func resourceMySQLConfiguration() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &configurations.ConfigurationId{})
 }
 
 func resourceMySQLConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
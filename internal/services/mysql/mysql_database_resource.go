@@ -21,7 +21,7 @@ import (
 )
 
 func resourceMySqlDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySqlDatabaseCreate,
 		Read:   resourceMySqlDatabaseRead,
 		Delete: resourceMySqlDatabaseDelete,
@@ -68,7 +68,7 @@ func resourceMySqlDatabase() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &databases.DatabaseId{})
 }
 
 func resourceMySqlDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMySqlFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySqlFirewallRuleCreateUpdate,
 		Read:   resourceMySqlFirewallRuleRead,
 		Update: resourceMySqlFirewallRuleCreateUpdate,
@@ -69,7 +69,7 @@ func resourceMySqlFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: azValidate.IPv4Address,
 			},
 		},
-	}
+	}, &firewallrules.FirewallRuleId{})
 }
 
 func resourceMySqlFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
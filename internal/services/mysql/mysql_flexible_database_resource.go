@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMySqlFlexibleDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySqlFlexibleDatabaseCreate,
 		Read:   resourceMySqlFlexibleDatabaseRead,
 		Delete: resourceMySqlFlexibleDatabaseDelete,
@@ -64,7 +64,7 @@ func resourceMySqlFlexibleDatabase() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &databases.DatabaseId{})
 }
 
 func resourceMySqlFlexibleDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
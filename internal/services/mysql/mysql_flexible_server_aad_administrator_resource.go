@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2022-01-01/azureadadministrators"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
@@ -43,6 +44,10 @@ func (r MySQLFlexibleServerAdministratorResource) IDValidationFunc() pluginsdk.S
 	return validate.FlexibleServerAzureActiveDirectoryAdministratorID
 }
 
+func (r MySQLFlexibleServerAdministratorResource) Identity() resourceids.ResourceId {
+	return &parse.FlexibleServerAzureActiveDirectoryAdministratorId{}
+}
+
 func (r MySQLFlexibleServerAdministratorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"server_id": {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceMySQLFlexibleServerConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySQLFlexibleServerConfigurationCreate,
 		Read:   resourceMySQLFlexibleServerConfigurationRead,
 		Update: resourceMySQLFlexibleServerConfigurationUpdate,
@@ -59,7 +59,7 @@ func resourceMySQLFlexibleServerConfiguration() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &configurations.ConfigurationId{})
 }
 
 func resourceMySQLFlexibleServerConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
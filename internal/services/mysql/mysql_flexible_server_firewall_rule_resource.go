@@ -19,7 +19,7 @@ import (
 )
 
 func resourceMySqlFlexibleServerFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySqlFlexibleServerFirewallRuleCreateUpdate,
 		Read:   resourceMySqlFlexibleServerFirewallRuleRead,
 		Update: resourceMySqlFlexibleServerFirewallRuleCreateUpdate,
@@ -65,7 +65,7 @@ func resourceMySqlFlexibleServerFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: azValidate.IPv4Address,
 			},
 		},
-	}
+	}, &firewallrules.FirewallRuleId{})
 }
 
 func resourceMySqlFlexibleServerFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -38,7 +38,7 @@ const (
 var mysqlFlexibleServerResourceName = "azurerm_mysql_flexible_server"
 
 func resourceMysqlFlexibleServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMysqlFlexibleServerCreate,
 		Read:   resourceMysqlFlexibleServerRead,
 		Update: resourceMysqlFlexibleServerUpdate,
@@ -77,10 +77,27 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 			},
 
 			"administrator_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validate.FlexibleServerAdministratorPassword,
+				ConflictsWith: []string{"administrator_password_wo"},
+			},
+
+			"administrator_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validate.FlexibleServerAdministratorPassword,
+				ConflictsWith: []string{"administrator_password"},
+				RequiredWith:  []string{"administrator_password_wo_version"},
+			},
+
+			"administrator_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validate.FlexibleServerAdministratorPassword,
+				RequiredWith: []string{"administrator_password_wo"},
 			},
 
 			"backup_retention_days": {
@@ -315,6 +332,10 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &servers.FlexibleServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &servers.FlexibleServerId{})
 }
 
 func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -340,6 +361,11 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 
 	createMode := servers.CreateMode(d.Get("create_mode").(string))
 
+	passwordWriteOnly, err := pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+	if err != nil {
+		return err
+	}
+
 	if _, ok := d.GetOk("replication_role"); ok {
 		return fmt.Errorf("`replication_role` cannot be set while creating")
 	}
@@ -360,8 +386,8 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		if _, ok := d.GetOk("administrator_login"); !ok {
 			return fmt.Errorf("`administrator_login` is required when `create_mode` is `Default`")
 		}
-		if _, ok := d.GetOk("administrator_password"); !ok {
-			return fmt.Errorf("`administrator_password` is required when `create_mode` is `Default`")
+		if _, ok := d.GetOk("administrator_password"); !ok && passwordWriteOnly == nil {
+			return fmt.Errorf("either `administrator_password` or `administrator_password_wo` is required when `create_mode` is `Default`")
 		}
 		if _, ok := d.GetOk("sku_name"); !ok {
 			return fmt.Errorf("`sku_name` is required when `create_mode` is `Default`")
@@ -404,6 +430,10 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		parameters.Properties.AdministratorLoginPassword = utils.String(v.(string))
 	}
 
+	if passwordWriteOnly != nil {
+		parameters.Properties.AdministratorLoginPassword = passwordWriteOnly
+	}
+
 	if v, ok := d.GetOk("zone"); ok && v.(string) != "" {
 		parameters.Properties.AvailabilityZone = utils.String(v.(string))
 	}
@@ -673,6 +703,14 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 		parameters.Properties.AdministratorLoginPassword = utils.String(d.Get("administrator_password").(string))
 	}
 
+	if d.HasChange("administrator_password_wo_version") {
+		passwordWriteOnly, err := pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+		if err != nil {
+			return err
+		}
+		parameters.Properties.AdministratorLoginPassword = passwordWriteOnly
+	}
+
 	if d.HasChange("backup_retention_days") || d.HasChange("geo_redundant_backup_enabled") {
 		parameters.Properties.Backup = expandArmServerBackup(d)
 	}
@@ -41,6 +41,28 @@ func TestAccMySqlFlexibleServer_basic(t *testing.T) {
 	})
 }
 
+func TestAccMySqlFlexibleServer_writeOnlyPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
+	r := MySqlFlexibleServerResource{}
+
+	data.ResourceTestWithMinimumTerraformVersion(t, r, "1.11.0", []acceptance.TestStep{
+		{
+			Config: r.writeOnlyPassword(data, "QAZwsx123", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password_wo", "administrator_password_wo_version"),
+		{
+			Config: r.writeOnlyPassword(data, "QAZwsx456", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password_wo", "administrator_password_wo_version"),
+	})
+}
+
 func TestAccMySqlFlexibleServer_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
 	r := MySqlFlexibleServerResource{}
@@ -554,6 +576,23 @@ resource "azurerm_mysql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MySqlFlexibleServerResource) writeOnlyPassword(data acceptance.TestData, password string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                              = "acctest-fs-%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  administrator_login               = "_admin_Terraform_892123456789312"
+  administrator_password_wo         = "%s"
+  administrator_password_wo_version = %d
+  sku_name                          = "B_Standard_B1s"
+  zone                              = "1"
+}
+`, r.template(data), data.RandomInteger, password, version)
+}
+
 func (r MySqlFlexibleServerResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
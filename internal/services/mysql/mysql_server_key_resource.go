@@ -25,7 +25,7 @@ import (
 )
 
 func resourceMySQLServerKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySQLServerKeyCreateUpdate,
 		Read:   resourceMySQLServerKeyRead,
 		Update: resourceMySQLServerKeyCreateUpdate,
@@ -59,7 +59,7 @@ func resourceMySQLServerKey() *pluginsdk.Resource {
 				ValidateFunc: keyVaultValidate.NestedItemId,
 			},
 		},
-	}
+	}, &serverkeys.KeyId{})
 }
 
 func getMySQLServerKeyName(ctx context.Context, keyVaultsClient *client.Client, subscriptionId string, keyVaultKeyURI string) (*string, error) {
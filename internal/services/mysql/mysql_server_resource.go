@@ -34,7 +34,7 @@ const (
 )
 
 func resourceMySqlServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceMySqlServerCreate,
 		Read:   resourceMySqlServerRead,
 		Update: resourceMySqlServerUpdate,
@@ -331,6 +331,10 @@ func resourceMySqlServer() *pluginsdk.Resource {
 			return nil
 		}),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &servers.ServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &servers.ServerId{})
 }
 
 func resourceMySqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
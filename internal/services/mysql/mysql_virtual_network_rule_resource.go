@@ -23,7 +23,7 @@ import (
 )
 
 func resourceMySQLVirtualNetworkRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceMySQLVirtualNetworkRuleCreateUpdate,
 		Read:   resourceMySQLVirtualNetworkRuleRead,
 		Update: resourceMySQLVirtualNetworkRuleCreateUpdate,
@@ -66,7 +66,7 @@ func resourceMySQLVirtualNetworkRule() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateSubnetID,
 			},
 		},
-	}
+	}, &virtualnetworkrules.VirtualNetworkRuleId{})
 }
 
 func resourceMySQLVirtualNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
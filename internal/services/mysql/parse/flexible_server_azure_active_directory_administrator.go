@@ -43,6 +43,22 @@ func (id FlexibleServerAzureActiveDirectoryAdministratorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName, id.AdministratorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FlexibleServerAzureActiveDirectoryAdministrator ID
+func (id FlexibleServerAzureActiveDirectoryAdministratorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDBforMySQL", "Microsoft.DBforMySQL", "Microsoft.DBforMySQL"),
+		resourceids.StaticSegment("staticFlexibleServers", "flexibleServers", "flexibleServers"),
+		resourceids.UserSpecifiedSegment("flexibleServerName", "server1"),
+		resourceids.StaticSegment("staticAdministrators", "administrators", "administrators"),
+		resourceids.UserSpecifiedSegment("administratorName", "ActiveDirectory"),
+	}
+}
+
 // FlexibleServerAzureActiveDirectoryAdministratorID parses a FlexibleServerAzureActiveDirectoryAdministrator ID into an FlexibleServerAzureActiveDirectoryAdministratorId struct
 func FlexibleServerAzureActiveDirectoryAdministratorID(input string) (*FlexibleServerAzureActiveDirectoryAdministratorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func FlexibleServerAzureActiveDirectoryAdministratorID(input string) (*FlexibleS
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FlexibleServerAzureActiveDirectoryAdministratorId using the ParseResult provided in 'input'
+func (id *FlexibleServerAzureActiveDirectoryAdministratorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.FlexibleServerName, ok = input.Parsed["flexibleServerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "flexibleServerName", input)
+	}
+
+	if id.AdministratorName, ok = input.Parsed["administratorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "administratorName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestFlexibleServerAzureActiveDirectoryAdministratorIDFormatter(t *testing.T
 	}
 }
 
+func TestFlexibleServerAzureActiveDirectoryAdministratorIDSegments(t *testing.T) {
+	expected := NewFlexibleServerAzureActiveDirectoryAdministratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "ActiveDirectory")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FlexibleServerAzureActiveDirectoryAdministratorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FlexibleServerAzureActiveDirectoryAdministratorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFlexibleServerAzureActiveDirectoryAdministratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
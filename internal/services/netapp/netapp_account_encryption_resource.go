@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/netappaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -29,6 +30,7 @@ import (
 type NetAppAccountEncryptionResource struct{}
 
 var _ sdk.Resource = NetAppAccountEncryptionResource{}
+var _ sdk.ResourceWithIdentity = NetAppAccountEncryptionResource{}
 
 func (r NetAppAccountEncryptionResource) ModelObject() interface{} {
 	return &netAppModels.NetAppAccountEncryption{}
@@ -42,6 +44,10 @@ func (r NetAppAccountEncryptionResource) IDValidationFunc() pluginsdk.SchemaVali
 	return netappaccounts.ValidateNetAppAccountID
 }
 
+func (r NetAppAccountEncryptionResource) Identity() resourceids.ResourceId {
+	return &netappaccounts.NetAppAccountId{}
+}
+
 func (r NetAppAccountEncryptionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 
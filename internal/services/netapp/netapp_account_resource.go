@@ -29,7 +29,7 @@ import (
 )
 
 func resourceNetAppAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetAppAccountCreate,
 		Read:   resourceNetAppAccountRead,
 		Update: resourceNetAppAccountUpdate,
@@ -163,6 +163,10 @@ func resourceNetAppAccount() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &netappaccounts.NetAppAccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &netappaccounts.NetAppAccountId{})
 }
 
 func resourceNetAppAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
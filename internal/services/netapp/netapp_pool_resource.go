@@ -117,7 +117,9 @@ func resourceNetAppPool() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &capacitypools.CapacityPoolId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &capacitypools.CapacityPoolId{})
 }
 
 func resourceNetAppPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error {
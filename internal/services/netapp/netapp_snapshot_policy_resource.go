@@ -27,7 +27,7 @@ import (
 )
 
 func resourceNetAppSnapshotPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetAppSnapshotPolicyCreate,
 		Read:   resourceNetAppSnapshotPolicyRead,
 		Update: resourceNetAppSnapshotPolicyUpdate,
@@ -227,6 +227,10 @@ func resourceNetAppSnapshotPolicy() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &snapshotpolicy.SnapshotPolicyId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &snapshotpolicy.SnapshotPolicyId{})
 }
 
 func resourceNetAppSnapshotPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
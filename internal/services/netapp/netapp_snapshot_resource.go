@@ -22,7 +22,7 @@ import (
 )
 
 func resourceNetAppSnapshot() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetAppSnapshotCreate,
 		Read:   resourceNetAppSnapshotRead,
 		Delete: resourceNetAppSnapshotDelete,
@@ -71,6 +71,10 @@ func resourceNetAppSnapshot() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &snapshots.SnapshotId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &snapshots.SnapshotId{})
 }
 
 func resourceNetAppSnapshotCreate(d *pluginsdk.ResourceData, meta interface{}) error {
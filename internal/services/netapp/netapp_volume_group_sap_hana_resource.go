@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/capacitypools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
@@ -31,6 +32,7 @@ import (
 type NetAppVolumeGroupSapHanaResource struct{}
 
 var _ sdk.Resource = NetAppVolumeGroupSapHanaResource{}
+var _ sdk.ResourceWithIdentity = NetAppVolumeGroupSapHanaResource{}
 
 func (r NetAppVolumeGroupSapHanaResource) ModelObject() interface{} {
 	return &netAppModels.NetAppVolumeGroupSapHanaModel{}
@@ -44,6 +46,10 @@ func (r NetAppVolumeGroupSapHanaResource) IDValidationFunc() pluginsdk.SchemaVal
 	return volumegroups.ValidateVolumeGroupID
 }
 
+func (r NetAppVolumeGroupSapHanaResource) Identity() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
+
 func (r NetAppVolumeGroupSapHanaResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumequotarules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -27,6 +28,7 @@ import (
 type NetAppVolumeQuotaRuleResource struct{}
 
 var _ sdk.Resource = NetAppVolumeQuotaRuleResource{}
+var _ sdk.ResourceWithIdentity = NetAppVolumeQuotaRuleResource{}
 
 func (r NetAppVolumeQuotaRuleResource) ModelObject() interface{} {
 	return &netAppModels.NetAppVolumeQuotaRuleModel{}
@@ -40,6 +42,10 @@ func (r NetAppVolumeQuotaRuleResource) IDValidationFunc() pluginsdk.SchemaValida
 	return volumequotarules.ValidateVolumeQuotaRuleID
 }
 
+func (r NetAppVolumeQuotaRuleResource) Identity() resourceids.ResourceId {
+	return &volumequotarules.VolumeQuotaRuleId{}
+}
+
 func (r NetAppVolumeQuotaRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
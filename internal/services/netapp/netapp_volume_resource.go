@@ -369,7 +369,9 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &volumes.VolumeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &volumes.VolumeId{})
 }
 
 func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) error {
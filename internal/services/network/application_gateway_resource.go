@@ -105,7 +105,7 @@ func sslProfileSchema(computed bool) *pluginsdk.Schema {
 }
 
 func resourceApplicationGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceApplicationGatewayCreate,
 		Read:   resourceApplicationGatewayRead,
 		Update: resourceApplicationGatewayUpdate,
@@ -1543,6 +1543,10 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(applicationGatewayCustomizeDiff),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &applicationgateways.ApplicationGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &applicationgateways.ApplicationGatewayId{})
 }
 
 func resourceApplicationGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -4784,7 +4788,7 @@ func applicationGatewayHttpListnerHash(v interface{}) int {
 					buf.WriteString(statusCode.(string))
 				}
 				if pageUrl, ok := customError["custom_error_page_url"]; ok {
-					buf.WriteString(fmt.Sprintf(pageUrl.(string)))
+					buf.WriteString(fmt.Sprintf("%s", pageUrl.(string)))
 				}
 			}
 		}
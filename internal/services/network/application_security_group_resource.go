@@ -21,7 +21,7 @@ import (
 )
 
 func resourceApplicationSecurityGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceApplicationSecurityGroupCreate,
 		Read:   resourceApplicationSecurityGroupRead,
 		Update: resourceApplicationSecurityGroupUpdate,
@@ -52,6 +52,10 @@ func resourceApplicationSecurityGroup() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &applicationsecuritygroups.ApplicationSecurityGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &applicationsecuritygroups.ApplicationSecurityGroupId{})
 }
 
 func resourceApplicationSecurityGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -126,20 +130,27 @@ func resourceApplicationSecurityGroupRead(d *pluginsdk.ResourceData, meta interf
 		return err
 	}
 
-	resp, err := client.Get(ctx, *id)
-	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
-			d.SetId("")
-			return nil
+	// only the Resource Manager representation of the Application Security Group is required, so this can be
+	// served from Resource Graph
+	// - the Resource Type and the API Version of this model must be supported by the snapshot
+	var model *applicationsecuritygroups.ApplicationSecurityGroup
+	if !meta.(*clients.Client).ResourceGraph.Lookup(ctx, id.ID(), &model) {
+		resp, err := client.Get(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
 		}
-
-		return fmt.Errorf("retrieving %s: %+v", *id, err)
+		model = resp.Model
 	}
 
 	d.Set("name", id.ApplicationSecurityGroupName)
 	d.Set("resource_group_name", id.ResourceGroupName)
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		return tags.FlattenAndSet(d, model.Tags)
 	}
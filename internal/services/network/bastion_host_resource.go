@@ -31,7 +31,7 @@ var skuWeight = map[string]int8{
 }
 
 func resourceBastionHost() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceBastionHostCreate,
 		Read:   resourceBastionHostRead,
 		Update: resourceBastionHostUpdate,
@@ -165,6 +165,10 @@ func resourceBastionHost() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &bastionhosts.BastionHostId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &bastionhosts.BastionHostId{})
 }
 
 func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitAuthorizationCreate,
 		Read:   resourceExpressRouteCircuitAuthorizationRead,
 		Delete: resourceExpressRouteCircuitAuthorizationDelete,
@@ -60,7 +60,7 @@ func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &expressroutecircuitauthorizations.AuthorizationId{})
 }
 
 func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
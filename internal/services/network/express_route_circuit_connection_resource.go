@@ -22,7 +22,7 @@ import (
 )
 
 func resourceExpressRouteCircuitConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitConnectionCreate,
 		Read:   resourceExpressRouteCircuitConnectionRead,
 		Update: resourceExpressRouteCircuitConnectionUpdate,
@@ -82,7 +82,7 @@ func resourceExpressRouteCircuitConnection() *pluginsdk.Resource {
 				ValidateFunc: validation.IsCIDR,
 			},
 		},
-	}
+	}, &expressroutecircuitconnections.PeeringConnectionId{})
 }
 
 func resourceExpressRouteCircuitConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
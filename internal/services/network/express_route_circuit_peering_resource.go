@@ -25,7 +25,7 @@ import (
 )
 
 func resourceExpressRouteCircuitPeering() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitPeeringCreate,
 		Read:   resourceExpressRouteCircuitPeeringRead,
 		Update: resourceExpressRouteCircuitPeeringUpdate,
@@ -235,7 +235,7 @@ func resourceExpressRouteCircuitPeering() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &commonids.ExpressRouteCircuitPeeringId{})
 }
 
 func resourceExpressRouteCircuitPeeringCreate(d *pluginsdk.ResourceData, meta interface{}) error {
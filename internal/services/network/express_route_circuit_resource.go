@@ -29,7 +29,7 @@ import (
 var expressRouteCircuitResourceName = "azurerm_express_route_circuit"
 
 func resourceExpressRouteCircuit() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitCreate,
 		Read:   resourceExpressRouteCircuitRead,
 		Update: resourceExpressRouteCircuitUpdate,
@@ -160,6 +160,10 @@ func resourceExpressRouteCircuit() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &expressroutecircuits.ExpressRouteCircuitId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &expressroutecircuits.ExpressRouteCircuitId{})
 }
 
 func resourceExpressRouteCircuitCreate(d *pluginsdk.ResourceData, meta interface{}) error {
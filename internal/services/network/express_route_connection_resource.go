@@ -23,7 +23,7 @@ import (
 )
 
 func resourceExpressRouteConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceExpressRouteConnectionCreate,
 		Read:   resourceExpressRouteConnectionRead,
 		Update: resourceExpressRouteConnectionUpdate,
@@ -156,7 +156,7 @@ func resourceExpressRouteConnection() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 32000),
 			},
 		},
-	}
+	}, &expressrouteconnections.ExpressRouteConnectionId{})
 }
 
 func resourceExpressRouteConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceExpressRouteGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceExpressRouteGatewayCreate,
 		Read:   resourceExpressRouteGatewayRead,
 		Update: resourceExpressRouteGatewayUpdate,
@@ -75,6 +75,10 @@ func resourceExpressRouteGateway() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &expressroutegateways.ExpressRouteGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &expressroutegateways.ExpressRouteGatewayId{})
 }
 
 func resourceExpressRouteGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
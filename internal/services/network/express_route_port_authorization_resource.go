@@ -20,7 +20,7 @@ import (
 )
 
 func resourceExpressRoutePortAuthorization() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceExpressRoutePortAuthorizationCreate,
 		Read:   resourceExpressRoutePortAuthorizationRead,
 		Delete: resourceExpressRoutePortAuthorizationDelete,
@@ -61,7 +61,7 @@ func resourceExpressRoutePortAuthorization() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &expressrouteportauthorizations.ExpressRoutePortAuthorizationId{})
 }
 
 func resourceExpressRoutePortAuthorizationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
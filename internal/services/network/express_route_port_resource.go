@@ -203,7 +203,9 @@ func resourceArmExpressRoutePort() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &expressrouteports.ExpressRoutePortId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &expressrouteports.ExpressRoutePortId{})
 }
 
 func resourceArmExpressRoutePortCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceIpGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIpGroupCreate,
 		Read:   resourceIpGroupRead,
 		Update: resourceIpGroupUpdate,
@@ -85,6 +85,10 @@ func resourceIpGroup() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &ipgroups.IPGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &ipgroups.IPGroupId{})
 }
 
 func resourceIpGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
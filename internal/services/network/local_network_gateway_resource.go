@@ -23,7 +23,7 @@ import (
 )
 
 func resourceLocalNetworkGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLocalNetworkGatewayCreate,
 		Read:   resourceLocalNetworkGatewayRead,
 		Update: resourceLocalNetworkGatewayUpdate,
@@ -99,6 +99,10 @@ func resourceLocalNetworkGateway() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &localnetworkgateways.LocalNetworkGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &localnetworkgateways.LocalNetworkGatewayId{})
 }
 
 func resourceLocalNetworkGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -28,7 +28,7 @@ import (
 var natGatewayResourceName = "azurerm_nat_gateway"
 
 func resourceNatGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNatGatewayCreate,
 		Read:   resourceNatGatewayRead,
 		Update: resourceNatGatewayUpdate,
@@ -48,6 +48,10 @@ func resourceNatGateway() *pluginsdk.Resource {
 
 		Schema: resourceNatGatewaySchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &natgateways.NatGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &natgateways.NatGatewayId{})
 }
 
 func resourceNatGatewaySchema() map[string]*pluginsdk.Schema {
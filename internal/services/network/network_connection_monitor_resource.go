@@ -30,7 +30,7 @@ import (
 )
 
 func resourceNetworkConnectionMonitor() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetworkConnectionMonitorCreate,
 		Read:   resourceNetworkConnectionMonitorRead,
 		Update: resourceNetworkConnectionMonitorUpdate,
@@ -49,6 +49,10 @@ func resourceNetworkConnectionMonitor() *pluginsdk.Resource {
 
 		Schema: resourceNetworkConnectionMonitorSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &connectionmonitors.ConnectionMonitorId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &connectionmonitors.ConnectionMonitorId{})
 }
 
 func resourceNetworkConnectionMonitorSchema() map[string]*pluginsdk.Schema {
@@ -26,7 +26,7 @@ import (
 const ddosProtectionPlanResourceName = "azurerm_network_ddos_protection_plan"
 
 func resourceNetworkDDoSProtectionPlan() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetworkDDoSProtectionPlanCreate,
 		Read:   resourceNetworkDDoSProtectionPlanRead,
 		Update: resourceNetworkDDoSProtectionPlanUpdate,
@@ -66,6 +66,10 @@ func resourceNetworkDDoSProtectionPlan() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &ddosprotectionplans.DdosProtectionPlanId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &ddosprotectionplans.DdosProtectionPlanId{})
 }
 
 func resourceNetworkDDoSProtectionPlanCreate(d *pluginsdk.ResourceData, meta interface{}) error {
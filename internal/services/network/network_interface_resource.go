@@ -237,7 +237,10 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 			Deprecated:    "The property `enable_ip_forwarding` has been superseded by `ip_forwarding_enabled` and will be removed in v4.0 of the AzureRM Provider.",
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.NetworkInterfaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.NetworkInterfaceId{})
 }
 
 func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/adminrulecollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -27,6 +28,7 @@ type ManagerAdminRuleCollectionModel struct {
 type ManagerAdminRuleCollectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerAdminRuleCollectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerAdminRuleCollectionResource{}
 
 func (r ManagerAdminRuleCollectionResource) ResourceType() string {
 	return "azurerm_network_manager_admin_rule_collection"
@@ -40,6 +42,10 @@ func (r ManagerAdminRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return adminrulecollections.ValidateRuleCollectionID
 }
 
+func (r ManagerAdminRuleCollectionResource) Identity() resourceids.ResourceId {
+	return &adminrulecollections.RuleCollectionId{}
+}
+
 func (r ManagerAdminRuleCollectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkmanagers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkmanagerconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,6 +30,7 @@ type ManagerManagementGroupConnectionModel struct {
 type ManagerManagementGroupConnectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerManagementGroupConnectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerManagementGroupConnectionResource{}
 
 func (r ManagerManagementGroupConnectionResource) ResourceType() string {
 	return "azurerm_network_manager_management_group_connection"
@@ -42,6 +44,10 @@ func (r ManagerManagementGroupConnectionResource) IDValidationFunc() pluginsdk.S
 	return networkmanagerconnections.ValidateProviders2NetworkManagerConnectionID
 }
 
+func (r ManagerManagementGroupConnectionResource) Identity() resourceids.ResourceId {
+	return &networkmanagerconnections.Providers2NetworkManagerConnectionId{}
+}
+
 func (r ManagerManagementGroupConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
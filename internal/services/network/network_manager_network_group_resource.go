@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,6 +27,7 @@ type ManagerNetworkGroupModel struct {
 type ManagerNetworkGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerNetworkGroupResource{}
+var _ sdk.ResourceWithIdentity = ManagerNetworkGroupResource{}
 
 func (r ManagerNetworkGroupResource) ResourceType() string {
 	return "azurerm_network_manager_network_group"
@@ -39,6 +41,10 @@ func (r ManagerNetworkGroupResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return networkgroups.ValidateNetworkGroupID
 }
 
+func (r ManagerNetworkGroupResource) Identity() resourceids.ResourceId {
+	return &networkgroups.NetworkGroupId{}
+}
+
 func (r ManagerNetworkGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
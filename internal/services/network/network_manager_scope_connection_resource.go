@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/scopeconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -27,6 +28,7 @@ type ManagerScopeConnectionModel struct {
 type ManagerScopeConnectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerScopeConnectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerScopeConnectionResource{}
 
 func (r ManagerScopeConnectionResource) ResourceType() string {
 	return "azurerm_network_manager_scope_connection"
@@ -40,6 +42,10 @@ func (r ManagerScopeConnectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return scopeconnections.ValidateScopeConnectionID
 }
 
+func (r ManagerScopeConnectionResource) Identity() resourceids.ResourceId {
+	return &scopeconnections.ScopeConnectionId{}
+}
+
 func (r ManagerScopeConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/securityadminconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,6 +27,7 @@ type ManagerSecurityAdminConfigurationModel struct {
 type ManagerSecurityAdminConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerSecurityAdminConfigurationResource{}
+var _ sdk.ResourceWithIdentity = ManagerSecurityAdminConfigurationResource{}
 
 func (r ManagerSecurityAdminConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_security_admin_configuration"
@@ -39,6 +41,10 @@ func (r ManagerSecurityAdminConfigurationResource) IDValidationFunc() pluginsdk.
 	return securityadminconfigurations.ValidateSecurityAdminConfigurationID
 }
 
+func (r ManagerSecurityAdminConfigurationResource) Identity() resourceids.ResourceId {
+	return &securityadminconfigurations.SecurityAdminConfigurationId{}
+}
+
 func (r ManagerSecurityAdminConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/staticmembers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,6 +27,7 @@ type ManagerStaticMemberModel struct {
 type ManagerStaticMemberResource struct{}
 
 var _ sdk.Resource = ManagerStaticMemberResource{}
+var _ sdk.ResourceWithIdentity = ManagerStaticMemberResource{}
 
 func (r ManagerStaticMemberResource) ResourceType() string {
 	return "azurerm_network_manager_static_member"
@@ -39,6 +41,10 @@ func (r ManagerStaticMemberResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return staticmembers.ValidateStaticMemberID
 }
 
+func (r ManagerStaticMemberResource) Identity() resourceids.ResourceId {
+	return &staticmembers.StaticMemberId{}
+}
+
 func (r ManagerStaticMemberResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
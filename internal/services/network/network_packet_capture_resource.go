@@ -22,7 +22,7 @@ import (
 )
 
 func resourceNetworkPacketCapture() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create:             resourceNetworkPacketCaptureCreate,
 		Read:               resourceNetworkPacketCaptureRead,
 		Delete:             resourceNetworkPacketCaptureDelete,
@@ -148,7 +148,7 @@ func resourceNetworkPacketCapture() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &packetcaptures.PacketCaptureId{})
 }
 
 func resourceNetworkPacketCaptureCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 const azureNetworkProfileResourceName = "azurerm_network_profile"
 
 func resourceNetworkProfile() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetworkProfileCreate,
 		Read:   resourceNetworkProfileRead,
 		Update: resourceNetworkProfileUpdate,
@@ -100,6 +100,10 @@ func resourceNetworkProfile() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &networkprofiles.NetworkProfileId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &networkprofiles.NetworkProfileId{})
 }
 
 func resourceNetworkProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -180,7 +180,9 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 		},
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &networksecuritygroups.NetworkSecurityGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &networksecuritygroups.NetworkSecurityGroupId{})
 }
 
 func resourceNetworkSecurityGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceNetworkSecurityRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceNetworkSecurityRuleCreate,
 		Read:   resourceNetworkSecurityRuleRead,
 		Update: resourceNetworkSecurityRuleUpdate,
@@ -170,7 +170,7 @@ func resourceNetworkSecurityRule() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &securityrules.SecurityRuleId{})
 }
 
 func resourceNetworkSecurityRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
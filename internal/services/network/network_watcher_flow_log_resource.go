@@ -181,7 +181,9 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &flowlogs.FlowLogId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &flowlogs.FlowLogId{})
 }
 
 func azureRMSuppressFlowLogRetentionPolicyEnabledDiff(_, old, _ string, d *pluginsdk.ResourceData) bool {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceNetworkWatcher() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNetworkWatcherCreate,
 		Read:   resourceNetworkWatcherRead,
 		Update: resourceNetworkWatcherUpdate,
@@ -51,6 +51,10 @@ func resourceNetworkWatcher() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &networkwatchers.NetworkWatcherId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &networkwatchers.NetworkWatcherId{})
 }
 
 func resourceNetworkWatcherCreate(d *pluginsdk.ResourceData, meta interface{}) error {
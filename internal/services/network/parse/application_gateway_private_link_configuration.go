@@ -43,6 +43,22 @@ func (id ApplicationGatewayPrivateLinkConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.PrivateLinkConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ApplicationGatewayPrivateLinkConfiguration ID
+func (id ApplicationGatewayPrivateLinkConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticApplicationGateways", "applicationGateways", "applicationGateways"),
+		resourceids.UserSpecifiedSegment("applicationGatewayName", "applicationGateway1"),
+		resourceids.StaticSegment("staticPrivateLinkConfigurations", "privateLinkConfigurations", "privateLinkConfigurations"),
+		resourceids.UserSpecifiedSegment("privateLinkConfigurationName", "privateLinkConfiguration1"),
+	}
+}
+
 // ApplicationGatewayPrivateLinkConfigurationID parses a ApplicationGatewayPrivateLinkConfiguration ID into an ApplicationGatewayPrivateLinkConfigurationId struct
 func ApplicationGatewayPrivateLinkConfigurationID(input string) (*ApplicationGatewayPrivateLinkConfigurationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func ApplicationGatewayPrivateLinkConfigurationIDInsensitively(input string) (*A
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ApplicationGatewayPrivateLinkConfigurationId using the ParseResult provided in 'input'
+func (id *ApplicationGatewayPrivateLinkConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ApplicationGatewayName, ok = input.Parsed["applicationGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationGatewayName", input)
+	}
+
+	if id.PrivateLinkConfigurationName, ok = input.Parsed["privateLinkConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateLinkConfigurationName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestApplicationGatewayPrivateLinkConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApplicationGatewayPrivateLinkConfigurationIDSegments(t *testing.T) {
+	expected := NewApplicationGatewayPrivateLinkConfigurationID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "privateLinkConfiguration1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApplicationGatewayPrivateLinkConfigurationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApplicationGatewayPrivateLinkConfigurationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApplicationGatewayPrivateLinkConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
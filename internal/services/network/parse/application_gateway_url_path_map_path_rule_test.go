@@ -21,6 +21,23 @@ func TestApplicationGatewayURLPathMapPathRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApplicationGatewayURLPathMapPathRuleIDSegments(t *testing.T) {
+	expected := NewApplicationGatewayURLPathMapPathRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "applicationGateway1", "urlPathMap1", "pathRule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ApplicationGatewayURLPathMapPathRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ApplicationGatewayURLPathMapPathRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestApplicationGatewayURLPathMapPathRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id AuthenticationCertificateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AuthenticationCertificate ID
+func (id AuthenticationCertificateId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticApplicationGateways", "applicationGateways", "applicationGateways"),
+		resourceids.UserSpecifiedSegment("applicationGatewayName", "applicationGateway1"),
+		resourceids.StaticSegment("staticAuthenticationCertificates", "authenticationCertificates", "authenticationCertificates"),
+		resourceids.UserSpecifiedSegment("name", "authcert1"),
+	}
+}
+
 // AuthenticationCertificateID parses a AuthenticationCertificate ID into an AuthenticationCertificateId struct
 func AuthenticationCertificateID(input string) (*AuthenticationCertificateId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func AuthenticationCertificateIDInsensitively(input string) (*AuthenticationCert
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AuthenticationCertificateId using the ParseResult provided in 'input'
+func (id *AuthenticationCertificateId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ApplicationGatewayName, ok = input.Parsed["applicationGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationGatewayName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
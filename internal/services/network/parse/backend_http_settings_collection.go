@@ -43,6 +43,22 @@ func (id BackendHttpSettingsCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.BackendHttpSettingsCollectionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this BackendHttpSettingsCollection ID
+func (id BackendHttpSettingsCollectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticApplicationGateways", "applicationGateways", "applicationGateways"),
+		resourceids.UserSpecifiedSegment("applicationGatewayName", "applicationGateway1"),
+		resourceids.StaticSegment("staticBackendHttpSettingsCollection", "backendHttpSettingsCollection", "backendHttpSettingsCollection"),
+		resourceids.UserSpecifiedSegment("backendHttpSettingsCollectionName", "backendHttpSettingsCollection1"),
+	}
+}
+
 // BackendHttpSettingsCollectionID parses a BackendHttpSettingsCollection ID into an BackendHttpSettingsCollectionId struct
 func BackendHttpSettingsCollectionID(input string) (*BackendHttpSettingsCollectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func BackendHttpSettingsCollectionIDInsensitively(input string) (*BackendHttpSet
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the BackendHttpSettingsCollectionId using the ParseResult provided in 'input'
+func (id *BackendHttpSettingsCollectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ApplicationGatewayName, ok = input.Parsed["applicationGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationGatewayName", input)
+	}
+
+	if id.BackendHttpSettingsCollectionName, ok = input.Parsed["backendHttpSettingsCollectionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "backendHttpSettingsCollectionName", input)
+	}
+
+	return nil
+}
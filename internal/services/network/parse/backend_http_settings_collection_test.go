@@ -21,6 +21,23 @@ func TestBackendHttpSettingsCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendHttpSettingsCollectionIDSegments(t *testing.T) {
+	expected := NewBackendHttpSettingsCollectionID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "backendHttpSettingsCollection1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BackendHttpSettingsCollectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BackendHttpSettingsCollectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBackendHttpSettingsCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestFrontendPortIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontendPortIDSegments(t *testing.T) {
+	expected := NewFrontendPortID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "feport1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FrontendPortId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FrontendPortId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFrontendPortID(t *testing.T) {
 	testData := []struct {
 		Input    string
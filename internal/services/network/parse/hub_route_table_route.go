@@ -46,6 +46,24 @@ func (id HubRouteTableRouteId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.HubRouteTableName, id.RouteName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this HubRouteTableRoute ID
+func (id HubRouteTableRouteId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticVirtualHubs", "virtualHubs", "virtualHubs"),
+		resourceids.UserSpecifiedSegment("virtualHubName", "virtualHub1"),
+		resourceids.StaticSegment("staticHubRouteTables", "hubRouteTables", "hubRouteTables"),
+		resourceids.UserSpecifiedSegment("hubRouteTableName", "routeTable1"),
+		resourceids.StaticSegment("staticRoutes", "routes", "routes"),
+		resourceids.UserSpecifiedSegment("routeName", "route1"),
+	}
+}
+
 // HubRouteTableRouteID parses a HubRouteTableRoute ID into an HubRouteTableRouteId struct
 func HubRouteTableRouteID(input string) (*HubRouteTableRouteId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func HubRouteTableRouteID(input string) (*HubRouteTableRouteId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the HubRouteTableRouteId using the ParseResult provided in 'input'
+func (id *HubRouteTableRouteId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VirtualHubName, ok = input.Parsed["virtualHubName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "virtualHubName", input)
+	}
+
+	if id.HubRouteTableName, ok = input.Parsed["hubRouteTableName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "hubRouteTableName", input)
+	}
+
+	if id.RouteName, ok = input.Parsed["routeName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "routeName", input)
+	}
+
+	return nil
+}
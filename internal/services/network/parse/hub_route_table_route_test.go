@@ -21,6 +21,23 @@ func TestHubRouteTableRouteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHubRouteTableRouteIDSegments(t *testing.T) {
+	expected := NewHubRouteTableRouteID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "routeTable1", "route1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HubRouteTableRouteId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HubRouteTableRouteId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHubRouteTableRouteID(t *testing.T) {
 	testData := []struct {
 		Input    string
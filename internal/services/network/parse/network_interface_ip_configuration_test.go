@@ -21,6 +21,23 @@ func TestNetworkInterfaceIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNetworkInterfaceIpConfigurationIDSegments(t *testing.T) {
+	expected := NewNetworkInterfaceIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "networkInterface1", "config1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&NetworkInterfaceIpConfigurationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := NetworkInterfaceIpConfigurationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestNetworkInterfaceIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestPrivateDnsZoneConfigIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateDnsZoneConfigIDSegments(t *testing.T) {
+	expected := NewPrivateDnsZoneConfigID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1", "privateDnsZoneGroup1", "privateDnsZoneConfig1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PrivateDnsZoneConfigId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PrivateDnsZoneConfigId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPrivateDnsZoneConfigID(t *testing.T) {
 	testData := []struct {
 		Input    string
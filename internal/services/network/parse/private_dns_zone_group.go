@@ -43,6 +43,22 @@ func (id PrivateDnsZoneGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this PrivateDnsZoneGroup ID
+func (id PrivateDnsZoneGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticPrivateEndpoints", "privateEndpoints", "privateEndpoints"),
+		resourceids.UserSpecifiedSegment("privateEndpointName", "endpoint1"),
+		resourceids.StaticSegment("staticPrivateDnsZoneGroups", "privateDnsZoneGroups", "privateDnsZoneGroups"),
+		resourceids.UserSpecifiedSegment("name", "privateDnsZoneGroup1"),
+	}
+}
+
 // PrivateDnsZoneGroupID parses a PrivateDnsZoneGroup ID into an PrivateDnsZoneGroupId struct
 func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the PrivateDnsZoneGroupId using the ParseResult provided in 'input'
+func (id *PrivateDnsZoneGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.PrivateEndpointName, ok = input.Parsed["privateEndpointName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateEndpointName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
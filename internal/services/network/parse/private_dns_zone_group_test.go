@@ -21,6 +21,23 @@ func TestPrivateDnsZoneGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateDnsZoneGroupIDSegments(t *testing.T) {
+	expected := NewPrivateDnsZoneGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1", "privateDnsZoneGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PrivateDnsZoneGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PrivateDnsZoneGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPrivateDnsZoneGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestProbeIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProbeIDSegments(t *testing.T) {
+	expected := NewProbeID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "probe1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProbeId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProbeId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProbeID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id RedirectConfigurationsId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.RedirectConfigurationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this RedirectConfigurations ID
+func (id RedirectConfigurationsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticApplicationGateways", "applicationGateways", "applicationGateways"),
+		resourceids.UserSpecifiedSegment("applicationGatewayName", "applicationGateway1"),
+		resourceids.StaticSegment("staticRedirectConfigurations", "redirectConfigurations", "redirectConfigurations"),
+		resourceids.UserSpecifiedSegment("redirectConfigurationName", "redirectConfig1"),
+	}
+}
+
 // RedirectConfigurationsID parses a RedirectConfigurations ID into an RedirectConfigurationsId struct
 func RedirectConfigurationsID(input string) (*RedirectConfigurationsId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func RedirectConfigurationsIDInsensitively(input string) (*RedirectConfiguration
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the RedirectConfigurationsId using the ParseResult provided in 'input'
+func (id *RedirectConfigurationsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ApplicationGatewayName, ok = input.Parsed["applicationGatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationGatewayName", input)
+	}
+
+	if id.RedirectConfigurationName, ok = input.Parsed["redirectConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "redirectConfigurationName", input)
+	}
+
+	return nil
+}
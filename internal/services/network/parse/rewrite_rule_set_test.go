@@ -21,6 +21,23 @@ func TestRewriteRuleSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRewriteRuleSetIDSegments(t *testing.T) {
+	expected := NewRewriteRuleSetID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "rewriteRuleSet1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&RewriteRuleSetId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := RewriteRuleSetId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestRewriteRuleSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSslProfileIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSslProfileIDSegments(t *testing.T) {
+	expected := NewSslProfileID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "sslprofile1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SslProfileId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SslProfileId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSslProfileID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestTrustedClientCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTrustedClientCertificateIDSegments(t *testing.T) {
+	expected := NewTrustedClientCertificateID("12345678-1234-9876-4563-123456789012", "group1", "applicationGateway1", "trustedClientCert1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&TrustedClientCertificateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := TrustedClientCertificateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestTrustedClientCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id VirtualNetworkDnsServersId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.DnsServerName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this VirtualNetworkDnsServers ID
+func (id VirtualNetworkDnsServersId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticVirtualNetworks", "virtualNetworks", "virtualNetworks"),
+		resourceids.UserSpecifiedSegment("virtualNetworkName", "network1"),
+		resourceids.StaticSegment("staticDnsServers", "dnsServers", "dnsServers"),
+		resourceids.UserSpecifiedSegment("dnsServerName", "default"),
+	}
+}
+
 // VirtualNetworkDnsServersID parses a VirtualNetworkDnsServers ID into an VirtualNetworkDnsServersId struct
 func VirtualNetworkDnsServersID(input string) (*VirtualNetworkDnsServersId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func VirtualNetworkDnsServersIDInsensitively(input string) (*VirtualNetworkDnsSe
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the VirtualNetworkDnsServersId using the ParseResult provided in 'input'
+func (id *VirtualNetworkDnsServersId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VirtualNetworkName, ok = input.Parsed["virtualNetworkName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "virtualNetworkName", input)
+	}
+
+	if id.DnsServerName, ok = input.Parsed["dnsServerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dnsServerName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestVirtualNetworkDnsServersIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkDnsServersIDSegments(t *testing.T) {
+	expected := NewVirtualNetworkDnsServersID("12345678-1234-9876-4563-123456789012", "resGroup1", "network1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VirtualNetworkDnsServersId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VirtualNetworkDnsServersId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVirtualNetworkDnsServersID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestVirtualNetworkGatewayPolicyGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkGatewayPolicyGroupIDSegments(t *testing.T) {
+	expected := NewVirtualNetworkGatewayPolicyGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "gw1", "policyGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VirtualNetworkGatewayPolicyGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VirtualNetworkGatewayPolicyGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVirtualNetworkGatewayPolicyGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -25,7 +25,7 @@ import (
 )
 
 func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePointToSiteVPNGatewayCreate,
 		Read:   resourcePointToSiteVPNGatewayRead,
 		Update: resourcePointToSiteVPNGatewayUpdate,
@@ -186,6 +186,10 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.VirtualWANP2SVPNGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.VirtualWANP2SVPNGatewayId{})
 }
 
 func resourcePointToSiteVPNGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -40,7 +40,7 @@ import (
 )
 
 func resourcePrivateEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePrivateEndpointCreate,
 		Read:   resourcePrivateEndpointRead,
 		Update: resourcePrivateEndpointUpdate,
@@ -290,6 +290,10 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &privateendpoints.PrivateEndpointId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &privateendpoints.PrivateEndpointId{})
 }
 
 func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
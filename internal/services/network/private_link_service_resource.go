@@ -28,7 +28,7 @@ import (
 )
 
 func resourcePrivateLinkService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePrivateLinkServiceCreate,
 		Read:   resourcePrivateLinkServiceRead,
 		Update: resourcePrivateLinkServiceUpdate,
@@ -164,6 +164,10 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 			return nil
 		}),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &privatelinkservices.PrivateLinkServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &privatelinkservices.PrivateLinkServiceId{})
 }
 
 func resourcePrivateLinkServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePublicIpPrefix() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePublicIpPrefixCreate,
 		Read:   resourcePublicIpPrefixRead,
 		Update: resourcePublicIpPrefixUpdate,
@@ -92,6 +92,10 @@ func resourcePublicIpPrefix() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &publicipprefixes.PublicIPPrefixId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &publicipprefixes.PublicIPPrefixId{})
 }
 
 func resourcePublicIpPrefixCreate(d *pluginsdk.ResourceData, meta interface{}) error {
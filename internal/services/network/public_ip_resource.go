@@ -31,7 +31,7 @@ import (
 )
 
 func resourcePublicIp() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePublicIpCreate,
 		Read:   resourcePublicIpRead,
 		Update: resourcePublicIpUpdate,
@@ -178,6 +178,10 @@ func resourcePublicIp() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.PublicIPAddressId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.PublicIPAddressId{})
 }
 
 func resourcePublicIpCreate(d *pluginsdk.ResourceData, meta interface{}) error {
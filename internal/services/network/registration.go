@@ -13,6 +13,7 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.ServiceRegistrationWithListResources       = Registration{}
 )
 
 // Name is the name of this Service
@@ -173,3 +174,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_web_application_firewall_policy":           resourceWebApplicationFirewallPolicy(),
 	}
 }
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		SubnetListResource{},
+		VirtualNetworkListResource{},
+	}
+}
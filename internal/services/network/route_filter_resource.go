@@ -24,7 +24,7 @@ import (
 )
 
 func resourceRouteFilter() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceRouteFilterCreate,
 		Read:   resourceRouteFilterRead,
 		Update: resourceRouteFilterUpdate,
@@ -99,6 +99,10 @@ func resourceRouteFilter() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &routefilters.RouteFilterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &routefilters.RouteFilterId{})
 }
 
 func resourceRouteFilterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceRoute() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRouteCreate,
 		Read:   resourceRouteRead,
 		Update: resourceRouteUpdate,
@@ -80,7 +80,7 @@ func resourceRoute() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &routes.RouteId{})
 }
 
 func resourceRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
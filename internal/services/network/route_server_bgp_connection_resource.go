@@ -21,7 +21,7 @@ import (
 )
 
 func resourceRouteServerBgpConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRouteServerBgpConnectionCreate,
 		Read:   resourceRouteServerBgpConnectionRead,
 		Delete: resourceRouteServerBgpConnectionDelete,
@@ -66,7 +66,7 @@ func resourceRouteServerBgpConnection() *pluginsdk.Resource {
 				ValidateFunc: validation.IsIPv4Address,
 			},
 		},
-	}
+	}, &commonids.VirtualHubBGPConnectionId{})
 }
 
 func resourceRouteServerBgpConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
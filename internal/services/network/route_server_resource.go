@@ -27,7 +27,7 @@ import (
 )
 
 func resourceRouteServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceRouteServerCreate,
 		Read:   resourceRouteServerRead,
 		Update: resourceRouteServerUpdate,
@@ -104,6 +104,10 @@ func resourceRouteServer() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualwans.VirtualHubId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.VirtualHubId{})
 }
 
 func resourceRouteServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -133,7 +133,9 @@ func resourceRouteTable() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &routetables.RouteTableId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &routetables.RouteTableId{})
 }
 
 func resourceRouteTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
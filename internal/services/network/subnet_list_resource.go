@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type SubnetListResource struct{}
+
+var _ sdk.ListResource = SubnetListResource{}
+
+func (r SubnetListResource) ResourceType() string {
+	return "azurerm_subnet"
+}
+
+func (r SubnetListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	client := metadata.Client.Network.Client.Subnets
+
+	// Subnets can only be listed within a Virtual Network, so the Virtual Networks are listed first
+	virtualNetworks, err := listVirtualNetworks(ctx, metadata, request)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, virtualNetwork := range virtualNetworks {
+		if virtualNetwork.Id == nil {
+			continue
+		}
+
+		virtualNetworkId, err := commonids.ParseVirtualNetworkIDInsensitively(*virtualNetwork.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.ListComplete(ctx, *virtualNetworkId)
+		if err != nil {
+			return nil, fmt.Errorf("listing Subnets within %s: %+v", *virtualNetworkId, err)
+		}
+
+		for _, item := range resp.Items {
+			if item.Id == nil {
+				continue
+			}
+
+			id, err := commonids.ParseSubnetIDInsensitively(*item.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			// Subnets don't support Tags
+			results = append(results, sdk.ListResult{
+				Id:   id,
+				Name: id.SubnetName,
+			})
+		}
+	}
+
+	return results, nil
+}
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSubnetNatGatewayAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSubnetNatGatewayAssociationCreate,
 		Read:   resourceSubnetNatGatewayAssociationRead,
 		Delete: resourceSubnetNatGatewayAssociationDelete,
@@ -52,7 +52,7 @@ func resourceSubnetNatGatewayAssociation() *pluginsdk.Resource {
 				ValidateFunc: natgateways.ValidateNatGatewayID,
 			},
 		},
-	}
+	}, &commonids.SubnetId{})
 }
 
 func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSubnetNetworkSecurityGroupAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSubnetNetworkSecurityGroupAssociationCreate,
 		Read:   resourceSubnetNetworkSecurityGroupAssociationRead,
 		Delete: resourceSubnetNetworkSecurityGroupAssociationDelete,
@@ -52,7 +52,7 @@ func resourceSubnetNetworkSecurityGroupAssociation() *pluginsdk.Resource {
 				ValidateFunc: networksecuritygroups.ValidateNetworkSecurityGroupID,
 			},
 		},
-	}
+	}, &commonids.SubnetId{})
 }
 
 func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -293,7 +293,7 @@ func resourceSubnet() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &commonids.SubnetId{})
 }
 
 // TODO: refactor the create/flatten functions
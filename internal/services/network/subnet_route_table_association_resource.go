@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSubnetRouteTableAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSubnetRouteTableAssociationCreate,
 		Read:   resourceSubnetRouteTableAssociationRead,
 		Delete: resourceSubnetRouteTableAssociationDelete,
@@ -52,7 +52,7 @@ func resourceSubnetRouteTableAssociation() *pluginsdk.Resource {
 				ValidateFunc: routetables.ValidateRouteTableID,
 			},
 		},
-	}
+	}, &commonids.SubnetId{})
 }
 
 func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
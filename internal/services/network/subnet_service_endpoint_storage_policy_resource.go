@@ -26,7 +26,7 @@ import (
 )
 
 func resourceSubnetServiceEndpointStoragePolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSubnetServiceEndpointStoragePolicyCreate,
 		Read:   resourceSubnetServiceEndpointStoragePolicyRead,
 		Update: resourceSubnetServiceEndpointStoragePolicyUpdate,
@@ -111,6 +111,10 @@ func resourceSubnetServiceEndpointStoragePolicy() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &serviceendpointpolicies.ServiceEndpointPolicyId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &serviceendpointpolicies.ServiceEndpointPolicyId{})
 }
 
 func resourceSubnetServiceEndpointStoragePolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
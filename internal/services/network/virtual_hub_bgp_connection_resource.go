@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVirtualHubBgpConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualHubBgpConnectionCreate,
 		Read:   resourceVirtualHubBgpConnectionRead,
 		Update: resourceVirtualHubBgpConnectionUpdate,
@@ -74,7 +74,7 @@ func resourceVirtualHubBgpConnection() *pluginsdk.Resource {
 				ValidateFunc: virtualwans.ValidateHubVirtualNetworkConnectionID,
 			},
 		},
-	}
+	}, &commonids.VirtualHubBGPConnectionId{})
 }
 
 func resourceVirtualHubBgpConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
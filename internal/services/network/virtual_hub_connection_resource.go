@@ -24,7 +24,7 @@ import (
 )
 
 func resourceVirtualHubConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualHubConnectionCreateOrUpdate,
 		Read:   resourceVirtualHubConnectionRead,
 		Update: resourceVirtualHubConnectionCreateOrUpdate,
@@ -175,7 +175,7 @@ func resourceVirtualHubConnection() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &virtualwans.HubVirtualNetworkConnectionId{})
 }
 
 func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVirtualHubIP() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualHubIPCreate,
 		Read:   resourceVirtualHubIPRead,
 		Update: resourceVirtualHubIPUpdate,
@@ -84,7 +84,7 @@ func resourceVirtualHubIP() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &commonids.VirtualHubIPConfigurationId{})
 }
 
 func resourceVirtualHubIPCreate(d *pluginsdk.ResourceData, meta interface{}) error {
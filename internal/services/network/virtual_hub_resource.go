@@ -29,7 +29,7 @@ import (
 const virtualHubResourceName = "azurerm_virtual_hub"
 
 func resourceVirtualHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualHubCreate,
 		Read:   resourceVirtualHubRead,
 		Update: resourceVirtualHubUpdate,
@@ -143,6 +143,10 @@ func resourceVirtualHub() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualwans.VirtualHubId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.VirtualHubId{})
 }
 
 func resourceVirtualHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
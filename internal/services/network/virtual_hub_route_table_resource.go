@@ -23,7 +23,7 @@ import (
 )
 
 func resourceVirtualHubRouteTable() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualHubRouteTableCreate,
 		Read:   resourceVirtualHubRouteTableRead,
 		Update: resourceVirtualHubRouteTableUpdate,
@@ -113,7 +113,7 @@ func resourceVirtualHubRouteTable() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &virtualwans.HubRouteTableId{})
 }
 
 func resourceVirtualHubRouteTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceVirtualHubRouteTableRoute() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualHubRouteTableRouteCreate,
 		Read:   resourceVirtualHubRouteTableRouteRead,
 		Update: resourceVirtualHubRouteTableRouteUpdate,
@@ -90,7 +90,7 @@ func resourceVirtualHubRouteTableRoute() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &parse.HubRouteTableRouteId{})
 }
 
 func resourceVirtualHubRouteTableRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualwans"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -31,6 +32,7 @@ type RoutingPolicy struct {
 type VirtualHubRoutingIntentResource struct{}
 
 var _ sdk.ResourceWithUpdate = VirtualHubRoutingIntentResource{}
+var _ sdk.ResourceWithIdentity = VirtualHubRoutingIntentResource{}
 
 func (r VirtualHubRoutingIntentResource) ResourceType() string {
 	return "azurerm_virtual_hub_routing_intent"
@@ -44,6 +46,10 @@ func (r VirtualHubRoutingIntentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return virtualwans.ValidateRoutingIntentID
 }
 
+func (r VirtualHubRoutingIntentResource) Identity() resourceids.ResourceId {
+	return &virtualwans.RoutingIntentId{}
+}
+
 func (r VirtualHubRoutingIntentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceVirtualHubSecurityPartnerProvider() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualHubSecurityPartnerProviderCreate,
 		Read:   resourceVirtualHubSecurityPartnerProviderRead,
 		Update: resourceVirtualHubSecurityPartnerProviderUpdate,
@@ -73,6 +73,10 @@ func resourceVirtualHubSecurityPartnerProvider() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &securitypartnerproviders.SecurityPartnerProviderId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &securitypartnerproviders.SecurityPartnerProviderId{})
 }
 
 func resourceVirtualHubSecurityPartnerProviderCreate(d *pluginsdk.ResourceData, meta interface{}) error {
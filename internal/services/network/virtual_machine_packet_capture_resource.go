@@ -23,7 +23,7 @@ import (
 )
 
 func resourceVirtualMachinePacketCapture() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualMachinePacketCaptureCreate,
 		Read:   resourceVirtualMachinePacketCaptureRead,
 		Delete: resourceVirtualMachinePacketCaptureDelete,
@@ -148,7 +148,7 @@ func resourceVirtualMachinePacketCapture() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &packetcaptures.PacketCaptureId{})
 }
 
 func resourceVirtualMachinePacketCaptureCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualMachineScaleSetPacketCaptureCreate,
 		Read:   resourceVirtualMachineScaleSetPacketCaptureRead,
 		Delete: resourceVirtualMachineScaleSetPacketCaptureDelete,
@@ -179,7 +179,7 @@ func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &packetcaptures.PacketCaptureId{})
 }
 
 func resourceVirtualMachineScaleSetPacketCaptureCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVirtualNetworkDnsServers() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualNetworkDnsServersCreate,
 		Read:   resourceVirtualNetworkDnsServersRead,
 		Update: resourceVirtualNetworkDnsServersUpdate,
@@ -56,7 +56,7 @@ func resourceVirtualNetworkDnsServers() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.VirtualNetworkDnsServersId{})
 }
 
 func resourceVirtualNetworkDnsServersCreate(d *pluginsdk.ResourceData, meta interface{}) error {
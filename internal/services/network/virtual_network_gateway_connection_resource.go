@@ -359,7 +359,10 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 			Sensitive: true,
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &virtualnetworkgatewayconnections.ConnectionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualnetworkgatewayconnections.ConnectionId{})
 }
 
 func resourceVirtualNetworkGatewayConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
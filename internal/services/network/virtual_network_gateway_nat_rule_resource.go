@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVirtualNetworkGatewayNatRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualNetworkGatewayNatRuleCreate,
 		Read:   resourceVirtualNetworkGatewayNatRuleRead,
 		Update: resourceVirtualNetworkGatewayNatRuleUpdate,
@@ -124,7 +124,7 @@ func resourceVirtualNetworkGatewayNatRule() *pluginsdk.Resource {
 				ValidateFunc: validate.VirtualNetworkGatewayIpConfigurationID,
 			},
 		},
-	}
+	}, &virtualnetworkgateways.VirtualNetworkGatewayNatRuleId{})
 }
 
 func resourceVirtualNetworkGatewayNatRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
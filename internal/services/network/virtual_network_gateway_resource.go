@@ -29,7 +29,7 @@ import (
 )
 
 func resourceVirtualNetworkGateway() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualNetworkGatewayCreate,
 		Read:   resourceVirtualNetworkGatewayRead,
 		Update: resourceVirtualNetworkGatewayUpdate,
@@ -48,6 +48,10 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 
 		Schema: resourceVirtualNetworkGatewaySchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualnetworkgateways.VirtualNetworkGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualnetworkgateways.VirtualNetworkGatewayId{})
 }
 
 func resourceVirtualNetworkGatewaySchema() map[string]*pluginsdk.Schema {
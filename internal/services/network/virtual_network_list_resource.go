@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type VirtualNetworkListResource struct{}
+
+var _ sdk.ListResource = VirtualNetworkListResource{}
+
+func (r VirtualNetworkListResource) ResourceType() string {
+	return "azurerm_virtual_network"
+}
+
+func (r VirtualNetworkListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	virtualNetworks, err := listVirtualNetworks(ctx, metadata, request)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, item := range virtualNetworks {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := commonids.ParseVirtualNetworkIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, sdk.ListResult{
+			Id:   id,
+			Name: id.VirtualNetworkName,
+			Tags: pointer.From(item.Tags),
+		})
+	}
+
+	return results, nil
+}
+
+// listVirtualNetworks returns the Virtual Networks within the Resource Group, or the Subscription when no
+// Resource Group is specified
+func listVirtualNetworks(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]virtualnetworks.VirtualNetwork, error) {
+	client := metadata.Client.Network.VirtualNetworks
+
+	if request.ResourceGroupName != "" {
+		resourceGroupId := commonids.NewResourceGroupID(metadata.SubscriptionId, request.ResourceGroupName)
+		resp, err := client.ListComplete(ctx, resourceGroupId)
+		if err != nil {
+			return nil, fmt.Errorf("listing Virtual Networks within %s: %+v", resourceGroupId, err)
+		}
+		return resp.Items, nil
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(metadata.SubscriptionId)
+	resp, err := client.ListAllComplete(ctx, subscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Virtual Networks within %s: %+v", subscriptionId, err)
+	}
+	return resp.Items, nil
+}
@@ -25,7 +25,7 @@ import (
 const virtualNetworkPeeringResourceType = "azurerm_virtual_network_peering"
 
 func resourceVirtualNetworkPeering() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVirtualNetworkPeeringCreate,
 		Read:   resourceVirtualNetworkPeeringRead,
 		Update: resourceVirtualNetworkPeeringUpdate,
@@ -127,7 +127,7 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &virtualnetworkpeerings.VirtualNetworkPeeringId{})
 }
 
 func resourceVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -38,7 +38,7 @@ import (
 var VirtualNetworkResourceName = "azurerm_virtual_network"
 
 func resourceVirtualNetwork() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualNetworkCreate,
 		Read:   resourceVirtualNetworkRead,
 		Update: resourceVirtualNetworkUpdate,
@@ -57,6 +57,10 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 
 		Schema: resourceVirtualNetworkSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.VirtualNetworkId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.VirtualNetworkId{})
 }
 
 func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceVirtualWan() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVirtualWanCreate,
 		Read:   resourceVirtualWanRead,
 		Update: resourceVirtualWanUpdate,
@@ -84,6 +84,10 @@ func resourceVirtualWan() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualwans.VirtualWANId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.VirtualWANId{})
 }
 
 func resourceVirtualWanCreate(d *pluginsdk.ResourceData, meta interface{}) error {
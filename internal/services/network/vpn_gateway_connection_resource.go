@@ -22,7 +22,7 @@ import (
 )
 
 func resourceVPNGatewayConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVpnGatewayConnectionResourceCreate,
 		Read:   resourceVpnGatewayConnectionResourceRead,
 		Update: resourceVpnGatewayConnectionResourceUpdate,
@@ -325,7 +325,7 @@ func resourceVPNGatewayConnection() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &commonids.VPNConnectionId{})
 }
 
 func resourceVpnGatewayConnectionResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
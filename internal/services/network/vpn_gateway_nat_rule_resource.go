@@ -197,7 +197,7 @@ func resourceVPNGatewayNatRule() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.NatRuleId{})
 }
 
 func resourceVPNGatewayNatRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -212,7 +212,9 @@ func resourceVPNGateway() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &virtualwans.VpnGatewayId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.VpnGatewayId{})
 }
 
 func resourceVPNGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVPNServerConfigurationPolicyGroupCreate,
 		Read:   resourceVPNServerConfigurationPolicyGroupRead,
 		Update: resourceVPNServerConfigurationPolicyGroupUpdate,
@@ -98,7 +98,7 @@ func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntAtLeast(0),
 			},
 		},
-	}
+	}, &virtualwans.ConfigurationPolicyGroupId{})
 }
 
 func resourceVPNServerConfigurationPolicyGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceVPNServerConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVPNServerConfigurationCreate,
 		Read:   resourceVPNServerConfigurationRead,
 		Update: resourceVPNServerConfigurationUpdate,
@@ -267,6 +267,10 @@ func resourceVPNServerConfiguration() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualwans.VpnServerConfigurationId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.VpnServerConfigurationId{})
 }
 
 func resourceVPNServerConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
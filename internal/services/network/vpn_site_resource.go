@@ -24,7 +24,7 @@ import (
 )
 
 func resourceVpnSite() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVpnSiteCreate,
 		Read:   resourceVpnSiteRead,
 		Update: resourceVpnSiteUpdate,
@@ -181,6 +181,10 @@ func resourceVpnSite() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &virtualwans.VpnSiteId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &virtualwans.VpnSiteId{})
 }
 
 func resourceVpnSiteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -494,7 +494,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &webapplicationfirewallpolicies.ApplicationGatewayWebApplicationFirewallPolicyId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &webapplicationfirewallpolicies.ApplicationGatewayWebApplicationFirewallPolicyId{})
 }
 
 func resourceWebApplicationFirewallPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -30,6 +31,7 @@ type NetworkFunctionAzureTrafficCollectorModel struct {
 type NetworkFunctionAzureTrafficCollectorResource struct{}
 
 var _ sdk.ResourceWithUpdate = NetworkFunctionAzureTrafficCollectorResource{}
+var _ sdk.ResourceWithIdentity = NetworkFunctionAzureTrafficCollectorResource{}
 
 func (r NetworkFunctionAzureTrafficCollectorResource) ResourceType() string {
 	return "azurerm_network_function_azure_traffic_collector"
@@ -43,6 +45,10 @@ func (r NetworkFunctionAzureTrafficCollectorResource) IDValidationFunc() plugins
 	return azuretrafficcollectors.ValidateAzureTrafficCollectorID
 }
 
+func (r NetworkFunctionAzureTrafficCollectorResource) Identity() resourceids.ResourceId {
+	return &azuretrafficcollectors.AzureTrafficCollectorId{}
+}
+
 func (r NetworkFunctionAzureTrafficCollectorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
@@ -43,6 +44,7 @@ type IpfxIngestionModel struct {
 type NetworkFunctionCollectorPolicyResource struct{}
 
 var _ sdk.ResourceWithUpdate = NetworkFunctionCollectorPolicyResource{}
+var _ sdk.ResourceWithIdentity = NetworkFunctionCollectorPolicyResource{}
 
 func (r NetworkFunctionCollectorPolicyResource) ResourceType() string {
 	return "azurerm_network_function_collector_policy"
@@ -56,6 +58,10 @@ func (r NetworkFunctionCollectorPolicyResource) IDValidationFunc() pluginsdk.Sch
 	return collectorpolicies.ValidateCollectorPolicyID
 }
 
+func (r NetworkFunctionCollectorPolicyResource) Identity() resourceids.ResourceId {
+	return &collectorpolicies.CollectorPolicyId{}
+}
+
 func (r NetworkFunctionCollectorPolicyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/monitors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -57,6 +58,7 @@ type UserInfoModel struct {
 type NewRelicMonitorResource struct{}
 
 var _ sdk.Resource = NewRelicMonitorResource{}
+var _ sdk.ResourceWithIdentity = NewRelicMonitorResource{}
 
 func (r NewRelicMonitorResource) ResourceType() string {
 	return "azurerm_new_relic_monitor"
@@ -70,6 +72,10 @@ func (r NewRelicMonitorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return monitors.ValidateMonitorID
 }
 
+func (r NewRelicMonitorResource) Identity() resourceids.ResourceId {
+	return &monitors.MonitorId{}
+}
+
 func (r NewRelicMonitorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/monitors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/tagrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -36,6 +37,7 @@ type FilteringTagModel struct {
 type NewRelicTagRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = NewRelicTagRuleResource{}
+var _ sdk.ResourceWithIdentity = NewRelicTagRuleResource{}
 
 func (r NewRelicTagRuleResource) ResourceType() string {
 	return "azurerm_new_relic_tag_rule"
@@ -49,6 +51,10 @@ func (r NewRelicTagRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return tagrules.ValidateTagRuleID
 }
 
+func (r NewRelicTagRuleResource) Identity() resourceids.ResourceId {
+	return &tagrules.TagRuleId{}
+}
+
 func (r NewRelicTagRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"monitor_id": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxconfiguration"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -789,6 +790,10 @@ func (m DeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxdeployment.ValidateNginxDeploymentID
 }
 
+func (m DeploymentResource) Identity() resourceids.ResourceId {
+	return &nginxdeployment.NginxDeploymentId{}
+}
+
 func expandConfiguration(model Configuration) nginxconfiguration.NginxConfiguration {
 	result := nginxconfiguration.NginxConfiguration{
 		Properties: &nginxconfiguration.NginxConfigurationProperties{},
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceNotificationHubAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceNotificationHubAuthorizationRuleCreateUpdate,
 		Read:   resourceNotificationHubAuthorizationRuleRead,
 		Update: resourceNotificationHubAuthorizationRuleCreateUpdate,
@@ -30,6 +30,7 @@ func resourceNotificationHubAuthorizationRule() *pluginsdk.Resource {
 			_, err := hubs.ParseNotificationHubAuthorizationRuleID(id)
 			return err
 		}),
+
 		// TODO: customizeDiff for send+listen when manage selected
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -107,7 +108,7 @@ func resourceNotificationHubAuthorizationRule() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	}, &hubs.NotificationHubAuthorizationRuleId{})
 }
 
 func resourceNotificationHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -29,7 +29,7 @@ import (
 var notificationHubNamespaceResourceName = "azurerm_notification_hub_namespace"
 
 func resourceNotificationHubNamespace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNotificationHubNamespaceCreate,
 		Read:   resourceNotificationHubNamespaceRead,
 		Update: resourceNotificationHubNamespaceUpdate,
@@ -97,6 +97,10 @@ func resourceNotificationHubNamespace() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &namespaces.NamespaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &namespaces.NamespaceId{})
 }
 
 func resourceNotificationHubNamespaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -34,7 +34,7 @@ const (
 )
 
 func resourceNotificationHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceNotificationHubCreateUpdate,
 		Read:   resourceNotificationHubRead,
 		Update: resourceNotificationHubCreateUpdate,
@@ -152,6 +152,10 @@ func resourceNotificationHub() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &hubs.NotificationHubId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &hubs.NotificationHubId{})
 }
 
 func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
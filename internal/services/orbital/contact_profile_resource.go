@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contactprofile"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,6 +25,7 @@ import (
 type ContactProfileResource struct{}
 
 var _ sdk.ResourceWithUpdate = ContactProfileResource{}
+var _ sdk.ResourceWithIdentity = ContactProfileResource{}
 
 type ContactProfileResourceModel struct {
 	Name                           string                    `tfschema:"name"`
@@ -240,6 +242,10 @@ func (r ContactProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return contactprofile.ValidateContactProfileID
 }
 
+func (r ContactProfileResource) Identity() resourceids.ResourceId {
+	return &contactprofile.ContactProfileId{}
+}
+
 func (r ContactProfileResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contact"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contactprofile"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
@@ -200,3 +201,7 @@ func (r ContactResource) Delete() sdk.ResourceFunc {
 func (r ContactResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return contact.ValidateContactID
 }
+
+func (r ContactResource) Identity() resourceids.ResourceId {
+	return &contact.ContactId{}
+}
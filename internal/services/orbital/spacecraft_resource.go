@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,7 @@ import (
 type SpacecraftResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpacecraftResource{}
+var _ sdk.ResourceWithIdentity = SpacecraftResource{}
 
 type SpacecraftResourceModel struct {
 	Name            string                `tfschema:"name"`
@@ -207,6 +209,10 @@ func (r SpacecraftResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return spacecraft.ValidateSpacecraftID
 }
 
+func (r SpacecraftResource) Identity() resourceids.ResourceId {
+	return &spacecraft.SpacecraftId{}
+}
+
 func (r SpacecraftResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
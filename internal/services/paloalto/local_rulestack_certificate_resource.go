@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +24,7 @@ import (
 type LocalRuleStackCertificate struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackCertificate{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackCertificate{}
 
 type LocalRuleStackCertificateModel struct {
 	Name                string `tfschema:"name"`
@@ -37,6 +39,10 @@ func (r LocalRuleStackCertificate) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return certificateobjectlocalrulestack.ValidateLocalRulestackCertificateID
 }
 
+func (r LocalRuleStackCertificate) Identity() resourceids.ResourceId {
+	return &certificateobjectlocalrulestack.LocalRulestackCertificateId{}
+}
+
 func (r LocalRuleStackCertificate) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_certificate"
 }
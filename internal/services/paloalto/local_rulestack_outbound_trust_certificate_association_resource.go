@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,11 +26,16 @@ type LocalRulestackOutboundTrustCertificateResourceModel struct {
 }
 
 var _ sdk.Resource = LocalRulestackOutboundTrustCertificateAssociationResource{}
+var _ sdk.ResourceWithIdentity = LocalRulestackOutboundTrustCertificateAssociationResource{}
 
 func (l LocalRulestackOutboundTrustCertificateAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return certificates.ValidateLocalRulestackCertificateID
 }
 
+func (l LocalRulestackOutboundTrustCertificateAssociationResource) Identity() resourceids.ResourceId {
+	return &certificates.LocalRulestackCertificateId{}
+}
+
 func (l LocalRulestackOutboundTrustCertificateAssociationResource) ModelObject() interface{} {
 	return &LocalRulestackOutboundTrustCertificateResourceModel{}
 }
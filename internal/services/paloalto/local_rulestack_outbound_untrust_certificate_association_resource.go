@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,11 +26,16 @@ type LocalRulestackOutboundUnTrustCertificateResourceModel struct {
 }
 
 var _ sdk.Resource = LocalRulestackOutboundUnTrustCertificateAssociationResource{}
+var _ sdk.ResourceWithIdentity = LocalRulestackOutboundUnTrustCertificateAssociationResource{}
 
 func (l LocalRulestackOutboundUnTrustCertificateAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return certificates.ValidateLocalRulestackCertificateID
 }
 
+func (l LocalRulestackOutboundUnTrustCertificateAssociationResource) Identity() resourceids.ResourceId {
+	return &certificates.LocalRulestackCertificateId{}
+}
+
 func (l LocalRulestackOutboundUnTrustCertificateAssociationResource) ModelObject() interface{} {
 	return &LocalRulestackOutboundUnTrustCertificateResourceModel{}
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/prefixlistlocalrulestack"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +24,7 @@ import (
 type LocalRuleStackPrefixList struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackPrefixList{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackPrefixList{}
 
 type LocalRuleStackPrefixListModel struct {
 	Name         string   `tfschema:"name"`
@@ -36,6 +38,10 @@ func (r LocalRuleStackPrefixList) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return prefixlistlocalrulestack.ValidateLocalRulestackPrefixListID
 }
 
+func (r LocalRuleStackPrefixList) Identity() resourceids.ResourceId {
+	return &prefixlistlocalrulestack.LocalRulestackPrefixListId{}
+}
+
 func (r LocalRuleStackPrefixList) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_prefix_list"
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
@@ -28,6 +29,7 @@ import (
 type LocalRuleStackRule struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackRule{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackRule{}
 
 var protocolApplicationDefault = "application-default"
 
@@ -58,6 +60,10 @@ func (r LocalRuleStackRule) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return localrules.ValidateLocalRuleID
 }
 
+func (r LocalRuleStackRule) Identity() resourceids.ResourceId {
+	return &localrules.LocalRuleId{}
+}
+
 func (r LocalRuleStackRule) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_rule"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2023-09-01/firewalls"
@@ -36,6 +37,7 @@ type NextGenerationFirewallVHubLocalRuleStackModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVHubLocalRuleStackResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVHubLocalRuleStackResource{}
 
 func (r NextGenerationFirewallVHubLocalRuleStackResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVHubLocalRuleStackModel{}
@@ -45,6 +47,10 @@ func (r NextGenerationFirewallVHubLocalRuleStackResource) IDValidationFunc() plu
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVHubLocalRuleStackResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVHubLocalRuleStackResource) ResourceType() string {
 	return "azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2023-09-01/firewalls"
 	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -38,6 +39,7 @@ type NextGenerationFirewallVHubPanoramaResourceModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVHubPanoramaResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVHubPanoramaResource{}
 
 func (r NextGenerationFirewallVHubPanoramaResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVHubPanoramaResourceModel{}
@@ -47,6 +49,10 @@ func (r NextGenerationFirewallVHubPanoramaResource) IDValidationFunc() pluginsdk
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVHubPanoramaResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVHubPanoramaResource) ResourceType() string {
 	return "azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2023-09-01/firewalls"
@@ -35,6 +36,7 @@ type NextGenerationFirewallVnetLocalRulestackModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetLocalRulestackResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVNetLocalRulestackResource{}
 
 func (r NextGenerationFirewallVNetLocalRulestackResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVnetLocalRulestackModel{}
@@ -217,6 +219,10 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) IDValidationFunc() plu
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVNetLocalRulestackResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVNetLocalRulestackResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 3 * time.Hour,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2023-09-01/firewalls"
 	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -36,6 +37,7 @@ type NextGenerationFirewallVnetPanoramaModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetPanoramaResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVNetPanoramaResource{}
 
 func (r NextGenerationFirewallVNetPanoramaResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVnetPanoramaModel{}
@@ -216,6 +218,10 @@ func (r NextGenerationFirewallVNetPanoramaResource) IDValidationFunc() pluginsdk
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVNetPanoramaResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVNetPanoramaResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 3 * time.Hour,
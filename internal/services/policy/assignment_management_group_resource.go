@@ -4,8 +4,10 @@
 package policy
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -56,6 +58,10 @@ func (r ManagementGroupAssignmentResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.ManagementGroupAssignmentID
 }
 
+func (r ManagementGroupAssignmentResource) Identity() resourceids.ResourceId {
+	return &parse.ManagementGroupAssignmentId{}
+}
+
 func (r ManagementGroupAssignmentResource) ModelObject() interface{} {
 	return nil
 }
@@ -4,6 +4,8 @@
 package policy
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -54,6 +56,10 @@ func (r ResourceAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.ResourceAssignmentId()
 }
 
+func (r ResourceAssignmentResource) Identity() resourceids.ResourceId {
+	return &policyassignments.ScopedPolicyAssignmentId{}
+}
+
 func (r ResourceAssignmentResource) ModelObject() interface{} {
 	return nil
 }
@@ -4,7 +4,9 @@
 package policy
 
 import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	resourceValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -54,6 +56,10 @@ func (r ResourceGroupAssignmentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.ResourceGroupAssignmentID
 }
 
+func (r ResourceGroupAssignmentResource) Identity() resourceids.ResourceId {
+	return &parse.ResourceGroupAssignmentId{}
+}
+
 func (r ResourceGroupAssignmentResource) ModelObject() interface{} {
 	return nil
 }
@@ -5,7 +5,9 @@ package policy
 
 import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -54,6 +56,10 @@ func (r SubscriptionAssignmentResource) IDValidationFunc() pluginsdk.SchemaValid
 	return validate.SubscriptionAssignmentID
 }
 
+func (r SubscriptionAssignmentResource) Identity() resourceids.ResourceId {
+	return &parse.SubscriptionAssignmentId{}
+}
+
 func (r SubscriptionAssignmentResource) ModelObject() interface{} {
 	return nil
 }
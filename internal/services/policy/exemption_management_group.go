@@ -26,7 +26,7 @@ import (
 )
 
 func resourceArmManagementGroupPolicyExemption() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmManagementGroupPolicyExemptionCreateUpdate,
 		Read:   resourceArmManagementGroupPolicyExemptionRead,
 		Update: resourceArmManagementGroupPolicyExemptionCreateUpdate,
@@ -103,7 +103,7 @@ func resourceArmManagementGroupPolicyExemption() *pluginsdk.Resource {
 
 			"metadata": metadataSchema(),
 		},
-	}
+	}, &parse.ResourcePolicyExemptionId{})
 }
 
 func resourceArmManagementGroupPolicyExemptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceArmResourcePolicyExemption() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmResourcePolicyExemptionCreateUpdate,
 		Read:   resourceArmResourcePolicyExemptionRead,
 		Update: resourceArmResourcePolicyExemptionCreateUpdate,
@@ -103,7 +103,7 @@ func resourceArmResourcePolicyExemption() *pluginsdk.Resource {
 
 			"metadata": metadataSchema(),
 		},
-	}
+	}, &parse.ResourcePolicyExemptionId{})
 }
 
 func resourceArmResourcePolicyExemptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
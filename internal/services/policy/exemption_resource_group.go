@@ -26,7 +26,7 @@ import (
 )
 
 func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmResourceGroupPolicyExemptionCreateUpdate,
 		Read:   resourceArmResourceGroupPolicyExemptionRead,
 		Update: resourceArmResourceGroupPolicyExemptionCreateUpdate,
@@ -104,7 +104,7 @@ func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
 
 			"metadata": metadataSchema(),
 		},
-	}
+	}, &parse.ResourceGroupPolicyExemptionId{})
 }
 
 func resourceArmResourceGroupPolicyExemptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
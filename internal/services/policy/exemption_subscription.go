@@ -25,7 +25,7 @@ import (
 )
 
 func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmSubscriptionPolicyExemptionCreateUpdate,
 		Read:   resourceArmSubscriptionPolicyExemptionRead,
 		Update: resourceArmSubscriptionPolicyExemptionCreateUpdate,
@@ -103,7 +103,7 @@ func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
 
 			"metadata": metadataSchema(),
 		},
-	}
+	}, &parse.SubscriptionPolicyExemptionId{})
 }
 
 func resourceArmSubscriptionPolicyExemptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -39,6 +39,35 @@ func (id ManagementGroupAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.PolicyAssignmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Management Group Assignment ID
+func (id ManagementGroupAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftManagement", "Microsoft.Management", "Microsoft.Management"),
+		resourceids.StaticSegment("staticManagementGroups", "managementGroups", "managementGroups"),
+		resourceids.UserSpecifiedSegment("managementGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyAssignments", "policyAssignments", "policyAssignments"),
+		resourceids.UserSpecifiedSegment("policyAssignmentName", "assignment1"),
+	}
+}
+
+// FromParseResult populates the ManagementGroupAssignmentId using the ParseResult provided in 'input'
+func (id *ManagementGroupAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ManagementGroupName, ok = input.Parsed["managementGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managementGroupName", input)
+	}
+
+	if id.PolicyAssignmentName, ok = input.Parsed["policyAssignmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyAssignmentName", input)
+	}
+
+	return nil
+}
+
 // ManagementGroupAssignmentID parses a ManagementGroupAssignment ID into an ManagementGroupAssignmentId struct
 func ManagementGroupAssignmentID(input string) (*ManagementGroupAssignmentId, error) {
 	// TODO: the generator should support outputting this method too
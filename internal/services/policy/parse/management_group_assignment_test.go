@@ -3,7 +3,11 @@
 
 package parse
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
 
 func TestManagementGroupAssignmentIDFormatter(t *testing.T) {
 	actual := NewManagementGroupAssignmentID("managementGroup1", "assignment1").ID()
@@ -88,3 +92,20 @@ func TestManagementGroupAssignmentID(t *testing.T) {
 		}
 	}
 }
+
+func TestManagementGroupAssignmentIDSegments(t *testing.T) {
+	expected := NewManagementGroupAssignmentID("managementGroup1", "assignment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagementGroupAssignmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagementGroupAssignmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
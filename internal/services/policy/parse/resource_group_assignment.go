@@ -40,6 +40,20 @@ func (id ResourceGroupAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PolicyAssignmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ResourceGroupAssignment ID
+func (id ResourceGroupAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyAssignments", "policyAssignments", "policyAssignments"),
+		resourceids.UserSpecifiedSegment("policyAssignmentName", "assignment1"),
+	}
+}
+
 // ResourceGroupAssignmentID parses a ResourceGroupAssignment ID into an ResourceGroupAssignmentId struct
 func ResourceGroupAssignmentID(input string) (*ResourceGroupAssignmentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func ResourceGroupAssignmentID(input string) (*ResourceGroupAssignmentId, error)
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ResourceGroupAssignmentId using the ParseResult provided in 'input'
+func (id *ResourceGroupAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.PolicyAssignmentName, ok = input.Parsed["policyAssignmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyAssignmentName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestResourceGroupAssignmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupAssignmentIDSegments(t *testing.T) {
+	expected := NewResourceGroupAssignmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "assignment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupAssignmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupAssignmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupAssignmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -40,6 +40,20 @@ func (id ResourceGroupPolicyExemptionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PolicyExemptionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ResourceGroupPolicyExemption ID
+func (id ResourceGroupPolicyExemptionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyExemptions", "policyExemptions", "policyExemptions"),
+		resourceids.UserSpecifiedSegment("policyExemptionName", "exemption1"),
+	}
+}
+
 // ResourceGroupPolicyExemptionID parses a ResourceGroupPolicyExemption ID into an ResourceGroupPolicyExemptionId struct
 func ResourceGroupPolicyExemptionID(input string) (*ResourceGroupPolicyExemptionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func ResourceGroupPolicyExemptionID(input string) (*ResourceGroupPolicyExemption
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ResourceGroupPolicyExemptionId using the ParseResult provided in 'input'
+func (id *ResourceGroupPolicyExemptionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.PolicyExemptionName, ok = input.Parsed["policyExemptionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyExemptionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestResourceGroupPolicyExemptionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupPolicyExemptionIDSegments(t *testing.T) {
+	expected := NewResourceGroupPolicyExemptionID("12345678-1234-9876-4563-123456789012", "resGroup1", "exemption1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupPolicyExemptionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupPolicyExemptionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupPolicyExemptionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestResourceGroupPolicyRemediationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupPolicyRemediationIDSegments(t *testing.T) {
+	expected := NewResourceGroupPolicyRemediationID("12345678-1234-9876-4563-123456789012", "resGroup1", "remediation1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupPolicyRemediationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupPolicyRemediationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupPolicyRemediationID(t *testing.T) {
 	testData := []struct {
 		Input    string
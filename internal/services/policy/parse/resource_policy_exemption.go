@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 type ResourcePolicyExemptionId struct {
@@ -28,6 +30,32 @@ func (id ResourcePolicyExemptionId) ID() string {
 	return fmt.Sprintf(fmtString, id.ResourceId, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Resource Policy Exemption ID
+func (id ResourcePolicyExemptionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyExemptions", "policyExemptions", "policyExemptions"),
+		resourceids.UserSpecifiedSegment("policyExemptionName", "exemption1"),
+	}
+}
+
+// FromParseResult populates the ResourcePolicyExemptionId using the ParseResult provided in 'input'
+func (id *ResourcePolicyExemptionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ResourceId, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if id.Name, ok = input.Parsed["policyExemptionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyExemptionName", input)
+	}
+
+	return nil
+}
+
 func NewResourcePolicyExemptionId(resourceID, name string) ResourcePolicyExemptionId {
 	return ResourcePolicyExemptionId{
 		Name:       name,
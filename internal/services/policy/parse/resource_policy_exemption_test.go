@@ -5,6 +5,8 @@ package parse
 
 import (
 	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 func TestResourcePolicyExemptionID(t *testing.T) {
@@ -94,3 +96,20 @@ func TestResourcePolicyExemptionID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourcePolicyExemptionIDSegments(t *testing.T) {
+	expected := NewResourcePolicyExemptionId("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "exemption1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourcePolicyExemptionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourcePolicyExemptionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
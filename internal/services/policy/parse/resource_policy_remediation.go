@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 type ResourcePolicyRemediationId struct {
@@ -28,6 +30,32 @@ func (id ResourcePolicyRemediationId) ID() string {
 	return fmt.Sprintf(fmtString, id.ResourceId, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Resource Policy Remediation ID
+func (id ResourcePolicyRemediationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftPolicyInsights", "Microsoft.PolicyInsights", "Microsoft.PolicyInsights"),
+		resourceids.StaticSegment("staticRemediations", "remediations", "remediations"),
+		resourceids.UserSpecifiedSegment("remediationName", "remediation1"),
+	}
+}
+
+// FromParseResult populates the ResourcePolicyRemediationId using the ParseResult provided in 'input'
+func (id *ResourcePolicyRemediationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ResourceId, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if id.Name, ok = input.Parsed["remediationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "remediationName", input)
+	}
+
+	return nil
+}
+
 func NewResourcePolicyRemediationId(resourceID, name string) ResourcePolicyRemediationId {
 	return ResourcePolicyRemediationId{
 		Name:       name,
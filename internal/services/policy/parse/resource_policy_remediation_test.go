@@ -5,6 +5,8 @@ package parse
 
 import (
 	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 func TestResourcePolicyRemediationID(t *testing.T) {
@@ -102,3 +104,20 @@ func TestResourcePolicyRemediationID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourcePolicyRemediationIDSegments(t *testing.T) {
+	expected := NewResourcePolicyRemediationId("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "remediation1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourcePolicyRemediationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourcePolicyRemediationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
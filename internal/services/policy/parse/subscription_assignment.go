@@ -37,6 +37,18 @@ func (id SubscriptionAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.PolicyAssignmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionAssignment ID
+func (id SubscriptionAssignmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyAssignments", "policyAssignments", "policyAssignments"),
+		resourceids.UserSpecifiedSegment("policyAssignmentName", "assignment1"),
+	}
+}
+
 // SubscriptionAssignmentID parses a SubscriptionAssignment ID into an SubscriptionAssignmentId struct
 func SubscriptionAssignmentID(input string) (*SubscriptionAssignmentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func SubscriptionAssignmentID(input string) (*SubscriptionAssignmentId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SubscriptionAssignmentId using the ParseResult provided in 'input'
+func (id *SubscriptionAssignmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.PolicyAssignmentName, ok = input.Parsed["policyAssignmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyAssignmentName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSubscriptionAssignmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionAssignmentIDSegments(t *testing.T) {
+	expected := NewSubscriptionAssignmentID("12345678-1234-9876-4563-123456789012", "assignment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionAssignmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionAssignmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionAssignmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -37,6 +37,18 @@ func (id SubscriptionPolicyExemptionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.PolicyExemptionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionPolicyExemption ID
+func (id SubscriptionPolicyExemptionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization", "Microsoft.Authorization"),
+		resourceids.StaticSegment("staticPolicyExemptions", "policyExemptions", "policyExemptions"),
+		resourceids.UserSpecifiedSegment("policyExemptionName", "exemption1"),
+	}
+}
+
 // SubscriptionPolicyExemptionID parses a SubscriptionPolicyExemption ID into an SubscriptionPolicyExemptionId struct
 func SubscriptionPolicyExemptionID(input string) (*SubscriptionPolicyExemptionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func SubscriptionPolicyExemptionID(input string) (*SubscriptionPolicyExemptionId
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SubscriptionPolicyExemptionId using the ParseResult provided in 'input'
+func (id *SubscriptionPolicyExemptionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.PolicyExemptionName, ok = input.Parsed["policyExemptionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "policyExemptionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSubscriptionPolicyExemptionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionPolicyExemptionIDSegments(t *testing.T) {
+	expected := NewSubscriptionPolicyExemptionID("12345678-1234-9876-4563-123456789012", "exemption1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionPolicyExemptionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionPolicyExemptionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionPolicyExemptionID(t *testing.T) {
 	testData := []struct {
 		Input    string
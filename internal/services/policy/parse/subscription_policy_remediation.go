@@ -37,6 +37,18 @@ func (id SubscriptionPolicyRemediationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.RemediationName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionPolicyRemediation ID
+func (id SubscriptionPolicyRemediationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftPolicyInsights", "Microsoft.PolicyInsights", "Microsoft.PolicyInsights"),
+		resourceids.StaticSegment("staticRemediations", "remediations", "remediations"),
+		resourceids.UserSpecifiedSegment("remediationName", "remediation1"),
+	}
+}
+
 // SubscriptionPolicyRemediationID parses a SubscriptionPolicyRemediation ID into an SubscriptionPolicyRemediationId struct
 func SubscriptionPolicyRemediationID(input string) (*SubscriptionPolicyRemediationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func SubscriptionPolicyRemediationID(input string) (*SubscriptionPolicyRemediati
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SubscriptionPolicyRemediationId using the ParseResult provided in 'input'
+func (id *SubscriptionPolicyRemediationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.RemediationName, ok = input.Parsed["remediationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "remediationName", input)
+	}
+
+	return nil
+}
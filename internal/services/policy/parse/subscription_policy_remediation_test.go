@@ -21,6 +21,23 @@ func TestSubscriptionPolicyRemediationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionPolicyRemediationIDSegments(t *testing.T) {
+	expected := NewSubscriptionPolicyRemediationID("12345678-1234-9876-4563-123456789012", "remediation1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionPolicyRemediationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionPolicyRemediationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionPolicyRemediationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePolicyVirtualMachineConfigurationAssignment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePolicyVirtualMachineConfigurationAssignmentCreateUpdate,
 		Read:   resourcePolicyVirtualMachineConfigurationAssignmentRead,
 		Update: resourcePolicyVirtualMachineConfigurationAssignmentCreateUpdate,
@@ -44,6 +44,10 @@ func resourcePolicyVirtualMachineConfigurationAssignment() *pluginsdk.Resource {
 
 		Schema: resourcePolicyVirtualMachineConfigurationAssignmentSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &guestconfigurationassignments.Providers2GuestConfigurationAssignmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &guestconfigurationassignments.Providers2GuestConfigurationAssignmentId{})
 }
 
 func resourcePolicyVirtualMachineConfigurationAssignmentSchema() map[string]*pluginsdk.Schema {
@@ -126,7 +126,7 @@ func resourceArmManagementGroupPolicyRemediation() *pluginsdk.Resource {
 			Deprecated: "`resource_discovery_mode` will be removed in version 4.0 of the AzureRM Provider as evaluating compliance before remediation is only supported at subscription scope and below.",
 		}
 	}
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &parse.ResourcePolicyRemediationId{})
 }
 
 func resourceArmManagementGroupPolicyRemediationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -121,7 +121,7 @@ func resourceArmResourcePolicyRemediation() *pluginsdk.Resource {
 			Deprecated:       "`policy_definition_id` will be removed in version 4.0 of the AzureRM Provider in favour of `policy_definition_reference_id`.",
 		}
 	}
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &parse.ResourcePolicyRemediationId{})
 }
 
 func resourceArmResourcePolicyRemediationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
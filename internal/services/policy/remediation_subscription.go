@@ -120,7 +120,7 @@ func resourceArmSubscriptionPolicyRemediation() *pluginsdk.Resource {
 			Deprecated:       "`policy_definition_id` will be removed in version 4.0 of the AzureRM Provider in favour of `policy_definition_reference_id`.",
 		}
 	}
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &parse.SubscriptionPolicyRemediationId{})
 }
 
 func resourceArmSubscriptionPolicyRemediationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
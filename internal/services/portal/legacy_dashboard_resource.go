@@ -24,7 +24,7 @@ import (
 )
 
 func resourceLegacyDashboard() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceLegacyDashboardCreateUpdate,
 		Read:   resourceLegacyDashboardRead,
 		Update: resourceLegacyDashboardCreateUpdate,
@@ -62,6 +62,10 @@ func resourceLegacyDashboard() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.DashboardId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.DashboardId{})
 }
 
 func resourceLegacyDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,23 @@ func TestDashboardIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDashboardIDSegments(t *testing.T) {
+	expected := NewDashboardID("12345678-1234-9876-4563-123456789012", "group1", "dashboard1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DashboardId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DashboardId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDashboardID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -36,6 +36,27 @@ func (id PortalTenantConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Portal Tenant Configuration ID
+func (id PortalTenantConfigurationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftPortal", "Microsoft.Portal", "Microsoft.Portal"),
+		resourceids.StaticSegment("staticTenantConfigurations", "tenantConfigurations", "tenantConfigurations"),
+		resourceids.UserSpecifiedSegment("tenantConfigurationName", "default"),
+	}
+}
+
+// FromParseResult populates the PortalTenantConfigurationId using the ParseResult provided in 'input'
+func (id *PortalTenantConfigurationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.Name, ok = input.Parsed["tenantConfigurationName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "tenantConfigurationName", input)
+	}
+
+	return nil
+}
+
 // PortalTenantConfigurationID parses a PortalTenantConfiguration ID into an PortalTenantConfigurationId struct
 func PortalTenantConfigurationID(input string) (*PortalTenantConfigurationId, error) {
 	id, err := azure.ParseAzureResourceIDWithoutSubscription(input)
@@ -3,7 +3,11 @@
 
 package parse
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
 
 func TestPortalTenantConfigurationIDFormatter(t *testing.T) {
 	actual := NewPortalTenantConfigurationID("default").ID()
@@ -72,3 +76,20 @@ func TestPortalTenantConfigurationID(t *testing.T) {
 		}
 	}
 }
+
+func TestPortalTenantConfigurationIDSegments(t *testing.T) {
+	expected := NewPortalTenantConfigurationID("default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PortalTenantConfigurationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PortalTenantConfigurationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
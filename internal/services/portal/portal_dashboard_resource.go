@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePortalDashboard() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePortalDashboardCreateUpdate,
 		Read:   resourcePortalDashboardRead,
 		Update: resourcePortalDashboardCreateUpdate,
@@ -64,6 +64,10 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &dashboard.DashboardId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &dashboard.DashboardId{})
 }
 
 func resourcePortalDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourcePortalTenantConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePortalTenantConfigurationCreateUpdate,
 		Read:   resourcePortalTenantConfigurationRead,
 		Update: resourcePortalTenantConfigurationCreateUpdate,
@@ -44,7 +44,7 @@ func resourcePortalTenantConfiguration() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.PortalTenantConfigurationId{})
 }
 
 func resourcePortalTenantConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,6 +21,23 @@ func TestAzureActiveDirectoryAdministratorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAzureActiveDirectoryAdministratorIDSegments(t *testing.T) {
+	expected := NewAzureActiveDirectoryAdministratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "activeDirectory")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AzureActiveDirectoryAdministratorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AzureActiveDirectoryAdministratorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAzureActiveDirectoryAdministratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,7 +21,7 @@ import (
 )
 
 func resourcePostgreSQLAdministrator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgreSQLAdministratorCreateUpdate,
 		Read:   resourcePostgreSQLAdministratorRead,
 		Update: resourcePostgreSQLAdministratorCreateUpdate,
@@ -70,7 +70,7 @@ func resourcePostgreSQLAdministrator() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &serveradministrators.ServerId{})
 }
 
 func resourcePostgreSQLAdministratorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourcePostgreSQLConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgreSQLConfigurationCreate,
 		Read:   resourcePostgreSQLConfigurationRead,
 		Delete: resourcePostgreSQLConfigurationDelete,
@@ -57,7 +57,7 @@ func resourcePostgreSQLConfiguration() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &configurations.ConfigurationId{})
 }
 
 func resourcePostgreSQLConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourcePostgreSQLDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgreSQLDatabaseCreate,
 		Read:   resourcePostgreSQLDatabaseRead,
 		Delete: resourcePostgreSQLDatabaseDelete,
@@ -72,7 +72,7 @@ func resourcePostgreSQLDatabase() *pluginsdk.Resource {
 				ValidateFunc: validate.PostgresqlDatabaseCollation,
 			},
 		},
-	}
+	}, &databases.DatabaseId{})
 }
 
 func resourcePostgreSQLDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
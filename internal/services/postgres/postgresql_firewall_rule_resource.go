@@ -21,7 +21,7 @@ import (
 )
 
 func resourcePostgreSQLFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgreSQLFirewallRuleCreate,
 		Read:   resourcePostgreSQLFirewallRuleRead,
 		Delete: resourcePostgreSQLFirewallRuleDelete,
@@ -70,7 +70,7 @@ func resourcePostgreSQLFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: validation.IsIPv4Address,
 			},
 		},
-	}
+	}, &firewallrules.FirewallRuleId{})
 }
 
 func resourcePostgreSQLFirewallRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourcePostgresqlFlexibleServerAdministrator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgresqlFlexibleServerAdministratorCreate,
 		Read:   resourcePostgresqlFlexibleServerAdministratorRead,
 		Delete: resourcePostgresqlFlexibleServerAdministratorDelete,
@@ -77,7 +77,7 @@ func resourcePostgresqlFlexibleServerAdministrator() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &administrators.AdministratorId{})
 }
 
 func resourcePostgresqlFlexibleServerAdministratorCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourcePostgresqlFlexibleServerConfiguration() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceFlexibleServerConfigurationUpdate,
 		Read:   resourceFlexibleServerConfigurationRead,
 		Update: resourceFlexibleServerConfigurationUpdate,
@@ -59,7 +59,7 @@ func resourcePostgresqlFlexibleServerConfiguration() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &configurations.ConfigurationId{})
 }
 
 func resourceFlexibleServerConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourcePostgresqlFlexibleServerDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgresqlFlexibleServerDatabaseCreate,
 		Read:   resourcePostgresqlFlexibleServerDatabaseRead,
 		Delete: resourcePostgresqlFlexibleServerDatabaseDelete,
@@ -68,7 +68,7 @@ func resourcePostgresqlFlexibleServerDatabase() *pluginsdk.Resource {
 				Default:      "en_US.utf8",
 			},
 		},
-	}
+	}, &databases.DatabaseId{})
 }
 
 func resourcePostgresqlFlexibleServerDatabaseCreate(d *pluginsdk.ResourceData, meta interface{}) error {
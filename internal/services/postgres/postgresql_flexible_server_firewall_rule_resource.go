@@ -20,7 +20,7 @@ import (
 )
 
 func resourcePostgresqlFlexibleServerFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgresqlFlexibleServerFirewallRuleCreateUpdate,
 		Read:   resourcePostgresqlFlexibleServerFirewallRuleRead,
 		Update: resourcePostgresqlFlexibleServerFirewallRuleCreateUpdate,
@@ -65,7 +65,7 @@ func resourcePostgresqlFlexibleServerFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: validation.IsIPAddress,
 			},
 		},
-	}
+	}, &firewallrules.FirewallRuleId{})
 }
 
 func resourcePostgresqlFlexibleServerFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -41,7 +41,7 @@ const (
 var postgresqlFlexibleServerResourceName = "azurerm_postgresql_flexible_server"
 
 func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePostgresqlFlexibleServerCreate,
 		Read:   resourcePostgresqlFlexibleServerRead,
 		Update: resourcePostgresqlFlexibleServerUpdate,
@@ -79,10 +79,27 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			},
 
 			"administrator_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"administrator_password_wo"},
+			},
+
+			"administrator_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"administrator_password"},
+				RequiredWith:  []string{"administrator_password_wo_version"},
+			},
+
+			"administrator_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"administrator_password_wo"},
 			},
 
 			"authentication": {
@@ -431,6 +448,10 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 		},
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &servers.FlexibleServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &servers.FlexibleServerId{})
 }
 
 func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -456,6 +477,11 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	createMode := d.Get("create_mode").(string)
 
+	passwordWriteOnly, err := pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+	if err != nil {
+		return err
+	}
+
 	if _, ok := d.GetOk("replication_role"); ok {
 		return fmt.Errorf("`replication_role` cannot be set while creating")
 	}
@@ -478,6 +504,9 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
 		_, adminLoginSet := d.GetOk("administrator_login")
 		_, adminPwdSet := d.GetOk("administrator_password")
+		if passwordWriteOnly != nil {
+			adminPwdSet = true
+		}
 
 		pwdEnabled := true // it defaults to true
 		if authRaw, authExist := d.GetOk("authentication"); authExist {
@@ -493,10 +522,10 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 			}
 
 			if !adminPwdSet {
-				return fmt.Errorf("`administrator_password` is required when `create_mode` is `Default` and `authentication.password_auth_enabled` is set to `true`")
+				return fmt.Errorf("either `administrator_password` or `administrator_password_wo` is required when `create_mode` is `Default` and `authentication.password_auth_enabled` is set to `true`")
 			}
 		} else if adminLoginSet || adminPwdSet {
-			return fmt.Errorf("`administrator_login` and `administrator_password` cannot be set during creation when `authentication.password_auth_enabled` is set to `false`")
+			return fmt.Errorf("`administrator_login` and `administrator_password` (or `administrator_password_wo`) cannot be set during creation when `authentication.password_auth_enabled` is set to `false`")
 		}
 
 		if _, ok := d.GetOk("sku_name"); !ok {
@@ -558,6 +587,10 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.AdministratorLoginPassword = utils.String(v.(string))
 	}
 
+	if passwordWriteOnly != nil {
+		parameters.Properties.AdministratorLoginPassword = passwordWriteOnly
+	}
+
 	if createMode != "" {
 		createModeAttr := servers.CreateMode(createMode)
 		parameters.Properties.CreateMode = &createModeAttr
@@ -749,11 +782,19 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 
 	requireUpdateOnLogin := false // it's required to call Create with `createMode` set to `Update` to update login name.
 
+	passwordWriteOnly, err := pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+	if err != nil {
+		return err
+	}
+
 	createMode := d.Get("create_mode").(string)
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
 
 		_, adminLoginSet := d.GetOk("administrator_login")
 		_, adminPwdSet := d.GetOk("administrator_password")
+		if passwordWriteOnly != nil {
+			adminPwdSet = true
+		}
 
 		pwdEnabled := true // it defaults to true
 		if authRaw, authExist := d.GetOk("authentication"); authExist {
@@ -768,7 +809,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 				return fmt.Errorf("`administrator_login` is required when `authentication.password_auth_enabled` is set to `true`")
 			}
 			if !adminPwdSet {
-				return fmt.Errorf("`administrator_password` is required when `authentication.password_auth_enabled` is set to `true`")
+				return fmt.Errorf("either `administrator_password` or `administrator_password_wo` is required when `authentication.password_auth_enabled` is set to `true`")
 			}
 		}
 
@@ -847,6 +888,10 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.AdministratorLoginPassword = utils.String(d.Get("administrator_password").(string))
 	}
 
+	if d.HasChange("administrator_password_wo_version") {
+		parameters.Properties.AdministratorLoginPassword = passwordWriteOnly
+	}
+
 	if d.HasChange("authentication") {
 		parameters.Properties.AuthConfig = expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{}))
 	}
@@ -916,6 +961,11 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	if requireUpdateOnLogin {
+		administratorPassword := utils.String(d.Get("administrator_password").(string))
+		if passwordWriteOnly != nil {
+			administratorPassword = passwordWriteOnly
+		}
+
 		updateMode := servers.CreateModeUpdate
 		loginParameters := servers.Server{
 			Location: location.Normalize(d.Get("location").(string)),
@@ -923,7 +973,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 				CreateMode:                 &updateMode,
 				AuthConfig:                 expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{})),
 				AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
-				AdministratorLoginPassword: utils.String(d.Get("administrator_password").(string)),
+				AdministratorLoginPassword: administratorPassword,
 				Network:                    expandArmServerNetwork(d),
 			},
 		}
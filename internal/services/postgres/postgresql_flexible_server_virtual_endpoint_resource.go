@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/virtualendpoints"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -31,6 +32,7 @@ type PostgresqlFlexibleServerVirtualEndpointModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = PostgresqlFlexibleServerVirtualEndpointResource{}
+var _ sdk.ResourceWithIdentity = PostgresqlFlexibleServerVirtualEndpointResource{}
 
 func (r PostgresqlFlexibleServerVirtualEndpointResource) ModelObject() interface{} {
 	return &PostgresqlFlexibleServerVirtualEndpointModel{}
@@ -44,6 +46,10 @@ func (r PostgresqlFlexibleServerVirtualEndpointResource) IDValidationFunc() plug
 	return virtualendpoints.ValidateVirtualEndpointID
 }
 
+func (r PostgresqlFlexibleServerVirtualEndpointResource) Identity() resourceids.ResourceId {
+	return &virtualendpoints.VirtualEndpointId{}
+}
+
 func (r PostgresqlFlexibleServerVirtualEndpointResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
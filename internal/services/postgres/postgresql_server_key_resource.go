@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePostgreSQLServerKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgreSQLServerKeyCreateUpdate,
 		Read:   resourcePostgreSQLServerKeyRead,
 		Update: resourcePostgreSQLServerKeyCreateUpdate,
@@ -56,7 +56,7 @@ func resourcePostgreSQLServerKey() *pluginsdk.Resource {
 				ValidateFunc: keyVaultValidate.NestedItemId,
 			},
 		},
-	}
+	}, &serverkeys.KeyId{})
 }
 
 func getPostgreSQLServerKeyName(ctx context.Context, keyVaultsClient *client.Client, subscriptionId string, keyVaultKeyURI string) (*string, error) {
@@ -59,7 +59,7 @@ var skuList = []string{
 }
 
 func resourcePostgreSQLServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePostgreSQLServerCreate,
 		Read:   resourcePostgreSQLServerRead,
 		Update: resourcePostgreSQLServerUpdate,
@@ -360,6 +360,10 @@ func resourcePostgreSQLServer() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &servers.ServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &servers.ServerId{})
 }
 
 func resourcePostgreSQLServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
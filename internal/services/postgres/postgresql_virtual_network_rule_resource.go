@@ -23,7 +23,7 @@ import (
 )
 
 func resourcePostgreSQLVirtualNetworkRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePostgreSQLVirtualNetworkRuleCreateUpdate,
 		Read:   resourcePostgreSQLVirtualNetworkRuleRead,
 		Update: resourcePostgreSQLVirtualNetworkRuleCreateUpdate,
@@ -69,7 +69,7 @@ func resourcePostgreSQLVirtualNetworkRule() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &virtualnetworkrules.VirtualNetworkRuleId{})
 }
 
 func resourcePostgreSQLVirtualNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePowerBIEmbedded() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePowerBIEmbeddedCreate,
 		Read:   resourcePowerBIEmbeddedRead,
 		Update: resourcePowerBIEmbeddedUpdate,
@@ -90,6 +90,10 @@ func resourcePowerBIEmbedded() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &capacities.CapacityId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &capacities.CapacityId{})
 }
 
 func resourcePowerBIEmbeddedCreate(d *pluginsdk.ResourceData, meta interface{}) error {
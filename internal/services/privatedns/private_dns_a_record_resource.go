@@ -22,7 +22,7 @@ import (
 )
 
 func resourcePrivateDnsARecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsARecordCreateUpdate,
 		Read:   resourcePrivateDnsARecordRead,
 		Update: resourcePrivateDnsARecordCreateUpdate,
@@ -86,7 +86,7 @@ func resourcePrivateDnsARecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourcePrivateDnsAaaaRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsAaaaRecordCreateUpdate,
 		Read:   resourcePrivateDnsAaaaRecordRead,
 		Update: resourcePrivateDnsAaaaRecordCreateUpdate,
@@ -82,7 +82,7 @@ func resourcePrivateDnsAaaaRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourcePrivateDnsCNameRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsCNameRecordCreateUpdate,
 		Read:   resourcePrivateDnsCNameRecordRead,
 		Update: resourcePrivateDnsCNameRecordCreateUpdate,
@@ -85,7 +85,7 @@ func resourcePrivateDnsCNameRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsCNameRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
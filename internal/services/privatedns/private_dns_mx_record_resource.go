@@ -23,7 +23,7 @@ import (
 )
 
 func resourcePrivateDnsMxRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsMxRecordCreateUpdate,
 		Read:   resourcePrivateDnsMxRecordRead,
 		Update: resourcePrivateDnsMxRecordCreateUpdate,
@@ -102,7 +102,7 @@ func resourcePrivateDnsMxRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
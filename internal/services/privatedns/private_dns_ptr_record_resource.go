@@ -22,7 +22,7 @@ import (
 )
 
 func resourcePrivateDnsPtrRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsPtrRecordCreateUpdate,
 		Read:   resourcePrivateDnsPtrRecordRead,
 		Update: resourcePrivateDnsPtrRecordCreateUpdate,
@@ -86,7 +86,7 @@ func resourcePrivateDnsPtrRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
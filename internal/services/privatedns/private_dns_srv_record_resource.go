@@ -23,7 +23,7 @@ import (
 )
 
 func resourcePrivateDnsSrvRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsSrvRecordCreateUpdate,
 		Read:   resourcePrivateDnsSrvRecordRead,
 		Update: resourcePrivateDnsSrvRecordCreateUpdate,
@@ -112,7 +112,7 @@ func resourcePrivateDnsSrvRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsSrvRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourcePrivateDnsTxtRecord() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsTxtRecordCreateUpdate,
 		Read:   resourcePrivateDnsTxtRecordRead,
 		Update: resourcePrivateDnsTxtRecordCreateUpdate,
@@ -93,7 +93,7 @@ func resourcePrivateDnsTxtRecord() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &recordsets.RecordTypeId{})
 }
 
 func resourcePrivateDnsTxtRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePrivateDnsZone() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsZoneCreateUpdate,
 		Read:   resourcePrivateDnsZoneRead,
 		Update: resourcePrivateDnsZoneCreateUpdate,
@@ -148,7 +148,7 @@ func resourcePrivateDnsZone() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &privatezones.PrivateDnsZoneId{})
 }
 
 func resourcePrivateDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
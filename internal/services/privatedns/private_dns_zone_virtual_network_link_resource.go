@@ -24,7 +24,7 @@ import (
 )
 
 func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate,
 		Read:   resourcePrivateDnsZoneVirtualNetworkLinkRead,
 		Update: resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate,
@@ -77,7 +77,7 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &virtualnetworklinks.VirtualNetworkLinkId{})
 }
 
 func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,6 +30,7 @@ type PrivateDNSResolverDnsForwardingRulesetModel struct {
 type PrivateDNSResolverDnsForwardingRulesetResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsForwardingRulesetResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverDnsForwardingRulesetResource{}
 
 func (r PrivateDNSResolverDnsForwardingRulesetResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_dns_forwarding_ruleset"
@@ -42,6 +44,10 @@ func (r PrivateDNSResolverDnsForwardingRulesetResource) IDValidationFunc() plugi
 	return dnsforwardingrulesets.ValidateDnsForwardingRulesetID
 }
 
+func (r PrivateDNSResolverDnsForwardingRulesetResource) Identity() resourceids.ResourceId {
+	return &dnsforwardingrulesets.DnsForwardingRulesetId{}
+}
+
 func (r PrivateDNSResolverDnsForwardingRulesetResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -37,6 +38,7 @@ type IPConfigurationModel struct {
 type PrivateDNSResolverInboundEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverInboundEndpointResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverInboundEndpointResource{}
 
 func (r PrivateDNSResolverInboundEndpointResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_inbound_endpoint"
@@ -50,6 +52,10 @@ func (r PrivateDNSResolverInboundEndpointResource) IDValidationFunc() pluginsdk.
 	return inboundendpoints.ValidateInboundEndpointID
 }
 
+func (r PrivateDNSResolverInboundEndpointResource) Identity() resourceids.ResourceId {
+	return &inboundendpoints.InboundEndpointId{}
+}
+
 func (r PrivateDNSResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
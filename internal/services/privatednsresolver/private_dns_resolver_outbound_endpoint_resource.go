@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -31,6 +32,7 @@ type PrivateDNSResolverOutboundEndpointModel struct {
 type PrivateDNSResolverOutboundEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverOutboundEndpointResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverOutboundEndpointResource{}
 
 func (r PrivateDNSResolverOutboundEndpointResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_outbound_endpoint"
@@ -44,6 +46,10 @@ func (r PrivateDNSResolverOutboundEndpointResource) IDValidationFunc() pluginsdk
 	return outboundendpoints.ValidateOutboundEndpointID
 }
 
+func (r PrivateDNSResolverOutboundEndpointResource) Identity() resourceids.ResourceId {
+	return &outboundendpoints.OutboundEndpointId{}
+}
+
 func (r PrivateDNSResolverOutboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,6 +30,7 @@ type PrivateDNSResolverDnsResolverModel struct {
 type PrivateDNSResolverDnsResolverResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverDnsResolverResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverDnsResolverResource{}
 
 func (r PrivateDNSResolverDnsResolverResource) ResourceType() string {
 	return "azurerm_private_dns_resolver"
@@ -42,6 +44,10 @@ func (r PrivateDNSResolverDnsResolverResource) IDValidationFunc() pluginsdk.Sche
 	return dnsresolvers.ValidateDnsResolverID
 }
 
+func (r PrivateDNSResolverDnsResolverResource) Identity() resourceids.ResourceId {
+	return &dnsresolvers.DnsResolverId{}
+}
+
 func (r PrivateDNSResolverDnsResolverResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/virtualnetworklinks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -27,6 +28,7 @@ type PrivateDNSResolverVirtualNetworkLinkModel struct {
 type PrivateDNSResolverVirtualNetworkLinkResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverVirtualNetworkLinkResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverVirtualNetworkLinkResource{}
 
 func (r PrivateDNSResolverVirtualNetworkLinkResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_virtual_network_link"
@@ -40,6 +42,10 @@ func (r PrivateDNSResolverVirtualNetworkLinkResource) IDValidationFunc() plugins
 	return virtualnetworklinks.ValidateVirtualNetworkLinkID
 }
 
+func (r PrivateDNSResolverVirtualNetworkLinkResource) Identity() resourceids.ResourceId {
+	return &virtualnetworklinks.VirtualNetworkLinkId{}
+}
+
 func (r PrivateDNSResolverVirtualNetworkLinkResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
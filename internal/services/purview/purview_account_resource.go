@@ -25,7 +25,7 @@ import (
 )
 
 func resourcePurviewAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourcePurviewAccountCreateUpdate,
 		Read:   resourcePurviewAccountRead,
 		Update: resourcePurviewAccountCreateUpdate,
@@ -45,6 +45,10 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 
 		Schema: resourcePurviewSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &account.AccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &account.AccountId{})
 }
 
 func resourcePurviewAccountCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
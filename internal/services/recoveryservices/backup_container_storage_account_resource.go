@@ -24,7 +24,7 @@ import (
 )
 
 func resourceBackupProtectionContainerStorageAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceBackupProtectionContainerStorageAccountCreate,
 		Read:   resourceBackupProtectionContainerStorageAccountRead,
 		Delete: resourceBackupProtectionContainerStorageAccountDelete,
@@ -55,7 +55,7 @@ func resourceBackupProtectionContainerStorageAccount() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 		},
-	}
+	}, &protectioncontainers.ProtectionContainerId{})
 }
 
 func resourceBackupProtectionContainerStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceBackupProtectionPolicyFileShare() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceBackupProtectionPolicyFileShareCreateUpdate,
 		Read:   resourceBackupProtectionPolicyFileShareRead,
 		Update: resourceBackupProtectionPolicyFileShareCreateUpdate,
@@ -79,7 +79,7 @@ func resourceBackupProtectionPolicyFileShare() *pluginsdk.Resource {
 			}
 			return nil
 		},
-	}
+	}, &protectionpolicies.BackupPolicyId{})
 }
 
 func resourceBackupProtectionPolicyFileShareCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -28,7 +28,7 @@ import (
 )
 
 func resourceBackupProtectionPolicyVM() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceBackupProtectionPolicyVMCreate,
 		Read:   resourceBackupProtectionPolicyVMRead,
 		Update: resourceBackupProtectionPolicyVMUpdate,
@@ -100,7 +100,7 @@ func resourceBackupProtectionPolicyVM() *pluginsdk.Resource {
 			}
 			return nil
 		}),
-	}
+	}, &protectionpolicies.BackupPolicyId{})
 }
 
 func resourceBackupProtectionPolicyVMCreate(d *pluginsdk.ResourceData, meta interface{}) error {
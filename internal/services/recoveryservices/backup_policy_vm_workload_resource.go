@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectionpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -88,6 +89,7 @@ type Settings struct {
 type BackupProtectionPolicyVMWorkloadResource struct{}
 
 var _ sdk.ResourceWithUpdate = BackupProtectionPolicyVMWorkloadResource{}
+var _ sdk.ResourceWithIdentity = BackupProtectionPolicyVMWorkloadResource{}
 
 func (r BackupProtectionPolicyVMWorkloadResource) ResourceType() string {
 	return "azurerm_backup_policy_vm_workload"
@@ -101,6 +103,10 @@ func (r BackupProtectionPolicyVMWorkloadResource) IDValidationFunc() pluginsdk.S
 	return protectionpolicies.ValidateBackupPolicyID
 }
 
+func (r BackupProtectionPolicyVMWorkloadResource) Identity() resourceids.ResourceId {
+	return &protectionpolicies.BackupPolicyId{}
+}
+
 func (r BackupProtectionPolicyVMWorkloadResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -32,7 +32,7 @@ import (
 )
 
 func resourceBackupProtectedFileShare() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceBackupProtectedFileShareCreateUpdate,
 		Read:   resourceBackupProtectedFileShareRead,
 		Update: resourceBackupProtectedFileShareCreateUpdate,
@@ -80,7 +80,7 @@ func resourceBackupProtectedFileShare() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceID,
 			},
 		},
-	}
+	}, &parse.ProtectedItemId{})
 }
 
 func resourceBackupProtectedFileShareCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -31,7 +31,7 @@ import (
 )
 
 func resourceRecoveryServicesBackupProtectedVM() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRecoveryServicesBackupProtectedVMCreate,
 		Read:   resourceRecoveryServicesBackupProtectedVMRead,
 		Update: resourceRecoveryServicesBackupProtectedVMUpdate,
@@ -56,7 +56,7 @@ func resourceRecoveryServicesBackupProtectedVM() *pluginsdk.Resource {
 		CustomizeDiff: pluginsdk.ForceNewIfChange("source_vm_id", func(ctx context.Context, old, new, meta interface{}) bool {
 			return new.(string) != "" && old.(string) != new.(string)
 		}),
-	}
+	}, &protecteditems.ProtectedItemId{})
 }
 
 func resourceRecoveryServicesBackupProtectedVMCreate(d *pluginsdk.ResourceData, meta interface{}) error {
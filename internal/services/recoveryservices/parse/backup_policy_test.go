@@ -21,6 +21,23 @@ func TestBackupPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackupPolicyIDSegments(t *testing.T) {
+	expected := NewBackupPolicyID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&BackupPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := BackupPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestBackupPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
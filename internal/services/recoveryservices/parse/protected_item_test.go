@@ -21,6 +21,23 @@ func TestProtectedItemIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProtectedItemIDSegments(t *testing.T) {
+	expected := NewProtectedItemID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "Azure", "container1", "protectedItem1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProtectedItemId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProtectedItemId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProtectedItemID(t *testing.T) {
 	testData := []struct {
 		Input    string
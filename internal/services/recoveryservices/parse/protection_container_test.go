@@ -21,6 +21,23 @@ func TestProtectionContainerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProtectionContainerIDSegments(t *testing.T) {
+	expected := NewProtectionContainerID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "fabric1", "container1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ProtectionContainerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ProtectionContainerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestProtectionContainerID(t *testing.T) {
 	testData := []struct {
 		Input    string
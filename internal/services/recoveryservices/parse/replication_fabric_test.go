@@ -21,6 +21,23 @@ func TestReplicationFabricIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationFabricIDSegments(t *testing.T) {
+	expected := NewReplicationFabricID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "fabric1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ReplicationFabricId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ReplicationFabricId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestReplicationFabricID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -49,6 +49,26 @@ func (id ReplicationNetworkMappingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.ReplicationFabricName, id.ReplicationNetworkName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ReplicationNetworkMapping ID
+func (id ReplicationNetworkMappingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftRecoveryServices", "Microsoft.RecoveryServices", "Microsoft.RecoveryServices"),
+		resourceids.StaticSegment("staticVaults", "vaults", "vaults"),
+		resourceids.UserSpecifiedSegment("vaultName", "vault1"),
+		resourceids.StaticSegment("staticReplicationFabrics", "replicationFabrics", "replicationFabrics"),
+		resourceids.UserSpecifiedSegment("replicationFabricName", "fabric1"),
+		resourceids.StaticSegment("staticReplicationNetworks", "replicationNetworks", "replicationNetworks"),
+		resourceids.UserSpecifiedSegment("replicationNetworkName", "network1"),
+		resourceids.StaticSegment("staticReplicationNetworkMappings", "replicationNetworkMappings", "replicationNetworkMappings"),
+		resourceids.UserSpecifiedSegment("name", "mapping1"),
+	}
+}
+
 // ReplicationNetworkMappingID parses a ReplicationNetworkMapping ID into an ReplicationNetworkMappingId struct
 func ReplicationNetworkMappingID(input string) (*ReplicationNetworkMappingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -88,3 +108,34 @@ func ReplicationNetworkMappingID(input string) (*ReplicationNetworkMappingId, er
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ReplicationNetworkMappingId using the ParseResult provided in 'input'
+func (id *ReplicationNetworkMappingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	if id.ReplicationFabricName, ok = input.Parsed["replicationFabricName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "replicationFabricName", input)
+	}
+
+	if id.ReplicationNetworkName, ok = input.Parsed["replicationNetworkName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "replicationNetworkName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestReplicationNetworkMappingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationNetworkMappingIDSegments(t *testing.T) {
+	expected := NewReplicationNetworkMappingID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "fabric1", "network1", "mapping1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ReplicationNetworkMappingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ReplicationNetworkMappingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestReplicationNetworkMappingID(t *testing.T) {
 	testData := []struct {
 		Input    string
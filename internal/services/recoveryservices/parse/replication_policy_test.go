@@ -21,6 +21,23 @@ func TestReplicationPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationPolicyIDSegments(t *testing.T) {
+	expected := NewReplicationPolicyID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ReplicationPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ReplicationPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestReplicationPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
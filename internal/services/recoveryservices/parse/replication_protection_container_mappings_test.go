@@ -21,6 +21,23 @@ func TestReplicationProtectionContainerMappingsIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationProtectionContainerMappingsIDSegments(t *testing.T) {
+	expected := NewReplicationProtectionContainerMappingsID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "fabric1", "container1", "mapping1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ReplicationProtectionContainerMappingsId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ReplicationProtectionContainerMappingsId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestReplicationProtectionContainerMappingsID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestReplicationProtectionContainerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestReplicationProtectionContainerIDSegments(t *testing.T) {
+	expected := NewReplicationProtectionContainerID("12345678-1234-9876-4563-123456789012", "group1", "vault1", "fabric1", "container1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ReplicationProtectionContainerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ReplicationProtectionContainerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestReplicationProtectionContainerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/resourceguards"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/resourceguardproxy"
@@ -28,6 +29,7 @@ const VaultGuardProxyDeleteRequestName = "default" // this name does not matter,
 type VaultGuardProxyResource struct{}
 
 var _ sdk.Resource = VaultGuardProxyResource{}
+var _ sdk.ResourceWithIdentity = VaultGuardProxyResource{}
 
 type VaultGuardProxyModel struct {
 	Name            string `tfschema:"name,removedInNextMajorVersion"`
@@ -39,6 +41,10 @@ func (r VaultGuardProxyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return resourceguardproxy.ValidateBackupResourceGuardProxyID
 }
 
+func (r VaultGuardProxyResource) Identity() resourceids.ResourceId {
+	return &resourceguardproxy.BackupResourceGuardProxyId{}
+}
+
 func (r VaultGuardProxyResource) ModelObject() interface{} {
 	return &VaultGuardProxyModel{}
 }
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSiteRecoveryFabric() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSiteRecoveryFabricCreate,
 		Read:   resourceSiteRecoveryFabricRead,
 		Delete: resourceSiteRecoveryFabricDelete,
@@ -55,6 +55,10 @@ func resourceSiteRecoveryFabric() *pluginsdk.Resource {
 			"location": commonschema.Location(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ReplicationFabricId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ReplicationFabricId{})
 }
 
 func resourceSiteRecoveryFabricCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotectioncontainermappings"
@@ -34,6 +35,7 @@ type HyperVReplicationPolicyAssociationModel struct {
 type HyperVReplicationPolicyAssociationResource struct{}
 
 var _ sdk.Resource = HyperVReplicationPolicyAssociationResource{}
+var _ sdk.ResourceWithIdentity = HyperVReplicationPolicyAssociationResource{}
 
 func (h HyperVReplicationPolicyAssociationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -76,6 +78,10 @@ func (h HyperVReplicationPolicyAssociationResource) IDValidationFunc() pluginsdk
 	return replicationprotectioncontainermappings.ValidateReplicationProtectionContainerMappingID
 }
 
+func (h HyperVReplicationPolicyAssociationResource) Identity() resourceids.ResourceId {
+	return &replicationprotectioncontainermappings.ReplicationProtectionContainerMappingId{}
+}
+
 func (h HyperVReplicationPolicyAssociationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 60 * time.Minute,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,7 @@ type ReplicationPolicyHyperVModel struct {
 type ReplicationPolicyHyperVResource struct{}
 
 var _ sdk.ResourceWithUpdate = ReplicationPolicyHyperVResource{}
+var _ sdk.ResourceWithIdentity = ReplicationPolicyHyperVResource{}
 
 func (r ReplicationPolicyHyperVResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -230,6 +232,10 @@ func (r ReplicationPolicyHyperVResource) IDValidationFunc() pluginsdk.SchemaVali
 	return replicationpolicies.ValidateReplicationPolicyID
 }
 
+func (r ReplicationPolicyHyperVResource) Identity() resourceids.ResourceId {
+	return &replicationpolicies.ReplicationPolicyId{}
+}
+
 func expandHyperVToAzurePolicyDetail(input *replicationpolicies.Policy) (out *replicationpolicies.HyperVReplicaAzurePolicyDetails, isA2A bool) {
 	if input.Properties == nil {
 		return nil, false
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceSiteRecoveryNetworkMapping() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSiteRecoveryNetworkMappingCreate,
 		Read:   resourceSiteRecoveryNetworkMappingRead,
 		Delete: resourceSiteRecoveryNetworkMappingDelete,
@@ -80,7 +80,7 @@ func resourceSiteRecoveryNetworkMapping() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 		},
-	}
+	}, &replicationnetworkmappings.ReplicationNetworkMappingId{})
 }
 
 func resourceSiteRecoveryNetworkMappingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
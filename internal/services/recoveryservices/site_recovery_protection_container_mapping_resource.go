@@ -25,7 +25,7 @@ import (
 )
 
 func resourceSiteRecoveryProtectionContainerMapping() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSiteRecoveryContainerMappingCreate,
 		Read:   resourceSiteRecoveryContainerMappingRead,
 		Update: resourceSiteRecoveryContainerMappingUpdate,
@@ -121,7 +121,7 @@ func resourceSiteRecoveryProtectionContainerMapping() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.ReplicationProtectionContainerMappingsId{})
 }
 
 func resourceSiteRecoveryContainerMappingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
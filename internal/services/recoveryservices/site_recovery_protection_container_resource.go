@@ -19,7 +19,7 @@ import (
 )
 
 func resourceSiteRecoveryProtectionContainer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSiteRecoveryProtectionContainerCreate,
 		Read:   resourceSiteRecoveryProtectionContainerRead,
 		Delete: resourceSiteRecoveryProtectionContainerDelete,
@@ -56,7 +56,7 @@ func resourceSiteRecoveryProtectionContainer() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &replicationprotectioncontainers.ReplicationProtectionContainerId{})
 }
 
 func resourceSiteRecoveryProtectionContainerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
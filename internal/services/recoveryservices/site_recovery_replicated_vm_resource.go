@@ -41,7 +41,7 @@ import (
 )
 
 func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSiteRecoveryReplicatedItemCreate,
 		Read:   resourceSiteRecoveryReplicatedItemRead,
 		Update: resourceSiteRecoveryReplicatedItemUpdate,
@@ -296,7 +296,7 @@ func resourceSiteRecoveryReplicatedVM() *pluginsdk.Resource {
 				Elem:       networkInterfaceResource(),
 			},
 		},
-	}
+	}, &parse.ReplicationProtectedItemId{})
 }
 
 func networkInterfaceResource() *pluginsdk.Resource {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSiteRecoveryReplicationPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSiteRecoveryReplicationPolicyCreate,
 		Read:   resourceSiteRecoveryReplicationPolicyRead,
 		Update: resourceSiteRecoveryReplicationPolicyUpdate,
@@ -67,7 +67,7 @@ func resourceSiteRecoveryReplicationPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 365*24*60),
 			},
 		},
-	}
+	}, &parse.ReplicationPolicyId{})
 }
 
 func resourceSiteRecoveryReplicationPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationrecoveryplans"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -75,6 +76,7 @@ type ReplicationRecoveryPlanA2ASpecificInputModel struct {
 type SiteRecoveryReplicationRecoveryPlanResource struct{}
 
 var _ sdk.ResourceWithUpdate = SiteRecoveryReplicationRecoveryPlanResource{}
+var _ sdk.ResourceWithIdentity = SiteRecoveryReplicationRecoveryPlanResource{}
 
 func (r SiteRecoveryReplicationRecoveryPlanResource) ResourceType() string {
 	return "azurerm_site_recovery_replication_recovery_plan"
@@ -88,6 +90,10 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) IDValidationFunc() pluginsd
 	return replicationrecoveryplans.ValidateReplicationRecoveryPlanID
 }
 
+func (r SiteRecoveryReplicationRecoveryPlanResource) Identity() resourceids.ResourceId {
+	return &replicationrecoveryplans.ReplicationRecoveryPlanId{}
+}
+
 func (r SiteRecoveryReplicationRecoveryPlanResource) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
 		"name": {
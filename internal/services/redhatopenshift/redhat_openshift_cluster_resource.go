@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redhatopenshift/2023-09-04/openshiftclusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -25,6 +26,7 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = RedHatOpenShiftCluster{}
+var _ sdk.ResourceWithIdentity = RedHatOpenShiftCluster{}
 
 type RedHatOpenShiftCluster struct{}
 
@@ -377,6 +379,10 @@ func (r RedHatOpenShiftCluster) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return openshiftclusters.ValidateProviderOpenShiftClusterID
 }
 
+func (r RedHatOpenShiftCluster) Identity() resourceids.ResourceId {
+	return &openshiftclusters.ProviderOpenShiftClusterId{}
+}
+
 func (r RedHatOpenShiftCluster) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 90 * time.Minute,
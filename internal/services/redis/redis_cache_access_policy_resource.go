@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-03-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,7 @@ import (
 type RedisCacheAccessPolicyResource struct{}
 
 var _ sdk.ResourceWithUpdate = RedisCacheAccessPolicyResource{}
+var _ sdk.ResourceWithIdentity = RedisCacheAccessPolicyResource{}
 
 type RedisCacheAccessPolicyResourceModel struct {
 	Name         string `tfschema:"name"`
@@ -61,6 +63,10 @@ func (r RedisCacheAccessPolicyResource) IDValidationFunc() pluginsdk.SchemaValid
 	return redis.ValidateAccessPolicyID
 }
 
+func (r RedisCacheAccessPolicyResource) Identity() resourceids.ResourceId {
+	return &redis.AccessPolicyId{}
+}
+
 func (r RedisCacheAccessPolicyResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
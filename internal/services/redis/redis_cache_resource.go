@@ -454,7 +454,9 @@ func resourceRedisCache() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &redis.RediId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &redis.RediId{})
 }
 
 func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceRedisFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRedisFirewallRuleCreateUpdate,
 		Read:   resourceRedisFirewallRuleRead,
 		Update: resourceRedisFirewallRuleCreateUpdate,
@@ -77,7 +77,7 @@ func resourceRedisFirewallRule() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &firewallrules.FirewallRuleId{})
 }
 
 func resourceRedisFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceRedisLinkedServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRedisLinkedServerCreate,
 		Read:   resourceRedisLinkedServerRead,
 		Delete: resourceRedisLinkedServerDelete,
@@ -82,7 +82,7 @@ func resourceRedisLinkedServer() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &redis.LinkedServerId{})
 }
 
 func resourceRedisLinkedServerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
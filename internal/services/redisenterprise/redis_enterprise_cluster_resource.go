@@ -28,7 +28,7 @@ import (
 )
 
 func resourceRedisEnterpriseCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceRedisEnterpriseClusterCreate,
 		Read:   resourceRedisEnterpriseClusterRead,
 		Update: resourceRedisEnterpriseClusterUpdate,
@@ -86,6 +86,10 @@ func resourceRedisEnterpriseCluster() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &redisenterprise.RedisEnterpriseId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &redisenterprise.RedisEnterpriseId{})
 }
 
 func resourceRedisEnterpriseClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRedisEnterpriseDatabaseCreate,
 		Read:   resourceRedisEnterpriseDatabaseRead,
 		Update: resourceRedisEnterpriseDatabaseUpdate,
@@ -46,7 +46,7 @@ func resourceRedisEnterpriseDatabase() *pluginsdk.Resource {
 		// Since update is not currently supported all attribute have to be marked as FORCE NEW
 		// until support for Update comes online in the near future
 		Schema: redisEnterpriseDatabaseSchema(),
-	}
+	}, &redisenterprise.DatabaseId{})
 }
 
 func redisEnterpriseDatabaseSchema() map[string]*pluginsdk.Schema {
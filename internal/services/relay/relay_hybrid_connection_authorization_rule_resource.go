@@ -19,7 +19,7 @@ import (
 )
 
 func resourceRelayHybridConnectionAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRelayHybridConnectionAuthorizationRuleCreateUpdate,
 		Read:   resourceRelayHybridConnectionAuthorizationRuleRead,
 		Update: resourceRelayHybridConnectionAuthorizationRuleCreateUpdate,
@@ -61,7 +61,7 @@ func resourceRelayHybridConnectionAuthorizationRule() *pluginsdk.Resource {
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
-	}
+	}, &hybridconnections.HybridConnectionAuthorizationRuleId{})
 }
 
 func resourceRelayHybridConnectionAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
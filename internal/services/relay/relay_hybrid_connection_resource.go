@@ -20,7 +20,7 @@ import (
 )
 
 func resourceArmRelayHybridConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmRelayHybridConnectionCreateUpdate,
 		Read:   resourceArmRelayHybridConnectionRead,
 		Update: resourceArmRelayHybridConnectionCreateUpdate,
@@ -66,7 +66,7 @@ func resourceArmRelayHybridConnection() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &hybridconnections.HybridConnectionId{})
 }
 
 func resourceArmRelayHybridConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
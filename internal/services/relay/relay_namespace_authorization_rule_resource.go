@@ -19,7 +19,7 @@ import (
 )
 
 func resourceRelayNamespaceAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceRelayNamespaceAuthorizationRuleCreateUpdate,
 		Read:   resourceRelayNamespaceAuthorizationRuleRead,
 		Update: resourceRelayNamespaceAuthorizationRuleCreateUpdate,
@@ -55,7 +55,7 @@ func resourceRelayNamespaceAuthorizationRule() *pluginsdk.Resource {
 		}),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
-	}
+	}, &namespaces.AuthorizationRuleId{})
 }
 
 func resourceRelayNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
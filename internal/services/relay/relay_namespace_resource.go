@@ -23,7 +23,7 @@ import (
 )
 
 func resourceRelayNamespace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceRelayNamespaceCreateUpdate,
 		Read:   resourceRelayNamespaceRead,
 		Update: resourceRelayNamespaceCreateUpdate,
@@ -92,6 +92,10 @@ func resourceRelayNamespace() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &namespaces.NamespaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &namespaces.NamespaceId{})
 }
 
 func resourceRelayNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+const (
+	resourceGroupsResourceType = "Microsoft.Resources/resourceGroups"
+	subscriptionsResourceType  = "Microsoft.Resources/subscriptions"
+)
+
+// armResourceReadOnlyProperties are the top-level properties returned by Resource Manager which can't be set in the
+// body of a resource, and so are excluded when the body is populated from Azure (for example when importing)
+var armResourceReadOnlyProperties = []string{
+	"etag",
+	"id",
+	"name",
+	"systemData",
+	"type",
+}
+
+// buildArmResourceId returns the Resource ID for the resource named `name` of the type `resourceType`, within the
+// resource (or scope) `parentId`
+func buildArmResourceId(parentId, resourceType, name string) (string, error) {
+	parentId = strings.TrimSuffix(parentId, "/")
+	segments := strings.Split(resourceType, "/")
+
+	if len(segments) == 2 {
+		if strings.EqualFold(resourceType, resourceGroupsResourceType) {
+			if !strings.EqualFold(armResourceTypeOf(parentId), subscriptionsResourceType) {
+				return "", fmt.Errorf("a Resource Group must be within a Subscription, but `parent_id` was %q", parentId)
+			}
+			return fmt.Sprintf("%s/resourceGroups/%s", parentId, name), nil
+		}
+
+		// top-level and extension resources are nested within the scope using the Resource Provider
+		return fmt.Sprintf("%s/providers/%s/%s", parentId, resourceType, name), nil
+	}
+
+	// child resources are nested directly within the parent resource, which must be of the parent type
+	parentType := strings.Join(segments[:len(segments)-1], "/")
+	if !strings.EqualFold(armResourceTypeOf(parentId), parentType) {
+		return "", fmt.Errorf("a %q must be within a %q, but `parent_id` was %q", resourceType, parentType, parentId)
+	}
+	return fmt.Sprintf("%s/%s/%s", parentId, segments[len(segments)-1], name), nil
+}
+
+// parseArmResourceId splits the Resource ID `id` into the ID of the resource (or scope) it's within, the type of the
+// resource and its name - the inverse of buildArmResourceId
+func parseArmResourceId(id string) (parentId, resourceType, name string, err error) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	providersIndex := lastProvidersSegment(segments)
+
+	if providersIndex == -1 {
+		if len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups") {
+			return "/" + strings.Join(segments[:2], "/"), resourceGroupsResourceType, segments[3], nil
+		}
+		return "", "", "", fmt.Errorf("expected %q to be the ID of a resource within a Resource Provider or a Resource Group", id)
+	}
+
+	typeSegments := segments[providersIndex+1:]
+	if len(typeSegments) < 3 || len(typeSegments)%2 == 0 {
+		return "", "", "", fmt.Errorf("expected %q to be the ID of a resource, in the format `{scope}/providers/{Resource Provider}/{type}/{name}`", id)
+	}
+
+	// the parent of a child resource is the resource it's nested within, otherwise it's the scope before `providers`
+	parentSegments := segments[:providersIndex]
+	if len(typeSegments) > 3 {
+		parentSegments = segments[:len(segments)-2]
+	}
+
+	types := []string{typeSegments[0]}
+	for i := 1; i < len(typeSegments); i += 2 {
+		types = append(types, typeSegments[i])
+	}
+
+	return "/" + strings.Join(parentSegments, "/"), strings.Join(types, "/"), segments[len(segments)-1], nil
+}
+
+// armResourceTypeOf returns the type of the resource (or scope) `id` - for example `Microsoft.Storage/storageAccounts`
+// - or an empty string for the Tenant
+func armResourceTypeOf(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	providersIndex := lastProvidersSegment(segments)
+
+	if providersIndex == -1 {
+		switch {
+		case len(segments) == 2 && strings.EqualFold(segments[0], "subscriptions"):
+			return subscriptionsResourceType
+		case len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups"):
+			return resourceGroupsResourceType
+		}
+		return ""
+	}
+
+	types := []string{segments[providersIndex+1]}
+	for i := providersIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// lastProvidersSegment returns the index of the last `providers` segment which is followed by a Resource Provider,
+// or -1 when the ID doesn't contain one - since the Resource Provider and each type is followed by a name, only the
+// segments in the position of a type are checked
+func lastProvidersSegment(segments []string) int {
+	index := -1
+	for i := 0; i+1 < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") {
+			index = i
+		}
+	}
+	return index
+}
+
+// armResourceBodyFromRemote returns the values of the properties defined in the body `config` from the resource
+// `remote` returned by Azure, allowing changes made outside of Terraform to be detected without a schema. Properties
+// which aren't returned by Azure (such as secrets) retain the value from `config`, as does the `location` when it only
+// differs in format from the value returned by Azure (e.g. `West Europe` and `westeurope`).
+func armResourceBodyFromRemote(config, remote interface{}) interface{} {
+	switch configValue := config.(type) {
+	case map[string]interface{}:
+		remoteValue, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+
+		out := make(map[string]interface{}, len(configValue))
+		for key, value := range configValue {
+			v, ok := lookupArmResourceProperty(remoteValue, key)
+			if !ok || (strings.EqualFold(key, "location") && locationsAreEquivalent(value, v)) {
+				out[key] = value
+				continue
+			}
+			out[key] = armResourceBodyFromRemote(value, v)
+		}
+		return out
+
+	case []interface{}:
+		remoteValue, ok := remote.([]interface{})
+		if !ok || len(remoteValue) != len(configValue) {
+			return remote
+		}
+
+		out := make([]interface{}, len(configValue))
+		for i := range configValue {
+			out[i] = armResourceBodyFromRemote(configValue[i], remoteValue[i])
+		}
+		return out
+	}
+
+	return remote
+}
+
+// locationsAreEquivalent returns whether both `config` and `remote` are the same location once normalized
+func locationsAreEquivalent(config, remote interface{}) bool {
+	configValue, ok := config.(string)
+	if !ok {
+		return false
+	}
+	remoteValue, ok := remote.(string)
+	if !ok {
+		return false
+	}
+	return location.Normalize(configValue) == location.Normalize(remoteValue)
+}
+
+// lookupArmResourceProperty returns the property `key` from `input`, falling back to a case-insensitive match since
+// some Resource Providers return properties using a different casing
+func lookupArmResourceProperty(input map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := input[key]; ok {
+		return v, true
+	}
+	for k, v := range input {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// armResourceBodyFromImport returns the body for a resource which has been imported, which is every property returned
+// by Azure other than those which are read-only
+func armResourceBodyFromImport(remote map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(remote))
+	for k, v := range remote {
+		out[k] = v
+	}
+	for _, property := range armResourceReadOnlyProperties {
+		delete(out, property)
+	}
+	if properties, ok := out["properties"].(map[string]interface{}); ok {
+		delete(properties, "provisioningState")
+	}
+	return out
+}
+
+// ignoreArmResourceBodyChanges replaces the values at each of the `paths` within `body` with those from `previous`,
+// so that any changes made to these properties outside of Terraform aren't detected
+func ignoreArmResourceBodyChanges(body, previous map[string]interface{}, paths []string) {
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+		if v, ok := getArmResourcePath(previous, segments); ok {
+			setArmResourcePath(body, segments, v)
+			continue
+		}
+		deleteArmResourcePath(body, segments)
+	}
+}
+
+// exportArmResourceValues returns the JSON containing the values at each of the `paths` within the resource `remote`,
+// with `*` exporting the entire resource - or an empty string when no paths are specified
+func exportArmResourceValues(remote map[string]interface{}, paths []string) (string, error) {
+	if len(paths) == 0 {
+		return "", nil
+	}
+
+	out := make(map[string]interface{})
+	for _, path := range paths {
+		if path == "*" {
+			out = remote
+			break
+		}
+
+		segments := strings.Split(path, ".")
+		if v, ok := getArmResourcePath(remote, segments); ok {
+			setArmResourcePath(out, segments, v)
+		}
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("marshaling the exported values: %+v", err)
+	}
+	return string(b), nil
+}
+
+func getArmResourcePath(input map[string]interface{}, segments []string) (interface{}, bool) {
+	var current interface{} = input
+	for _, segment := range segments {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = lookupArmResourceProperty(object, segment); !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func setArmResourcePath(input map[string]interface{}, segments []string, value interface{}) {
+	current := input
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			current[segment] = next
+		}
+		current = next
+	}
+	current[segments[len(segments)-1]] = value
+}
+
+func deleteArmResourcePath(input map[string]interface{}, segments []string) {
+	current := input
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]interface{})
+		if !ok {
+			return
+		}
+		current = next
+	}
+	delete(current, segments[len(segments)-1])
+}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	_ sdk.ResourceWithUpdate         = ArmResourceResource{}
+	_ sdk.ResourceWithCustomImporter = ArmResourceResource{}
+)
+
+// ArmResourceResource manages any resource within Resource Manager using the specified API Version and a JSON body,
+// which allows resource types which aren't (yet) supported by a typed resource to be managed. Since there's no
+// schema, only the properties defined in the `body` are checked for changes made outside of Terraform.
+type ArmResourceResource struct{}
+
+type ArmResourceResourceModel struct {
+	Type                 string   `tfschema:"type"`
+	ApiVersion           string   `tfschema:"api_version"`
+	ParentId             string   `tfschema:"parent_id"`
+	Name                 string   `tfschema:"name"`
+	Body                 string   `tfschema:"body"`
+	IgnoreBodyChanges    []string `tfschema:"ignore_body_changes"`
+	ResponseExportValues []string `tfschema:"response_export_values"`
+	Output               string   `tfschema:"output"`
+}
+
+func (r ArmResourceResource) ResourceType() string {
+	return "azurerm_arm_resource"
+}
+
+func (r ArmResourceResource) ModelObject() interface{} {
+	return &ArmResourceResourceModel{}
+}
+
+func (r ArmResourceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return armResourceImportId
+}
+
+func (r ArmResourceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ResourceManagerType,
+		},
+
+		"api_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceManagerApiVersion,
+		},
+
+		"parent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ResourceManagerID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/?#]+$`), "the name must not contain a `/`, `?` or `#`"),
+		},
+
+		"body": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     armResourceBody,
+			StateFunc:        utils.NormalizeJson,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"ignore_body_changes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: armResourcePath,
+			},
+		},
+
+		"response_export_values": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validation.StringInSlice([]string{"*"}, false),
+					armResourcePath,
+				),
+			},
+		},
+	}
+}
+
+func (r ArmResourceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArmResourceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			var config ArmResourceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := buildArmResourceId(config.ParentId, config.Type, config.Name)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id, config.ApiVersion)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %q (API Version %q): %+v", id, config.ApiVersion, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, config.ApiVersion, json.RawMessage(config.Body)); err != nil {
+				return fmt.Errorf("creating %q (API Version %q): %+v", id, config.ApiVersion, err)
+			}
+
+			metadata.ResourceData.SetId(id)
+			return nil
+		},
+	}
+}
+
+func (r ArmResourceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+			id := metadata.ResourceData.Id()
+
+			var state ArmResourceResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, id, state.ApiVersion)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(armResourceId(id))
+				}
+				return fmt.Errorf("retrieving %q (API Version %q): %+v", id, state.ApiVersion, err)
+			}
+
+			remote := make(map[string]interface{})
+			if resp.Body != nil {
+				if err := json.Unmarshal(resp.Body, &remote); err != nil {
+					return fmt.Errorf("parsing the response for %q: %+v", id, err)
+				}
+			}
+
+			var body map[string]interface{}
+			if state.Body == "" {
+				// when the resource has been imported the properties which were sent aren't known
+				body = armResourceBodyFromImport(remote)
+			} else {
+				previous := make(map[string]interface{})
+				if err := json.Unmarshal([]byte(state.Body), &previous); err != nil {
+					return fmt.Errorf("parsing the `body`: %+v", err)
+				}
+				body = armResourceBodyFromRemote(previous, remote).(map[string]interface{})
+				ignoreArmResourceBodyChanges(body, previous, state.IgnoreBodyChanges)
+			}
+
+			b, err := json.Marshal(body)
+			if err != nil {
+				return fmt.Errorf("marshaling the `body`: %+v", err)
+			}
+			state.Body = string(b)
+
+			if state.Output, err = exportArmResourceValues(remote, state.ResponseExportValues); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArmResourceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+			id := metadata.ResourceData.Id()
+
+			var config ArmResourceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the `ignore_body_changes` and `response_export_values` only affect what's read back from Azure
+			if metadata.ResourceData.HasChanges("api_version", "body") {
+				if _, err := client.CreateOrUpdate(ctx, id, config.ApiVersion, json.RawMessage(config.Body)); err != nil {
+					return fmt.Errorf("updating %q (API Version %q): %+v", id, config.ApiVersion, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArmResourceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+			id := metadata.ResourceData.Id()
+
+			var state ArmResourceResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.Delete(ctx, id, state.ApiVersion); err != nil {
+				return fmt.Errorf("deleting %q (API Version %q): %+v", id, state.ApiVersion, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// CustomImporter imports the resource using the ID `{Resource ID}?api-version={API Version}`, since the API Version
+// used to retrieve the resource isn't part of the Resource ID
+func (r ArmResourceResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, apiVersion, _ := strings.Cut(metadata.ResourceData.Id(), "?api-version=")
+
+		parentId, resourceType, name, err := parseArmResourceId(id)
+		if err != nil {
+			return err
+		}
+
+		metadata.ResourceData.SetId(id)
+		state := ArmResourceResourceModel{
+			Type:       resourceType,
+			ApiVersion: apiVersion,
+			ParentId:   parentId,
+			Name:       name,
+		}
+		return metadata.Encode(&state)
+	}
+}
+
+// armResourceId allows the Resource ID of a generic resource to be used where a `resourceids.Id` is expected
+type armResourceId string
+
+func (id armResourceId) ID() string {
+	return string(id)
+}
+
+func (id armResourceId) String() string {
+	return fmt.Sprintf("Resource %q", string(id))
+}
+
+// armResourceImportId validates the ID used to import the resource, which is the Resource ID suffixed with the API
+// Version - for example `{Resource ID}?api-version=2023-01-01`
+func armResourceImportId(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	id, apiVersion, ok := strings.Cut(input, "?api-version=")
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q must be in the format `{Resource ID}?api-version={API Version}`, got %q", k, input))
+		return
+	}
+
+	warnings, errors = validate.ResourceManagerID(id, k)
+	w, e := validate.ResourceManagerApiVersion(apiVersion, k)
+	return append(warnings, w...), append(errors, e...)
+}
+
+// armResourceBody validates that the body is a JSON object
+func armResourceBody(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &body); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %+v", k, err))
+	}
+	return
+}
+
+// armResourcePath validates a path to a property within the body of the resource, for example `properties.sku`
+func armResourcePath(v interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[^.\s]+(\.[^.\s]+)*$`), "the path must be the names of the properties separated by a `.`, for example `properties.sku`")(v, k)
+}
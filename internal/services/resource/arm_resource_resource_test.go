@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArmResourceResource struct{}
+
+func TestAccArmResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arm_resource", "test")
+	r := ArmResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccArmResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arm_resource", "test")
+	r := ArmResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArmResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arm_resource", "test")
+	r := ArmResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccArmResource_childResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arm_resource", "child")
+	r := ArmResourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.childResource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestArmResource_fakeServer(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakearm.NewServer(fakearm.Options{
+		PollsUntilComplete: 1,
+	})
+	defer server.Close()
+
+	resourceGroupId := "/subscriptions/" + fakearm.SubscriptionId + "/resourceGroups/example-resources"
+	server.PutResource(resourceGroupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	client, err := server.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	harness := fakearm.NewResourceHarness(t, client, resource.ArmResourceResource{})
+
+	config := map[string]interface{}{
+		"type":                   "Microsoft.Example/widgets",
+		"api_version":            "2024-01-01",
+		"parent_id":              resourceGroupId,
+		"name":                   "example",
+		"body":                   `{"location": "westeurope", "properties": {"size": "Small"}, "tags": {"environment": "test"}}`,
+		"ignore_body_changes":    []interface{}{"tags"},
+		"response_export_values": []interface{}{"properties.provisioningState"},
+	}
+	d, err := harness.Create(ctx, config)
+	if err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	widgetId := resourceGroupId + "/providers/Microsoft.Example/widgets/example"
+	if d.Id() != widgetId {
+		t.Fatalf("expected the ID to be %q but got %q", widgetId, d.Id())
+	}
+	if v := d.Get("output").(string); v != `{"properties":{"provisioningState":"Succeeded"}}` {
+		t.Fatalf("expected the `output` to contain the exported values but got %q", v)
+	}
+
+	if _, err := harness.Create(ctx, config); err == nil {
+		t.Fatal("expected an error when the resource already exists")
+	}
+
+	// changes made outside of Terraform are only detected for the properties within the `body` which aren't ignored,
+	// and the `location` is compared once normalized
+	server.PutResource(widgetId, map[string]interface{}{
+		"location": "West Europe",
+		"properties": map[string]interface{}{
+			"size":      "Large",
+			"createdBy": "someone-else",
+		},
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	})
+	if err := harness.Refresh(ctx, d); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	if v := d.Get("body").(string); v != `{"location":"westeurope","properties":{"size":"Large"},"tags":{"environment":"test"}}` {
+		t.Fatalf("expected the `body` to contain the changed `size` but got %q", v)
+	}
+
+	config["body"] = `{"location": "westeurope", "properties": {"size": "Medium"}}`
+	if _, err := harness.Update(ctx, widgetId, config); err != nil {
+		t.Fatalf("updating: %+v", err)
+	}
+	widget, _ := server.GetResource(widgetId)
+	if size := widget["properties"].(map[string]interface{})["size"]; size != "Medium" {
+		t.Fatalf("expected the `size` to be updated to `Medium` but got %v", size)
+	}
+
+	d, err = harness.Create(ctx, map[string]interface{}{
+		"type":        "Microsoft.Example/widgets/gadgets",
+		"api_version": "2024-01-01",
+		"parent_id":   widgetId,
+		"name":        "first",
+		"body":        `{"properties": {}}`,
+	})
+	if err != nil {
+		t.Fatalf("creating the child resource: %+v", err)
+	}
+	if d.Id() != widgetId+"/gadgets/first" {
+		t.Fatalf("expected the ID to be %q but got %q", widgetId+"/gadgets/first", d.Id())
+	}
+
+	if _, err := harness.Create(ctx, map[string]interface{}{
+		"type":        "Microsoft.Example/widgets/gadgets",
+		"api_version": "2024-01-01",
+		"parent_id":   resourceGroupId,
+		"name":        "second",
+		"body":        `{"properties": {}}`,
+	}); err == nil {
+		t.Fatal("expected an error when the child resource isn't within the parent type")
+	}
+
+	if err := harness.Destroy(ctx, d); err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if _, ok := server.GetResource(widgetId + "/gadgets/first"); ok {
+		t.Fatal("expected the child resource to have been deleted")
+	}
+	if _, ok := server.GetResource(widgetId); !ok {
+		t.Fatal("expected the parent resource to still exist")
+	}
+}
+
+func (r ArmResourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	resp, err := clients.Resource.GenericResourcesClient.Get(ctx, state.ID, state.Attributes["api_version"])
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %+v", state.ID, err)
+	}
+
+	var body map[string]interface{}
+	if err := json.Unmarshal(resp.Body, &body); err != nil {
+		return nil, fmt.Errorf("parsing the response for %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(body["id"] != nil), nil
+}
+
+// importStep imports the resource using the API Version, where the `body` isn't checked since every property
+// returned from Azure is imported
+func (ArmResourceResource) importStep(data acceptance.TestData) acceptance.TestStep {
+	step := data.ImportStep("body")
+	step.ImportStateId = fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.ManagedIdentity/userAssignedIdentities/acctestuai-%d?api-version=2023-01-31", os.Getenv("ARM_SUBSCRIPTION_ID"), data.RandomInteger, data.RandomInteger)
+	return step
+}
+
+func (r ArmResourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arm_resource" "test" {
+  type        = "Microsoft.ManagedIdentity/userAssignedIdentities"
+  api_version = "2023-01-31"
+  parent_id   = azurerm_resource_group.test.id
+  name        = "acctestuai-%d"
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ArmResourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arm_resource" "import" {
+  type        = azurerm_arm_resource.test.type
+  api_version = azurerm_arm_resource.test.api_version
+  parent_id   = azurerm_arm_resource.test.parent_id
+  name        = azurerm_arm_resource.test.name
+  body        = azurerm_arm_resource.test.body
+}
+`, r.basic(data))
+}
+
+func (r ArmResourceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arm_resource" "test" {
+  type        = "Microsoft.ManagedIdentity/userAssignedIdentities"
+  api_version = "2023-01-31"
+  parent_id   = azurerm_resource_group.test.id
+  name        = "acctestuai-%d"
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+    tags = {
+      environment = "Production"
+    }
+  })
+
+  ignore_body_changes    = ["tags.updatedBy"]
+  response_export_values = ["properties.principalId", "properties.clientId"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ArmResourceResource) childResource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arm_resource" "test" {
+  type        = "Microsoft.ManagedIdentity/userAssignedIdentities"
+  api_version = "2023-01-31"
+  parent_id   = azurerm_resource_group.test.id
+  name        = "acctestuai-%[2]d"
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+  })
+}
+
+resource "azurerm_arm_resource" "child" {
+  type        = "Microsoft.ManagedIdentity/userAssignedIdentities/federatedIdentityCredentials"
+  api_version = "2023-01-31"
+  parent_id   = azurerm_arm_resource.test.id
+  name        = "acctestfic-%[2]d"
+  body = jsonencode({
+    properties = {
+      audiences = ["api://AzureADTokenExchange"]
+      issuer    = "https://token.actions.githubusercontent.com"
+      subject   = "repo:example/example:ref:refs/heads/main"
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ArmResourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -16,12 +16,14 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
 	DeploymentScriptsClient             *deploymentscripts.DeploymentScriptsClient
 	FeaturesClient                      *features.FeaturesClient
+	GenericResourcesClient              *GenericResourcesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
@@ -53,6 +55,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(featuresClient.Client, o.Authorizers.ResourceManager)
 
+	genericResourcesClient, err := resourcemanager.NewResourceManagerClient(o.Environment.ResourceManager, "resources", "")
+	if err != nil {
+		return nil, fmt.Errorf("building GenericResources client: %+v", err)
+	}
+	o.Configure(genericResourcesClient, o.Authorizers.ResourceManager)
+
 	resourceGroupsClient, err := resourcegroups.NewResourceGroupsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Features client: %+v", err)
@@ -108,6 +116,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DeploymentsClient:                   &deploymentsClient,
 		DeploymentScriptsClient:             deploymentScriptsClient,
 		FeaturesClient:                      featuresClient,
+		GenericResourcesClient:              &GenericResourcesClient{Client: genericResourcesClient},
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// GenericResourcesClient sends requests to Resource Manager for any Resource ID using the specified API Version,
+// which allows resource types (and actions) which aren't available within `hashicorp/go-azure-sdk` to be used
+type GenericResourcesClient struct {
+	Client *resourcemanager.Client
+}
+
+type GenericResourceResponse struct {
+	HttpResponse *http.Response
+
+	// Body is the (JSON) body of the final response, which is nil when no body was returned
+	Body json.RawMessage
+}
+
+type apiVersionOptions struct {
+	apiVersion string
+}
+
+func (o apiVersionOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o apiVersionOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o apiVersionOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	out.Append("api-version", o.apiVersion)
+	return &out
+}
+
+// Action performs the POST action `action` on the resource with the ID `resourceId` (for example `listKeys`), waiting
+// for the action to complete when it's a long-running operation
+func (c GenericResourcesClient) Action(ctx context.Context, resourceId string, action string, apiVersion string, input interface{}) (result GenericResourceResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: apiVersionOptions{apiVersion: apiVersion},
+		Path:          fmt.Sprintf("%s/%s", strings.TrimSuffix(resourceId, "/"), action),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if input != nil {
+		if err = req.Marshal(input); err != nil {
+			err = fmt.Errorf("marshaling request: %+v", err)
+			return
+		}
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if resp, err = c.waitForLongRunningOperation(ctx, resp); err != nil {
+		return
+	}
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+
+	result.Body, err = responseBody(resp)
+	return
+}
+
+// Get retrieves the resource with the ID `resourceId` using the API Version `apiVersion`
+func (c GenericResourcesClient) Get(ctx context.Context, resourceId string, apiVersion string) (result GenericResourceResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: apiVersionOptions{apiVersion: apiVersion},
+		Path:          resourceId,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Body, err = responseBody(resp)
+	return
+}
+
+// CreateOrUpdate creates (or replaces) the resource with the ID `resourceId` using the API Version `apiVersion`,
+// waiting for the resource to be provisioned
+func (c GenericResourcesClient) CreateOrUpdate(ctx context.Context, resourceId string, apiVersion string, input interface{}) (result GenericResourceResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: apiVersionOptions{apiVersion: apiVersion},
+		Path:          resourceId,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		err = fmt.Errorf("marshaling request: %+v", err)
+		return
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	// unlike an action, the resource may be provisioned asynchronously when a `200 OK` is returned - in which case
+	// the `provisioningState` of the resource is polled
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		err = fmt.Errorf("building poller: %+v", err)
+		return
+	}
+	if err = poller.PollUntilDone(ctx); err != nil {
+		err = fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+		return
+	}
+
+	return
+}
+
+// Delete deletes the resource with the ID `resourceId` using the API Version `apiVersion`, waiting for the
+// resource to be deleted
+func (c GenericResourcesClient) Delete(ctx context.Context, resourceId string, apiVersion string) (result GenericResourceResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: apiVersionOptions{apiVersion: apiVersion},
+		Path:          resourceId,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		err = fmt.Errorf("building poller: %+v", err)
+		return
+	}
+	if err = poller.PollUntilDone(ctx); err != nil {
+		err = fmt.Errorf("polling after Delete: %+v", err)
+		return
+	}
+
+	return
+}
+
+// waitForLongRunningOperation polls until the long-running operation started by the request completes, returning
+// the final response - requests which completed synchronously (or which return no polling URI) are returned as-is
+func (c GenericResourcesClient) waitForLongRunningOperation(ctx context.Context, resp *client.Response) (*client.Response, error) {
+	if resp == nil || resp.Response == nil {
+		return resp, nil
+	}
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusCreated {
+		return resp, nil
+	}
+	asyncOperation := resp.Header.Get("Azure-AsyncOperation")
+	location := resp.Header.Get("Location")
+	if asyncOperation == "" && location == "" {
+		return resp, nil
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return nil, fmt.Errorf("building poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return nil, fmt.Errorf("polling after %s: %+v", resp.Request.Method, err)
+	}
+
+	// when both headers are returned the `Azure-AsyncOperation` header is polled, which returns the status of the
+	// operation - so the result of the operation is retrieved from the `Location` header
+	if asyncOperation != "" && location != "" {
+		return c.getFinalResult(ctx, location)
+	}
+
+	if latest := poller.LatestResponse(); latest != nil {
+		return latest, nil
+	}
+	return resp, nil
+}
+
+// getFinalResult retrieves the result of a long-running operation from the absolute URI `location`
+func (c GenericResourcesClient) getFinalResult(ctx context.Context, location string) (*client.Response, error) {
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Location %q: %+v", location, err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       uri.Path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = uri.RawQuery
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the result of the operation from %q: %+v", location, err)
+	}
+
+	return resp, nil
+}
+
+// responseBody returns the JSON body of the response, or nil when no JSON body was returned
+func responseBody(resp *client.Response) (json.RawMessage, error) {
+	if resp == nil || resp.Response == nil || resp.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+	if len(strings.TrimSpace(string(body))) == 0 || !json.Valid(body) {
+		return nil, nil
+	}
+
+	return body, nil
+}
@@ -26,7 +26,7 @@ import (
 )
 
 func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	r := &pluginsdk.Resource{
 		Create: managementGroupTemplateDeploymentResourceCreate,
 		Read:   managementGroupTemplateDeploymentResourceRead,
 		Update: managementGroupTemplateDeploymentResourceUpdate,
@@ -109,6 +109,10 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(r, &parse.ManagementGroupTemplateDeploymentId{})
+
+	return pluginsdk.WithResourceIdentity(r, &parse.ManagementGroupTemplateDeploymentId{})
 }
 
 func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
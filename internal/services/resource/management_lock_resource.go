@@ -20,7 +20,7 @@ import (
 )
 
 func resourceManagementLock() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceManagementLockCreate,
 		Read:   resourceManagementLockRead,
 		Delete: resourceManagementLockDelete,
@@ -67,7 +67,7 @@ func resourceManagementLock() *pluginsdk.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
 		},
-	}
+	}, &managementlocks.ScopedLockId{})
 }
 
 func resourceManagementLockCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -40,6 +40,35 @@ func (id ManagementGroupTemplateDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.DeploymentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Management Group Template Deployment ID
+func (id ManagementGroupTemplateDeploymentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftManagement", "Microsoft.Management", "Microsoft.Management"),
+		resourceids.StaticSegment("staticManagementGroups", "managementGroups", "managementGroups"),
+		resourceids.UserSpecifiedSegment("managementGroupName", "my-management-group-id"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeployments", "deployments", "deployments"),
+		resourceids.UserSpecifiedSegment("deploymentName", "deploy1"),
+	}
+}
+
+// FromParseResult populates the ManagementGroupTemplateDeploymentId using the ParseResult provided in 'input'
+func (id *ManagementGroupTemplateDeploymentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ManagementGroupName, ok = input.Parsed["managementGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managementGroupName", input)
+	}
+
+	if id.DeploymentName, ok = input.Parsed["deploymentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "deploymentName", input)
+	}
+
+	return nil
+}
+
 // ManagementGroupTemplateDeploymentID parses a ManagementGroupTemplateDeployment ID into an ManagementGroupTemplateDeploymentId struct
 func ManagementGroupTemplateDeploymentID(input string) (*ManagementGroupTemplateDeploymentId, error) {
 	idURL, err := url.ParseRequestURI(input)
@@ -3,7 +3,11 @@
 
 package parse
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
 
 func TestManagementGroupTemplateDeploymentIDFormatter(t *testing.T) {
 	actual := NewManagementGroupTemplateDeploymentID("my-management-group-id", "deploy1").ID()
@@ -88,3 +92,20 @@ func TestManagementGroupTemplateDeploymentID(t *testing.T) {
 		}
 	}
 }
+
+func TestManagementGroupTemplateDeploymentIDSegments(t *testing.T) {
+	expected := NewManagementGroupTemplateDeploymentID("my-management-group-id", "deploy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagementGroupTemplateDeploymentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagementGroupTemplateDeploymentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -37,6 +37,16 @@ func (id ResourceGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this ResourceGroup ID
+func (id ResourceGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+	}
+}
+
 // ResourceGroupID parses a ResourceGroup ID into an ResourceGroupId struct
 func ResourceGroupID(input string) (*ResourceGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -95,3 +105,18 @@ func ResourceGroupIDInsensitively(input string) (*ResourceGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the ResourceGroupId using the ParseResult provided in 'input'
+func (id *ResourceGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestResourceGroupTemplateDeploymentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupTemplateDeploymentIDSegments(t *testing.T) {
+	expected := NewResourceGroupTemplateDeploymentID("12345678-1234-9876-4563-123456789012", "group1", "deploy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupTemplateDeploymentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupTemplateDeploymentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupTemplateDeploymentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestResourceGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupIDSegments(t *testing.T) {
+	expected := NewResourceGroupID("12345678-1234-9876-4563-123456789012", "group1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ResourceGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ResourceGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestResourceGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
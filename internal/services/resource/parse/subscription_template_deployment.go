@@ -37,6 +37,18 @@ func (id SubscriptionTemplateDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DeploymentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SubscriptionTemplateDeployment ID
+func (id SubscriptionTemplateDeploymentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeployments", "deployments", "deployments"),
+		resourceids.UserSpecifiedSegment("deploymentName", "deploy1"),
+	}
+}
+
 // SubscriptionTemplateDeploymentID parses a SubscriptionTemplateDeployment ID into an SubscriptionTemplateDeploymentId struct
 func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploy
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SubscriptionTemplateDeploymentId using the ParseResult provided in 'input'
+func (id *SubscriptionTemplateDeploymentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.DeploymentName, ok = input.Parsed["deploymentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "deploymentName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSubscriptionTemplateDeploymentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionTemplateDeploymentIDSegments(t *testing.T) {
+	expected := NewSubscriptionTemplateDeploymentID("12345678-1234-9876-4563-123456789012", "deploy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SubscriptionTemplateDeploymentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SubscriptionTemplateDeploymentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSubscriptionTemplateDeploymentID(t *testing.T) {
 	testData := []struct {
 		Input    string
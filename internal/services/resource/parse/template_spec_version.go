@@ -43,6 +43,22 @@ func (id TemplateSpecVersionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TemplateSpecName, id.VersionName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this TemplateSpecVersion ID
+func (id TemplateSpecVersionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "templateSpecRG"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticTemplateSpecs", "templateSpecs", "templateSpecs"),
+		resourceids.UserSpecifiedSegment("templateSpecName", "templateSpec1"),
+		resourceids.StaticSegment("staticVersions", "versions", "versions"),
+		resourceids.UserSpecifiedSegment("versionName", "v1.0"),
+	}
+}
+
 // TemplateSpecVersionID parses a TemplateSpecVersion ID into an TemplateSpecVersionId struct
 func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the TemplateSpecVersionId using the ParseResult provided in 'input'
+func (id *TemplateSpecVersionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.TemplateSpecName, ok = input.Parsed["templateSpecName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "templateSpecName", input)
+	}
+
+	if id.VersionName, ok = input.Parsed["versionName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "versionName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestTemplateSpecVersionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTemplateSpecVersionIDSegments(t *testing.T) {
+	expected := NewTemplateSpecVersionID("12345678-1234-9876-4563-123456789012", "templateSpecRG", "templateSpec1", "v1.0")
+	parsed, err := resourceids.NewParserFromResourceIdType(&TemplateSpecVersionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := TemplateSpecVersionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestTemplateSpecVersionID(t *testing.T) {
 	testData := []struct {
 		Input    string
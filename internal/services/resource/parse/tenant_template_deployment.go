@@ -37,6 +37,27 @@ func (id TenantTemplateDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.DeploymentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Tenant Template Deployment ID
+func (id TenantTemplateDeploymentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeployments", "deployments", "deployments"),
+		resourceids.UserSpecifiedSegment("deploymentName", "deploy1"),
+	}
+}
+
+// FromParseResult populates the TenantTemplateDeploymentId using the ParseResult provided in 'input'
+func (id *TenantTemplateDeploymentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.DeploymentName, ok = input.Parsed["deploymentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "deploymentName", input)
+	}
+
+	return nil
+}
+
 // TenantTemplateDeploymentID parses a TenantTemplateDeployment ID into an TenantTemplateDeploymentId struct
 func TenantTemplateDeploymentID(input string) (*TenantTemplateDeploymentId, error) {
 	idURL, err := url.ParseRequestURI(input)
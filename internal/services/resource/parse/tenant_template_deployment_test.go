@@ -3,7 +3,11 @@
 
 package parse
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
 
 func TestTenantTemplateDeploymentIDFormatter(t *testing.T) {
 	actual := NewTenantTemplateDeploymentID("deploy1").ID()
@@ -72,3 +76,20 @@ func TestTenantTemplateDeploymentID(t *testing.T) {
 		}
 	}
 }
+
+func TestTenantTemplateDeploymentIDSegments(t *testing.T) {
+	expected := NewTenantTemplateDeploymentID("deploy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&TenantTemplateDeploymentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := TenantTemplateDeploymentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
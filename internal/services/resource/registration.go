@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration             = Registration{}
+	_ sdk.UntypedServiceRegistration           = Registration{}
+	_ sdk.ServiceRegistrationWithListResources = Registration{}
 )
 
 type Registration struct{}
@@ -63,7 +64,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		ResourceActionDataSource{},
+	}
 }
 
 // Resources returns a list of Resources supported by this Service
@@ -74,5 +77,14 @@ func (r Registration) Resources() []sdk.Resource {
 		ResourceManagementPrivateLinkResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
+		ResourceActionResource{},
+		ArmResourceResource{},
+	}
+}
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		ResourceGroupListResource{},
 	}
 }
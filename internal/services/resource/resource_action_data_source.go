@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.DataSource = ResourceActionDataSource{}
+
+// ResourceActionDataSource performs a POST action against any resource within Resource Manager each time it's read,
+// which is intended for actions which only retrieve information - such as `listKeys` or `listConnectionStrings`
+type ResourceActionDataSource struct{}
+
+type ResourceActionDataSourceModel struct {
+	ResourceId string `tfschema:"resource_id"`
+	Action     string `tfschema:"action"`
+	ApiVersion string `tfschema:"api_version"`
+	Body       string `tfschema:"body"`
+	Output     string `tfschema:"output"`
+}
+
+func (d ResourceActionDataSource) ResourceType() string {
+	return "azurerm_resource_action"
+}
+
+func (d ResourceActionDataSource) ModelObject() interface{} {
+	return &ResourceActionDataSourceModel{}
+}
+
+func (d ResourceActionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceManagerID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: resourceActionName,
+		},
+
+		"api_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceManagerApiVersion,
+		},
+
+		"body": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+	}
+}
+
+func (d ResourceActionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (d ResourceActionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			var state ResourceActionDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			output, err := performResourceAction(ctx, client, state.ResourceId, state.Action, state.ApiVersion, state.Body)
+			if err != nil {
+				return err
+			}
+			state.Output = output
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/%s", state.ResourceId, state.Action))
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceActionDataSource struct{}
+
+func TestAccResourceActionDataSource_listKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_action", "test")
+	r := ResourceActionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.listKeys(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+	})
+}
+
+func (ResourceActionDataSource) listKeys(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "listKeys"
+  api_version = "2023-01-01"
+}
+`, ResourceActionResource{}.template(data))
+}
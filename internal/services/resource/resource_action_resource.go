@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithCustomImporter = ResourceActionResource{}
+
+// ResourceActionResource performs a POST action (for example `listKeys`, `restart` or `regenerateKey`) against any
+// resource within Resource Manager when it's created - and each time it's replaced, for example when the `triggers`
+// change. Since the action isn't a resource within Azure, nothing is read back and deleting this is a no-op.
+type ResourceActionResource struct{}
+
+type ResourceActionResourceModel struct {
+	ResourceId string            `tfschema:"resource_id"`
+	Action     string            `tfschema:"action"`
+	ApiVersion string            `tfschema:"api_version"`
+	Body       string            `tfschema:"body"`
+	Triggers   map[string]string `tfschema:"triggers"`
+	Output     string            `tfschema:"output"`
+}
+
+func (r ResourceActionResource) ResourceType() string {
+	return "azurerm_resource_action"
+}
+
+func (r ResourceActionResource) ModelObject() interface{} {
+	return &ResourceActionResourceModel{}
+}
+
+func (r ResourceActionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ResourceManagerID
+}
+
+func (r ResourceActionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ResourceManagerID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: resourceActionName,
+		},
+
+		"api_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ResourceManagerApiVersion,
+		},
+
+		"body": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ResourceActionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ResourceActionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			var config ResourceActionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			output, err := performResourceAction(ctx, client, config.ResourceId, config.Action, config.ApiVersion, config.Body)
+			if err != nil {
+				return err
+			}
+			config.Output = output
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/%s", config.ResourceId, config.Action))
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (r ResourceActionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the action has been performed and isn't a resource within Azure, so there's nothing to read - the
+			// existing values (including the `output`) are retained until the action is performed again
+			return nil
+		},
+	}
+}
+
+func (r ResourceActionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the action can't be undone, so this only removes the resource from the state
+			return nil
+		},
+	}
+}
+
+func (r ResourceActionResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("%s doesn't support being imported, since the action isn't a resource within Azure - define the resource in the configuration to perform the action instead", r.ResourceType())
+	}
+}
+
+// performResourceAction performs the POST action `action` on the resource `resourceId`, returning the (normalized)
+// JSON body of the response - or an empty string when the action doesn't return a body
+func performResourceAction(ctx context.Context, genericClient *client.GenericResourcesClient, resourceId, action, apiVersion, body string) (string, error) {
+	var input interface{}
+	if body != "" {
+		input = json.RawMessage(body)
+	}
+
+	resp, err := genericClient.Action(ctx, resourceId, action, apiVersion, input)
+	if err != nil {
+		return "", fmt.Errorf("performing the action %q on %q (API Version %q): %+v", action, resourceId, apiVersion, err)
+	}
+
+	if resp.Body == nil {
+		return "", nil
+	}
+	return utils.NormalizeJson(string(resp.Body)), nil
+}
+
+// resourceActionName validates the name of the action, which is appended to the Resource ID - for example `listKeys`
+func resourceActionName(v interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^[^/?#\s][^?#\s]*$`), "the action must be the name of the action (for example `listKeys`) without a leading `/`, query string or whitespace")(v, k)
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
+)
+
+type ResourceActionResource struct{}
+
+func TestAccResourceAction_listKeys(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+
+	// the action isn't a resource within Azure, so there's nothing to check has been destroyed
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.listKeys(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.regenerateKey(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsSet(),
+			),
+		},
+		{
+			Config: r.regenerateKey(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").IsSet(),
+				check.That(data.ResourceName).Key("triggers.rotation").HasValue("second"),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_longRunningOperation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.longRunningOperation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").IsSet(),
+			),
+		},
+	})
+}
+
+func TestResourceAction_fakeServer(t *testing.T) {
+	ctx := context.Background()
+
+	server := fakearm.NewServer(fakearm.Options{
+		PollsUntilComplete: 1,
+	})
+	defer server.Close()
+
+	accountId := "/subscriptions/" + fakearm.SubscriptionId + "/resourceGroups/example-resources/providers/Microsoft.Storage/storageAccounts/example"
+	server.PutResource(accountId, map[string]interface{}{
+		"location": "westeurope",
+	})
+	server.RegisterAction("listKeys", false, func(resource map[string]interface{}, _ map[string]interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"keys": []interface{}{
+				map[string]interface{}{
+					"keyName": "key1",
+					"value":   fmt.Sprintf("secret-for-%s", resource["name"]),
+				},
+			},
+		}, nil
+	})
+	server.RegisterAction("failover", true, func(_ map[string]interface{}, input map[string]interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"failoverType": input["failoverType"],
+		}, nil
+	})
+
+	client, err := server.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	harness := fakearm.NewResourceHarness(t, client, resource.ResourceActionResource{})
+
+	d, err := harness.Create(ctx, map[string]interface{}{
+		"resource_id": accountId,
+		"action":      "listKeys",
+		"api_version": "2023-01-01",
+	})
+	if err != nil {
+		t.Fatalf("performing `listKeys`: %+v", err)
+	}
+	if d.Id() != accountId+"/listKeys" {
+		t.Fatalf("expected the ID to be %q but got %q", accountId+"/listKeys", d.Id())
+	}
+	var keys struct {
+		Keys []struct {
+			Value string `json:"value"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal([]byte(d.Get("output").(string)), &keys); err != nil {
+		t.Fatalf("parsing the `output`: %+v", err)
+	}
+	if len(keys.Keys) != 1 || keys.Keys[0].Value != "secret-for-example" {
+		t.Fatalf("expected the `output` to contain the keys but got %q", d.Get("output").(string))
+	}
+
+	d, err = harness.Create(ctx, map[string]interface{}{
+		"resource_id": accountId,
+		"action":      "failover",
+		"api_version": "2023-01-01",
+		"body":        `{"failoverType": "Planned"}`,
+	})
+	if err != nil {
+		t.Fatalf("performing `failover`: %+v", err)
+	}
+	if v := d.Get("output").(string); v != `{"failoverType":"Planned"}` {
+		t.Fatalf("expected the `output` to be the result of the long-running operation but got %q", v)
+	}
+
+	if _, err = harness.Create(ctx, map[string]interface{}{
+		"resource_id": accountId,
+		"action":      "regenerateKey",
+		"api_version": "2023-01-01",
+	}); err == nil {
+		t.Fatal("expected an error when the action isn't supported")
+	}
+}
+
+func (r ResourceActionResource) listKeys(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "listKeys"
+  api_version = "2023-01-01"
+}
+`, r.template(data))
+}
+
+func (r ResourceActionResource) regenerateKey(data acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  api_version = "2023-01-01"
+  body = jsonencode({
+    keyName = "key2"
+  })
+
+  triggers = {
+    rotation = %q
+  }
+}
+`, r.template(data), rotation)
+}
+
+func (r ResourceActionResource) longRunningOperation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "B1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_linux_web_app.test.id
+  action      = "restart"
+  api_version = "2023-01-01"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ResourceActionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
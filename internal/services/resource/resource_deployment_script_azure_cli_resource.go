@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -22,6 +23,7 @@ type ResourceDeploymentScriptAzureCliModel ResourceDeploymentScriptModel
 type ResourceDeploymentScriptAzureCliResource struct{}
 
 var _ sdk.ResourceWithUpdate = ResourceDeploymentScriptAzureCliResource{}
+var _ sdk.ResourceWithIdentity = ResourceDeploymentScriptAzureCliResource{}
 
 func (r ResourceDeploymentScriptAzureCliResource) ResourceType() string {
 	return "azurerm_resource_deployment_script_azure_cli"
@@ -35,6 +37,10 @@ func (r ResourceDeploymentScriptAzureCliResource) IDValidationFunc() pluginsdk.S
 	return deploymentscripts.ValidateDeploymentScriptID
 }
 
+func (r ResourceDeploymentScriptAzureCliResource) Identity() resourceids.ResourceId {
+	return &deploymentscripts.DeploymentScriptId{}
+}
+
 func (r ResourceDeploymentScriptAzureCliResource) Arguments() map[string]*pluginsdk.Schema {
 	return getDeploymentScriptArguments(AzureCliKind)
 }
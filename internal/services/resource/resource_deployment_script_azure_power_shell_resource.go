@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -22,6 +23,7 @@ type ResourceDeploymentScriptAzurePowerShellModel ResourceDeploymentScriptModel
 type ResourceDeploymentScriptAzurePowerShellResource struct{}
 
 var _ sdk.ResourceWithUpdate = ResourceDeploymentScriptAzurePowerShellResource{}
+var _ sdk.ResourceWithIdentity = ResourceDeploymentScriptAzurePowerShellResource{}
 
 func (r ResourceDeploymentScriptAzurePowerShellResource) ResourceType() string {
 	return "azurerm_resource_deployment_script_azure_power_shell"
@@ -35,6 +37,10 @@ func (r ResourceDeploymentScriptAzurePowerShellResource) IDValidationFunc() plug
 	return deploymentscripts.ValidateDeploymentScriptID
 }
 
+func (r ResourceDeploymentScriptAzurePowerShellResource) Identity() resourceids.ResourceId {
+	return &deploymentscripts.DeploymentScriptId{}
+}
+
 func (r ResourceDeploymentScriptAzurePowerShellResource) Arguments() map[string]*pluginsdk.Schema {
 	return getDeploymentScriptArguments(AzurePowerShellKind)
 }
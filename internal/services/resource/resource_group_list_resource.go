@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ResourceGroupListResource struct{}
+
+var _ sdk.ListResource = ResourceGroupListResource{}
+
+func (r ResourceGroupListResource) ResourceType() string {
+	return "azurerm_resource_group"
+}
+
+func (r ResourceGroupListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	client := metadata.Client.Resource.ResourceGroupsClient
+
+	subscriptionId := commonids.NewSubscriptionID(metadata.SubscriptionId)
+	resp, err := client.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, item := range resp.Items {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := commonids.ParseResourceGroupIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+		if request.ResourceGroupName != "" && !strings.EqualFold(id.ResourceGroupName, request.ResourceGroupName) {
+			continue
+		}
+
+		results = append(results, sdk.ListResult{
+			Id:   id,
+			Name: id.ResourceGroupName,
+			Tags: pointer.From(item.Tags),
+		})
+	}
+
+	return results, nil
+}
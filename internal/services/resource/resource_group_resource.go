@@ -12,6 +12,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -25,7 +26,7 @@ import (
 )
 
 func resourceResourceGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceResourceGroupCreateUpdate,
 		Read:   resourceResourceGroupRead,
 		Update: resourceResourceGroupCreateUpdate,
@@ -56,6 +57,10 @@ func resourceResourceGroup() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &commonids.ResourceGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.ResourceGroupId{})
 }
 
 func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -268,5 +273,5 @@ delete this using the Azure API directly (which will clear up any nested resourc
 More information on the 'features' block can be found in the documentation:
 https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/features-block
 `, name, strings.Join(formattedResourceUris, "\n"))
-	return fmt.Errorf(strings.ReplaceAll(message, "'", "`"))
+	return fmt.Errorf("%s", strings.ReplaceAll(message, "'", "`"))
 }
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceGroupTemplateDeploymentResourceCreate,
 		Read:   resourceGroupTemplateDeploymentResourceRead,
 		Update: resourceGroupTemplateDeploymentResourceUpdate,
@@ -133,7 +133,7 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			return nil
 		},
-	}
+	}, &parse.ResourceGroupTemplateDeploymentId{})
 }
 
 func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
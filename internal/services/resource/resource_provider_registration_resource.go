@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -328,6 +329,10 @@ func (r ResourceProviderRegistrationResource) IDValidationFunc() pluginsdk.Schem
 	return validate.ResourceProviderID
 }
 
+func (r ResourceProviderRegistrationResource) Identity() resourceids.ResourceId {
+	return &providers.SubscriptionProviderId{}
+}
+
 func (r ResourceProviderRegistrationResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		client := metadata.Client.Resource.ResourceProvidersClient
@@ -24,7 +24,7 @@ import (
 )
 
 func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	r := &pluginsdk.Resource{
 		Create: subscriptionTemplateDeploymentResourceCreate,
 		Read:   subscriptionTemplateDeploymentResourceRead,
 		Update: subscriptionTemplateDeploymentResourceUpdate,
@@ -100,6 +100,10 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(r, &parse.SubscriptionTemplateDeploymentId{})
+
+	return pluginsdk.WithResourceIdentity(r, &parse.SubscriptionTemplateDeploymentId{})
 }
 
 func subscriptionTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func tenantTemplateDeploymentResource() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	r := &pluginsdk.Resource{
 		Create: tenantTemplateDeploymentResourceCreate,
 		Read:   tenantTemplateDeploymentResourceRead,
 		Update: tenantTemplateDeploymentResourceUpdate,
@@ -100,6 +100,10 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(r, &parse.TenantTemplateDeploymentId{})
+
+	return pluginsdk.WithResourceIdentity(r, &parse.TenantTemplateDeploymentId{})
 }
 
 func tenantTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
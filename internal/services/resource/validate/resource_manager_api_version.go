@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ResourceManagerApiVersion validates that the value is a Resource Manager API Version, for example `2023-01-01` or
+// `2023-01-01-preview`
+func ResourceManagerApiVersion(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-[A-Za-z]+)?$`).MatchString(input) {
+		errors = append(errors, fmt.Errorf("%q must be an API Version in the format `YYYY-MM-DD` (optionally suffixed with `-preview`), got %q", k, input))
+	}
+
+	return
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestResourceManagerApiVersion(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "2023-01-01",
+			Valid: true,
+		},
+		{
+			Input: "2023-01-01-preview",
+			Valid: true,
+		},
+		{
+			Input: "2023-01-01-privatepreview",
+			Valid: true,
+		},
+		{
+			Input: "2023-1-1",
+			Valid: false,
+		},
+		{
+			Input: "2023-01-01-",
+			Valid: false,
+		},
+		{
+			Input: "latest",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ResourceManagerApiVersion(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceManagerID validates that the value is the ID of a resource within Resource Manager, of any type and at
+// any scope (for example a Management Group, Subscription, Resource Group or a resource within one of these)
+func ResourceManagerID(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !strings.HasPrefix(input, "/") {
+		errors = append(errors, fmt.Errorf("%q must be a Resource ID starting with a `/`", k))
+		return
+	}
+
+	if len(input) > 1 && strings.HasSuffix(input, "/") {
+		errors = append(errors, fmt.Errorf("%q must not end with a `/`", k))
+	}
+
+	if strings.Contains(input, "//") {
+		errors = append(errors, fmt.Errorf("%q must not contain empty segments", k))
+	}
+
+	if strings.ContainsAny(input, "?#") {
+		errors = append(errors, fmt.Errorf("%q must not contain a query string or fragment", k))
+	}
+
+	return
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestResourceManagerID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid: false,
+		},
+		{
+			Input: "/",
+			Valid: true,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/example",
+			Valid: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Valid: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Valid: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012//resourceGroups/group1",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1?api-version=2021-01-01",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ResourceManagerID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ResourceManagerType validates that the value is a Resource Manager Resource Type, comprised of the Resource
+// Provider and one or more types - for example `Microsoft.Storage/storageAccounts` or
+// `Microsoft.Storage/storageAccounts/blobServices/containers`
+func ResourceManagerType(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+(/[A-Za-z0-9-]+)+$`).MatchString(input) {
+		errors = append(errors, fmt.Errorf("%q must be a Resource Type in the format `{Resource Provider}/{type}`, for example `Microsoft.Storage/storageAccounts`, got %q", k, input))
+	}
+
+	return
+}
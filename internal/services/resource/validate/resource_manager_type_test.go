@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestResourceManagerType(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.Storage",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.Storage/storageAccounts",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.Storage/storageAccounts/blobServices/containers",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.Storage/storageAccounts/",
+			Valid: false,
+		},
+		{
+			Input: "/Microsoft.Storage/storageAccounts",
+			Valid: false,
+		},
+		{
+			Input: "storageAccounts",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.Storage/storageAccounts@2023-01-01",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ResourceManagerType(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -28,7 +28,7 @@ import (
 )
 
 func resourceSearchService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSearchServiceCreate,
 		Read:   resourceSearchServiceRead,
 		Update: resourceSearchServiceUpdate,
@@ -187,6 +187,10 @@ func resourceSearchService() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &services.SearchServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &services.SearchServiceId{})
 }
 
 func resourceSearchServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/sharedprivatelinkresources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -94,6 +95,10 @@ func (r SharedPrivateLinkServiceResource) IDValidationFunc() pluginsdk.SchemaVal
 	return sharedprivatelinkresources.ValidateSharedPrivateLinkResourceID
 }
 
+func (r SharedPrivateLinkServiceResource) Identity() resourceids.ResourceId {
+	return &sharedprivatelinkresources.SharedPrivateLinkResourceId{}
+}
+
 func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceAdvancedThreatProtection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAdvancedThreatProtectionCreateUpdate,
 		Read:   resourceAdvancedThreatProtectionRead,
 		Update: resourceAdvancedThreatProtectionCreateUpdate,
@@ -56,7 +56,7 @@ func resourceAdvancedThreatProtection() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.AdvancedThreatProtectionId{})
 }
 
 func resourceAdvancedThreatProtectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
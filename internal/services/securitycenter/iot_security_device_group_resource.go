@@ -21,7 +21,7 @@ import (
 )
 
 func resourceIotSecurityDeviceGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceIotSecurityDeviceGroupCreateUpdate,
 		Read:   resourceIotSecurityDeviceGroupRead,
 		Update: resourceIotSecurityDeviceGroupCreateUpdate,
@@ -150,7 +150,7 @@ func resourceIotSecurityDeviceGroup() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.IotSecurityDeviceGroupId{})
 }
 
 func resourceIotSecurityDeviceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
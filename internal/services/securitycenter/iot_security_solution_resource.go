@@ -28,7 +28,7 @@ import (
 )
 
 func resourceIotSecuritySolution() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceIotSecuritySolutionCreateUpdate,
 		Read:   resourceIotSecuritySolutionRead,
 		Update: resourceIotSecuritySolutionCreateUpdate,
@@ -271,6 +271,10 @@ func resourceIotSecuritySolution() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.IotSecuritySolutionId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.IotSecuritySolutionId{})
 }
 
 func resourceIotSecuritySolutionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -30,6 +30,29 @@ func (id AdvancedThreatProtectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.TargetResourceID, id.SettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Advanced Threat Protection ID
+func (id AdvancedThreatProtectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticAdvancedThreatProtectionSettings", "advancedThreatProtectionSettings", "advancedThreatProtectionSettings"),
+		resourceids.StaticSegment("staticCurrent", "current", "current"),
+	}
+}
+
+// FromParseResult populates the AdvancedThreatProtectionId using the ParseResult provided in 'input'
+func (id *AdvancedThreatProtectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.TargetResourceID, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+	id.SettingName = "current"
+
+	return nil
+}
+
 func (id AdvancedThreatProtectionId) String() string {
 	components := []string{
 		fmt.Sprintf("Target Resource ID %q", id.TargetResourceID),
@@ -38,6 +38,32 @@ func (id AssessmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.TargetResourceID, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Assessment ID
+func (id AssessmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticAssessments", "assessments", "assessments"),
+		resourceids.UserSpecifiedSegment("assessmentName", "assessment1"),
+	}
+}
+
+// FromParseResult populates the AssessmentId using the ParseResult provided in 'input'
+func (id *AssessmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.TargetResourceID, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if id.Name, ok = input.Parsed["assessmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "assessmentName", input)
+	}
+
+	return nil
+}
+
 func AssessmentID(input string) (*AssessmentId, error) {
 	id, err := azure.ParseAzureResourceID(input)
 	if err != nil {
@@ -37,6 +37,18 @@ func (id AssessmentMetadataId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.AssessmentMetadataName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AssessmentMetadata ID
+func (id AssessmentMetadataId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticAssessmentMetadata", "assessmentMetadata", "assessmentMetadata"),
+		resourceids.UserSpecifiedSegment("assessmentMetadataName", "metadata1"),
+	}
+}
+
 // AssessmentMetadataID parses a AssessmentMetadata ID into an AssessmentMetadataId struct
 func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AssessmentMetadataId using the ParseResult provided in 'input'
+func (id *AssessmentMetadataId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.AssessmentMetadataName, ok = input.Parsed["assessmentMetadataName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "assessmentMetadataName", input)
+	}
+
+	return nil
+}
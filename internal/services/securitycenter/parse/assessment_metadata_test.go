@@ -21,6 +21,23 @@ func TestAssessmentMetadataIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAssessmentMetadataIDSegments(t *testing.T) {
+	expected := NewAssessmentMetadataID("12345678-1234-9876-4563-123456789012", "metadata1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AssessmentMetadataId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AssessmentMetadataId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAssessmentMetadataID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -5,6 +5,8 @@ package parse
 
 import (
 	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 func TestAssessmentIDFormatter(t *testing.T) {
@@ -82,3 +84,20 @@ func TestAssessmentID(t *testing.T) {
 		}
 	}
 }
+
+func TestAssessmentIDSegments(t *testing.T) {
+	expected := NewAssessmentID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleset1", "assessment1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AssessmentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AssessmentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
@@ -21,6 +21,23 @@ func TestAutoProvisioningSettingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAutoProvisioningSettingIDSegments(t *testing.T) {
+	expected := NewAutoProvisioningSettingID("12345678-1234-9876-4563-123456789012", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AutoProvisioningSettingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AutoProvisioningSettingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAutoProvisioningSettingID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestAutomationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAutomationIDSegments(t *testing.T) {
+	expected := NewAutomationID("12345678-1234-9876-4563-123456789012", "group1", "testAutomation1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AutomationId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AutomationId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAutomationID(t *testing.T) {
 	testData := []struct {
 		Input    string
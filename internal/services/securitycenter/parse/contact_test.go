@@ -21,6 +21,23 @@ func TestContactIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContactIDSegments(t *testing.T) {
+	expected := NewContactID("12345678-1234-9876-4563-123456789012", "contact1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ContactId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ContactId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestContactID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 )
 
@@ -36,6 +37,32 @@ func (id IotSecurityDeviceGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.IotHubID, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Iot Security Device Group ID
+func (id IotSecurityDeviceGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticDeviceSecurityGroups", "deviceSecurityGroups", "deviceSecurityGroups"),
+		resourceids.UserSpecifiedSegment("deviceSecurityGroupName", "group1"),
+	}
+}
+
+// FromParseResult populates the IotSecurityDeviceGroupId using the ParseResult provided in 'input'
+func (id *IotSecurityDeviceGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.IotHubID, ok = input.Parsed["scope"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scope", input)
+	}
+
+	if id.Name, ok = input.Parsed["deviceSecurityGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "deviceSecurityGroupName", input)
+	}
+
+	return nil
+}
+
 func IotSecurityDeviceGroupID(input string) (*IotSecurityDeviceGroupId, error) {
 	id, err := azure.ParseAzureResourceID(input)
 	if err != nil {
@@ -5,6 +5,8 @@ package parse
 
 import (
 	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 func TestIotSecurityDeviceGroupID(t *testing.T) {
@@ -75,3 +77,20 @@ func TestIotSecurityDeviceGroupID(t *testing.T) {
 		}
 	}
 }
+
+func TestIotSecurityDeviceGroupIDSegments(t *testing.T) {
+	expected := NewIotSecurityDeviceGroupId("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1", "group1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&IotSecurityDeviceGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := IotSecurityDeviceGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
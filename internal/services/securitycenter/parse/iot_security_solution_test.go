@@ -21,6 +21,23 @@ func TestIotSecuritySolutionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIotSecuritySolutionIDSegments(t *testing.T) {
+	expected := NewIotSecuritySolutionID("12345678-1234-9876-4563-123456789012", "resGroup1", "solution1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&IotSecuritySolutionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := IotSecuritySolutionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestIotSecuritySolutionID(t *testing.T) {
 	testData := []struct {
 		Input    string
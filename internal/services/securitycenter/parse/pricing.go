@@ -37,6 +37,18 @@ func (id PricingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Pricing ID
+func (id PricingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticPricings", "pricings", "pricings"),
+		resourceids.UserSpecifiedSegment("name", "pricing1"),
+	}
+}
+
 // PricingID parses a Pricing ID into an PricingId struct
 func PricingID(input string) (*PricingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func PricingID(input string) (*PricingId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the PricingId using the ParseResult provided in 'input'
+func (id *PricingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestPricingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPricingIDSegments(t *testing.T) {
+	expected := NewPricingID("12345678-1234-9876-4563-123456789012", "pricing1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PricingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PricingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPricingID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -37,6 +37,18 @@ func (id SettingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Setting ID
+func (id SettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticSettings", "settings", "settings"),
+		resourceids.UserSpecifiedSegment("name", "setting1"),
+	}
+}
+
 // SettingID parses a Setting ID into an SettingId struct
 func SettingID(input string) (*SettingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func SettingID(input string) (*SettingId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SettingId using the ParseResult provided in 'input'
+func (id *SettingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSettingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSettingIDSegments(t *testing.T) {
+	expected := NewSettingID("12345678-1234-9876-4563-123456789012", "setting1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SettingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SettingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSettingID(t *testing.T) {
 	testData := []struct {
 		Input    string
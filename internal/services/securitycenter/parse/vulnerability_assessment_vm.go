@@ -43,6 +43,24 @@ func (id VulnerabilityAssessmentVmId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.ServerVulnerabilityAssessmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this VulnerabilityAssessmentVm ID
+func (id VulnerabilityAssessmentVmId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute", "Microsoft.Compute"),
+		resourceids.StaticSegment("staticVirtualMachines", "virtualMachines", "virtualMachines"),
+		resourceids.UserSpecifiedSegment("virtualMachineName", "vm-name1"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticServerVulnerabilityAssessments", "serverVulnerabilityAssessments", "serverVulnerabilityAssessments"),
+		resourceids.UserSpecifiedSegment("serverVulnerabilityAssessmentName", "default1"),
+	}
+}
+
 // VulnerabilityAssessmentVmID parses a VulnerabilityAssessmentVm ID into an VulnerabilityAssessmentVmId struct
 func VulnerabilityAssessmentVmID(input string) (*VulnerabilityAssessmentVmId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +94,26 @@ func VulnerabilityAssessmentVmID(input string) (*VulnerabilityAssessmentVmId, er
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the VulnerabilityAssessmentVmId using the ParseResult provided in 'input'
+func (id *VulnerabilityAssessmentVmId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VirtualMachineName, ok = input.Parsed["virtualMachineName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "virtualMachineName", input)
+	}
+
+	if id.ServerVulnerabilityAssessmentName, ok = input.Parsed["serverVulnerabilityAssessmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverVulnerabilityAssessmentName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestVulnerabilityAssessmentVmIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityAssessmentVmIDSegments(t *testing.T) {
+	expected := NewVulnerabilityAssessmentVmID("12345678-1234-9876-4563-123456789012", "resGroup1", "vm-name1", "default1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VulnerabilityAssessmentVmId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VulnerabilityAssessmentVmId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVulnerabilityAssessmentVmID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -37,6 +37,18 @@ func (id VulnerabilityAssessmentsSettingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ServerVulnerabilityAssessmentsSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this VulnerabilityAssessmentsSetting ID
+func (id VulnerabilityAssessmentsSettingId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticServerVulnerabilityAssessmentsSettings", "serverVulnerabilityAssessmentsSettings", "serverVulnerabilityAssessmentsSettings"),
+		resourceids.UserSpecifiedSegment("serverVulnerabilityAssessmentsSettingName", "AzureServersSetting"),
+	}
+}
+
 // VulnerabilityAssessmentsSettingID parses a VulnerabilityAssessmentsSetting ID into an VulnerabilityAssessmentsSettingId struct
 func VulnerabilityAssessmentsSettingID(input string) (*VulnerabilityAssessmentsSettingId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func VulnerabilityAssessmentsSettingID(input string) (*VulnerabilityAssessmentsS
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the VulnerabilityAssessmentsSettingId using the ParseResult provided in 'input'
+func (id *VulnerabilityAssessmentsSettingId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ServerVulnerabilityAssessmentsSettingName, ok = input.Parsed["serverVulnerabilityAssessmentsSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverVulnerabilityAssessmentsSettingName", input)
+	}
+
+	return nil
+}
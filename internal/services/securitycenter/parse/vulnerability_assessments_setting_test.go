@@ -21,6 +21,23 @@ func TestVulnerabilityAssessmentsSettingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityAssessmentsSettingIDSegments(t *testing.T) {
+	expected := NewVulnerabilityAssessmentsSettingID("12345678-1234-9876-4563-123456789012", "AzureServersSetting")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VulnerabilityAssessmentsSettingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VulnerabilityAssessmentsSettingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVulnerabilityAssessmentsSettingID(t *testing.T) {
 	testData := []struct {
 		Input    string
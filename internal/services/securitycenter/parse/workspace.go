@@ -37,6 +37,18 @@ func (id WorkspaceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.WorkspaceSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Workspace ID
+func (id WorkspaceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security", "Microsoft.Security"),
+		resourceids.StaticSegment("staticWorkspaceSettings", "workspaceSettings", "workspaceSettings"),
+		resourceids.UserSpecifiedSegment("workspaceSettingName", "workspace1"),
+	}
+}
+
 // WorkspaceID parses a Workspace ID into an WorkspaceId struct
 func WorkspaceID(input string) (*WorkspaceId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -62,3 +74,18 @@ func WorkspaceID(input string) (*WorkspaceId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the WorkspaceId using the ParseResult provided in 'input'
+func (id *WorkspaceId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.WorkspaceSettingName, ok = input.Parsed["workspaceSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceSettingName", input)
+	}
+
+	return nil
+}
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceArmSecurityCenterAssessmentPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceArmSecurityCenterAssessmentPolicyCreate,
 		Read:   resourceArmSecurityCenterAssessmentPolicyRead,
 		Update: resourceArmSecurityCenterAssessmentPolicyUpdate,
@@ -133,7 +133,7 @@ func resourceArmSecurityCenterAssessmentPolicy() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &assessmentsmetadata.ProviderAssessmentMetadataId{})
 }
 
 func resourceArmSecurityCenterAssessmentPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
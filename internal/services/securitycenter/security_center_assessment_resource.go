@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSecurityCenterAssessment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSecurityCenterAssessmentCreateUpdate,
 		Read:   resourceSecurityCenterAssessmentRead,
 		Update: resourceSecurityCenterAssessmentCreateUpdate,
@@ -93,7 +93,7 @@ func resourceSecurityCenterAssessment() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.AssessmentId{})
 }
 
 func resourceSecurityCenterAssessmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
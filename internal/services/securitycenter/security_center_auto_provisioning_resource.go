@@ -19,7 +19,7 @@ import (
 )
 
 func resourceSecurityCenterAutoProvisioning() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSecurityCenterAutoProvisioningCreateUpdate,
 		Read:   resourceSecurityCenterAutoProvisioningRead,
 		Update: resourceSecurityCenterAutoProvisioningCreateUpdate,
@@ -53,7 +53,7 @@ func resourceSecurityCenterAutoProvisioning() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &parse.AutoProvisioningSettingId{})
 }
 
 func resourceSecurityCenterAutoProvisioningCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ const (
 )
 
 func resourceServerVulnerabilityAssessmentVirtualMachine() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServerVulnerabilityAssessmentVirtualMachineCreate,
 		Read:   resourceServerVulnerabilityAssessmentVirtualMachineRead,
 		Delete: resourceServerVulnerabilityAssessmentVirtualMachineDelete,
@@ -49,7 +49,7 @@ func resourceServerVulnerabilityAssessmentVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateVirtualMachineID,
 			},
 		},
-	}
+	}, &parse.VulnerabilityAssessmentVmId{})
 }
 
 func resourceServerVulnerabilityAssessmentVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 
 func resourceSecurityCenterServerVulnerabilityAssessmentsSetting() *pluginsdk.Resource {
 
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSecurityCenterServerVulnerabilityAssessmentsSettingCreateOrUpdate,
 		Read:   resourceSecurityCenterServerVulnerabilityAssessmentsSettingRead,
 		Update: resourceSecurityCenterServerVulnerabilityAssessmentsSettingCreateOrUpdate,
@@ -46,7 +46,7 @@ func resourceSecurityCenterServerVulnerabilityAssessmentsSetting() *pluginsdk.Re
 				ValidateFunc: validation.StringInSlice(servervulnerabilityassessmentssettings.PossibleValuesForServerVulnerabilityAssessmentsAzureSettingSelectedProvider(), false),
 			},
 		},
-	}
+	}, &parse.VulnerabilityAssessmentsSettingId{})
 }
 
 func resourceSecurityCenterServerVulnerabilityAssessmentsSettingCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
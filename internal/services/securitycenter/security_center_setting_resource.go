@@ -31,7 +31,7 @@ func resourceSecurityCenterSetting() *pluginsdk.Resource {
 		validSettingName = append(validSettingName, "SENTINEL")
 	}
 
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSecurityCenterSettingUpdate,
 		Read:   resourceSecurityCenterSettingRead,
 		Update: resourceSecurityCenterSettingUpdate,
@@ -73,7 +73,7 @@ func resourceSecurityCenterSetting() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.SettingId{})
 }
 
 func resourceSecurityCenterSettingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
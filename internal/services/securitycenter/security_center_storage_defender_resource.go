@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/topics"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -34,11 +35,16 @@ type StorageDefenderModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = StorageDefenderResource{}
+var _ sdk.ResourceWithIdentity = StorageDefenderResource{}
 
 func (s StorageDefenderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateStorageAccountID
 }
 
+func (s StorageDefenderResource) Identity() resourceids.ResourceId {
+	return &commonids.StorageAccountId{}
+}
+
 func (s StorageDefenderResource) ModelObject() interface{} {
 	return &StorageDefenderModel{}
 }
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceSecurityCenterSubscriptionPricing() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSecurityCenterSubscriptionPricingUpdate,
 		Read:   resourceSecurityCenterSubscriptionPricingRead,
 		Update: resourceSecurityCenterSubscriptionPricingUpdate,
@@ -103,7 +103,7 @@ func resourceSecurityCenterSubscriptionPricing() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.PricingId{})
 }
 
 func resourceSecurityCenterSubscriptionPricingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -25,7 +25,7 @@ import (
 const securityCenterWorkspaceName = "default"
 
 func resourceSecurityCenterWorkspace() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSecurityCenterWorkspaceCreateUpdate,
 		Read:   resourceSecurityCenterWorkspaceRead,
 		Update: resourceSecurityCenterWorkspaceCreateUpdate,
@@ -59,7 +59,7 @@ func resourceSecurityCenterWorkspace() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 		},
-	}
+	}, &parse.WorkspaceId{})
 }
 
 func resourceSecurityCenterWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
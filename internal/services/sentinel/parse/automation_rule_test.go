@@ -21,6 +21,23 @@ func TestAutomationRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAutomationRuleIDSegments(t *testing.T) {
+	expected := NewAutomationRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "rule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AutomationRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AutomationRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAutomationRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
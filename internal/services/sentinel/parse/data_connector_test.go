@@ -21,6 +21,23 @@ func TestDataConnectorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataConnectorIDSegments(t *testing.T) {
+	expected := NewDataConnectorID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "dc1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&DataConnectorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := DataConnectorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestDataConnectorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,24 @@ func (id MLAnalyticsSettingsId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SecurityMLAnalyticsSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this MLAnalyticsSettings ID
+func (id MLAnalyticsSettingsId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights", "Microsoft.OperationalInsights"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurityInsights", "Microsoft.SecurityInsights", "Microsoft.SecurityInsights"),
+		resourceids.StaticSegment("staticSecurityMLAnalyticsSettings", "securityMLAnalyticsSettings", "securityMLAnalyticsSettings"),
+		resourceids.UserSpecifiedSegment("securityMLAnalyticsSettingName", "setting1"),
+	}
+}
+
 // MLAnalyticsSettingsID parses a MLAnalyticsSettings ID into an MLAnalyticsSettingsId struct
 func MLAnalyticsSettingsID(input string) (*MLAnalyticsSettingsId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +94,26 @@ func MLAnalyticsSettingsID(input string) (*MLAnalyticsSettingsId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the MLAnalyticsSettingsId using the ParseResult provided in 'input'
+func (id *MLAnalyticsSettingsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SecurityMLAnalyticsSettingName, ok = input.Parsed["securityMLAnalyticsSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "securityMLAnalyticsSettingName", input)
+	}
+
+	return nil
+}
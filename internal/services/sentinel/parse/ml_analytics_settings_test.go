@@ -21,6 +21,23 @@ func TestMLAnalyticsSettingsIDFormatter(t *testing.T) {
 	}
 }
 
+func TestMLAnalyticsSettingsIDSegments(t *testing.T) {
+	expected := NewMLAnalyticsSettingsID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "setting1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&MLAnalyticsSettingsId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := MLAnalyticsSettingsId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestMLAnalyticsSettingsID(t *testing.T) {
 	testData := []struct {
 		Input    string
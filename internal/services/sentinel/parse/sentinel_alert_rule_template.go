@@ -43,6 +43,24 @@ func (id SentinelAlertRuleTemplateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.AlertRuleTemplateName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SentinelAlertRuleTemplate ID
+func (id SentinelAlertRuleTemplateId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights", "Microsoft.OperationalInsights"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSecurityInsights", "Microsoft.SecurityInsights", "Microsoft.SecurityInsights"),
+		resourceids.StaticSegment("staticAlertRuleTemplates", "alertRuleTemplates", "alertRuleTemplates"),
+		resourceids.UserSpecifiedSegment("alertRuleTemplateName", "template1"),
+	}
+}
+
 // SentinelAlertRuleTemplateID parses a SentinelAlertRuleTemplate ID into an SentinelAlertRuleTemplateId struct
 func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +150,26 @@ func SentinelAlertRuleTemplateIDInsensitively(input string) (*SentinelAlertRuleT
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SentinelAlertRuleTemplateId using the ParseResult provided in 'input'
+func (id *SentinelAlertRuleTemplateId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.AlertRuleTemplateName, ok = input.Parsed["alertRuleTemplateName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "alertRuleTemplateName", input)
+	}
+
+	return nil
+}
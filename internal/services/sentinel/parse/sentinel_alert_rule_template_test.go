@@ -21,6 +21,23 @@ func TestSentinelAlertRuleTemplateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSentinelAlertRuleTemplateIDSegments(t *testing.T) {
+	expected := NewSentinelAlertRuleTemplateID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "template1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SentinelAlertRuleTemplateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SentinelAlertRuleTemplateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSentinelAlertRuleTemplateID(t *testing.T) {
 	testData := []struct {
 		Input    string
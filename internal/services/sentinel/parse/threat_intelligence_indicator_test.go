@@ -21,6 +21,23 @@ func TestThreatIntelligenceIndicatorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestThreatIntelligenceIndicatorIDSegments(t *testing.T) {
+	expected := NewThreatIntelligenceIndicatorID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "main", "indicator1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ThreatIntelligenceIndicatorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ThreatIntelligenceIndicatorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestThreatIntelligenceIndicatorID(t *testing.T) {
 	testData := []struct {
 		Input    string
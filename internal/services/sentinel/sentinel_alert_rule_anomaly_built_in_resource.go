@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -57,6 +58,10 @@ func (r AlertRuleAnomalyBuiltInResource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.MLAnalyticsSettingsID
 }
 
+func (r AlertRuleAnomalyBuiltInResource) Identity() resourceids.ResourceId {
+	return &parse.MLAnalyticsSettingsId{}
+}
+
 func (r AlertRuleAnomalyBuiltInResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
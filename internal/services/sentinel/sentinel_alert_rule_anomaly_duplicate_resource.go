@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -60,6 +61,10 @@ func (r AlertRuleAnomalyDuplicateResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.MLAnalyticsSettingsID
 }
 
+func (r AlertRuleAnomalyDuplicateResource) Identity() resourceids.ResourceId {
+	return &parse.MLAnalyticsSettingsId{}
+}
+
 func (r AlertRuleAnomalyDuplicateResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"display_name": {
@@ -18,7 +18,7 @@ import (
 )
 
 func resourceSentinelAlertRuleFusion() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleFusionCreateUpdate,
 		Read:   resourceSentinelAlertRuleFusionRead,
 		Update: resourceSentinelAlertRuleFusionCreateUpdate,
@@ -121,7 +121,7 @@ func resourceSentinelAlertRuleFusion() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &alertrules.AlertRuleId{})
 }
 
 func resourceSentinelAlertRuleFusionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -18,7 +18,7 @@ import (
 )
 
 func resourceSentinelAlertRuleMLBehaviorAnalytics() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleMLBehaviorAnalyticsCreateUpdate,
 		Read:   resourceSentinelAlertRuleMLBehaviorAnalyticsRead,
 		Update: resourceSentinelAlertRuleMLBehaviorAnalyticsCreateUpdate,
@@ -64,7 +64,7 @@ func resourceSentinelAlertRuleMLBehaviorAnalytics() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &alertrules.AlertRuleId{})
 }
 
 func resourceSentinelAlertRuleMLBehaviorAnalyticsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSentinelAlertRuleMsSecurityIncident() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleMsSecurityIncidentCreateUpdate,
 		Read:   resourceSentinelAlertRuleMsSecurityIncidentRead,
 		Update: resourceSentinelAlertRuleMsSecurityIncidentCreateUpdate,
@@ -128,7 +128,7 @@ func resourceSentinelAlertRuleMsSecurityIncident() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &alertrules.AlertRuleId{})
 }
 
 func resourceSentinelAlertRuleMsSecurityIncidentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
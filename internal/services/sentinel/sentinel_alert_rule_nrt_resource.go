@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelAlertRuleNrtCreateUpdate,
 		Read:   resourceSentinelAlertRuleNrtRead,
 		Update: resourceSentinelAlertRuleNrtCreateUpdate,
@@ -320,7 +320,7 @@ func resourceSentinelAlertRuleNrt() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &alertrules.AlertRuleId{})
 }
 
 func resourceSentinelAlertRuleNrtCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -435,7 +435,7 @@ func resourceSentinelAlertRuleScheduled() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &alertrules.AlertRuleId{})
 }
 
 func resourceSentinelAlertRuleScheduledCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	alertruletemplates "github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -31,6 +32,7 @@ type AlertRuleThreatIntelligenceResource struct{}
 
 var _ sdk.ResourceWithCustomImporter = AlertRuleThreatIntelligenceResource{}
 var _ sdk.ResourceWithUpdate = AlertRuleThreatIntelligenceResource{}
+var _ sdk.ResourceWithIdentity = AlertRuleThreatIntelligenceResource{}
 
 func (a AlertRuleThreatIntelligenceResource) ModelObject() interface{} {
 	return &AlertRuleThreatIntelligenceModel{}
@@ -44,6 +46,10 @@ func (a AlertRuleThreatIntelligenceResource) IDValidationFunc() pluginsdk.Schema
 	return alertrules.ValidateAlertRuleID
 }
 
+func (a AlertRuleThreatIntelligenceResource) Identity() resourceids.ResourceId {
+	return &alertrules.AlertRuleId{}
+}
+
 func (a AlertRuleThreatIntelligenceResource) CustomImporter() sdk.ResourceRunFunc {
 	return importSentinelAlertRuleForTypedSdk(alertrules.AlertRuleKindThreatIntelligence)
 }
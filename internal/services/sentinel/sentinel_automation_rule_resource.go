@@ -215,7 +215,7 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 		schema["condition_json"].ConflictsWith = []string{"condition"}
 	}
 
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelAutomationRuleCreateOrUpdate,
 		Read:   resourceSentinelAutomationRuleRead,
 		Update: resourceSentinelAutomationRuleCreateOrUpdate,
@@ -239,7 +239,7 @@ func resourceSentinelAutomationRule() *pluginsdk.Resource {
 		},
 
 		Schema: schema,
-	}
+	}, &parse.AutomationRuleId{})
 }
 
 func resourceSentinelAutomationRuleCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
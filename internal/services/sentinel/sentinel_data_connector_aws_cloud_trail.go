@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSentinelDataConnectorAwsCloudTrail() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorAwsCloudTrailCreateUpdate,
 		Read:   resourceSentinelDataConnectorAwsCloudTrailRead,
 		Update: resourceSentinelDataConnectorAwsCloudTrailCreateUpdate,
@@ -57,7 +57,7 @@ func resourceSentinelDataConnectorAwsCloudTrail() *pluginsdk.Resource {
 				ValidateFunc: validate.IsARN,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorAwsCloudTrailCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
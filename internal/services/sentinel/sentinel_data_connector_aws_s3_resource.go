@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
@@ -86,6 +87,10 @@ func (r DataConnectorAwsS3Resource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.DataConnectorID
 }
 
+func (r DataConnectorAwsS3Resource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func (r DataConnectorAwsS3Resource) CustomImporter() sdk.ResourceRunFunc {
 	return importDataConnectorTyped(securityinsight.DataConnectorKindAmazonWebServicesS3)
 }
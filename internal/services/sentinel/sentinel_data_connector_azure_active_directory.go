@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSentinelDataConnectorAzureActiveDirectory() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorAzureActiveDirectoryCreate,
 		Read:   resourceSentinelDataConnectorAzureActiveDirectoryRead,
 		Delete: resourceSentinelDataConnectorAzureActiveDirectoryDelete,
@@ -56,7 +56,7 @@ func resourceSentinelDataConnectorAzureActiveDirectory() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorAzureActiveDirectoryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
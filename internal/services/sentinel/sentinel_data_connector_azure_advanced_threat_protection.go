@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSentinelDataConnectorAzureAdvancedThreatProtection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorAzureAdvancedThreatProtectionCreate,
 		Read:   resourceSentinelDataConnectorAzureAdvancedThreatProtectionRead,
 		Delete: resourceSentinelDataConnectorAzureAdvancedThreatProtectionDelete,
@@ -56,7 +56,7 @@ func resourceSentinelDataConnectorAzureAdvancedThreatProtection() *pluginsdk.Res
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorAzureAdvancedThreatProtectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
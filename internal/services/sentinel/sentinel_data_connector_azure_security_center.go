@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSentinelDataConnectorAzureSecurityCenter() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorAzureSecurityCenterCreate,
 		Read:   resourceSentinelDataConnectorAzureSecurityCenterRead,
 		Delete: resourceSentinelDataConnectorAzureSecurityCenterDelete,
@@ -56,7 +56,7 @@ func resourceSentinelDataConnectorAzureSecurityCenter() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorAzureSecurityCenterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
@@ -70,6 +71,10 @@ func (r DataConnectorDynamics365Resource) IDValidationFunc() pluginsdk.SchemaVal
 	return validate.DataConnectorID
 }
 
+func (r DataConnectorDynamics365Resource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func (r DataConnectorDynamics365Resource) CustomImporter() sdk.ResourceRunFunc {
 	return importDataConnectorTyped(securityinsight.DataConnectorKindDynamics365)
 }
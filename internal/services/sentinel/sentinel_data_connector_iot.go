@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
@@ -70,6 +71,10 @@ func (r DataConnectorIOTResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return validate.DataConnectorID
 }
 
+func (r DataConnectorIOTResource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func (r DataConnectorIOTResource) CustomImporter() sdk.ResourceRunFunc {
 	return importDataConnectorTyped(securityinsight.DataConnectorKindIOT)
 }
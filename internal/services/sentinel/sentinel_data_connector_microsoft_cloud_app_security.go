@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSentinelDataConnectorMicrosoftCloudAppSecurity() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorMicrosoftCloudAppSecurityCreateUpdate,
 		Read:   resourceSentinelDataConnectorMicrosoftCloudAppSecurityRead,
 		Update: resourceSentinelDataConnectorMicrosoftCloudAppSecurityCreateUpdate,
@@ -70,7 +70,7 @@ func resourceSentinelDataConnectorMicrosoftCloudAppSecurity() *pluginsdk.Resourc
 				Default:  true,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorMicrosoftCloudAppSecurityCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
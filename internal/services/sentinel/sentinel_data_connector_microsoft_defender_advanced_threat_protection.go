@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtectionCreate,
 		Read:   resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtectionRead,
 		Delete: resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtectionDelete,
@@ -56,7 +56,7 @@ func resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtection() *p
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorMicrosoftDefenderAdvancedThreatProtectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -240,6 +241,10 @@ func (s DataConnectorMicrosoftThreatIntelligenceResource) IDValidationFunc() plu
 	return validate.DataConnectorID
 }
 
+func (s DataConnectorMicrosoftThreatIntelligenceResource) Identity() resourceids.ResourceId {
+	return &parse.DataConnectorId{}
+}
+
 func expandSentinelDataConnectorMicrosoftThreatIntelligenceBingSafetyPhishingUrl(input DataConnectorMicrosoftThreatIntelligenceModel) *securityinsight.MSTIDataConnectorDataTypesBingSafetyPhishingURL {
 	if input.BingSafetyPhishingUrlLookBackDate == "" {
 		return &securityinsight.MSTIDataConnectorDataTypesBingSafetyPhishingURL{
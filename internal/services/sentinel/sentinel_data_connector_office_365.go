@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSentinelDataConnectorOffice365() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorOffice365CreateUpdate,
 		Read:   resourceSentinelDataConnectorOffice365Read,
 		Update: resourceSentinelDataConnectorOffice365CreateUpdate,
@@ -77,7 +77,7 @@ func resourceSentinelDataConnectorOffice365() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorOffice365CreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
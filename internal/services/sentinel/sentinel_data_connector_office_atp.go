@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSentinelDataConnectorOfficeATP() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorOfficeATPCreate,
 		Read:   resourceSentinelDataConnectorOfficeATPRead,
 		Delete: resourceSentinelDataConnectorOfficeATPDelete,
@@ -56,7 +56,7 @@ func resourceSentinelDataConnectorOfficeATP() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorOfficeATPCreate(d *pluginsdk.ResourceData, meta interface{}) error {
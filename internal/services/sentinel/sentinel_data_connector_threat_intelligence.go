@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSentinelDataConnectorThreatIntelligence() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSentinelDataConnectorThreatIntelligenceCreateUpdate,
 		Read:   resourceSentinelDataConnectorThreatIntelligenceRead,
 		Delete: resourceSentinelDataConnectorThreatIntelligenceDelete,
@@ -65,7 +65,7 @@ func resourceSentinelDataConnectorThreatIntelligence() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 		},
-	}
+	}, &parse.DataConnectorId{})
 }
 
 func resourceSentinelDataConnectorThreatIntelligenceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +31,7 @@ type SecurityInsightsSentinelOnboardingStateModel struct {
 type LogAnalyticsWorkspaceOnboardResource struct{}
 
 var _ sdk.Resource = LogAnalyticsWorkspaceOnboardResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsWorkspaceOnboardResource{}
 
 func (r LogAnalyticsWorkspaceOnboardResource) ResourceType() string {
 	return "azurerm_sentinel_log_analytics_workspace_onboarding"
@@ -43,6 +45,10 @@ func (r LogAnalyticsWorkspaceOnboardResource) IDValidationFunc() pluginsdk.Schem
 	return sentinelonboardingstates.ValidateOnboardingStateID
 }
 
+func (r LogAnalyticsWorkspaceOnboardResource) Identity() resourceids.ResourceId {
+	return &sentinelonboardingstates.OnboardingStateId{}
+}
+
 func (r LogAnalyticsWorkspaceOnboardResource) Arguments() map[string]*pluginsdk.Schema {
 	out := map[string]*pluginsdk.Schema{
 		// lintignore:S013
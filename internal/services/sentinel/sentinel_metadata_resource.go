@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	sentinelmetadata "github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -71,6 +72,7 @@ type MetadataCategoryModel struct {
 type MetadataResource struct{}
 
 var _ sdk.ResourceWithUpdate = MetadataResource{}
+var _ sdk.ResourceWithIdentity = MetadataResource{}
 
 func (a MetadataResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -348,6 +350,10 @@ func (a MetadataResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return sentinelmetadata.ValidateMetadataID
 }
 
+func (a MetadataResource) Identity() resourceids.ResourceId {
+	return &sentinelmetadata.MetadataId{}
+}
+
 func (a MetadataResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -107,6 +108,10 @@ func (r ThreatIntelligenceIndicator) IDValidationFunc() pluginsdk.SchemaValidate
 	return validate.ThreatIntelligenceIndicatorID
 }
 
+func (r ThreatIntelligenceIndicator) Identity() resourceids.ResourceId {
+	return &parse.ThreatIntelligenceIndicatorId{}
+}
+
 func (r ThreatIntelligenceIndicator) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"workspace_id": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlistitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,7 @@ import (
 type WatchlistItemResource struct{}
 
 var _ sdk.ResourceWithUpdate = WatchlistItemResource{}
+var _ sdk.ResourceWithIdentity = WatchlistItemResource{}
 
 type WatchlistItemModel struct {
 	Name        string                 `tfschema:"name"`
@@ -68,6 +70,10 @@ func (r WatchlistItemResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return watchlistitems.ValidateWatchlistItemID
 }
 
+func (r WatchlistItemResource) Identity() resourceids.ResourceId {
+	return &watchlistitems.WatchlistItemId{}
+}
+
 func (r WatchlistItemResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -21,6 +22,7 @@ import (
 type WatchlistResource struct{}
 
 var _ sdk.Resource = WatchlistResource{}
+var _ sdk.ResourceWithIdentity = WatchlistResource{}
 
 type WatchlistModel struct {
 	Name                    string   `tfschema:"name"`
@@ -98,6 +100,10 @@ func (r WatchlistResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return watchlists.ValidateWatchlistID
 }
 
+func (r WatchlistResource) Identity() resourceids.ResourceId {
+	return &watchlists.WatchlistId{}
+}
+
 func (r WatchlistResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
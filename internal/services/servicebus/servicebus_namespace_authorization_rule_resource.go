@@ -20,7 +20,7 @@ import (
 )
 
 func resourceServiceBusNamespaceAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusNamespaceAuthorizationRuleCreateUpdate,
 		Read:   resourceServiceBusNamespaceAuthorizationRuleRead,
 		Update: resourceServiceBusNamespaceAuthorizationRuleCreateUpdate,
@@ -47,7 +47,7 @@ func resourceServiceBusNamespaceAuthorizationRule() *pluginsdk.Resource {
 		Schema: resourceServiceBusNamespaceAuthorizationRuleSchema(),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
-	}
+	}, &namespacesauthorizationrule.AuthorizationRuleId{})
 }
 
 func resourceServiceBusNamespaceAuthorizationRuleSchema() map[string]*pluginsdk.Schema {
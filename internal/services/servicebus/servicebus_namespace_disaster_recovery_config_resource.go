@@ -25,7 +25,7 @@ import (
 type ServiceBusNamespaceDisasterRecoveryConfigResource struct{}
 
 func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusNamespaceDisasterRecoveryConfigCreate,
 		Read:   resourceServiceBusNamespaceDisasterRecoveryConfigRead,
 		Update: resourceServiceBusNamespaceDisasterRecoveryConfigUpdate,
@@ -92,7 +92,7 @@ func resourceServiceBusNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	}, &disasterrecoveryconfigs.DisasterRecoveryConfigId{})
 }
 
 func resourceServiceBusNamespaceDisasterRecoveryConfigCreate(d *pluginsdk.ResourceData, meta interface{}) error {
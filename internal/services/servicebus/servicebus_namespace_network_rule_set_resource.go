@@ -24,7 +24,7 @@ import (
 )
 
 func resourceServiceBusNamespaceNetworkRuleSet() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusNamespaceNetworkRuleSetCreateUpdate,
 		Read:   resourceServiceBusNamespaceNetworkRuleSetRead,
 		Update: resourceServiceBusNamespaceNetworkRuleSetCreateUpdate,
@@ -50,7 +50,7 @@ func resourceServiceBusNamespaceNetworkRuleSet() *pluginsdk.Resource {
 		},
 
 		Schema: resourceServicebusNamespaceNetworkRuleSetSchema(),
-	}
+	}, &namespaces.NamespaceId{})
 }
 
 func resourceServicebusNamespaceNetworkRuleSetSchema() map[string]*pluginsdk.Schema {
@@ -290,7 +290,9 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &namespaces.NamespaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &namespaces.NamespaceId{})
 }
 
 func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceServiceBusQueueAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusQueueAuthorizationRuleCreateUpdate,
 		Read:   resourceServiceBusQueueAuthorizationRuleRead,
 		Update: resourceServiceBusQueueAuthorizationRuleCreateUpdate,
@@ -42,7 +42,7 @@ func resourceServiceBusQueueAuthorizationRule() *pluginsdk.Resource {
 		Schema: resourceServiceBusqueueAuthorizationRuleSchema(),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
-	}
+	}, &queuesauthorizationrule.QueueAuthorizationRuleId{})
 }
 
 func resourceServiceBusqueueAuthorizationRuleSchema() map[string]*pluginsdk.Schema {
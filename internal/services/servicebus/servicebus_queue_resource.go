@@ -25,7 +25,7 @@ import (
 )
 
 func resourceServiceBusQueue() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusQueueCreateUpdate,
 		Read:   resourceServiceBusQueueRead,
 		Update: resourceServiceBusQueueCreateUpdate,
@@ -44,7 +44,7 @@ func resourceServiceBusQueue() *pluginsdk.Resource {
 		},
 
 		Schema: resourceServicebusQueueSchema(),
-	}
+	}, &queues.QueueId{})
 }
 
 func resourceServicebusQueueSchema() map[string]*pluginsdk.Schema {
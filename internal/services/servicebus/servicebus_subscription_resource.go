@@ -24,7 +24,7 @@ import (
 )
 
 func resourceServiceBusSubscription() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusSubscriptionCreateUpdate,
 		Read:   resourceServiceBusSubscriptionRead,
 		Update: resourceServiceBusSubscriptionCreateUpdate,
@@ -48,7 +48,7 @@ func resourceServiceBusSubscription() *pluginsdk.Resource {
 		},
 
 		Schema: resourceServicebusSubscriptionSchema(),
-	}
+	}, &subscriptions.Subscriptions2Id{})
 }
 
 func resourceServicebusSubscriptionSchema() map[string]*pluginsdk.Schema {
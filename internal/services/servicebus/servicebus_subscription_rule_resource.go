@@ -23,7 +23,7 @@ import (
 )
 
 func resourceServiceBusSubscriptionRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusSubscriptionRuleCreateUpdate,
 		Read:   resourceServiceBusSubscriptionRuleRead,
 		Update: resourceServiceBusSubscriptionRuleCreateUpdate,
@@ -42,7 +42,7 @@ func resourceServiceBusSubscriptionRule() *pluginsdk.Resource {
 		},
 
 		Schema: resourceServicebusSubscriptionRuleSchema(),
-	}
+	}, &rules.RuleId{})
 }
 
 func resourceServicebusSubscriptionRuleSchema() map[string]*pluginsdk.Schema {
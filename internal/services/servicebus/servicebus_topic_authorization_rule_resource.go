@@ -21,7 +21,7 @@ import (
 )
 
 func resourceServiceBusTopicAuthorizationRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusTopicAuthorizationRuleCreateUpdate,
 		Read:   resourceServiceBusTopicAuthorizationRuleRead,
 		Update: resourceServiceBusTopicAuthorizationRuleCreateUpdate,
@@ -42,7 +42,7 @@ func resourceServiceBusTopicAuthorizationRule() *pluginsdk.Resource {
 		Schema: authorizationRuleSchemaFrom(resourceServiceBusTopicAuthorizationRuleSchema()),
 
 		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
-	}
+	}, &topicsauthorizationrule.TopicAuthorizationRuleId{})
 }
 
 func resourceServiceBusTopicAuthorizationRuleSchema() map[string]*pluginsdk.Schema {
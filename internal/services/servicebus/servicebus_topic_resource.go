@@ -23,7 +23,7 @@ import (
 )
 
 func resourceServiceBusTopic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceServiceBusTopicCreateUpdate,
 		Read:   resourceServiceBusTopicRead,
 		Update: resourceServiceBusTopicCreateUpdate,
@@ -42,7 +42,7 @@ func resourceServiceBusTopic() *pluginsdk.Resource {
 		},
 
 		Schema: resourceServiceBusTopicSchema(),
-	}
+	}, &topics.TopicId{})
 }
 
 func resourceServiceBusTopicSchema() map[string]*pluginsdk.Schema {
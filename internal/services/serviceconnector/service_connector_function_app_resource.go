@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2022-05-01/links"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2024-04-01/servicelinker"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -303,3 +304,7 @@ func (r FunctionAppConnectorResource) Update() sdk.ResourceFunc {
 func (r FunctionAppConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return servicelinker.ValidateScopedLinkerID
 }
+
+func (r FunctionAppConnectorResource) Identity() resourceids.ResourceId {
+	return &servicelinker.ScopedLinkerId{}
+}
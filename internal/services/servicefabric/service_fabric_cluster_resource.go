@@ -25,7 +25,7 @@ import (
 )
 
 func resourceServiceFabricCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceServiceFabricClusterCreateUpdate,
 		Read:   resourceServiceFabricClusterRead,
 		Update: resourceServiceFabricClusterCreateUpdate,
@@ -561,6 +561,10 @@ func resourceServiceFabricCluster() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &cluster.ClusterId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &cluster.ClusterId{})
 }
 
 func resourceServiceFabricClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2021-05-01/managedcluster"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2021-05-01/nodetype"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -101,6 +102,7 @@ const (
 type ClusterResource struct{}
 
 var _ sdk.ResourceWithCustomizeDiff = ClusterResource{}
+var _ sdk.ResourceWithIdentity = ClusterResource{}
 
 type ClusterResourceModel struct {
 	BackupRestoreService bool   `tfschema:"backup_service_enabled"`
@@ -552,6 +554,10 @@ func (k ClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return managedcluster.ValidateManagedClusterID
 }
 
+func (k ClusterResource) Identity() resourceids.ResourceId {
+	return &managedcluster.ManagedClusterId{}
+}
+
 func flattenClusterProperties(cluster *managedcluster.ManagedCluster) *ClusterResourceModel {
 	model := &ClusterResourceModel{}
 	if cluster == nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/frontendsinterface"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/trafficcontrollerinterface"
@@ -31,6 +32,7 @@ type FrontendsModel struct {
 }
 
 var _ sdk.Resource = FrontendsResource{}
+var _ sdk.ResourceWithIdentity = FrontendsResource{}
 
 func (f FrontendsResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -73,6 +75,10 @@ func (f FrontendsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return frontendsinterface.ValidateFrontendID
 }
 
+func (f FrontendsResource) Identity() resourceids.ResourceId {
+	return &frontendsinterface.FrontendId{}
+}
+
 func (f FrontendsResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
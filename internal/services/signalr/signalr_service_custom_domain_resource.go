@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2023-02-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -27,6 +28,7 @@ type CustomDomainSignalrServiceModel struct {
 type CustomDomainSignalrServiceResource struct{}
 
 var _ sdk.Resource = CustomDomainSignalrServiceResource{}
+var _ sdk.ResourceWithIdentity = CustomDomainSignalrServiceResource{}
 
 func (r CustomDomainSignalrServiceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -237,6 +239,10 @@ func (r CustomDomainSignalrServiceResource) IDValidationFunc() pluginsdk.SchemaV
 	return signalr.ValidateCustomDomainID
 }
 
+func (r CustomDomainSignalrServiceResource) Identity() resourceids.ResourceId {
+	return &signalr.CustomDomainId{}
+}
+
 func signalrServiceCustomDomainProvisioningStateRefreshFunc(ctx context.Context, client *signalr.SignalRClient, id signalr.CustomDomainId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.CustomDomainsGet(ctx, id)
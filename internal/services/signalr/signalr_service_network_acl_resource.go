@@ -20,7 +20,7 @@ import (
 )
 
 func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSignalRServiceNetworkACLCreateUpdate,
 		Read:   resourceSignalRServiceNetworkACLRead,
 		Update: resourceSignalRServiceNetworkACLCreateUpdate,
@@ -141,7 +141,7 @@ func resourceArmSignalRServiceNetworkACL() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &signalr.SignalRId{})
 }
 
 func resourceSignalRServiceNetworkACLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -31,7 +31,7 @@ import (
 )
 
 func resourceArmSignalRService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmSignalRServiceCreate,
 		Read:   resourceArmSignalRServiceRead,
 		Update: resourceArmSignalRServiceUpdate,
@@ -56,6 +56,10 @@ func resourceArmSignalRService() *pluginsdk.Resource {
 
 		Schema: resourceArmSignalRServiceSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &signalr.SignalRId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &signalr.SignalRId{})
 }
 
 func resourceArmSignalRServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
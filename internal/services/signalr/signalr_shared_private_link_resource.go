@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSignalRSharedPrivateLinkResource() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSignalRSharedPrivateLinkCreateUpdate,
 		Read:   resourceSignalRSharedPrivateLinkRead,
 		Update: resourceSignalRSharedPrivateLinkCreateUpdate,
@@ -79,7 +79,7 @@ func resourceSignalRSharedPrivateLinkResource() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &signalr.SharedPrivateLinkResourceId{})
 }
 
 func resourceSignalRSharedPrivateLinkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -27,6 +28,7 @@ type CustomDomainWebPubsubModel struct {
 type CustomDomainWebPubsubResource struct{}
 
 var _ sdk.Resource = CustomDomainWebPubsubResource{}
+var _ sdk.ResourceWithIdentity = CustomDomainWebPubsubResource{}
 
 func (r CustomDomainWebPubsubResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -237,6 +239,10 @@ func (r CustomDomainWebPubsubResource) IDValidationFunc() pluginsdk.SchemaValida
 	return webpubsub.ValidateCustomDomainID
 }
 
+func (r CustomDomainWebPubsubResource) Identity() resourceids.ResourceId {
+	return &webpubsub.CustomDomainId{}
+}
+
 func webPubsubCustomDomainProvisioningStateRefreshFunc(ctx context.Context, client *webpubsub.WebPubSubClient, id webpubsub.CustomDomainId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.CustomDomainsGet(ctx, id)
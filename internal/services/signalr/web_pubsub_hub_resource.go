@@ -24,7 +24,7 @@ import (
 )
 
 func resourceWebPubSubHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceWebPubSubHubCreateUpdate,
 		Read:   resourceWebPubSubHubRead,
 		Update: resourceWebPubSubHubCreateUpdate,
@@ -155,7 +155,7 @@ func resourceWebPubSubHub() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &webpubsub.HubId{})
 }
 
 func resourceWebPubSubHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
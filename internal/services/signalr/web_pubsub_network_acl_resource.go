@@ -30,7 +30,7 @@ var defaultRequestTypes = []webpubsub.WebPubSubRequestType{
 }
 
 func resourceWebpubsubNetworkACL() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceWebPubsubNetworkACLCreateUpdate,
 		Read:   resourceWebPubsubNetworkACLRead,
 		Update: resourceWebPubsubNetworkACLCreateUpdate,
@@ -122,7 +122,7 @@ func resourceWebpubsubNetworkACL() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &webpubsub.WebPubSubId{})
 }
 
 func resourceWebPubsubNetworkACLCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -30,7 +30,7 @@ import (
 )
 
 func resourceWebPubSub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceWebPubSubCreateUpdate,
 		Read:   resourceWebPubSubRead,
 		Update: resourceWebPubSubCreateUpdate,
@@ -194,6 +194,10 @@ func resourceWebPubSub() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &webpubsub.WebPubSubId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &webpubsub.WebPubSubId{})
 }
 
 func resourceWebPubSubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceWebPubSubSharedPrivateLinkService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceWebPubsubSharedPrivateLinkServiceCreateUpdate,
 		Read:   resourceWebPubsubSharedPrivateLinkServiceRead,
 		Update: resourceWebPubsubSharedPrivateLinkServiceCreateUpdate,
@@ -75,7 +75,7 @@ func resourceWebPubSubSharedPrivateLinkService() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &webpubsub.SharedPrivateLinkResourceId{})
 }
 
 func resourceWebPubsubSharedPrivateLinkServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -43,6 +43,22 @@ func (id SpringCloudAcceleratorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ApplicationAcceleratorName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudAccelerator ID
+func (id SpringCloudAcceleratorId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "spring1"),
+		resourceids.StaticSegment("staticApplicationAccelerators", "applicationAccelerators", "applicationAccelerators"),
+		resourceids.UserSpecifiedSegment("applicationAcceleratorName", "default"),
+	}
+}
+
 // SpringCloudAcceleratorID parses a SpringCloudAccelerator ID into an SpringCloudAcceleratorId struct
 func SpringCloudAcceleratorID(input string) (*SpringCloudAcceleratorId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SpringCloudAcceleratorIDInsensitively(input string) (*SpringCloudAccelerato
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudAcceleratorId using the ParseResult provided in 'input'
+func (id *SpringCloudAcceleratorId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ApplicationAcceleratorName, ok = input.Parsed["applicationAcceleratorName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationAcceleratorName", input)
+	}
+
+	return nil
+}
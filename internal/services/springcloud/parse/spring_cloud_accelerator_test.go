@@ -21,6 +21,23 @@ func TestSpringCloudAcceleratorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAcceleratorIDSegments(t *testing.T) {
+	expected := NewSpringCloudAcceleratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudAcceleratorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudAcceleratorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudAcceleratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id SpringCloudAPIPortalCustomDomainId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ApiPortalName, id.DomainName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudAPIPortalCustomDomain ID
+func (id SpringCloudAPIPortalCustomDomainId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticApiPortals", "apiPortals", "apiPortals"),
+		resourceids.UserSpecifiedSegment("apiPortalName", "apiPortal1"),
+		resourceids.StaticSegment("staticDomains", "domains", "domains"),
+		resourceids.UserSpecifiedSegment("domainName", "domain1"),
+	}
+}
+
 // SpringCloudAPIPortalCustomDomainID parses a SpringCloudAPIPortalCustomDomain ID into an SpringCloudAPIPortalCustomDomainId struct
 func SpringCloudAPIPortalCustomDomainID(input string) (*SpringCloudAPIPortalCustomDomainId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -150,3 +168,30 @@ func SpringCloudAPIPortalCustomDomainIDInsensitively(input string) (*SpringCloud
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudAPIPortalCustomDomainId using the ParseResult provided in 'input'
+func (id *SpringCloudAPIPortalCustomDomainId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ApiPortalName, ok = input.Parsed["apiPortalName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "apiPortalName", input)
+	}
+
+	if id.DomainName, ok = input.Parsed["domainName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "domainName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSpringCloudAPIPortalCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAPIPortalCustomDomainIDSegments(t *testing.T) {
+	expected := NewSpringCloudAPIPortalCustomDomainID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "apiPortal1", "domain1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudAPIPortalCustomDomainId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudAPIPortalCustomDomainId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudAPIPortalCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
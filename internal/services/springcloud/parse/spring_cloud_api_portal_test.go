@@ -21,6 +21,23 @@ func TestSpringCloudAPIPortalIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAPIPortalIDSegments(t *testing.T) {
+	expected := NewSpringCloudAPIPortalID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "apiPortal1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudAPIPortalId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudAPIPortalId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudAPIPortalID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id SpringCloudAppId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.AppName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudApp ID
+func (id SpringCloudAppId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "spring1"),
+		resourceids.StaticSegment("staticApps", "apps", "apps"),
+		resourceids.UserSpecifiedSegment("appName", "app1"),
+	}
+}
+
 // SpringCloudAppID parses a SpringCloudApp ID into an SpringCloudAppId struct
 func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SpringCloudAppIDInsensitively(input string) (*SpringCloudAppId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudAppId using the ParseResult provided in 'input'
+func (id *SpringCloudAppId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.AppName, ok = input.Parsed["appName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "appName", input)
+	}
+
+	return nil
+}
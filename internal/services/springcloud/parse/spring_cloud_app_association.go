@@ -46,6 +46,24 @@ func (id SpringCloudAppAssociationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.AppName, id.BindingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudAppAssociation ID
+func (id SpringCloudAppAssociationId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "spring1"),
+		resourceids.StaticSegment("staticApps", "apps", "apps"),
+		resourceids.UserSpecifiedSegment("appName", "app1"),
+		resourceids.StaticSegment("staticBindings", "bindings", "bindings"),
+		resourceids.UserSpecifiedSegment("bindingName", "bind1"),
+	}
+}
+
 // SpringCloudAppAssociationID parses a SpringCloudAppAssociation ID into an SpringCloudAppAssociationId struct
 func SpringCloudAppAssociationID(input string) (*SpringCloudAppAssociationId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -150,3 +168,30 @@ func SpringCloudAppAssociationIDInsensitively(input string) (*SpringCloudAppAsso
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudAppAssociationId using the ParseResult provided in 'input'
+func (id *SpringCloudAppAssociationId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.AppName, ok = input.Parsed["appName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "appName", input)
+	}
+
+	if id.BindingName, ok = input.Parsed["bindingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "bindingName", input)
+	}
+
+	return nil
+}
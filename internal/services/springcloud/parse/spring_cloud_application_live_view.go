@@ -43,6 +43,22 @@ func (id SpringCloudApplicationLiveViewId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ApplicationLiveViewName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudApplicationLiveView ID
+func (id SpringCloudApplicationLiveViewId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticApplicationLiveViews", "applicationLiveViews", "applicationLiveViews"),
+		resourceids.UserSpecifiedSegment("applicationLiveViewName", "default"),
+	}
+}
+
 // SpringCloudApplicationLiveViewID parses a SpringCloudApplicationLiveView ID into an SpringCloudApplicationLiveViewId struct
 func SpringCloudApplicationLiveViewID(input string) (*SpringCloudApplicationLiveViewId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func SpringCloudApplicationLiveViewID(input string) (*SpringCloudApplicationLive
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudApplicationLiveViewId using the ParseResult provided in 'input'
+func (id *SpringCloudApplicationLiveViewId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ApplicationLiveViewName, ok = input.Parsed["applicationLiveViewName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "applicationLiveViewName", input)
+	}
+
+	return nil
+}
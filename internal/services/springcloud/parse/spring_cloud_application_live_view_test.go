@@ -21,6 +21,23 @@ func TestSpringCloudApplicationLiveViewIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudApplicationLiveViewIDSegments(t *testing.T) {
+	expected := NewSpringCloudApplicationLiveViewID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudApplicationLiveViewId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudApplicationLiveViewId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudApplicationLiveViewID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSpringCloudBuildPackBindingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudBuildPackBindingIDSegments(t *testing.T) {
+	expected := NewSpringCloudBuildPackBindingID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "buildService1", "builder1", "buildPackBinding1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudBuildPackBindingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudBuildPackBindingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudBuildPackBindingID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id SpringCloudBuildServiceBuilderId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.BuildServiceName, id.BuilderName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudBuildServiceBuilder ID
+func (id SpringCloudBuildServiceBuilderId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticBuildServices", "buildServices", "buildServices"),
+		resourceids.UserSpecifiedSegment("buildServiceName", "buildService1"),
+		resourceids.StaticSegment("staticBuilders", "builders", "builders"),
+		resourceids.UserSpecifiedSegment("builderName", "builder1"),
+	}
+}
+
 // SpringCloudBuildServiceBuilderID parses a SpringCloudBuildServiceBuilder ID into an SpringCloudBuildServiceBuilderId struct
 func SpringCloudBuildServiceBuilderID(input string) (*SpringCloudBuildServiceBuilderId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -150,3 +168,30 @@ func SpringCloudBuildServiceBuilderIDInsensitively(input string) (*SpringCloudBu
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudBuildServiceBuilderId using the ParseResult provided in 'input'
+func (id *SpringCloudBuildServiceBuilderId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.BuildServiceName, ok = input.Parsed["buildServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "buildServiceName", input)
+	}
+
+	if id.BuilderName, ok = input.Parsed["builderName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "builderName", input)
+	}
+
+	return nil
+}
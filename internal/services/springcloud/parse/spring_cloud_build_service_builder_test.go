@@ -21,6 +21,23 @@ func TestSpringCloudBuildServiceBuilderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudBuildServiceBuilderIDSegments(t *testing.T) {
+	expected := NewSpringCloudBuildServiceBuilderID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "buildService1", "builder1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudBuildServiceBuilderId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudBuildServiceBuilderId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudBuildServiceBuilderID(t *testing.T) {
 	testData := []struct {
 		Input    string
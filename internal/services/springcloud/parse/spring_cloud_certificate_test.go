@@ -21,6 +21,23 @@ func TestSpringCloudCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudCertificateIDSegments(t *testing.T) {
+	expected := NewSpringCloudCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "cert1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudCertificateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudCertificateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
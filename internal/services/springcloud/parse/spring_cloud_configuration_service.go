@@ -43,6 +43,22 @@ func (id SpringCloudConfigurationServiceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ConfigurationServiceName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudConfigurationService ID
+func (id SpringCloudConfigurationServiceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticConfigurationServices", "configurationServices", "configurationServices"),
+		resourceids.UserSpecifiedSegment("configurationServiceName", "configurationService1"),
+	}
+}
+
 // SpringCloudConfigurationServiceID parses a SpringCloudConfigurationService ID into an SpringCloudConfigurationServiceId struct
 func SpringCloudConfigurationServiceID(input string) (*SpringCloudConfigurationServiceId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SpringCloudConfigurationServiceIDInsensitively(input string) (*SpringCloudC
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudConfigurationServiceId using the ParseResult provided in 'input'
+func (id *SpringCloudConfigurationServiceId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ConfigurationServiceName, ok = input.Parsed["configurationServiceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "configurationServiceName", input)
+	}
+
+	return nil
+}
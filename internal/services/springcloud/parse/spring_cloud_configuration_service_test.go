@@ -21,6 +21,23 @@ func TestSpringCloudConfigurationServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudConfigurationServiceIDSegments(t *testing.T) {
+	expected := NewSpringCloudConfigurationServiceID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "configurationService1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudConfigurationServiceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudConfigurationServiceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudConfigurationServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
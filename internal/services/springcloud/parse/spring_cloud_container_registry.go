@@ -43,6 +43,22 @@ func (id SpringCloudContainerRegistryId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.ContainerRegistryName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudContainerRegistry ID
+func (id SpringCloudContainerRegistryId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "spring1"),
+		resourceids.StaticSegment("staticContainerRegistries", "containerRegistries", "containerRegistries"),
+		resourceids.UserSpecifiedSegment("containerRegistryName", "containerRegistry1"),
+	}
+}
+
 // SpringCloudContainerRegistryID parses a SpringCloudContainerRegistry ID into an SpringCloudContainerRegistryId struct
 func SpringCloudContainerRegistryID(input string) (*SpringCloudContainerRegistryId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SpringCloudContainerRegistryIDInsensitively(input string) (*SpringCloudCont
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudContainerRegistryId using the ParseResult provided in 'input'
+func (id *SpringCloudContainerRegistryId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.ContainerRegistryName, ok = input.Parsed["containerRegistryName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "containerRegistryName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSpringCloudContainerRegistryIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudContainerRegistryIDSegments(t *testing.T) {
+	expected := NewSpringCloudContainerRegistryID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "containerRegistry1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudContainerRegistryId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudContainerRegistryId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudContainerRegistryID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSpringCloudCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudCustomDomainIDSegments(t *testing.T) {
+	expected := NewSpringCloudCustomDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1", "domain.com")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudCustomDomainId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudCustomDomainId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSpringCloudCustomizedAcceleratorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudCustomizedAcceleratorIDSegments(t *testing.T) {
+	expected := NewSpringCloudCustomizedAcceleratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "default", "customizedAccelerator1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudCustomizedAcceleratorId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudCustomizedAcceleratorId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudCustomizedAcceleratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
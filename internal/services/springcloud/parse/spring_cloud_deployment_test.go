@@ -21,6 +21,23 @@ func TestSpringCloudDeploymentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudDeploymentIDSegments(t *testing.T) {
+	expected := NewSpringCloudDeploymentID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1", "deploy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudDeploymentId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudDeploymentId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudDeploymentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSpringCloudDevToolPortalIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudDevToolPortalIDSegments(t *testing.T) {
+	expected := NewSpringCloudDevToolPortalID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudDevToolPortalId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudDevToolPortalId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudDevToolPortalID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSpringCloudGatewayCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudGatewayCustomDomainIDSegments(t *testing.T) {
+	expected := NewSpringCloudGatewayCustomDomainID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "gateway1", "domain1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudGatewayCustomDomainId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudGatewayCustomDomainId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudGatewayCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -46,6 +46,24 @@ func (id SpringCloudGatewayRouteConfigId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.GatewayName, id.RouteConfigName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SpringCloudGatewayRouteConfig ID
+func (id SpringCloudGatewayRouteConfigId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resourceGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform", "Microsoft.AppPlatform"),
+		resourceids.StaticSegment("staticSpring", "spring", "spring"),
+		resourceids.UserSpecifiedSegment("springName", "service1"),
+		resourceids.StaticSegment("staticGateways", "gateways", "gateways"),
+		resourceids.UserSpecifiedSegment("gatewayName", "gateway1"),
+		resourceids.StaticSegment("staticRouteConfigs", "routeConfigs", "routeConfigs"),
+		resourceids.UserSpecifiedSegment("routeConfigName", "routeConfig1"),
+	}
+}
+
 // SpringCloudGatewayRouteConfigID parses a SpringCloudGatewayRouteConfig ID into an SpringCloudGatewayRouteConfigId struct
 func SpringCloudGatewayRouteConfigID(input string) (*SpringCloudGatewayRouteConfigId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -150,3 +168,30 @@ func SpringCloudGatewayRouteConfigIDInsensitively(input string) (*SpringCloudGat
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SpringCloudGatewayRouteConfigId using the ParseResult provided in 'input'
+func (id *SpringCloudGatewayRouteConfigId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SpringName, ok = input.Parsed["springName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "springName", input)
+	}
+
+	if id.GatewayName, ok = input.Parsed["gatewayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "gatewayName", input)
+	}
+
+	if id.RouteConfigName, ok = input.Parsed["routeConfigName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "routeConfigName", input)
+	}
+
+	return nil
+}
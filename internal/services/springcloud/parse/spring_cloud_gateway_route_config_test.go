@@ -21,6 +21,23 @@ func TestSpringCloudGatewayRouteConfigIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudGatewayRouteConfigIDSegments(t *testing.T) {
+	expected := NewSpringCloudGatewayRouteConfigID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "gateway1", "routeConfig1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudGatewayRouteConfigId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudGatewayRouteConfigId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudGatewayRouteConfigID(t *testing.T) {
 	testData := []struct {
 		Input    string
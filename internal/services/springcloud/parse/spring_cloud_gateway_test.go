@@ -21,6 +21,23 @@ func TestSpringCloudGatewayIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudGatewayIDSegments(t *testing.T) {
+	expected := NewSpringCloudGatewayID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "service1", "gateway1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudGatewayId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudGatewayId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudGatewayID(t *testing.T) {
 	testData := []struct {
 		Input    string
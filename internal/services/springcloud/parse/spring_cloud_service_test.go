@@ -21,6 +21,23 @@ func TestSpringCloudServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudServiceIDSegments(t *testing.T) {
+	expected := NewSpringCloudServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SpringCloudServiceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SpringCloudServiceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSpringCloudServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
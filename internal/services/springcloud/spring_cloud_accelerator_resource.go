@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/migration"
@@ -42,6 +43,10 @@ func (s SpringCloudAcceleratorResource) IDValidationFunc() pluginsdk.SchemaValid
 	return validate.SpringCloudAcceleratorID
 }
 
+func (s SpringCloudAcceleratorResource) Identity() resourceids.ResourceId {
+	return &parse.SpringCloudAcceleratorId{}
+}
+
 func (s SpringCloudAcceleratorResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSpringCloudActiveDeployment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudActiveDeploymentCreate,
 		Read:   resourceSpringCloudActiveDeploymentRead,
 		Update: resourceSpringCloudActiveDeploymentUpdate,
@@ -57,7 +57,7 @@ func resourceSpringCloudActiveDeployment() *pluginsdk.Resource {
 				ValidateFunc: validate.SpringCloudDeploymentName,
 			},
 		},
-	}
+	}, &parse.SpringCloudAppId{})
 }
 
 func resourceSpringCloudActiveDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSpringCloudAPIPortalCustomDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudAPIPortalCustomDomainCreateUpdate,
 		Read:   resourceSpringCloudAPIPortalCustomDomainRead,
 		Update: resourceSpringCloudAPIPortalCustomDomainCreateUpdate,
@@ -62,7 +62,7 @@ func resourceSpringCloudAPIPortalCustomDomain() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &parse.SpringCloudAPIPortalCustomDomainId{})
 }
 func resourceSpringCloudAPIPortalCustomDomainCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/migration"
@@ -41,6 +42,7 @@ type SpringCloudAPIPortalResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudAPIPortalResource{}
 var _ sdk.ResourceWithStateMigration = SpringCloudAPIPortalResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudAPIPortalResource{}
 
 func (s SpringCloudAPIPortalResource) ResourceType() string {
 	return "azurerm_spring_cloud_api_portal"
@@ -54,6 +56,10 @@ func (s SpringCloudAPIPortalResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return appplatform.ValidateApiPortalID
 }
 
+func (s SpringCloudAPIPortalResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApiPortalId{}
+}
+
 func (s SpringCloudAPIPortalResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -35,7 +35,7 @@ const (
 )
 
 func resourceSpringCloudAppCosmosDBAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudAppCosmosDBAssociationCreateUpdate,
 		Read:   resourceSpringCloudAppCosmosDBAssociationRead,
 		Update: resourceSpringCloudAppCosmosDBAssociationCreateUpdate,
@@ -136,7 +136,7 @@ func resourceSpringCloudAppCosmosDBAssociation() *pluginsdk.Resource {
 				ConflictsWith: []string{"cosmosdb_cassandra_keyspace_name", "cosmosdb_gremlin_database_name", "cosmosdb_gremlin_graph_name", "cosmosdb_mongo_database_name"},
 			},
 		},
-	}
+	}, &parse.SpringCloudAppAssociationId{})
 }
 
 func resourceSpringCloudAppCosmosDBAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -38,6 +38,7 @@ type SpringCloudAppDynamicsApplicationPerformanceMonitoringModel struct {
 type SpringCloudAppDynamicsApplicationPerformanceMonitoringResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
 
 func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_app_dynamics_application_performance_monitoring"
@@ -51,6 +52,10 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) IDValida
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -28,7 +28,7 @@ const (
 )
 
 func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudAppMysqlAssociationCreateUpdate,
 		Read:   resourceSpringCloudAppMysqlAssociationRead,
 		Update: resourceSpringCloudAppMysqlAssociationCreateUpdate,
@@ -95,7 +95,7 @@ func resourceSpringCloudAppMysqlAssociation() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.SpringCloudAppAssociationId{})
 }
 
 func resourceSpringCloudAppMysqlAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
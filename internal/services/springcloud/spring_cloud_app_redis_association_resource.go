@@ -25,7 +25,7 @@ import (
 const springCloudAppRedisAssociationKeySSL = "useSsl"
 
 func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudAppRedisAssociationCreateUpdate,
 		Read:   resourceSpringCloudAppRedisAssociationRead,
 		Update: resourceSpringCloudAppRedisAssociationCreateUpdate,
@@ -82,7 +82,7 @@ func resourceSpringCloudAppRedisAssociation() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &parse.SpringCloudAppAssociationId{})
 }
 
 func resourceSpringCloudAppRedisAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
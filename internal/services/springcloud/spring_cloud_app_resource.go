@@ -26,7 +26,7 @@ import (
 )
 
 func resourceSpringCloudApp() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudAppCreate,
 		Read:   resourceSpringCloudAppRead,
 		Update: resourceSpringCloudAppUpdate,
@@ -224,7 +224,7 @@ func resourceSpringCloudApp() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.SpringCloudAppId{})
 }
 
 func resourceSpringCloudAppCreate(d *pluginsdk.ResourceData, meta interface{}) error {
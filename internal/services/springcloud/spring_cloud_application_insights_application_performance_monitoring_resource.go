@@ -35,6 +35,7 @@ type SpringCloudApplicationInsightsApplicationPerformanceMonitoringModel struct
 type SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
 
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_application_insights_application_performance_monitoring"
@@ -48,6 +49,10 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
@@ -40,6 +41,10 @@ func (s SpringCloudApplicationLiveViewResource) IDValidationFunc() pluginsdk.Sch
 	return validate.SpringCloudApplicationLiveViewID
 }
 
+func (s SpringCloudApplicationLiveViewResource) Identity() resourceids.ResourceId {
+	return &parse.SpringCloudApplicationLiveViewId{}
+}
+
 func (s SpringCloudApplicationLiveViewResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
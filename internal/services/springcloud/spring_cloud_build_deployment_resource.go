@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSpringCloudBuildDeployment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudBuildDeploymentCreateUpdate,
 		Read:   resourceSpringCloudBuildDeploymentRead,
 		Update: resourceSpringCloudBuildDeploymentCreateUpdate,
@@ -126,7 +126,7 @@ func resourceSpringCloudBuildDeployment() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.SpringCloudDeploymentId{})
 }
 
 func resourceSpringCloudBuildDeploymentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSpringCloudBuildPackBinding() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudBuildPackBindingCreateUpdate,
 		Read:   resourceSpringCloudBuildPackBindingRead,
 		Update: resourceSpringCloudBuildPackBindingCreateUpdate,
@@ -98,7 +98,7 @@ func resourceSpringCloudBuildPackBinding() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.SpringCloudBuildPackBindingId{})
 }
 func resourceSpringCloudBuildPackBindingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSpringCloudBuildServiceBuilder() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudBuildServiceBuilderCreateUpdate,
 		Read:   resourceSpringCloudBuildServiceBuilderRead,
 		Update: resourceSpringCloudBuildServiceBuilderCreateUpdate,
@@ -104,7 +104,7 @@ func resourceSpringCloudBuildServiceBuilder() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.SpringCloudBuildServiceBuilderId{})
 }
 func resourceSpringCloudBuildServiceBuilderCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -25,7 +25,7 @@ import (
 )
 
 func resourceSpringCloudCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudCertificateCreate,
 		Read:   resourceSpringCloudCertificateRead,
 		Delete: resourceSpringCloudCertificateDelete,
@@ -90,7 +90,7 @@ func resourceSpringCloudCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.SpringCloudCertificateId{})
 }
 
 func resourceSpringCloudCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceSpringCloudContainerDeployment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudContainerDeploymentCreateUpdate,
 		Read:   resourceSpringCloudContainerDeploymentRead,
 		Update: resourceSpringCloudContainerDeploymentCreateUpdate,
@@ -159,7 +159,7 @@ func resourceSpringCloudContainerDeployment() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.SpringCloudDeploymentId{})
 }
 
 func resourceSpringCloudContainerDeploymentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSpringCloudCustomDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudCustomDomainCreateUpdate,
 		Read:   resourceSpringCloudCustomDomainRead,
 		Update: resourceSpringCloudCustomDomainCreateUpdate,
@@ -74,7 +74,7 @@ func resourceSpringCloudCustomDomain() *pluginsdk.Resource {
 				RequiredWith: []string{"certificate_name"},
 			},
 		},
-	}
+	}, &parse.SpringCloudCustomDomainId{})
 }
 
 func resourceSpringCloudCustomDomainCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -59,6 +60,7 @@ type SpringCloudCustomizedAcceleratorResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudCustomizedAcceleratorResource{}
 var _ sdk.ResourceWithStateMigration = SpringCloudCustomizedAcceleratorResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudCustomizedAcceleratorResource{}
 
 func (s SpringCloudCustomizedAcceleratorResource) ResourceType() string {
 	return "azurerm_spring_cloud_customized_accelerator"
@@ -72,6 +74,10 @@ func (s SpringCloudCustomizedAcceleratorResource) IDValidationFunc() pluginsdk.S
 	return appplatform.ValidateCustomizedAcceleratorID
 }
 
+func (s SpringCloudCustomizedAcceleratorResource) Identity() resourceids.ResourceId {
+	return &appplatform.CustomizedAcceleratorId{}
+}
+
 func (s SpringCloudCustomizedAcceleratorResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
@@ -51,6 +52,10 @@ func (s SpringCloudDevToolPortalResource) IDValidationFunc() pluginsdk.SchemaVal
 	return validate.SpringCloudDevToolPortalID
 }
 
+func (s SpringCloudDevToolPortalResource) Identity() resourceids.ResourceId {
+	return &parse.SpringCloudDevToolPortalId{}
+}
+
 func (s SpringCloudDevToolPortalResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
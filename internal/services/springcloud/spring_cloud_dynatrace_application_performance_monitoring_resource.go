@@ -34,6 +34,7 @@ type SpringCloudDynatraceApplicationPerformanceMonitoringModel struct {
 type SpringCloudDynatraceApplicationPerformanceMonitoringResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
 
 func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_dynatrace_application_performance_monitoring"
@@ -47,6 +48,10 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) IDValidati
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -32,6 +32,7 @@ type SpringCloudElasticApplicationPerformanceMonitoringModel struct {
 type SpringCloudElasticApplicationPerformanceMonitoringResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudElasticApplicationPerformanceMonitoringResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudElasticApplicationPerformanceMonitoringResource{}
 
 func (s SpringCloudElasticApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_elastic_application_performance_monitoring"
@@ -45,6 +46,10 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) IDValidation
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSpringCloudGatewayCustomDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudGatewayCustomDomainCreateUpdate,
 		Read:   resourceSpringCloudGatewayCustomDomainRead,
 		Update: resourceSpringCloudGatewayCustomDomainCreateUpdate,
@@ -64,7 +64,7 @@ func resourceSpringCloudGatewayCustomDomain() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.SpringCloudGatewayCustomDomainId{})
 }
 func resourceSpringCloudGatewayCustomDomainCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/migration"
@@ -82,6 +83,7 @@ type SpringCloudGatewayResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudGatewayResource{}
 var _ sdk.ResourceWithStateMigration = SpringCloudGatewayResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudGatewayResource{}
 
 func (s SpringCloudGatewayResource) ResourceType() string {
 	return "azurerm_spring_cloud_gateway"
@@ -95,6 +97,10 @@ func (s SpringCloudGatewayResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return appplatform.ValidateGatewayID
 }
 
+func (s SpringCloudGatewayResource) Identity() resourceids.ResourceId {
+	return &appplatform.GatewayId{}
+}
+
 func (s SpringCloudGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
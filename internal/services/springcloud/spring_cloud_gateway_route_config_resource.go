@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSpringCloudGatewayRouteConfig() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudGatewayRouteConfigCreateUpdate,
 		Read:   resourceSpringCloudGatewayRouteConfigRead,
 		Update: resourceSpringCloudGatewayRouteConfigCreateUpdate,
@@ -188,7 +188,7 @@ func resourceSpringCloudGatewayRouteConfig() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
-	}
+	}, &parse.SpringCloudGatewayRouteConfigId{})
 }
 
 func resourceSpringCloudGatewayRouteConfigCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSpringCloudJavaDeployment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudJavaDeploymentCreate,
 		Read:   resourceSpringCloudJavaDeploymentRead,
 		Update: resourceSpringCloudJavaDeploymentUpdate,
@@ -46,7 +46,7 @@ func resourceSpringCloudJavaDeployment() *pluginsdk.Resource {
 		},
 
 		Schema: resourceSprintCloudJavaDeploymentSchema(),
-	}
+	}, &parse.SpringCloudDeploymentId{})
 }
 
 func resourceSpringCloudJavaDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -40,6 +40,7 @@ type SpringCloudNewRelicApplicationPerformanceMonitoringModel struct {
 type SpringCloudNewRelicApplicationPerformanceMonitoringResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
+var _ sdk.ResourceWithIdentity = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
 
 func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) ResourceType() string {
 	return "azurerm_spring_cloud_new_relic_application_performance_monitoring"
@@ -53,6 +54,10 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) IDValidatio
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -30,7 +30,7 @@ import (
 )
 
 func resourceSpringCloudService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSpringCloudServiceCreate,
 		Read:   resourceSpringCloudServiceRead,
 		Update: resourceSpringCloudServiceUpdate,
@@ -424,6 +424,10 @@ func resourceSpringCloudService() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.SpringCloudServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.SpringCloudServiceId{})
 }
 
 func resourceSpringCloudServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
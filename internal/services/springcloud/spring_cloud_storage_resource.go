@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSpringCloudStorage() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSpringCloudStorageCreateUpdate,
 		Read:   resourceSpringCloudStorageRead,
 		Update: resourceSpringCloudStorageCreateUpdate,
@@ -71,7 +71,7 @@ func resourceSpringCloudStorage() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.SpringCloudStorageId{})
 }
 func resourceSpringCloudStorageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -21,6 +21,23 @@ func TestFailoverGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFailoverGroupIDSegments(t *testing.T) {
+	expected := NewFailoverGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "failoverGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FailoverGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FailoverGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFailoverGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestInstanceFailoverGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestInstanceFailoverGroupIDSegments(t *testing.T) {
+	expected := NewInstanceFailoverGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "Location", "failoverGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&InstanceFailoverGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := InstanceFailoverGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestInstanceFailoverGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestManagedDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedDatabaseIDSegments(t *testing.T) {
+	expected := NewManagedDatabaseID("12345678-1234-9876-4563-123456789012", "resGroup1", "instance1", "database1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedDatabaseId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedDatabaseId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
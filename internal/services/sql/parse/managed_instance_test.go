@@ -21,6 +21,23 @@ func TestManagedInstanceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedInstanceIDSegments(t *testing.T) {
+	expected := NewManagedInstanceID("12345678-1234-9876-4563-123456789012", "resGroup1", "instance1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedInstanceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedInstanceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedInstanceID(t *testing.T) {
 	testData := []struct {
 		Input    string
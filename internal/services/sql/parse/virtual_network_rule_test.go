@@ -21,6 +21,23 @@ func TestVirtualNetworkRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkRuleIDSegments(t *testing.T) {
+	expected := NewVirtualNetworkRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "virtualNetworkRule1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VirtualNetworkRuleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VirtualNetworkRuleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVirtualNetworkRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
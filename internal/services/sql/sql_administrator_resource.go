@@ -23,7 +23,7 @@ import (
 )
 
 func resourceSqlAdministrator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSqlActiveDirectoryAdministratorCreateUpdate,
 		Read:   resourceSqlActiveDirectoryAdministratorRead,
 		Update: resourceSqlActiveDirectoryAdministratorCreateUpdate,
@@ -80,7 +80,7 @@ func resourceSqlAdministrator() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.AzureActiveDirectoryAdministratorId{})
 }
 
 func resourceSqlActiveDirectoryAdministratorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
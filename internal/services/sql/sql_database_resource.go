@@ -28,7 +28,7 @@ import (
 )
 
 func resourceSqlDatabase() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSqlDatabaseCreateUpdate,
 		Read:   resourceSqlDatabaseRead,
 		Update: resourceSqlDatabaseCreateUpdate,
@@ -68,6 +68,10 @@ func resourceSqlDatabase() *pluginsdk.Resource {
 			return nil
 		}),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.DatabaseId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.DatabaseId{})
 }
 
 func resourceSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSqlElasticPool() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSqlElasticPoolCreateUpdate,
 		Read:   resourceSqlElasticPoolRead,
 		Update: resourceSqlElasticPoolCreateUpdate,
@@ -102,6 +102,10 @@ func resourceSqlElasticPool() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ElasticPoolId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ElasticPoolId{})
 }
 
 func resourceSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceSqlFailoverGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSqlFailoverGroupCreateUpdate,
 		Read:   resourceSqlFailoverGroupRead,
 		Update: resourceSqlFailoverGroupCreateUpdate,
@@ -143,6 +143,10 @@ func resourceSqlFailoverGroup() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.FailoverGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.FailoverGroupId{})
 }
 
 func resourceSqlFailoverGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
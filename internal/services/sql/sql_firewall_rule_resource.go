@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSqlFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSqlFirewallRuleCreateUpdate,
 		Read:   resourceSqlFirewallRuleRead,
 		Update: resourceSqlFirewallRuleCreateUpdate,
@@ -75,7 +75,7 @@ func resourceSqlFirewallRule() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &parse.FirewallRuleId{})
 }
 
 func resourceSqlFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceArmSqlManagedDatabase() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceArmSqlManagedDatabaseCreate,
 		Read:   resourceArmSqlManagedDatabaseRead,
 		Delete: resourceArmSqlManagedDatabaseDelete,
@@ -59,6 +59,10 @@ func resourceArmSqlManagedDatabase() *schema.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ManagedDatabaseId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ManagedDatabaseId{})
 }
 
 func resourceArmSqlManagedDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
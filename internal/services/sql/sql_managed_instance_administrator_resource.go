@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSqlManagedInstanceAdministrator() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSqlManagedInstanceActiveDirectoryAdministratorCreateUpdate,
 		Read:   resourceSqlManagedInstanceActiveDirectoryAdministratorRead,
 		Update: resourceSqlManagedInstanceActiveDirectoryAdministratorCreateUpdate,
@@ -74,7 +74,7 @@ func resourceSqlManagedInstanceAdministrator() *pluginsdk.Resource {
 				Default:  false,
 			},
 		},
-	}
+	}, &parse.ManagedInstanceAzureActiveDirectoryAdministratorId{})
 }
 
 func resourceSqlManagedInstanceActiveDirectoryAdministratorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSqlInstanceFailoverGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSqlInstanceFailoverGroupCreateUpdate,
 		Read:   resourceSqlInstanceFailoverGroupRead,
 		Update: resourceSqlInstanceFailoverGroupCreateUpdate,
@@ -118,6 +118,10 @@ func resourceSqlInstanceFailoverGroup() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.InstanceFailoverGroupId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.InstanceFailoverGroupId{})
 }
 
 func resourceSqlInstanceFailoverGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -29,7 +29,7 @@ import (
 )
 
 func resourceArmSqlMiServer() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceArmSqlMiServerCreateUpdate,
 		Read:   resourceArmSqlMiServerRead,
 		Update: resourceArmSqlMiServerCreateUpdate,
@@ -203,6 +203,10 @@ func resourceArmSqlMiServer() *schema.Resource {
 			}),
 		),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ManagedInstanceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ManagedInstanceId{})
 }
 
 func resourceArmSqlMiServerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceSqlServer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSqlServerCreateUpdate,
 		Read:   resourceSqlServerRead,
 		Update: resourceSqlServerCreateUpdate,
@@ -192,6 +192,10 @@ func resourceSqlServer() *pluginsdk.Resource {
 			return nil
 		}),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.ServerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.ServerId{})
 }
 
 func resourceSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
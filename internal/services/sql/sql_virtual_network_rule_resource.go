@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSqlVirtualNetworkRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSqlVirtualNetworkRuleCreateUpdate,
 		Read:   resourceSqlVirtualNetworkRuleRead,
 		Update: resourceSqlVirtualNetworkRuleCreateUpdate,
@@ -68,7 +68,7 @@ func resourceSqlVirtualNetworkRule() *pluginsdk.Resource {
 				Default:  false, // When not provided, Azure defaults to false
 			},
 		},
-	}
+	}, &parse.VirtualNetworkRuleId{})
 }
 
 func resourceSqlVirtualNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
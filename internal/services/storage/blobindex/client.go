@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package blobindex contains the Blob Storage Data Plane operations for Blob Index Tags which aren't (yet)
+// available in giovanni.
+package blobindex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane/storage"
+)
+
+const (
+	apiVersion    = "2023-11-03"
+	componentName = "blob/index"
+)
+
+// Client is the base client for the Blob Index operations.
+type Client struct {
+	Client *storage.Client
+}
+
+func NewWithBaseUri(baseUri string) (*Client, error) {
+	baseClient, err := storage.NewStorageClient(baseUri, componentName, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building base client: %+v", err)
+	}
+	return &Client{
+		Client: baseClient,
+	}, nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobindex
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type FindBlobsByTagsInput struct {
+	// ContainerName optionally limits the search to the specified Container, otherwise
+	// every Container within the Storage Account is searched
+	ContainerName *string
+
+	// Where is the Blob Index Tag expression used to find the Blobs, e.g. `"project" = 'example'`
+	Where string
+
+	Marker     *string
+	MaxResults *int
+}
+
+type FindBlobsByTagsResponse struct {
+	FindBlobsByTagsResult
+
+	HttpResponse *http.Response
+}
+
+type FindBlobsByTagsResult struct {
+	Where      string                `xml:"Where"`
+	Blobs      []FilteredBlobDetails `xml:"Blobs>Blob"`
+	NextMarker *string               `xml:"NextMarker,omitempty"`
+}
+
+type FilteredBlobDetails struct {
+	Name          string `xml:"Name"`
+	ContainerName string `xml:"ContainerName"`
+
+	// Tags contains the Blob Index Tags which matched the `Where` expression
+	Tags Tags `xml:"Tags"`
+}
+
+// FindBlobsByTags returns a single page of the Blobs whose Blob Index Tags match the specified expression.
+func (c Client) FindBlobsByTags(ctx context.Context, input FindBlobsByTagsInput) (result FindBlobsByTagsResponse, err error) {
+	if input.Where == "" {
+		err = fmt.Errorf("`input.Where` cannot be an empty string")
+		return
+	}
+	if input.ContainerName != nil && *input.ContainerName == "" {
+		err = fmt.Errorf("`input.ContainerName` can either be nil or a non-empty string")
+		return
+	}
+	if input.MaxResults != nil && (*input.MaxResults <= 0 || *input.MaxResults > 5000) {
+		err = fmt.Errorf("`input.MaxResults` can either be nil or between 0 and 5000")
+		return
+	}
+
+	path := "/"
+	if input.ContainerName != nil {
+		path = fmt.Sprintf("/%s", *input.ContainerName)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: findBlobsByTagsOptions{
+			input: input,
+		},
+		Path: path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			if err = resp.Unmarshal(&result.FindBlobsByTagsResult); err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %+v", err)
+		return
+	}
+
+	return
+}
+
+// FindAllBlobsByTags returns every Blob whose Blob Index Tags match the specified expression, following the
+// continuation markers returned by the API.
+func (c Client) FindAllBlobsByTags(ctx context.Context, input FindBlobsByTagsInput) (*[]FilteredBlobDetails, error) {
+	output := make([]FilteredBlobDetails, 0)
+	for {
+		resp, err := c.FindBlobsByTags(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, resp.Blobs...)
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return &output, nil
+}
+
+var _ client.Options = findBlobsByTagsOptions{}
+
+type findBlobsByTagsOptions struct {
+	input FindBlobsByTagsInput
+}
+
+func (o findBlobsByTagsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o findBlobsByTagsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o findBlobsByTagsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	if o.input.ContainerName != nil {
+		out.Append("restype", "container")
+	}
+	out.Append("comp", "blobs")
+	out.Append("where", o.input.Where)
+
+	if o.input.Marker != nil {
+		out.Append("marker", *o.input.Marker)
+	}
+	if o.input.MaxResults != nil {
+		out.Append("maxresults", fmt.Sprintf("%d", *o.input.MaxResults))
+	}
+	return out
+}
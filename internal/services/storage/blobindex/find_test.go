@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobindex
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestFindBlobsByTagsResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example.blob.core.windows.net/">
+  <Where>"project" = 'example'</Where>
+  <Blobs>
+    <Blob>
+      <Name>logs/first.txt</Name>
+      <ContainerName>content</ContainerName>
+      <Tags>
+        <TagSet>
+          <Tag>
+            <Key>project</Key>
+            <Value>example</Value>
+          </Tag>
+        </TagSet>
+      </Tags>
+    </Blob>
+    <Blob>
+      <Name>second.txt</Name>
+      <ContainerName>other</ContainerName>
+      <Tags>
+        <TagSet>
+          <Tag>
+            <Key>project</Key>
+            <Value>example</Value>
+          </Tag>
+        </TagSet>
+      </Tags>
+    </Blob>
+  </Blobs>
+  <NextMarker>2!100!MDAwMDE0</NextMarker>
+</EnumerationResults>`
+
+	var result FindBlobsByTagsResult
+	if err := xml.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(result.Blobs) != 2 {
+		t.Fatalf("expected 2 blobs but got %d", len(result.Blobs))
+	}
+	if result.Blobs[0].Name != "logs/first.txt" || result.Blobs[0].ContainerName != "content" {
+		t.Fatalf("unexpected first blob: %+v", result.Blobs[0])
+	}
+	if v := result.Blobs[1].Tags.ToMap()["project"]; v != "example" {
+		t.Fatalf("expected the tag `project` to be `example` but got %q", v)
+	}
+	if result.NextMarker == nil || *result.NextMarker != "2!100!MDAwMDE0" {
+		t.Fatalf("unexpected next marker: %v", result.NextMarker)
+	}
+}
+
+func TestFindBlobsByTagsOptionsToQuery(t *testing.T) {
+	cases := []struct {
+		Input    FindBlobsByTagsInput
+		Expected string
+	}{
+		{
+			Input: FindBlobsByTagsInput{
+				Where: `"project" = 'example'`,
+			},
+			Expected: "comp=blobs&where=%22project%22+%3D+%27example%27",
+		},
+		{
+			Input: FindBlobsByTagsInput{
+				ContainerName: pointer.To("content"),
+				Where:         `"project" = 'example'`,
+				Marker:        pointer.To("abc"),
+				MaxResults:    pointer.To(10),
+			},
+			Expected: "comp=blobs&marker=abc&maxresults=10&restype=container&where=%22project%22+%3D+%27example%27",
+		},
+	}
+
+	for _, tc := range cases {
+		actual := findBlobsByTagsOptions{input: tc.Input}.ToQuery().Values().Encode()
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
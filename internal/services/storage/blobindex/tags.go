@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobindex
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// Tags is the XML representation of the Blob Index Tags for a Blob.
+type Tags struct {
+	XMLName xml.Name `xml:"Tags"`
+	TagSet  TagSet   `xml:"TagSet"`
+}
+
+type TagSet struct {
+	Tags []Tag `xml:"Tag"`
+}
+
+type Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+// NewTags returns the XML representation of the specified Blob Index Tags, ordered by key.
+func NewTags(input map[string]string) Tags {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]Tag, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, Tag{
+			Key:   k,
+			Value: input[k],
+		})
+	}
+
+	return Tags{
+		TagSet: TagSet{
+			Tags: tags,
+		},
+	}
+}
+
+// ToMap returns the Blob Index Tags as a map of key to value.
+func (t Tags) ToMap() map[string]string {
+	output := make(map[string]string, len(t.TagSet.Tags))
+	for _, tag := range t.TagSet.Tags {
+		output[tag.Key] = tag.Value
+	}
+	return output
+}
+
+type GetTagsResponse struct {
+	HttpResponse *http.Response
+
+	Tags map[string]string
+}
+
+// GetTags retrieves the Blob Index Tags for the specified Blob.
+func (c Client) GetTags(ctx context.Context, containerName, blobName string) (result GetTagsResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: tagsOptions{},
+		Path:          fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			var tags Tags
+			if err = resp.Unmarshal(&tags); err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.Tags = tags.ToMap()
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %+v", err)
+		return
+	}
+
+	return
+}
+
+type SetTagsResponse struct {
+	HttpResponse *http.Response
+}
+
+// SetTags replaces the Blob Index Tags for the specified Blob - an empty map removes all of the Blob Index Tags.
+func (c Client) SetTags(ctx context.Context, containerName, blobName string, tags map[string]string) (result SetTagsResponse, err error) {
+	if containerName == "" {
+		err = fmt.Errorf("`containerName` cannot be an empty string")
+		return
+	}
+	if blobName == "" {
+		err = fmt.Errorf("`blobName` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+		},
+		HttpMethod:    http.MethodPut,
+		OptionsObject: tagsOptions{},
+		Path:          fmt.Sprintf("/%s/%s", containerName, blobName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	payload := NewTags(tags)
+	if err = req.Marshal(&payload); err != nil {
+		err = fmt.Errorf("marshalling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %+v", err)
+		return
+	}
+
+	return
+}
+
+var _ client.Options = tagsOptions{}
+
+type tagsOptions struct{}
+
+func (o tagsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o tagsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o tagsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("comp", "tags")
+	return out
+}
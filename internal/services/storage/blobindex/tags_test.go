@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobindex
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func TestNewTagsMarshal(t *testing.T) {
+	tags := NewTags(map[string]string{
+		"project": "example",
+		"env":     "prod",
+	})
+
+	actual, err := xml.Marshal(tags)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected := `<Tags><TagSet><Tag><Key>env</Key><Value>prod</Value></Tag><Tag><Key>project</Key><Value>example</Value></Tag></TagSet></Tags>`
+	if string(actual) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(actual))
+	}
+}
+
+func TestNewTagsEmpty(t *testing.T) {
+	actual, err := xml.Marshal(NewTags(map[string]string{}))
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected := `<Tags><TagSet></TagSet></Tags>`
+	if string(actual) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(actual))
+	}
+}
+
+func TestTagsUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<Tags>
+  <TagSet>
+    <Tag>
+      <Key>project</Key>
+      <Value>example</Value>
+    </Tag>
+    <Tag>
+      <Key>owner</Key>
+      <Value></Value>
+    </Tag>
+  </TagSet>
+</Tags>`
+
+	var tags Tags
+	if err := xml.Unmarshal([]byte(input), &tags); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expected := map[string]string{
+		"project": "example",
+		"owner":   "",
+	}
+	if actual := tags.ToMap(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
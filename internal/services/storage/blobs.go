@@ -6,6 +6,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -37,6 +38,10 @@ type BlobUpload struct {
 	Source          string
 	SourceContent   string
 	SourceUri       string
+
+	// UploadInBlocks specifies that a Block blob whose `Source` is larger than `maxBlockSize` should be uploaded in
+	// blocks, rather than being read into memory - which is used when synchronising a directory
+	UploadInBlocks bool
 }
 
 func (sbu BlobUpload) Create(ctx context.Context) error {
@@ -161,10 +166,22 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("could not stat file %q: %s", file.Name(), err)
+	}
+
+	if sbu.UploadInBlocks && info.Size() > maxBlockSize {
+		return sbu.blockUploadFromSource(ctx, file, info.Size())
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: pointer.To(sbu.ContentType),
 		MetaData:    sbu.MetaData,
 	}
+	if sbu.CacheControl != "" {
+		input.CacheControl = pointer.To(sbu.CacheControl)
+	}
 	if sbu.ContentMD5 != "" {
 		input.ContentMD5 = pointer.To(sbu.ContentMD5)
 	}
@@ -272,31 +289,11 @@ func (sbu BlobUpload) pageUploadFromSource(ctx context.Context, file io.ReaderAt
 	}
 
 	// finally we upload the contents of said file
-	pages := make(chan storageBlobPage, len(pageList))
-	errors := make(chan error, len(pageList))
-	wg := &sync.WaitGroup{}
-	wg.Add(len(pageList))
-
-	total := int64(0)
-	for _, page := range pageList {
-		total += page.section.Size()
-		pages <- page
-	}
-	close(pages)
-
-	for i := 0; i < workerCount; i++ {
-		go sbu.blobPageUploadWorker(ctx, blobPageUploadContext{
-			blobSize: fileSize,
-			pages:    pages,
-			errors:   errors,
-			wg:       wg,
-		})
-	}
-
-	wg.Wait()
-
-	if len(errors) > 0 {
-		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+	err = runInParallel(ctx, workerCount, len(pageList), func(i int) error {
+		return sbu.blobPageUpload(ctx, pageList[i], fileSize)
+	})
+	if err != nil {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, err)
 	}
 
 	return nil
@@ -360,43 +357,142 @@ func (sbu BlobUpload) storageBlobPageSplit(file io.ReaderAt, fileSize int64) ([]
 	return pages, nil
 }
 
-type blobPageUploadContext struct {
-	blobSize int64
-	pages    chan storageBlobPage
-	errors   chan error
-	wg       *sync.WaitGroup
+func (sbu BlobUpload) blobPageUpload(ctx context.Context, page storageBlobPage, blobSize int64) error {
+	start := page.offset
+	end := page.offset + page.section.Size() - 1
+	if end > blobSize-1 {
+		end = blobSize - 1
+	}
+	size := end - start + 1
+
+	chunk := make([]byte, size)
+	if _, err := page.section.Read(chunk); err != nil && err != io.EOF {
+		return fmt.Errorf("reading source file %q at offset %d: %s", sbu.Source, page.offset, err)
+	}
+
+	input := blobs.PutPageUpdateInput{
+		StartByte: start,
+		EndByte:   end,
+		Content:   chunk,
+	}
+
+	if _, err := sbu.Client.PutPageUpdate(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("writing page at offset %d for file %q: %s", page.offset, sbu.Source, err)
+	}
+
+	return nil
 }
 
-func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPageUploadContext) {
-	for page := range uploadCtx.pages {
-		start := page.offset
-		end := page.offset + page.section.Size() - 1
-		if end > uploadCtx.blobSize-1 {
-			end = uploadCtx.blobSize - 1
+// runInParallel runs `fn` for each of the `count` items using `workerCount` workers, returning the first error
+func runInParallel(ctx context.Context, workerCount, count int, fn func(i int) error) error {
+	if count == 0 {
+		return nil
+	}
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	items := make(chan int, count)
+	errors := make(chan error, count)
+	wg := &sync.WaitGroup{}
+	wg.Add(count)
+
+	for i := 0; i < count; i++ {
+		items <- i
+	}
+	close(items)
+
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			for item := range items {
+				if ctx.Err() != nil {
+					errors <- ctx.Err()
+				} else if err := fn(item); err != nil {
+					errors <- err
+				}
+				wg.Done()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return <-errors
+	}
+
+	return nil
+}
+
+// maxBlockSize is the size of each block when uploading a Block blob in blocks, which limits the memory used for
+// each upload to `maxBlockSize`
+const maxBlockSize int64 = 4 * 1024 * 1024
+
+// blockUploadFromSource uploads the source file one block at a time, which are then committed as the blob. Each block
+// is verified by the service using its MD5 - however the `ContentMD5` set when committing the blocks is stored without
+// being verified, so this is compared against the MD5 of the content which was uploaded prior to committing the blocks.
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
+	blockIds := make([]blobs.BlockID, 0)
+	contentHash := md5.New()
+	for offset := int64(0); offset < fileSize; offset += maxBlockSize {
+		size := maxBlockSize
+		if offset+size > fileSize {
+			size = fileSize - offset
 		}
-		size := end - start + 1
 
 		chunk := make([]byte, size)
-		if _, err := page.section.Read(chunk); err != nil && err != io.EOF {
-			uploadCtx.errors <- fmt.Errorf("reading source file %q at offset %d: %s", sbu.Source, page.offset, err)
-			uploadCtx.wg.Done()
-			continue
+		if _, err := file.ReadAt(chunk, offset); err != nil && err != io.EOF {
+			return fmt.Errorf("reading source file %q at offset %d: %s", sbu.Source, offset, err)
 		}
-
-		input := blobs.PutPageUpdateInput{
-			StartByte: start,
-			EndByte:   end,
-			Content:   chunk,
+		contentHash.Write(chunk)
+		blockHash := md5.Sum(chunk)
+
+		// the Block IDs must be Base64 encoded and the same length for all blocks within the blob
+		blockId := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%010d", len(blockIds))))
+		input := blobs.PutBlockInput{
+			BlockID:    blockId,
+			Content:    chunk,
+			ContentMD5: pointer.To(base64.StdEncoding.EncodeToString(blockHash[:])),
+		}
+		if sbu.EncryptionScope != "" {
+			input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+		}
+		if _, err := sbu.Client.PutBlock(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+			return fmt.Errorf("writing block at offset %d for file %q: %s", offset, sbu.Source, err)
 		}
 
-		if _, err := sbu.Client.PutPageUpdate(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
-			uploadCtx.errors <- fmt.Errorf("writing page at offset %d for file %q: %s", page.offset, sbu.Source, err)
-			uploadCtx.wg.Done()
-			continue
+		blockIds = append(blockIds, blobs.BlockID{
+			Value: blockId,
+		})
+	}
+
+	if sbu.ContentMD5 != "" {
+		if uploaded := base64.StdEncoding.EncodeToString(contentHash.Sum(nil)); uploaded != sbu.ContentMD5 {
+			return fmt.Errorf("the MD5 of the uploaded content %q doesn't match the expected MD5 %q for file %q - the file may have changed during the upload", uploaded, sbu.ContentMD5, sbu.Source)
 		}
+	}
 
-		uploadCtx.wg.Done()
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: pointer.To(sbu.ContentType),
+		MetaData:    sbu.MetaData,
 	}
+	if sbu.CacheControl != "" {
+		input.CacheControl = pointer.To(sbu.CacheControl)
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = pointer.To(sbu.ContentMD5)
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("committing the blocks for file %q: %s", sbu.Source, err)
+	}
+
+	return nil
 }
 
 func convertHexToBase64Encoding(str string) (string, error) {
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobservice
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type GetUserDelegationKeyInput struct {
+	// Start is the time (in ISO-8601 format, in UTC) at which the key becomes valid
+	Start string
+
+	// Expiry is the time (in ISO-8601 format, in UTC) at which the key expires, which can be at most 7 days in the future
+	Expiry string
+}
+
+type keyInfo struct {
+	XMLName xml.Name `xml:"KeyInfo"`
+	Start   string   `xml:"Start"`
+	Expiry  string   `xml:"Expiry"`
+}
+
+type UserDelegationKey struct {
+	SignedOid     string `xml:"SignedOid"`
+	SignedTid     string `xml:"SignedTid"`
+	SignedStart   string `xml:"SignedStart"`
+	SignedExpiry  string `xml:"SignedExpiry"`
+	SignedService string `xml:"SignedService"`
+	SignedVersion string `xml:"SignedVersion"`
+	Value         string `xml:"Value"`
+}
+
+type GetUserDelegationKeyResponse struct {
+	HttpResponse *http.Response
+
+	Model *UserDelegationKey
+}
+
+// GetUserDelegationKey obtains a key which can be used to sign a User Delegation SAS - which requires that
+// the client is authenticated using Azure AD.
+func (c Client) GetUserDelegationKey(ctx context.Context, input GetUserDelegationKeyInput) (result GetUserDelegationKeyResponse, err error) {
+	if input.Expiry == "" {
+		err = fmt.Errorf("`input.Expiry` cannot be an empty string")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: userDelegationKeyOptions{},
+		Path:          "/",
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	payload := keyInfo{
+		Start:  input.Start,
+		Expiry: input.Expiry,
+	}
+	if err = req.Marshal(&payload); err != nil {
+		err = fmt.Errorf("marshalling request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+
+		if err == nil {
+			var model UserDelegationKey
+			if err = resp.Unmarshal(&model); err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+			result.Model = &model
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %+v", err)
+		return
+	}
+
+	return
+}
+
+var _ client.Options = userDelegationKeyOptions{}
+
+type userDelegationKeyOptions struct{}
+
+func (o userDelegationKeyOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o userDelegationKeyOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o userDelegationKeyOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "service")
+	out.Append("comp", "userdelegationkey")
+	return out
+}
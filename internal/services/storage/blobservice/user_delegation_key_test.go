@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobservice
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestKeyInfoMarshal(t *testing.T) {
+	actual, err := xml.Marshal(keyInfo{
+		Start:  "2024-01-01T00:00:00Z",
+		Expiry: "2024-01-02T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+
+	expected := `<KeyInfo><Start>2024-01-01T00:00:00Z</Start><Expiry>2024-01-02T00:00:00Z</Expiry></KeyInfo>`
+	if string(actual) != expected {
+		t.Fatalf("expected %s but got %s", expected, string(actual))
+	}
+}
+
+func TestUserDelegationKeyUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<UserDelegationKey>
+  <SignedOid>11111111-1111-1111-1111-111111111111</SignedOid>
+  <SignedTid>22222222-2222-2222-2222-222222222222</SignedTid>
+  <SignedStart>2024-01-01T00:00:00Z</SignedStart>
+  <SignedExpiry>2024-01-02T00:00:00Z</SignedExpiry>
+  <SignedService>b</SignedService>
+  <SignedVersion>2023-11-03</SignedVersion>
+  <Value>ZXhhbXBsZQ==</Value>
+</UserDelegationKey>`
+
+	var actual UserDelegationKey
+	if err := xml.Unmarshal([]byte(input), &actual); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expected := UserDelegationKey{
+		SignedOid:     "11111111-1111-1111-1111-111111111111",
+		SignedTid:     "22222222-2222-2222-2222-222222222222",
+		SignedStart:   "2024-01-01T00:00:00Z",
+		SignedExpiry:  "2024-01-02T00:00:00Z",
+		SignedService: "b",
+		SignedVersion: "2023-11-03",
+		Value:         "ZXhhbXBsZQ==",
+	}
+	if actual != expected {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
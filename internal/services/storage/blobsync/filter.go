@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobsync
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Filter determines which files are synchronised using glob patterns, matched against the path of the file relative
+// to the directory (or the prefix within the container) using `/` as the separator. Within a pattern `*` matches any
+// characters other than `/`, `**` matches any characters (including `/`) and `?` matches a single character other
+// than `/` - for example `**/*.html` matches every HTML file.
+type Filter struct {
+	// Include are the patterns for the files which should be synchronised, where every file is synchronised when
+	// no patterns are specified
+	Include []string
+
+	// Exclude are the patterns for the files which shouldn't be synchronised, which take precedence over Include
+	Exclude []string
+}
+
+// Matches returns whether the file with the relative path `name` should be synchronised
+func (f Filter) Matches(name string) (bool, error) {
+	for _, pattern := range f.Exclude {
+		matches, err := Match(pattern, name)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return false, nil
+		}
+	}
+
+	if len(f.Include) == 0 {
+		return true, nil
+	}
+	for _, pattern := range f.Include {
+		matches, err := Match(pattern, name)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Match returns whether the relative path `name` matches the glob `pattern`
+func Match(pattern, name string) (bool, error) {
+	expression, err := globExpression(pattern)
+	if err != nil {
+		return false, err
+	}
+	return expression.MatchString(name), nil
+}
+
+// ValidateGlob validates that the value is a glob pattern which can be used to filter the files
+func ValidateGlob(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := globExpression(input); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+	}
+	return
+}
+
+func globExpression(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("the pattern cannot be empty")
+	}
+	if strings.HasPrefix(pattern, "/") {
+		return nil, fmt.Errorf("the pattern %q must be relative, so cannot start with `/`", pattern)
+	}
+
+	var expression strings.Builder
+	expression.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				// `**/` also matches no directories, so that `**/*.html` matches `index.html`
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					expression.WriteString("(.*/)?")
+					continue
+				}
+				expression.WriteString(".*")
+				continue
+			}
+			expression.WriteString("[^/]*")
+		case '?':
+			expression.WriteString("[^/]")
+		default:
+			expression.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expression.WriteString("$")
+
+	return regexp.Compile(expression.String())
+}
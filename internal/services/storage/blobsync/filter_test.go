@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobsync
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		Pattern  string
+		Name     string
+		Expected bool
+	}{
+		{
+			Pattern:  "*.html",
+			Name:     "index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "*.html",
+			Name:     "docs/index.html",
+			Expected: false,
+		},
+		{
+			Pattern:  "**/*.html",
+			Name:     "index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "**/*.html",
+			Name:     "docs/guides/index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "docs/**",
+			Name:     "docs/guides/index.html",
+			Expected: true,
+		},
+		{
+			Pattern:  "docs/**",
+			Name:     "documents/index.html",
+			Expected: false,
+		},
+		{
+			Pattern:  "image-?.png",
+			Name:     "image-1.png",
+			Expected: true,
+		},
+		{
+			Pattern:  "image-?.png",
+			Name:     "image-10.png",
+			Expected: false,
+		},
+		{
+			Pattern:  "file.(1).txt",
+			Name:     "file.(1).txt",
+			Expected: true,
+		},
+		{
+			Pattern:  "file.(1).txt",
+			Name:     "fileX(1).txt",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q against %q", tc.Name, tc.Pattern)
+
+		actual, err := Match(tc.Pattern, tc.Name)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != tc.Expected {
+			t.Fatalf("expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	filter := Filter{
+		Include: []string{"**/*.html", "**/*.css"},
+		Exclude: []string{"drafts/**"},
+	}
+
+	cases := map[string]bool{
+		"index.html":        true,
+		"css/site.css":      true,
+		"drafts/index.html": false,
+		"images/logo.png":   false,
+	}
+
+	for name, expected := range cases {
+		actual, err := filter.Matches(name)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %+v", name, err)
+		}
+		if actual != expected {
+			t.Fatalf("expected %q to be %t but got %t", name, expected, actual)
+		}
+	}
+
+	everything, err := Filter{}.Matches("images/logo.png")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !everything {
+		t.Fatal("expected every file to match when no patterns are specified")
+	}
+}
+
+func TestValidateGlob(t *testing.T) {
+	cases := map[string]bool{
+		"":          false,
+		"/absolute": false,
+		"*.html":    true,
+		"**/*.css":  true,
+	}
+
+	for input, valid := range cases {
+		_, errors := ValidateGlob(input, "include")
+		if (len(errors) == 0) != valid {
+			t.Fatalf("expected %q to be valid: %t but got %+v", input, valid, errors)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobsync
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// File is a file within the local directory (or a blob within the container) which is synchronised
+type File struct {
+	// Name is the name of the blob, including the prefix
+	Name string
+
+	// Path is the path to the file within the local directory, which is empty for a blob
+	Path string
+
+	// ContentMD5 is the hex-encoded MD5 hash of the contents
+	ContentMD5 string
+
+	ContentType string
+}
+
+// Options configures which files within the local directory are synchronised, and how
+type Options struct {
+	// Source is the path to the local directory
+	Source string
+
+	// Prefix is prepended to the path of each file (relative to the Source) to give the name of the blob
+	Prefix string
+
+	Filter Filter
+
+	// ContentTypes is a mapping of file extensions (for example `.html`) to the Content Type for these files,
+	// which takes precedence over the default Content Types
+	ContentTypes map[string]string
+}
+
+// LocalManifest returns the files within the local directory which should be synchronised, sorted by name
+func LocalManifest(options Options) ([]File, error) {
+	files := make([]File, 0)
+
+	err := filepath.WalkDir(options.Source, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			// directories are implied by the names of the blobs, and other files (such as symlinks) are skipped
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(options.Source, filePath)
+		if err != nil {
+			return fmt.Errorf("determining the relative path for %q: %+v", filePath, err)
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		matches, err := options.Filter.Matches(relativePath)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			return nil
+		}
+
+		contentMD5, err := fileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, File{
+			Name:        options.Prefix + relativePath,
+			Path:        filePath,
+			ContentMD5:  contentMD5,
+			ContentType: ContentType(relativePath, options.ContentTypes),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading the directory %q: %w", options.Source, err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// Hash returns a hash of the names, contents and Content Types of the files - which changes when any file is added,
+// removed or changed, regardless of the order of the files
+func Hash(files []File) string {
+	sorted := make([]File, len(files))
+	copy(sorted, files)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	hash := sha256.New()
+	for _, file := range sorted {
+		fmt.Fprintf(hash, "%s\t%s\t%s\n", file.Name, strings.ToLower(file.ContentMD5), file.ContentType)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// defaultContentTypes are the Content Types used for common file extensions, which are defined here (rather than
+// using the `mime` package) so that the Content Types don't depend on the configuration of the machine
+var defaultContentTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/x-icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp4":   "video/mp4",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "text/xml; charset=utf-8",
+	".yaml":  "application/yaml",
+	".yml":   "application/yaml",
+	".zip":   "application/zip",
+}
+
+// DefaultContentType is the Content Type used for files with an unknown extension
+const DefaultContentType = "application/octet-stream"
+
+// ContentType returns the Content Type for the file, using the `mapping` of file extensions to Content Types before
+// falling back to the Content Types for common file extensions
+func ContentType(name string, mapping map[string]string) string {
+	extension := strings.ToLower(path.Ext(name))
+	if extension == "" {
+		return DefaultContentType
+	}
+
+	for k, v := range mapping {
+		if strings.EqualFold(normalizeExtension(k), extension) {
+			return v
+		}
+	}
+	if v, ok := defaultContentTypes[extension]; ok {
+		return v
+	}
+	return DefaultContentType
+}
+
+func normalizeExtension(input string) string {
+	if strings.HasPrefix(input, ".") {
+		return input
+	}
+	return "." + input
+}
+
+func fileMD5(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", filePath, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("hashing %q: %+v", filePath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobsync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalManifest(t *testing.T) {
+	source := t.TempDir()
+	writeFile(t, filepath.Join(source, "index.html"), "<html></html>")
+	writeFile(t, filepath.Join(source, "css", "site.css"), "body {}")
+	writeFile(t, filepath.Join(source, "drafts", "post.html"), "draft")
+	writeFile(t, filepath.Join(source, "data.custom"), "{}")
+
+	files, err := LocalManifest(Options{
+		Source: source,
+		Prefix: "site/",
+		Filter: Filter{
+			Exclude: []string{"drafts/**"},
+		},
+		ContentTypes: map[string]string{
+			"custom": "application/json",
+		},
+	})
+	if err != nil {
+		t.Fatalf("building manifest: %+v", err)
+	}
+
+	expected := []File{
+		{
+			Name:        "site/css/site.css",
+			ContentMD5:  "fcdce6b6d6e2175f6406869882f6f1ce",
+			ContentType: "text/css; charset=utf-8",
+		},
+		{
+			Name:        "site/data.custom",
+			ContentMD5:  "99914b932bd37a50b983c5e7c90ae93b",
+			ContentType: "application/json",
+		},
+		{
+			Name:        "site/index.html",
+			ContentMD5:  "c83301425b2ad1d496473a5ff3d9ecca",
+			ContentType: "text/html; charset=utf-8",
+		},
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("expected %d files but got %d: %+v", len(expected), len(files), files)
+	}
+	for i, file := range files {
+		if file.Name != expected[i].Name || file.ContentMD5 != expected[i].ContentMD5 || file.ContentType != expected[i].ContentType {
+			t.Fatalf("expected file %d to be %+v but got %+v", i, expected[i], file)
+		}
+		if file.Path == "" {
+			t.Fatalf("expected the path to be set for %q", file.Name)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	first := []File{
+		{Name: "a.txt", ContentMD5: "00", ContentType: "text/plain"},
+		{Name: "b.txt", ContentMD5: "01", ContentType: "text/plain"},
+	}
+	reordered := []File{first[1], first[0]}
+	if Hash(first) != Hash(reordered) {
+		t.Fatal("expected the hash not to depend on the order of the files")
+	}
+
+	changed := []File{first[0], {Name: "b.txt", ContentMD5: "02", ContentType: "text/plain"}}
+	if Hash(first) == Hash(changed) {
+		t.Fatal("expected the hash to change when the contents of a file changes")
+	}
+
+	contentType := []File{first[0], {Name: "b.txt", ContentMD5: "01", ContentType: "text/html"}}
+	if Hash(first) == Hash(contentType) {
+		t.Fatal("expected the hash to change when the Content Type of a file changes")
+	}
+}
+
+func TestContentType(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Mapping  map[string]string
+		Expected string
+	}{
+		{
+			Name:     "index.html",
+			Expected: "text/html; charset=utf-8",
+		},
+		{
+			Name:     "IMAGE.PNG",
+			Expected: "image/png",
+		},
+		{
+			Name:     "README",
+			Expected: DefaultContentType,
+		},
+		{
+			Name:     "archive.unknown",
+			Expected: DefaultContentType,
+		},
+		{
+			Name: "index.html",
+			Mapping: map[string]string{
+				".html": "text/html",
+			},
+			Expected: "text/html",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := ContentType(tc.Name, tc.Mapping); actual != tc.Expected {
+			t.Fatalf("expected the Content Type for %q to be %q but got %q", tc.Name, tc.Expected, actual)
+		}
+	}
+}
+
+func writeFile(t *testing.T, filePath, contents string) {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", filePath, err)
+	}
+	if err := os.WriteFile(filePath, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+}
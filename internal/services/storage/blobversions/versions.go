@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobversions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ListBlobVersionsInput struct {
+	// IncludeSnapshots specifies whether the Snapshots of the Blob should be returned
+	IncludeSnapshots bool
+
+	// IncludeVersions specifies whether the Versions of the Blob should be returned, which
+	// requires that Blob Versioning is enabled on the Storage Account
+	IncludeVersions bool
+}
+
+type ListBlobVersionsResult struct {
+	Blobs      []BlobVersionDetails `xml:"Blobs>Blob"`
+	NextMarker *string              `xml:"NextMarker,omitempty"`
+}
+
+// BlobVersionDetails represents the Base Blob, a Snapshot or a Version of a Blob.
+type BlobVersionDetails struct {
+	Name             string                `xml:"Name"`
+	Snapshot         string                `xml:"Snapshot,omitempty"`
+	VersionId        string                `xml:"VersionId,omitempty"`
+	IsCurrentVersion bool                  `xml:"IsCurrentVersion,omitempty"`
+	Properties       BlobVersionProperties `xml:"Properties"`
+}
+
+type BlobVersionProperties struct {
+	AccessTier    string `xml:"AccessTier,omitempty"`
+	BlobType      string `xml:"BlobType,omitempty"`
+	ContentLength int64  `xml:"Content-Length,omitempty"`
+	ContentMD5    string `xml:"Content-MD5,omitempty"`
+	ContentType   string `xml:"Content-Type,omitempty"`
+	CreationTime  string `xml:"Creation-Time,omitempty"`
+	LastModified  string `xml:"Last-Modified,omitempty"`
+}
+
+// ListBlobVersions returns the Snapshots and/or Versions of the specified Blob, as requested by the input.
+func (c Client) ListBlobVersions(ctx context.Context, containerName, blobName string, input ListBlobVersionsInput) (*[]BlobVersionDetails, error) {
+	if containerName == "" {
+		return nil, fmt.Errorf("`containerName` cannot be an empty string")
+	}
+	if blobName == "" {
+		return nil, fmt.Errorf("`blobName` cannot be an empty string")
+	}
+	if !input.IncludeSnapshots && !input.IncludeVersions {
+		return nil, fmt.Errorf("at least one of `input.IncludeSnapshots` and `input.IncludeVersions` must be specified")
+	}
+
+	output := make([]BlobVersionDetails, 0)
+	var marker *string
+	for {
+		page, err := c.listBlobVersions(ctx, containerName, blobName, input, marker)
+		if err != nil {
+			return nil, err
+		}
+
+		// the prefix also matches any other Blobs whose name starts with the name of this Blob
+		for _, blob := range page.Blobs {
+			if blob.Name == blobName {
+				output = append(output, blob)
+			}
+		}
+
+		if page.NextMarker == nil || *page.NextMarker == "" {
+			break
+		}
+		marker = page.NextMarker
+	}
+
+	return &output, nil
+}
+
+func (c Client) listBlobVersions(ctx context.Context, containerName, blobName string, input ListBlobVersionsInput, marker *string) (*ListBlobVersionsResult, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/xml; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: listBlobVersionsOptions{
+			blobName: blobName,
+			input:    input,
+			marker:   marker,
+		},
+		Path: fmt.Sprintf("/%s", containerName),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	var result ListBlobVersionsResult
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, fmt.Errorf("unmarshalling response: %+v", err)
+	}
+
+	return &result, nil
+}
+
+var _ client.Options = listBlobVersionsOptions{}
+
+type listBlobVersionsOptions struct {
+	blobName string
+	input    ListBlobVersionsInput
+	marker   *string
+}
+
+func (o listBlobVersionsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o listBlobVersionsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o listBlobVersionsOptions) ToQuery() *client.QueryParams {
+	include := make([]string, 0)
+	if o.input.IncludeSnapshots {
+		include = append(include, "snapshots")
+	}
+	if o.input.IncludeVersions {
+		include = append(include, "versions")
+	}
+
+	out := &client.QueryParams{}
+	out.Append("restype", "container")
+	out.Append("comp", "list")
+	out.Append("prefix", o.blobName)
+	out.Append("include", strings.Join(include, ","))
+
+	if o.marker != nil {
+		out.Append("marker", *o.marker)
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package blobversions
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestListBlobVersionsResultUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?>
+<EnumerationResults ServiceEndpoint="https://example.blob.core.windows.net/" ContainerName="content">
+  <Prefix>example.txt</Prefix>
+  <Blobs>
+    <Blob>
+      <Name>example.txt</Name>
+      <Snapshot>2024-01-01T10:00:00.0000000Z</Snapshot>
+      <Properties>
+        <Creation-Time>Mon, 01 Jan 2024 09:00:00 GMT</Creation-Time>
+        <Last-Modified>Mon, 01 Jan 2024 09:30:00 GMT</Last-Modified>
+        <Content-Length>11</Content-Length>
+        <Content-Type>text/plain</Content-Type>
+        <Content-MD5>sQqNsWTgdUEFt6mb5y4/5Q==</Content-MD5>
+        <BlobType>BlockBlob</BlobType>
+        <AccessTier>Hot</AccessTier>
+      </Properties>
+    </Blob>
+    <Blob>
+      <Name>example.txt</Name>
+      <VersionId>2024-01-02T10:00:00.0000000Z</VersionId>
+      <IsCurrentVersion>true</IsCurrentVersion>
+      <Properties>
+        <Content-Length>12</Content-Length>
+      </Properties>
+    </Blob>
+  </Blobs>
+  <NextMarker />
+</EnumerationResults>`
+
+	var result ListBlobVersionsResult
+	if err := xml.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if len(result.Blobs) != 2 {
+		t.Fatalf("expected 2 blobs but got %d", len(result.Blobs))
+	}
+
+	snapshot := result.Blobs[0]
+	if snapshot.Snapshot != "2024-01-01T10:00:00.0000000Z" || snapshot.VersionId != "" || snapshot.IsCurrentVersion {
+		t.Fatalf("unexpected snapshot: %+v", snapshot)
+	}
+	if snapshot.Properties.ContentLength != 11 || snapshot.Properties.AccessTier != "Hot" || snapshot.Properties.LastModified != "Mon, 01 Jan 2024 09:30:00 GMT" {
+		t.Fatalf("unexpected snapshot properties: %+v", snapshot.Properties)
+	}
+
+	version := result.Blobs[1]
+	if version.VersionId != "2024-01-02T10:00:00.0000000Z" || !version.IsCurrentVersion {
+		t.Fatalf("unexpected version: %+v", version)
+	}
+
+	if result.NextMarker != nil && *result.NextMarker != "" {
+		t.Fatalf("expected no next marker but got %q", *result.NextMarker)
+	}
+}
+
+func TestListBlobVersionsOptionsToQuery(t *testing.T) {
+	actual := listBlobVersionsOptions{
+		blobName: "example.txt",
+		input: ListBlobVersionsInput{
+			IncludeSnapshots: true,
+			IncludeVersions:  true,
+		},
+	}.ToQuery().Values().Encode()
+
+	expected := "comp=list&include=snapshots%2Cversions&prefix=example.txt&restype=container"
+	if actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -31,7 +31,10 @@ type Client struct {
 	SyncServerEndpointsClient  *serverendpointresource.ServerEndpointResourceClient
 	SyncServiceClient          *storagesyncservicesresource.StorageSyncServicesResourceClient
 
+	// authConfig is used for the Data Plane operations which can only be authenticated using Azure AD
+	authConfig           *auth.Credentials
 	authConfigForAzureAD *auth.Credentials
+	recorder             common.RequestRecorder
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -91,6 +94,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SyncGroupsClient:           syncGroupsClient,
 
 		StorageDomainSuffix: *storageSuffix,
+
+		authConfig: o.AuthConfig,
+		recorder:   o.Recorder,
 	}
 
 	if o.StorageUseAzureAD {
@@ -9,6 +9,11 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobindex"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/datalakeacl"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
@@ -27,6 +32,9 @@ type DataPlaneOperation struct {
 	SupportsAadAuthentication       bool
 	SupportsSharedKeyAuthentication bool
 
+	// requiresAadAuthentication specifies that Azure AD authentication is used regardless of whether
+	// `storage_use_azuread` is enabled, since the operation can't be authenticated using a Shared Key
+	requiresAadAuthentication   bool
 	sharedKeyAuthenticationType auth.SharedKeyType
 }
 
@@ -44,10 +52,30 @@ func (Client) DataPlaneOperationSupportingOnlySharedKeyAuth() DataPlaneOperation
 	}
 }
 
+func (Client) DataPlaneOperationRequiringAadAuth() DataPlaneOperation {
+	return DataPlaneOperation{
+		SupportsAadAuthentication:       true,
+		SupportsSharedKeyAuthentication: false,
+		requiresAadAuthentication:       true,
+	}
+}
+
 func (c Client) configureDataPlane(ctx context.Context, clientName, resourceIdentifier string, baseClient client.BaseClient, account AccountDetails, operation DataPlaneOperation) error {
-	if operation.SupportsAadAuthentication && c.authConfigForAzureAD != nil {
-		api := c.authConfigForAzureAD.Environment.Storage.WithResourceIdentifier(resourceIdentifier)
-		storageAuth, err := auth.NewAuthorizerFromCredentials(ctx, *c.authConfigForAzureAD, api)
+	common.ConfigureRecording(baseClient, c.recorder)
+
+	authConfigForAzureAD := c.authConfigForAzureAD
+	if operation.requiresAadAuthentication && authConfigForAzureAD == nil {
+		authConfigForAzureAD = c.authConfig
+	}
+
+	if operation.SupportsAadAuthentication && authConfigForAzureAD != nil {
+		if c.recorder != nil && c.recorder.Replaying() {
+			baseClient.SetAuthorizer(common.RecordingAuthorizer())
+			return nil
+		}
+
+		api := authConfigForAzureAD.Environment.Storage.WithResourceIdentifier(resourceIdentifier)
+		storageAuth, err := auth.NewAuthorizerFromCredentials(ctx, *authConfigForAzureAD, api)
 		if err != nil {
 			return fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 		}
@@ -118,6 +146,72 @@ func (c Client) BlobsDataPlaneClient(ctx context.Context, account AccountDetails
 	return apiClient, nil
 }
 
+func (c Client) BlobIndexDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (*blobindex.Client, error) {
+	const clientName = "Blob Storage Blob Index"
+	operation.sharedKeyAuthenticationType = auth.SharedKey
+
+	baseUri, err := account.DataPlaneEndpoint(EndpointTypeBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := blobindex.NewWithBaseUri(*baseUri)
+	if err != nil {
+		return nil, fmt.Errorf("building %s client: %+v", clientName, err)
+	}
+
+	err = c.configureDataPlane(ctx, clientName, *baseUri, apiClient.Client, account, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiClient, nil
+}
+
+func (c Client) BlobVersionsDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (*blobversions.Client, error) {
+	const clientName = "Blob Storage Blob Versions"
+	operation.sharedKeyAuthenticationType = auth.SharedKey
+
+	baseUri, err := account.DataPlaneEndpoint(EndpointTypeBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := blobversions.NewWithBaseUri(*baseUri)
+	if err != nil {
+		return nil, fmt.Errorf("building %s client: %+v", clientName, err)
+	}
+
+	err = c.configureDataPlane(ctx, clientName, *baseUri, apiClient.Client, account, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiClient, nil
+}
+
+func (c Client) BlobServiceDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (*blobservice.Client, error) {
+	const clientName = "Blob Storage Service"
+	operation.sharedKeyAuthenticationType = auth.SharedKey
+
+	baseUri, err := account.DataPlaneEndpoint(EndpointTypeBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := blobservice.NewWithBaseUri(*baseUri)
+	if err != nil {
+		return nil, fmt.Errorf("building %s client: %+v", clientName, err)
+	}
+
+	err = c.configureDataPlane(ctx, clientName, *baseUri, apiClient.Client, account, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiClient, nil
+}
+
 func (c Client) ContainersDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (shim.StorageContainerWrapper, error) {
 	const clientName = "Blob Storage Containers"
 	operation.sharedKeyAuthenticationType = auth.SharedKey
@@ -162,6 +256,28 @@ func (c Client) DataLakeFilesystemsDataPlaneClient(ctx context.Context, account
 	return apiClient, nil
 }
 
+func (c Client) DataLakeAccessControlDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (*datalakeacl.Client, error) {
+	const clientName = "Data Lake Gen2 Access Control"
+	operation.sharedKeyAuthenticationType = auth.SharedKey
+
+	baseUri, err := account.DataPlaneEndpoint(EndpointTypeDfs)
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := datalakeacl.NewWithBaseUri(*baseUri)
+	if err != nil {
+		return nil, fmt.Errorf("building %s client: %+v", clientName, err)
+	}
+
+	err = c.configureDataPlane(ctx, clientName, *baseUri, apiClient.Client, account, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiClient, nil
+}
+
 func (c Client) DataLakePathsDataPlaneClient(ctx context.Context, account AccountDetails, operation DataPlaneOperation) (*paths.Client, error) {
 	const clientName = "Data Lake Gen2 Paths"
 	operation.sharedKeyAuthenticationType = auth.SharedKey
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package datalakeacl contains the Data Lake Storage Gen2 Data Plane operation for setting the Access Control List
+// of a Path and its children recursively, which isn't (yet) available in giovanni.
+package datalakeacl
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane/storage"
+)
+
+const (
+	apiVersion    = "2023-11-03"
+	componentName = "datalakestore/acl"
+)
+
+// Client is the base client for the Data Lake Storage Gen2 Access Control operations.
+type Client struct {
+	Client *storage.Client
+}
+
+func NewWithBaseUri(baseUri string) (*Client, error) {
+	baseClient, err := storage.NewStorageClient(baseUri, componentName, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building base client: %+v", err)
+	}
+	return &Client{
+		Client: baseClient,
+	}, nil
+}
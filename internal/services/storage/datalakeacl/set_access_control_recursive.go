@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datalakeacl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type SetAccessControlRecursiveMode string
+
+const (
+	// SetAccessControlRecursiveModeModify adds (or updates) the specified entries, retaining any other entries
+	SetAccessControlRecursiveModeModify SetAccessControlRecursiveMode = "modify"
+
+	// SetAccessControlRecursiveModeRemove removes the specified entries
+	SetAccessControlRecursiveModeRemove SetAccessControlRecursiveMode = "remove"
+
+	// SetAccessControlRecursiveModeSet replaces the Access Control List with the specified entries
+	SetAccessControlRecursiveModeSet SetAccessControlRecursiveMode = "set"
+)
+
+type SetAccessControlRecursiveInput struct {
+	Mode SetAccessControlRecursiveMode
+
+	// ACL is the Access Control List in the short form, e.g. `user::rwx,group::r-x,other::---`
+	ACL string
+
+	// Continuation is the token returned from a previous request, used to resume the operation
+	Continuation *string
+
+	// ForceFlag specifies that the operation should continue when the Access Control List of a
+	// child can't be set, rather than stopping at the first failure
+	ForceFlag bool
+
+	// MaxRecords is the maximum number of files or directories which are updated by a single request
+	MaxRecords *int
+}
+
+type SetAccessControlRecursiveResponse struct {
+	SetAccessControlRecursiveResult
+
+	HttpResponse *http.Response
+
+	// Continuation is the token used to resume the operation, which is empty once every path has been processed
+	Continuation string
+}
+
+type SetAccessControlRecursiveResult struct {
+	DirectoriesSuccessful int64         `json:"directoriesSuccessful"`
+	FilesSuccessful       int64         `json:"filesSuccessful"`
+	FailureCount          int64         `json:"failureCount"`
+	FailedEntries         []FailedEntry `json:"failedEntries"`
+}
+
+type FailedEntry struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// SetAccessControlRecursive sets the Access Control List for a single batch of the Path and its children - the
+// returned Continuation token should be used to process the remaining paths.
+func (c Client) SetAccessControlRecursive(ctx context.Context, fileSystemName string, path string, input SetAccessControlRecursiveInput) (result SetAccessControlRecursiveResponse, err error) {
+	if fileSystemName == "" {
+		err = fmt.Errorf("`fileSystemName` cannot be an empty string")
+		return
+	}
+	if input.Mode == "" {
+		err = fmt.Errorf("`input.Mode` cannot be an empty string")
+		return
+	}
+	if input.ACL == "" {
+		err = fmt.Errorf("`input.ACL` cannot be an empty string")
+		return
+	}
+	if input.MaxRecords != nil && (*input.MaxRecords <= 0 || *input.MaxRecords > 2000) {
+		err = fmt.Errorf("`input.MaxRecords` can either be nil or between 1 and 2000")
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPatch,
+		OptionsObject: setAccessControlRecursiveOptions{
+			input: input,
+		},
+		Path: fmt.Sprintf("/%s/%s", fileSystemName, strings.TrimPrefix(path, "/")),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		err = fmt.Errorf("building request: %+v", err)
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil && resp.Response != nil {
+		result.HttpResponse = resp.Response
+		result.Continuation = resp.Header.Get("x-ms-continuation")
+
+		if err == nil {
+			if err = resp.Unmarshal(&result.SetAccessControlRecursiveResult); err != nil {
+				err = fmt.Errorf("unmarshalling response: %+v", err)
+				return
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("executing request: %+v", err)
+		return
+	}
+
+	return
+}
+
+// SetAccessControlRecursiveComplete sets the Access Control List for the Path and all of its children, following
+// the continuation tokens returned by the API and combining the results of each batch.
+func (c Client) SetAccessControlRecursiveComplete(ctx context.Context, fileSystemName string, path string, input SetAccessControlRecursiveInput) (*SetAccessControlRecursiveResult, error) {
+	output := SetAccessControlRecursiveResult{
+		FailedEntries: make([]FailedEntry, 0),
+	}
+	for {
+		resp, err := c.SetAccessControlRecursive(ctx, fileSystemName, path, input)
+		if err != nil {
+			return nil, err
+		}
+		output.append(resp.SetAccessControlRecursiveResult)
+
+		// when `ForceFlag` isn't set the service stops at the first failure, returning a
+		// continuation token which would retry the same batch
+		if resp.Continuation == "" || (resp.FailureCount > 0 && !input.ForceFlag) {
+			break
+		}
+		input.Continuation = &resp.Continuation
+	}
+
+	return &output, nil
+}
+
+func (r *SetAccessControlRecursiveResult) append(input SetAccessControlRecursiveResult) {
+	r.DirectoriesSuccessful += input.DirectoriesSuccessful
+	r.FilesSuccessful += input.FilesSuccessful
+	r.FailureCount += input.FailureCount
+	r.FailedEntries = append(r.FailedEntries, input.FailedEntries...)
+}
+
+var _ client.Options = setAccessControlRecursiveOptions{}
+
+type setAccessControlRecursiveOptions struct {
+	input SetAccessControlRecursiveInput
+}
+
+func (o setAccessControlRecursiveOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-acl", o.input.ACL)
+	return headers
+}
+
+func (o setAccessControlRecursiveOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o setAccessControlRecursiveOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "setAccessControlRecursive")
+	out.Append("mode", string(o.input.Mode))
+
+	if o.input.Continuation != nil {
+		out.Append("continuation", *o.input.Continuation)
+	}
+	if o.input.ForceFlag {
+		out.Append("forceFlag", "true")
+	}
+	if o.input.MaxRecords != nil {
+		out.Append("maxRecords", fmt.Sprintf("%d", *o.input.MaxRecords))
+	}
+	return out
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datalakeacl
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestSetAccessControlRecursiveResultUnmarshal(t *testing.T) {
+	input := `{
+  "directoriesSuccessful": 2,
+  "filesSuccessful": 10,
+  "failureCount": 1,
+  "failedEntries": [
+    {
+      "errorMessage": "This request is not authorized to perform this operation using this permission.",
+      "name": "example/locked.txt",
+      "type": "FILE"
+    }
+  ]
+}`
+
+	var result SetAccessControlRecursiveResult
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	if result.DirectoriesSuccessful != 2 || result.FilesSuccessful != 10 || result.FailureCount != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if len(result.FailedEntries) != 1 {
+		t.Fatalf("expected 1 failed entry but got %d", len(result.FailedEntries))
+	}
+	if v := result.FailedEntries[0]; v.Name != "example/locked.txt" || v.Type != "FILE" || v.ErrorMessage == "" {
+		t.Fatalf("unexpected failed entry: %+v", v)
+	}
+}
+
+func TestSetAccessControlRecursiveResultAppend(t *testing.T) {
+	result := SetAccessControlRecursiveResult{}
+	result.append(SetAccessControlRecursiveResult{
+		DirectoriesSuccessful: 1,
+		FilesSuccessful:       3,
+	})
+	result.append(SetAccessControlRecursiveResult{
+		FilesSuccessful: 2,
+		FailureCount:    1,
+		FailedEntries: []FailedEntry{
+			{
+				Name: "example/locked.txt",
+				Type: "FILE",
+			},
+		},
+	})
+
+	if result.DirectoriesSuccessful != 1 || result.FilesSuccessful != 5 || result.FailureCount != 1 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	if len(result.FailedEntries) != 1 {
+		t.Fatalf("expected 1 failed entry but got %d", len(result.FailedEntries))
+	}
+}
+
+func TestSetAccessControlRecursiveOptionsToQuery(t *testing.T) {
+	cases := []struct {
+		Input    SetAccessControlRecursiveInput
+		Expected string
+	}{
+		{
+			Input: SetAccessControlRecursiveInput{
+				Mode: SetAccessControlRecursiveModeSet,
+				ACL:  "user::rwx,group::r-x,other::---",
+			},
+			Expected: "action=setAccessControlRecursive&mode=set",
+		},
+		{
+			Input: SetAccessControlRecursiveInput{
+				Mode:         SetAccessControlRecursiveModeModify,
+				ACL:          "user::rwx,group::r-x,other::---",
+				Continuation: pointer.To("VBaS6LfytLHINBgYAAAAAQ=="),
+				ForceFlag:    true,
+				MaxRecords:   pointer.To(100),
+			},
+			Expected: "action=setAccessControlRecursive&continuation=VBaS6LfytLHINBgYAAAAAQ%3D%3D&forceFlag=true&maxRecords=100&mode=modify",
+		},
+	}
+
+	for _, v := range cases {
+		opts := setAccessControlRecursiveOptions{
+			input: v.Input,
+		}
+		if actual := opts.ToQuery().Values().Encode(); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+		if actual := opts.ToHeaders().Headers().Get("x-ms-acl"); actual != v.Input.ACL {
+			t.Fatalf("expected the `x-ms-acl` header to be %q but got %q", v.Input.ACL, actual)
+		}
+	}
+}
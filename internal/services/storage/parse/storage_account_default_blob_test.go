@@ -21,6 +21,23 @@ func TestStorageAccountDefaultBlobIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageAccountDefaultBlobIDSegments(t *testing.T) {
+	expected := NewStorageAccountDefaultBlobID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StorageAccountDefaultBlobId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StorageAccountDefaultBlobId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStorageAccountDefaultBlobID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id StorageAccountManagementPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ManagementPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StorageAccountManagementPolicy ID
+func (id StorageAccountManagementPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage", "Microsoft.Storage"),
+		resourceids.StaticSegment("staticStorageAccounts", "storageAccounts", "storageAccounts"),
+		resourceids.UserSpecifiedSegment("storageAccountName", "storageAccount1"),
+		resourceids.StaticSegment("staticManagementPolicies", "managementPolicies", "managementPolicies"),
+		resourceids.UserSpecifiedSegment("managementPolicyName", "policy1"),
+	}
+}
+
 // StorageAccountManagementPolicyID parses a StorageAccountManagementPolicy ID into an StorageAccountManagementPolicyId struct
 func StorageAccountManagementPolicyID(input string) (*StorageAccountManagementPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func StorageAccountManagementPolicyID(input string) (*StorageAccountManagementPo
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the StorageAccountManagementPolicyId using the ParseResult provided in 'input'
+func (id *StorageAccountManagementPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.ManagementPolicyName, ok = input.Parsed["managementPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "managementPolicyName", input)
+	}
+
+	return nil
+}
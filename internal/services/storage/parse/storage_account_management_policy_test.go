@@ -21,6 +21,23 @@ func TestStorageAccountManagementPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageAccountManagementPolicyIDSegments(t *testing.T) {
+	expected := NewStorageAccountManagementPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StorageAccountManagementPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StorageAccountManagementPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStorageAccountManagementPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestStorageContainerImmutabilityPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageContainerImmutabilityPolicyIDSegments(t *testing.T) {
+	expected := NewStorageContainerImmutabilityPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "default", "container1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StorageContainerImmutabilityPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StorageContainerImmutabilityPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStorageContainerImmutabilityPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
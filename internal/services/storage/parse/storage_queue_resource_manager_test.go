@@ -21,6 +21,23 @@ func TestStorageQueueResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageQueueResourceManagerIDSegments(t *testing.T) {
+	expected := NewStorageQueueResourceManagerID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "default", "queue1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StorageQueueResourceManagerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StorageQueueResourceManagerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStorageQueueResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
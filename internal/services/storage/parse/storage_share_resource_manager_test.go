@@ -21,6 +21,23 @@ func TestStorageShareResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageShareResourceManagerIDSegments(t *testing.T) {
+	expected := NewStorageShareResourceManagerID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "fileService1", "share1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StorageShareResourceManagerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StorageShareResourceManagerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStorageShareResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
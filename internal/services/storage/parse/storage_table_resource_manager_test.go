@@ -21,6 +21,23 @@ func TestStorageTableResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageTableResourceManagerIDSegments(t *testing.T) {
+	expected := NewStorageTableResourceManagerID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "tableService1", "table1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StorageTableResourceManagerId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StorageTableResourceManagerId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStorageTableResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
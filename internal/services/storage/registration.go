@@ -4,13 +4,18 @@
 package storage
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkServiceRegistration               = Registration{}
+	_ sdk.ServiceRegistrationWithListResources       = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/storage"
@@ -35,13 +40,18 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_account_sas":                dataSourceStorageAccountSharedAccessSignature(),
 		"azurerm_storage_account":                    dataSourceStorageAccount(),
 		"azurerm_storage_blob":                       dataSourceStorageBlob(),
+		"azurerm_storage_blob_user_delegation_sas":   dataSourceStorageBlobUserDelegationSharedAccessSignature(),
 		"azurerm_storage_container":                  dataSourceStorageContainer(),
 		"azurerm_storage_encryption_scope":           dataSourceStorageEncryptionScope(),
 		"azurerm_storage_management_policy":          dataSourceStorageManagementPolicy(),
 		"azurerm_storage_queue":                      dataSourceStorageQueue(),
+		"azurerm_storage_queue_sas":                  dataSourceStorageQueueSharedAccessSignature(),
 		"azurerm_storage_share":                      dataSourceStorageShare(),
+		"azurerm_storage_share_file_sas":             dataSourceStorageShareFileSharedAccessSignature(),
+		"azurerm_storage_share_sas":                  dataSourceStorageShareSharedAccessSignature(),
 		"azurerm_storage_sync":                       dataSourceStorageSync(),
 		"azurerm_storage_sync_group":                 dataSourceStorageSyncGroup(),
+		"azurerm_storage_table_sas":                  dataSourceStorageTableSharedAccessSignature(),
 		"azurerm_storage_table_entity":               dataSourceStorageTableEntity(),
 	}
 }
@@ -56,6 +66,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_blob_inventory_policy":        resourceStorageBlobInventoryPolicy(),
 		"azurerm_storage_container":                    resourceStorageContainer(),
 		"azurerm_storage_encryption_scope":             resourceStorageEncryptionScope(),
+		"azurerm_storage_data_lake_gen2_acl":           resourceStorageDataLakeGen2Acl(),
 		"azurerm_storage_data_lake_gen2_filesystem":    resourceStorageDataLakeGen2FileSystem(),
 		"azurerm_storage_data_lake_gen2_path":          resourceStorageDataLakeGen2Path(),
 		"azurerm_storage_management_policy":            resourceStorageManagementPolicy(),
@@ -77,6 +88,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 		storageTableDataSource{},
 		storageTableEntitiesDataSource{},
 		storageContainersDataSource{},
+		storageBlobsDataSource{},
+		storageBlobVersionsDataSource{},
+		storageBlobSnapshotsDataSource{},
 	}
 }
 
@@ -84,6 +98,22 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LocalUserResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageBlobDirectorySyncResource{},
 		SyncServerEndpointResource{},
 	}
 }
+
+// EphemeralResources returns the Ephemeral Resources supported by this Service
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewStorageAccountBlobContainerSasEphemeralResource,
+		NewStorageAccountSasEphemeralResource,
+	}
+}
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		StorageAccountListResource{},
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+)
+
+func TestBlobServiceSASContainer(t *testing.T) {
+	input := BlobInput{
+		AccountName:   "myaccount",
+		ContainerName: "pictures",
+		Permissions:   "rl",
+		Start:         "2024-01-01T00:00:00Z",
+		Expiry:        "2024-01-02T00:00:00Z",
+		IP:            "168.1.5.60-168.1.5.70",
+		Protocol:      ProtocolHttps,
+		ResponseHeaders: ResponseHeaders{
+			ContentType: "image/jpeg",
+		},
+	}
+
+	expectedStringToSign := "rl\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/blob/myaccount/pictures\n" +
+		"\n" + // signedIdentifier
+		"168.1.5.60-168.1.5.70\n" +
+		"https\n" +
+		"2023-11-03\n" +
+		"c\n" +
+		"\n" + // signedSnapshotTime
+		"\n" + // signedEncryptionScope
+		"\n" + // rscc
+		"\n" + // rscd
+		"\n" + // rsce
+		"\n" + // rscl
+		"image/jpeg"
+	if actual := input.ServiceStringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := BlobServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":   "2023-11-03",
+		"sr":   "c",
+		"st":   "2024-01-01T00:00:00Z",
+		"se":   "2024-01-02T00:00:00Z",
+		"sp":   "rl",
+		"sip":  "168.1.5.60-168.1.5.70",
+		"spr":  "https",
+		"rsct": "image/jpeg",
+		"sig":  "Gbm5lIW3F0sJ7fCzHhH89GBvlBioN3F7c0zidDuUDuA=",
+	})
+}
+
+func TestBlobServiceSASBlob(t *testing.T) {
+	input := BlobInput{
+		AccountName:     "myaccount",
+		ContainerName:   "pictures",
+		BlobName:        "profile.jpg",
+		Permissions:     "r",
+		Expiry:          "2024-01-02T00:00:00Z",
+		Identifier:      "policy1",
+		Protocol:        ProtocolHttpsAndHttp,
+		EncryptionScope: "scope1",
+		ResponseHeaders: ResponseHeaders{
+			CacheControl:       "no-cache",
+			ContentDisposition: "attachment; filename=profile.jpg",
+			ContentLanguage:    "en-GB",
+		},
+	}
+
+	expectedStringToSign := "r\n" +
+		"\n" + // signedStart
+		"2024-01-02T00:00:00Z\n" +
+		"/blob/myaccount/pictures/profile.jpg\n" +
+		"policy1\n" +
+		"\n" + // signedIP
+		"https,http\n" +
+		"2023-11-03\n" +
+		"b\n" +
+		"\n" + // signedSnapshotTime
+		"scope1\n" +
+		"no-cache\n" +
+		"attachment; filename=profile.jpg\n" +
+		"\n" + // rsce
+		"en-GB\n" +
+		"" // rsct
+	if actual := input.ServiceStringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := BlobServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":   "2023-11-03",
+		"sr":   "b",
+		"se":   "2024-01-02T00:00:00Z",
+		"sp":   "r",
+		"spr":  "https,http",
+		"si":   "policy1",
+		"ses":  "scope1",
+		"rscc": "no-cache",
+		"rscd": "attachment; filename=profile.jpg",
+		"rscl": "en-GB",
+		"sig":  "GR/qvkb7AsMAqsbYCr++dkM5SXO7PTEc/oINVx3bxh0=",
+	})
+}
+
+func TestBlobServiceSASLegacyVersion(t *testing.T) {
+	// prior to version 2020-12-06 the Encryption Scope isn't part of the string-to-sign, which
+	// is the layout used by the existing `azurerm_storage_account_blob_container_sas` Data Source
+	input := BlobInput{
+		AccountName:   "myaccount",
+		ContainerName: "pictures",
+		Permissions:   "racwdl",
+		Start:         "2024-01-01",
+		Expiry:        "2024-01-02",
+		Protocol:      ProtocolHttps,
+		Version:       "2018-11-09",
+	}
+
+	expectedStringToSign := "racwdl\n2024-01-01\n2024-01-02\n/blob/myaccount/pictures\n\n\nhttps\n2018-11-09\nc\n\n\n\n\n\n"
+	if actual := input.ServiceStringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := BlobServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	expectedSignature := "ofCKguEOE+ttPxEJsP3EB4ksMESPLtv8yPcd+LEApD0="
+	if actual := parseToken(t, token).Get("sig"); actual != expectedSignature {
+		t.Fatalf("expected the signature %q but got %q", expectedSignature, actual)
+	}
+
+	legacy, err := storage.ComputeContainerSASToken("racwdl", "2024-01-01", "2024-01-02", "myaccount", testAccountKey, "pictures", "", "", "https", "", "", "", "", "", "")
+	if err != nil {
+		t.Fatalf("computing the legacy SAS: %+v", err)
+	}
+	if actual := parseToken(t, legacy).Get("sig"); actual != expectedSignature {
+		t.Fatalf("expected the legacy signature %q but got %q", expectedSignature, actual)
+	}
+}
+
+func TestBlobUserDelegationSAS(t *testing.T) {
+	input := BlobInput{
+		AccountName:   "myaccount",
+		ContainerName: "pictures",
+		BlobName:      "profile.jpg",
+		Permissions:   "rw",
+		Start:         "2024-01-01T00:00:00Z",
+		Expiry:        "2024-01-02T00:00:00Z",
+		Protocol:      ProtocolHttps,
+	}
+	key := UserDelegationKey{
+		SignedOid:     "11111111-1111-1111-1111-111111111111",
+		SignedTid:     "22222222-2222-2222-2222-222222222222",
+		SignedStart:   "2024-01-01T00:00:00Z",
+		SignedExpiry:  "2024-01-02T00:00:00Z",
+		SignedService: "b",
+		SignedVersion: "2023-11-03",
+		Value:         testUserDelegationKey,
+	}
+
+	expectedStringToSign := "rw\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/blob/myaccount/pictures/profile.jpg\n" +
+		"11111111-1111-1111-1111-111111111111\n" +
+		"22222222-2222-2222-2222-222222222222\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"b\n" +
+		"2023-11-03\n" +
+		"\n" + // signedAuthorizedUserObjectId
+		"\n" + // signedUnauthorizedUserObjectId
+		"\n" + // signedCorrelationId
+		"\n" + // signedIP
+		"https\n" +
+		"2023-11-03\n" +
+		"b\n" +
+		"\n" + // signedSnapshotTime
+		"\n" + // signedEncryptionScope
+		"\n" + // rscc
+		"\n" + // rscd
+		"\n" + // rsce
+		"\n" + // rscl
+		"" // rsct
+	if actual := input.UserDelegationStringToSign(key); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := BlobUserDelegationSAS(input, key)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":    "2023-11-03",
+		"sr":    "b",
+		"st":    "2024-01-01T00:00:00Z",
+		"se":    "2024-01-02T00:00:00Z",
+		"sp":    "rw",
+		"spr":   "https",
+		"skoid": "11111111-1111-1111-1111-111111111111",
+		"sktid": "22222222-2222-2222-2222-222222222222",
+		"skt":   "2024-01-01T00:00:00Z",
+		"ske":   "2024-01-02T00:00:00Z",
+		"sks":   "b",
+		"skv":   "2023-11-03",
+		"sig":   "OOsh0yaQ5oLzzL5FoQpCO0cpjKiubz9cQHwAQMoaJa4=",
+	})
+}
+
+func TestBlobUserDelegationSASInvalid(t *testing.T) {
+	key := UserDelegationKey{
+		Value: testUserDelegationKey,
+	}
+
+	cases := map[string]BlobInput{
+		"no container": {
+			AccountName: "myaccount",
+			Permissions: "r",
+			Expiry:      "2024-01-02T00:00:00Z",
+		},
+		"no permissions": {
+			AccountName:   "myaccount",
+			ContainerName: "pictures",
+			Expiry:        "2024-01-02T00:00:00Z",
+		},
+		"stored access policy": {
+			AccountName:   "myaccount",
+			ContainerName: "pictures",
+			Permissions:   "r",
+			Expiry:        "2024-01-02T00:00:00Z",
+			Identifier:    "policy1",
+		},
+		"legacy version": {
+			AccountName:   "myaccount",
+			ContainerName: "pictures",
+			Permissions:   "r",
+			Expiry:        "2024-01-02T00:00:00Z",
+			Version:       "2018-11-09",
+		},
+	}
+
+	for name, input := range cases {
+		if _, err := BlobUserDelegationSAS(input, key); err == nil {
+			t.Fatalf("expected an error for %q", name)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	SignedResourceFile  = "f"
+	SignedResourceShare = "s"
+)
+
+// FileInput specifies the File Share (or File within it) which the Shared Access Signature grants access to.
+type FileInput struct {
+	AccountName string
+	ShareName   string
+
+	// FilePath is the path to the File (including any Directories) which the SAS grants access to - when
+	// empty the SAS grants access to the File Share
+	FilePath string
+
+	Permissions string
+	Start       string
+	Expiry      string
+
+	// Identifier is the optional name of a Stored Access Policy on the File Share
+	Identifier string
+	IP         string
+	Protocol   string
+
+	ResponseHeaders
+
+	// Version is the signed version of the SAS, which defaults to SignedVersion
+	Version string
+}
+
+func (in FileInput) canonicalizedResource() string {
+	resource := fmt.Sprintf("/file/%s/%s", in.AccountName, in.ShareName)
+	if in.FilePath != "" {
+		resource += "/" + strings.TrimPrefix(in.FilePath, "/")
+	}
+	return resource
+}
+
+func (in FileInput) signedResource() string {
+	if in.FilePath != "" {
+		return SignedResourceFile
+	}
+	return SignedResourceShare
+}
+
+// StringToSign returns the string-to-sign for a File Service SAS (version 2015-04-05 and later).
+func (in FileInput) StringToSign() string {
+	values := []string{
+		in.Permissions,
+		in.Start,
+		in.Expiry,
+		in.canonicalizedResource(),
+		in.Identifier,
+		in.IP,
+		in.Protocol,
+		versionOrDefault(in.Version),
+	}
+	values = append(values, in.ResponseHeaders.stringToSign()...)
+
+	return strings.Join(values, "\n")
+}
+
+// FileServiceSAS returns a Service SAS for the File Share or File signed using the Storage Account Key.
+func FileServiceSAS(input FileInput, accountKey string) (string, error) {
+	if input.AccountName == "" {
+		return "", fmt.Errorf("`AccountName` cannot be an empty string")
+	}
+	if input.ShareName == "" {
+		return "", fmt.Errorf("`ShareName` cannot be an empty string")
+	}
+	if input.Permissions == "" {
+		return "", fmt.Errorf("`Permissions` cannot be an empty string")
+	}
+	if input.Expiry == "" {
+		return "", fmt.Errorf("`Expiry` cannot be an empty string")
+	}
+
+	signature, err := sign(accountKey, input.StringToSign())
+	if err != nil {
+		return "", err
+	}
+
+	parameters := []parameter{
+		{"sv", versionOrDefault(input.Version)},
+		{"sr", input.signedResource()},
+		{"st", input.Start},
+		{"se", input.Expiry},
+		{"sp", input.Permissions},
+		{"sip", input.IP},
+		{"spr", input.Protocol},
+		{"si", input.Identifier},
+	}
+	parameters = append(parameters, input.ResponseHeaders.parameters()...)
+
+	return buildToken(parameters, signature), nil
+}
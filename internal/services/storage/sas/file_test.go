@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import "testing"
+
+func TestFileServiceSASShare(t *testing.T) {
+	input := FileInput{
+		AccountName: "myaccount",
+		ShareName:   "myshare",
+		Permissions: "rcwdl",
+		Start:       "2024-01-01T00:00:00Z",
+		Expiry:      "2024-01-02T00:00:00Z",
+		Protocol:    ProtocolHttps,
+	}
+
+	expectedStringToSign := "rcwdl\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/file/myaccount/myshare\n" +
+		"\n" + // signedIdentifier
+		"\n" + // signedIP
+		"https\n" +
+		"2023-11-03\n" +
+		"\n" + // rscc
+		"\n" + // rscd
+		"\n" + // rsce
+		"\n" + // rscl
+		"" // rsct
+	if actual := input.StringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := FileServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":  "2023-11-03",
+		"sr":  "s",
+		"st":  "2024-01-01T00:00:00Z",
+		"se":  "2024-01-02T00:00:00Z",
+		"sp":  "rcwdl",
+		"spr": "https",
+		"sig": "D+YbJrXSTqr6P45j97MosMFSU1rVd6xg+36z2SXK6xY=",
+	})
+}
+
+func TestFileServiceSASFile(t *testing.T) {
+	input := FileInput{
+		AccountName: "myaccount",
+		ShareName:   "myshare",
+		FilePath:    "/directory/report.pdf",
+		Permissions: "r",
+		Expiry:      "2024-01-02T00:00:00Z",
+		IP:          "10.0.0.1",
+		Protocol:    ProtocolHttps,
+		ResponseHeaders: ResponseHeaders{
+			ContentDisposition: "inline",
+			ContentType:        "application/pdf",
+		},
+	}
+
+	expectedStringToSign := "r\n" +
+		"\n" + // signedStart
+		"2024-01-02T00:00:00Z\n" +
+		"/file/myaccount/myshare/directory/report.pdf\n" +
+		"\n" + // signedIdentifier
+		"10.0.0.1\n" +
+		"https\n" +
+		"2023-11-03\n" +
+		"\n" + // rscc
+		"inline\n" +
+		"\n" + // rsce
+		"\n" + // rscl
+		"application/pdf"
+	if actual := input.StringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := FileServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":   "2023-11-03",
+		"sr":   "f",
+		"se":   "2024-01-02T00:00:00Z",
+		"sp":   "r",
+		"sip":  "10.0.0.1",
+		"spr":  "https",
+		"rscd": "inline",
+		"rsct": "application/pdf",
+		"sig":  "qihiVBdlEX6p8b0eDuHpg40EGsgzhXHK1t1JACxZ5dU=",
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueueInput specifies the Queue which the Shared Access Signature grants access to.
+type QueueInput struct {
+	AccountName string
+	QueueName   string
+
+	Permissions string
+	Start       string
+	Expiry      string
+
+	// Identifier is the optional name of a Stored Access Policy on the Queue
+	Identifier string
+	IP         string
+	Protocol   string
+
+	// Version is the signed version of the SAS, which defaults to SignedVersion
+	Version string
+}
+
+// StringToSign returns the string-to-sign for a Queue Service SAS (version 2015-04-05 and later).
+func (in QueueInput) StringToSign() string {
+	return strings.Join([]string{
+		in.Permissions,
+		in.Start,
+		in.Expiry,
+		fmt.Sprintf("/queue/%s/%s", in.AccountName, in.QueueName),
+		in.Identifier,
+		in.IP,
+		in.Protocol,
+		versionOrDefault(in.Version),
+	}, "\n")
+}
+
+// QueueServiceSAS returns a Service SAS for the Queue signed using the Storage Account Key.
+func QueueServiceSAS(input QueueInput, accountKey string) (string, error) {
+	if input.AccountName == "" {
+		return "", fmt.Errorf("`AccountName` cannot be an empty string")
+	}
+	if input.QueueName == "" {
+		return "", fmt.Errorf("`QueueName` cannot be an empty string")
+	}
+	if input.Permissions == "" {
+		return "", fmt.Errorf("`Permissions` cannot be an empty string")
+	}
+	if input.Expiry == "" {
+		return "", fmt.Errorf("`Expiry` cannot be an empty string")
+	}
+
+	signature, err := sign(accountKey, input.StringToSign())
+	if err != nil {
+		return "", err
+	}
+
+	return buildToken([]parameter{
+		{"sv", versionOrDefault(input.Version)},
+		{"st", input.Start},
+		{"se", input.Expiry},
+		{"sp", input.Permissions},
+		{"sip", input.IP},
+		{"spr", input.Protocol},
+		{"si", input.Identifier},
+	}, signature), nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import "testing"
+
+func TestQueueServiceSAS(t *testing.T) {
+	input := QueueInput{
+		AccountName: "myaccount",
+		QueueName:   "myqueue",
+		Permissions: "raup",
+		Start:       "2024-01-01T00:00:00Z",
+		Expiry:      "2024-01-02T00:00:00Z",
+		Protocol:    ProtocolHttps,
+	}
+
+	expectedStringToSign := "raup\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/queue/myaccount/myqueue\n" +
+		"\n" + // signedIdentifier
+		"\n" + // signedIP
+		"https\n" +
+		"2023-11-03"
+	if actual := input.StringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := QueueServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":  "2023-11-03",
+		"st":  "2024-01-01T00:00:00Z",
+		"se":  "2024-01-02T00:00:00Z",
+		"sp":  "raup",
+		"spr": "https",
+		"sig": "yK00I3sQx7Jk6qnNoo5FI7+acnHz1aAXbhCmIzSl/aw=",
+	})
+}
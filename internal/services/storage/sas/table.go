@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TableInput specifies the Table (and optionally the range of Entities within it) which the Shared Access Signature grants access to.
+type TableInput struct {
+	AccountName string
+	TableName   string
+
+	Permissions string
+	Start       string
+	Expiry      string
+
+	// Identifier is the optional name of a Stored Access Policy on the Table
+	Identifier string
+	IP         string
+	Protocol   string
+
+	StartPartitionKey string
+	StartRowKey       string
+	EndPartitionKey   string
+	EndRowKey         string
+
+	// Version is the signed version of the SAS, which defaults to SignedVersion
+	Version string
+}
+
+// StringToSign returns the string-to-sign for a Table Service SAS (version 2015-04-05 and later).
+func (in TableInput) StringToSign() string {
+	return strings.Join([]string{
+		in.Permissions,
+		in.Start,
+		in.Expiry,
+		// the name of the Table must be lower-cased within the canonicalized resource
+		fmt.Sprintf("/table/%s/%s", in.AccountName, strings.ToLower(in.TableName)),
+		in.Identifier,
+		in.IP,
+		in.Protocol,
+		versionOrDefault(in.Version),
+		in.StartPartitionKey,
+		in.StartRowKey,
+		in.EndPartitionKey,
+		in.EndRowKey,
+	}, "\n")
+}
+
+// TableServiceSAS returns a Service SAS for the Table signed using the Storage Account Key.
+func TableServiceSAS(input TableInput, accountKey string) (string, error) {
+	if input.AccountName == "" {
+		return "", fmt.Errorf("`AccountName` cannot be an empty string")
+	}
+	if input.TableName == "" {
+		return "", fmt.Errorf("`TableName` cannot be an empty string")
+	}
+	if input.Permissions == "" {
+		return "", fmt.Errorf("`Permissions` cannot be an empty string")
+	}
+	if input.Expiry == "" {
+		return "", fmt.Errorf("`Expiry` cannot be an empty string")
+	}
+
+	signature, err := sign(accountKey, input.StringToSign())
+	if err != nil {
+		return "", err
+	}
+
+	return buildToken([]parameter{
+		{"sv", versionOrDefault(input.Version)},
+		{"tn", input.TableName},
+		{"st", input.Start},
+		{"se", input.Expiry},
+		{"sp", input.Permissions},
+		{"sip", input.IP},
+		{"spr", input.Protocol},
+		{"si", input.Identifier},
+		{"spk", input.StartPartitionKey},
+		{"srk", input.StartRowKey},
+		{"epk", input.EndPartitionKey},
+		{"erk", input.EndRowKey},
+	}, signature), nil
+}
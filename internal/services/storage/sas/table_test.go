@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import "testing"
+
+func TestTableServiceSAS(t *testing.T) {
+	input := TableInput{
+		AccountName:       "myaccount",
+		TableName:         "MyTable",
+		Permissions:       "raud",
+		Start:             "2024-01-01T00:00:00Z",
+		Expiry:            "2024-01-02T00:00:00Z",
+		Protocol:          ProtocolHttps,
+		StartPartitionKey: "Jeff",
+		EndPartitionKey:   "Jeff",
+		EndRowKey:         "zzz",
+	}
+
+	expectedStringToSign := "raud\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/table/myaccount/mytable\n" +
+		"\n" + // signedIdentifier
+		"\n" + // signedIP
+		"https\n" +
+		"2023-11-03\n" +
+		"Jeff\n" +
+		"\n" + // startingRowKey
+		"Jeff\n" +
+		"zzz"
+	if actual := input.StringToSign(); actual != expectedStringToSign {
+		t.Fatalf("expected the string-to-sign %q but got %q", expectedStringToSign, actual)
+	}
+
+	token, err := TableServiceSAS(input, testAccountKey)
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+	assertToken(t, token, map[string]string{
+		"sv":  "2023-11-03",
+		"tn":  "MyTable",
+		"st":  "2024-01-01T00:00:00Z",
+		"se":  "2024-01-02T00:00:00Z",
+		"sp":  "raud",
+		"spr": "https",
+		"spk": "Jeff",
+		"epk": "Jeff",
+		"erk": "zzz",
+		"sig": "5J4S3iZuQ/2Wultp8c6p/r3OvHoNT+OwA1nRZpflR8I=",
+	})
+}
@@ -69,6 +69,23 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, containerName
 	}, nil
 }
 
+// ListBlobs returns every blob within the container matching the input, retrieving each page of results
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, containerName string, input containers.ListBlobsInput) ([]containers.BlobDetails, error) {
+	out := make([]containers.BlobDetails, 0)
+	for {
+		resp, err := w.client.ListBlobs(ctx, containerName, input)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resp.Blobs.Blobs...)
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			return out, nil
+		}
+		input.Marker = resp.NextMarker
+	}
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, containerName string, level containers.AccessLevel) error {
 	input := containers.SetAccessControlInput{
 		AccessLevel: level,
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &StorageAccountBlobContainerSasEphemeralResource{}
+
+func NewStorageAccountBlobContainerSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountBlobContainerSasEphemeralResource{}
+}
+
+// StorageAccountBlobContainerSasEphemeralResource is the ephemeral equivalent of the
+// `azurerm_storage_account_blob_container_sas` Data Source
+type StorageAccountBlobContainerSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountBlobContainerSasEphemeralResourceModel struct {
+	ConnectionString   string                                    `tfsdk:"connection_string"`
+	ContainerName      string                                    `tfsdk:"container_name"`
+	HttpsOnly          types.Bool                                `tfsdk:"https_only"`
+	IPAddress          types.String                              `tfsdk:"ip_address"`
+	Start              string                                    `tfsdk:"start"`
+	Expiry             string                                    `tfsdk:"expiry"`
+	Permissions        StorageAccountBlobContainerSasPermissions `tfsdk:"permissions"`
+	CacheControl       types.String                              `tfsdk:"cache_control"`
+	ContentDisposition types.String                              `tfsdk:"content_disposition"`
+	ContentEncoding    types.String                              `tfsdk:"content_encoding"`
+	ContentLanguage    types.String                              `tfsdk:"content_language"`
+	ContentType        types.String                              `tfsdk:"content_type"`
+	Sas                types.String                              `tfsdk:"sas"`
+}
+
+type StorageAccountBlobContainerSasPermissions struct {
+	Read   bool `tfsdk:"read"`
+	Add    bool `tfsdk:"add"`
+	Create bool `tfsdk:"create"`
+	Write  bool `tfsdk:"write"`
+	Delete bool `tfsdk:"delete"`
+	List   bool `tfsdk:"list"`
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_blob_container_sas"
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"container_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Defaults to `true`.",
+			},
+
+			"ip_address": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: storageValidate.SharedAccessSignatureIP,
+					},
+				},
+			},
+
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"cache_control": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_disposition": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_encoding": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_language": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Optional: true,
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"permissions": schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					frameworkhelpers.RequiredBlockValidator{},
+				},
+				Attributes: map[string]schema.Attribute{
+					"read": schema.BoolAttribute{
+						Required: true,
+					},
+					"add": schema.BoolAttribute{
+						Required: true,
+					},
+					"create": schema.BoolAttribute{
+						Required: true,
+					},
+					"write": schema.BoolAttribute{
+						Required: true,
+					},
+					"delete": schema.BoolAttribute{
+						Required: true,
+					},
+					"list": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *StorageAccountBlobContainerSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountBlobContainerSasEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions := BuildContainerPermissionsString(map[string]interface{}{
+		"read":   data.Permissions.Read,
+		"add":    data.Permissions.Add,
+		"create": data.Permissions.Create,
+		"write":  data.Permissions.Write,
+		"delete": data.Permissions.Delete,
+		"list":   data.Permissions.List,
+	})
+
+	kvp, err := storage.ParseAccountSASConnectionString(data.ConnectionString)
+	if err != nil {
+		resp.Diagnostics.AddError("parsing `connection_string`", err.Error())
+		return
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+	signedProtocol := "https"
+	if !data.HttpsOnly.IsNull() && !data.HttpsOnly.ValueBool() {
+		signedProtocol = "https,http"
+	}
+	signedIdentifier := ""
+	signedSnapshotTime := ""
+
+	sasToken, err := storage.ComputeContainerSASToken(permissions, data.Start, data.Expiry, accountName, accountKey,
+		data.ContainerName, signedIdentifier, data.IPAddress.ValueString(), signedProtocol, signedSnapshotTime,
+		data.CacheControl.ValueString(), data.ContentDisposition.ValueString(), data.ContentEncoding.ValueString(),
+		data.ContentLanguage.ValueString(), data.ContentType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("computing the Shared Access Signature", err.Error())
+		return
+	}
+
+	data.Sas = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -108,7 +108,7 @@ func resourceStorageAccountCustomerManagedKey() *pluginsdk.Resource {
 		)
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &commonids.StorageAccountId{})
 }
 
 func resourceStorageAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type StorageAccountListResource struct{}
+
+var _ sdk.ListResource = StorageAccountListResource{}
+
+func (r StorageAccountListResource) ResourceType() string {
+	return "azurerm_storage_account"
+}
+
+func (r StorageAccountListResource) List(ctx context.Context, metadata sdk.ListMetadata, request sdk.ListRequest) ([]sdk.ListResult, error) {
+	client := metadata.Client.Storage.ResourceManager.StorageAccounts
+
+	var accounts []storageaccounts.StorageAccount
+	if request.ResourceGroupName != "" {
+		resourceGroupId := commonids.NewResourceGroupID(metadata.SubscriptionId, request.ResourceGroupName)
+		resp, err := client.ListByResourceGroupComplete(ctx, resourceGroupId)
+		if err != nil {
+			return nil, fmt.Errorf("listing Storage Accounts within %s: %+v", resourceGroupId, err)
+		}
+		accounts = resp.Items
+	} else {
+		subscriptionId := commonids.NewSubscriptionID(metadata.SubscriptionId)
+		resp, err := client.ListComplete(ctx, subscriptionId)
+		if err != nil {
+			return nil, fmt.Errorf("listing Storage Accounts within %s: %+v", subscriptionId, err)
+		}
+		accounts = resp.Items
+	}
+
+	results := make([]sdk.ListResult, 0)
+	for _, item := range accounts {
+		if item.Id == nil {
+			continue
+		}
+
+		id, err := commonids.ParseStorageAccountIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, sdk.ListResult{
+			Id:   id,
+			Name: id.StorageAccountName,
+			Tags: pointer.From(item.Tags),
+		})
+	}
+
+	return results, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/localusers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computevalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -25,6 +26,7 @@ import (
 type LocalUserResource struct{}
 
 var _ sdk.ResourceWithUpdate = LocalUserResource{}
+var _ sdk.ResourceWithIdentity = LocalUserResource{}
 
 type PermissionsModel struct {
 	Create bool `tfschema:"create"`
@@ -193,6 +195,10 @@ func (r LocalUserResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return localusers.ValidateLocalUserID
 }
 
+func (r LocalUserResource) Identity() resourceids.ResourceId {
+	return &localusers.LocalUserId{}
+}
+
 func (r LocalUserResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceStorageAccountNetworkRules() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStorageAccountNetworkRulesCreate,
 		Read:   resourceStorageAccountNetworkRulesRead,
 		Update: resourceStorageAccountNetworkRulesUpdate,
@@ -116,7 +116,7 @@ func resourceStorageAccountNetworkRules() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &commonids.StorageAccountId{})
 }
 
 func resourceStorageAccountNetworkRulesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
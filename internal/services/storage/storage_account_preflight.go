@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// storageAccountPreflightValidation checks the name of the Storage Account is available and that Resource Manager
+// accepts the planned Storage Account, when Preflight Validation is enabled
+func storageAccountPreflightValidation(ctx context.Context, d *pluginsdk.ResourceDiff, client *clients.Client) error {
+	if !preflight.ValuesKnown(d, "name", "resource_group_name", "location", "account_kind", "account_tier", "account_replication_type") {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	if err := preflight.ValidateName(validate.StorageAccountName, "name", name); err != nil {
+		return err
+	}
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	availabilityRequest := storageaccounts.StorageAccountCheckNameAvailabilityParameters{
+		Name: name,
+		Type: storageaccounts.TypeMicrosoftPointStorageStorageAccounts,
+	}
+	resp, err := client.Storage.ResourceManager.StorageAccounts.CheckNameAvailability(ctx, subscriptionId, availabilityRequest)
+	if err != nil {
+		return fmt.Errorf("checking if the name %q was available: %+v", name, err)
+	}
+	if model := resp.Model; model != nil && model.NameAvailable != nil && !*model.NameAvailable {
+		return preflight.NameUnavailableError("Storage Account", name, (*string)(model.Reason), model.Message)
+	}
+
+	resource := preflight.TemplateResource{
+		Type:       "Microsoft.Storage/storageAccounts",
+		ApiVersion: "2023-01-01",
+		Name:       name,
+		Location:   location.Normalize(d.Get("location").(string)),
+		Kind:       d.Get("account_kind").(string),
+		Sku: map[string]interface{}{
+			"name": fmt.Sprintf("%s_%s", d.Get("account_tier").(string), d.Get("account_replication_type").(string)),
+		},
+	}
+	if d.NewValueKnown("access_tier") {
+		if accessTier := d.Get("access_tier").(string); accessTier != "" {
+			resource.Properties = map[string]interface{}{
+				"accessTier": accessTier,
+			}
+		}
+	}
+	if d.NewValueKnown("tags") {
+		resource.Tags = d.Get("tags").(map[string]interface{})
+	}
+
+	return preflight.ValidateDeployment(ctx, client, d.Get("resource_group_name").(string), resource)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/preflight"
 	keyVaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -1270,6 +1271,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			preflight.CustomizeDiff(storageAccountPreflightValidation),
 		),
 	}
 
@@ -1286,7 +1288,9 @@ func resourceStorageAccount() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &commonids.StorageAccountId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &commonids.StorageAccountId{})
 }
 
 func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &StorageAccountSasEphemeralResource{}
+
+func NewStorageAccountSasEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountSasEphemeralResource{}
+}
+
+// StorageAccountSasEphemeralResource is the ephemeral equivalent of the `azurerm_storage_account_sas`
+// Data Source, meaning the generated token is never persisted into the plan or state
+type StorageAccountSasEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountSasEphemeralResourceModel struct {
+	ConnectionString string                         `tfsdk:"connection_string"`
+	HttpsOnly        types.Bool                     `tfsdk:"https_only"`
+	IPAddresses      types.String                   `tfsdk:"ip_addresses"`
+	SignedVersion    types.String                   `tfsdk:"signed_version"`
+	ResourceTypes    StorageAccountSasResourceTypes `tfsdk:"resource_types"`
+	Services         StorageAccountSasServices      `tfsdk:"services"`
+	Start            string                         `tfsdk:"start"`
+	Expiry           string                         `tfsdk:"expiry"`
+	Permissions      StorageAccountSasPermissions   `tfsdk:"permissions"`
+	Sas              types.String                   `tfsdk:"sas"`
+}
+
+type StorageAccountSasResourceTypes struct {
+	Service   bool `tfsdk:"service"`
+	Container bool `tfsdk:"container"`
+	Object    bool `tfsdk:"object"`
+}
+
+type StorageAccountSasServices struct {
+	Blob  bool `tfsdk:"blob"`
+	Queue bool `tfsdk:"queue"`
+	Table bool `tfsdk:"table"`
+	File  bool `tfsdk:"file"`
+}
+
+type StorageAccountSasPermissions struct {
+	Read    bool `tfsdk:"read"`
+	Write   bool `tfsdk:"write"`
+	Delete  bool `tfsdk:"delete"`
+	List    bool `tfsdk:"list"`
+	Add     bool `tfsdk:"add"`
+	Create  bool `tfsdk:"create"`
+	Update  bool `tfsdk:"update"`
+	Process bool `tfsdk:"process"`
+	Tag     bool `tfsdk:"tag"`
+	Filter  bool `tfsdk:"filter"`
+}
+
+func (e *StorageAccountSasEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_sas"
+}
+
+func (e *StorageAccountSasEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountSasEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Defaults to `true`.",
+			},
+
+			"ip_addresses": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validation.Any(
+							validation.IsIPv4Address,
+							validation.IsIPv4Range,
+						),
+					},
+				},
+			},
+
+			"signed_version": schema.StringAttribute{
+				Optional: true,
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"start": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			// Always in UTC and must be ISO-8601 format
+			"expiry": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: validate.ISO8601DateTime,
+					},
+				},
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"resource_types": schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					frameworkhelpers.RequiredBlockValidator{},
+				},
+				Attributes: map[string]schema.Attribute{
+					"service": schema.BoolAttribute{
+						Required: true,
+					},
+					"container": schema.BoolAttribute{
+						Required: true,
+					},
+					"object": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+
+			"services": schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					frameworkhelpers.RequiredBlockValidator{},
+				},
+				Attributes: map[string]schema.Attribute{
+					"blob": schema.BoolAttribute{
+						Required: true,
+					},
+					"queue": schema.BoolAttribute{
+						Required: true,
+					},
+					"table": schema.BoolAttribute{
+						Required: true,
+					},
+					"file": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+
+			"permissions": schema.SingleNestedBlock{
+				Validators: []validator.Object{
+					frameworkhelpers.RequiredBlockValidator{},
+				},
+				Attributes: map[string]schema.Attribute{
+					"read": schema.BoolAttribute{
+						Required: true,
+					},
+					"write": schema.BoolAttribute{
+						Required: true,
+					},
+					"delete": schema.BoolAttribute{
+						Required: true,
+					},
+					"list": schema.BoolAttribute{
+						Required: true,
+					},
+					"add": schema.BoolAttribute{
+						Required: true,
+					},
+					"create": schema.BoolAttribute{
+						Required: true,
+					},
+					"update": schema.BoolAttribute{
+						Required: true,
+					},
+					"process": schema.BoolAttribute{
+						Required: true,
+					},
+					"tag": schema.BoolAttribute{
+						Required: true,
+					},
+					"filter": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *StorageAccountSasEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data StorageAccountSasEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	signedVersion := "2017-07-29"
+	if features.FourPointOhBeta() {
+		signedVersion = "2022-11-02"
+	}
+	if v := data.SignedVersion.ValueString(); v != "" {
+		signedVersion = v
+	}
+
+	resourceTypes := BuildResourceTypesString(map[string]interface{}{
+		"service":   data.ResourceTypes.Service,
+		"container": data.ResourceTypes.Container,
+		"object":    data.ResourceTypes.Object,
+	})
+	services := BuildServicesString(map[string]interface{}{
+		"blob":  data.Services.Blob,
+		"queue": data.Services.Queue,
+		"table": data.Services.Table,
+		"file":  data.Services.File,
+	})
+	permissions := BuildPermissionsString(map[string]interface{}{
+		"read":    data.Permissions.Read,
+		"write":   data.Permissions.Write,
+		"delete":  data.Permissions.Delete,
+		"list":    data.Permissions.List,
+		"add":     data.Permissions.Add,
+		"create":  data.Permissions.Create,
+		"update":  data.Permissions.Update,
+		"process": data.Permissions.Process,
+		"tag":     data.Permissions.Tag,
+		"filter":  data.Permissions.Filter,
+	})
+
+	kvp, err := storage.ParseAccountSASConnectionString(data.ConnectionString)
+	if err != nil {
+		resp.Diagnostics.AddError("parsing `connection_string`", err.Error())
+		return
+	}
+
+	accountName := kvp[connStringAccountNameKey]
+	accountKey := kvp[connStringAccountKeyKey]
+	signedProtocol := "https"
+	if !data.HttpsOnly.IsNull() && !data.HttpsOnly.ValueBool() {
+		signedProtocol = "https,http"
+	}
+
+	sasToken, err := storage.ComputeAccountSASToken(accountName, accountKey, permissions, services, resourceTypes,
+		data.Start, data.Expiry, signedProtocol, data.IPAddresses.ValueString(), signedVersion, "")
+	if err != nil {
+		resp.Diagnostics.AddError("computing the Shared Access Signature", err.Error())
+		return
+	}
+
+	data.Sas = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -74,6 +74,14 @@ func dataSourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -153,5 +161,13 @@ func dataSourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("setting `metadata`: %+v", err)
 	}
 
+	indexTags, err := retrieveStorageBlobIndexTags(ctx, storageClient, *account, containerName, name, false)
+	if err != nil {
+		return fmt.Errorf("retrieving Index Tags for %s: %v", id, err)
+	}
+	if err := d.Set("index_tags", indexTags); err != nil {
+		return fmt.Errorf("setting `index_tags`: %+v", err)
+	}
+
 	return nil
 }
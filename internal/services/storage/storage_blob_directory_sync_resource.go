@@ -0,0 +1,586 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobsync"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/containers"
+)
+
+var (
+	_ sdk.ResourceWithUpdate         = StorageBlobDirectorySyncResource{}
+	_ sdk.ResourceWithCustomizeDiff  = StorageBlobDirectorySyncResource{}
+	_ sdk.ResourceWithCustomImporter = StorageBlobDirectorySyncResource{}
+)
+
+// StorageBlobDirectorySyncResource synchronises the files within a local directory to (Block) blobs within a
+// Storage Container, uploading only the files where the Content-MD5 (or properties) of the blob differ
+type StorageBlobDirectorySyncResource struct{}
+
+type StorageBlobDirectorySyncModel struct {
+	StorageAccountName   string            `tfschema:"storage_account_name"`
+	StorageContainerName string            `tfschema:"storage_container_name"`
+	Prefix               string            `tfschema:"prefix"`
+	Source               string            `tfschema:"source"`
+	Include              []string          `tfschema:"include"`
+	Exclude              []string          `tfschema:"exclude"`
+	ContentTypeMapping   map[string]string `tfschema:"content_type_mapping"`
+	CacheControl         string            `tfschema:"cache_control"`
+	DeleteOrphanedBlobs  bool              `tfschema:"delete_orphaned_blobs"`
+	Parallelism          int64             `tfschema:"parallelism"`
+	Blobs                map[string]string `tfschema:"blobs"`
+	ManifestHash         string            `tfschema:"manifest_hash"`
+}
+
+func (r StorageBlobDirectorySyncResource) ResourceType() string {
+	return "azurerm_storage_blob_directory_sync"
+}
+
+func (r StorageBlobDirectorySyncResource) ModelObject() interface{} {
+	return &StorageBlobDirectorySyncModel{}
+}
+
+func (r StorageBlobDirectorySyncResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.IsURLWithHTTPS
+}
+
+func (r StorageBlobDirectorySyncResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+
+		"prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: storageBlobDirectorySyncPrefix,
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"include": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: blobsync.ValidateGlob,
+			},
+		},
+
+		"exclude": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: blobsync.ValidateGlob,
+			},
+		},
+
+		"content_type_mapping": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"cache_control": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"delete_orphaned_blobs": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"blobs": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"manifest_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			for _, key := range []string{"prefix", "source", "include", "exclude", "content_type_mapping"} {
+				if !diff.NewValueKnown(key) {
+					return r.setManifestComputed(diff)
+				}
+			}
+
+			var config StorageBlobDirectorySyncModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			files, err := blobsync.LocalManifest(config.manifestOptions())
+			if err != nil {
+				// the directory may be populated by another resource during the apply
+				if errors.Is(err, fs.ErrNotExist) {
+					return r.setManifestComputed(diff)
+				}
+				return err
+			}
+
+			// the blobs are only synchronised when the files within the directory differ from those within the container
+			manifestHash := blobsync.Hash(files)
+			if existing, _ := diff.GetChange("manifest_hash"); existing.(string) == manifestHash {
+				return nil
+			}
+			if err := diff.SetNew("manifest_hash", manifestHash); err != nil {
+				return fmt.Errorf("setting `manifest_hash`: %+v", err)
+			}
+			if err := diff.SetNew("blobs", flattenStorageBlobDirectorySyncFiles(files)); err != nil {
+				return fmt.Errorf("setting `blobs`: %+v", err)
+			}
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var config StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId := accounts.AccountId{
+				AccountName:   config.StorageAccountName,
+				DomainSuffix:  storageClient.StorageDomainSuffix,
+				SubDomainType: accounts.BlobSubDomainType,
+			}
+			id := fmt.Sprintf("%s/%s", containers.NewContainerID(accountId, config.StorageContainerName).ID(), config.Prefix)
+
+			if err := r.sync(ctx, metadata, &config); err != nil {
+				return fmt.Errorf("synchronising %q: %+v", id, err)
+			}
+
+			metadata.ResourceData.SetId(id)
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			_, containersClient, err := r.dataPlaneClients(ctx, metadata, state)
+			if err != nil {
+				return err
+			}
+			if containersClient == nil {
+				log.Printf("[DEBUG] Unable to locate Account %q for %s - assuming removed & removing from state!", state.StorageAccountName, metadata.ResourceData.Id())
+				metadata.ResourceData.SetId("")
+				return nil
+			}
+
+			exists, err := containersClient.Exists(ctx, state.StorageContainerName)
+			if err != nil {
+				return fmt.Errorf("checking for the presence of Container %q: %+v", state.StorageContainerName, err)
+			}
+			if !pointer.From(exists) {
+				log.Printf("[DEBUG] Container %q was not found for %s - assuming removed & removing from state!", state.StorageContainerName, metadata.ResourceData.Id())
+				metadata.ResourceData.SetId("")
+				return nil
+			}
+
+			remote, err := listStorageBlobDirectorySyncBlobs(ctx, containersClient, state)
+			if err != nil {
+				return err
+			}
+
+			// the manifest is built from the blobs which were synchronised (and any orphaned blobs which would be deleted)
+			// so that changes made to the blobs outside of Terraform cause them to be synchronised again
+			files := make([]blobsync.File, 0)
+			for name := range state.Blobs {
+				if blob, ok := remote[name]; ok {
+					files = append(files, blob.File)
+				}
+			}
+			blobs := flattenStorageBlobDirectorySyncFiles(files)
+			if state.DeleteOrphanedBlobs {
+				for name, blob := range remote {
+					if _, ok := state.Blobs[name]; !ok {
+						files = append(files, blob.File)
+					}
+				}
+			}
+
+			state.Blobs = blobs
+			state.ManifestHash = blobsync.Hash(files)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := r.sync(ctx, metadata, &config); err != nil {
+				return fmt.Errorf("synchronising %q: %+v", metadata.ResourceData.Id(), err)
+			}
+
+			return metadata.Encode(&config)
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			blobsClient, _, err := r.dataPlaneClients(ctx, metadata, state)
+			if err != nil {
+				return err
+			}
+			if blobsClient == nil {
+				return fmt.Errorf("locating Storage Account %q", state.StorageAccountName)
+			}
+
+			// only the blobs which were synchronised are deleted, any other blobs within the container are retained
+			names := make([]string, 0, len(state.Blobs))
+			for name := range state.Blobs {
+				names = append(names, name)
+			}
+			if err := deleteStorageBlobDirectorySyncBlobs(ctx, blobsClient, state, names); err != nil {
+				return fmt.Errorf("deleting the blobs for %q: %+v", metadata.ResourceData.Id(), err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		return fmt.Errorf("%s doesn't support being imported, since the local directory which is synchronised isn't known - define the resource in the configuration to synchronise the directory instead", r.ResourceType())
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) setManifestComputed(diff *pluginsdk.ResourceDiff) error {
+	if err := diff.SetNewComputed("manifest_hash"); err != nil {
+		return fmt.Errorf("setting `manifest_hash` as computed: %+v", err)
+	}
+	if err := diff.SetNewComputed("blobs"); err != nil {
+		return fmt.Errorf("setting `blobs` as computed: %+v", err)
+	}
+	return nil
+}
+
+// dataPlaneClients returns the Blobs and Containers clients for the Storage Account, which are nil when the Storage
+// Account doesn't exist
+func (r StorageBlobDirectorySyncResource) dataPlaneClients(ctx context.Context, metadata sdk.ResourceMetaData, model StorageBlobDirectorySyncModel) (*blobs.Client, shim.StorageContainerWrapper, error) {
+	storageClient := metadata.Client.Storage
+
+	account, err := storageClient.FindAccount(ctx, metadata.Client.Account.SubscriptionId, model.StorageAccountName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving Storage Account %q: %+v", model.StorageAccountName, err)
+	}
+	if account == nil {
+		return nil, nil, nil
+	}
+
+	blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	return blobsClient, containersClient, nil
+}
+
+// sync uploads the files within the local directory which differ from the blobs within the container (and deletes
+// any orphaned blobs when enabled), populating the `blobs` and `manifest_hash` from the local directory
+func (r StorageBlobDirectorySyncResource) sync(ctx context.Context, metadata sdk.ResourceMetaData, model *StorageBlobDirectorySyncModel) error {
+	blobsClient, containersClient, err := r.dataPlaneClients(ctx, metadata, *model)
+	if err != nil {
+		return err
+	}
+	if blobsClient == nil {
+		return fmt.Errorf("locating Storage Account %q", model.StorageAccountName)
+	}
+
+	files, err := blobsync.LocalManifest(model.manifestOptions())
+	if err != nil {
+		return err
+	}
+
+	remote, err := listStorageBlobDirectorySyncBlobs(ctx, containersClient, *model)
+	if err != nil {
+		return err
+	}
+
+	toUpload := make([]blobsync.File, 0)
+	toUpdate := make([]blobsync.File, 0)
+	for _, file := range files {
+		existing, ok := remote[file.Name]
+		switch {
+		case !ok || !strings.EqualFold(existing.ContentMD5, file.ContentMD5):
+			toUpload = append(toUpload, file)
+		case existing.ContentType != file.ContentType || existing.CacheControl != model.CacheControl:
+			toUpdate = append(toUpdate, file)
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d files to Container %q (Account %q)..", len(toUpload), len(files), model.StorageContainerName, model.StorageAccountName)
+	err = runInParallel(ctx, int(model.Parallelism), len(toUpload), func(i int) error {
+		file := toUpload[i]
+		contentMD5, err := convertHexToBase64Encoding(file.ContentMD5)
+		if err != nil {
+			return err
+		}
+
+		upload := BlobUpload{
+			AccountName:   model.StorageAccountName,
+			ContainerName: model.StorageContainerName,
+			BlobName:      file.Name,
+			Client:        blobsClient,
+
+			BlobType:     "Block",
+			CacheControl: model.CacheControl,
+			ContentType:  file.ContentType,
+			ContentMD5:   contentMD5,
+			Source:       file.Path,
+
+			// the files are uploaded in parallel, so larger files are uploaded in blocks to limit the memory used
+			UploadInBlocks: true,
+		}
+		if err := upload.Create(ctx); err != nil {
+			return fmt.Errorf("uploading %q to %q: %+v", file.Path, file.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = runInParallel(ctx, int(model.Parallelism), len(toUpdate), func(i int) error {
+		file := toUpdate[i]
+		contentMD5, err := convertHexToBase64Encoding(file.ContentMD5)
+		if err != nil {
+			return err
+		}
+
+		// the `ContentMD5` must be specified, otherwise it's removed from the blob
+		input := blobs.SetPropertiesInput{
+			CacheControl: pointer.To(model.CacheControl),
+			ContentMD5:   pointer.To(contentMD5),
+			ContentType:  pointer.To(file.ContentType),
+		}
+		if _, err := blobsClient.SetProperties(ctx, model.StorageContainerName, file.Name, input); err != nil {
+			return fmt.Errorf("updating the properties for %q: %+v", file.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if model.DeleteOrphanedBlobs {
+		local := flattenStorageBlobDirectorySyncFiles(files)
+		orphaned := make([]string, 0)
+		for name := range remote {
+			if _, ok := local[name]; !ok {
+				orphaned = append(orphaned, name)
+			}
+		}
+
+		log.Printf("[DEBUG] Deleting %d orphaned blobs from Container %q (Account %q)..", len(orphaned), model.StorageContainerName, model.StorageAccountName)
+		if err := deleteStorageBlobDirectorySyncBlobs(ctx, blobsClient, *model, orphaned); err != nil {
+			return err
+		}
+	}
+
+	model.Blobs = flattenStorageBlobDirectorySyncFiles(files)
+	model.ManifestHash = blobsync.Hash(files)
+	return nil
+}
+
+func (m StorageBlobDirectorySyncModel) manifestOptions() blobsync.Options {
+	return blobsync.Options{
+		Source: m.Source,
+		Prefix: m.Prefix,
+		Filter: blobsync.Filter{
+			Include: m.Include,
+			Exclude: m.Exclude,
+		},
+		ContentTypes: m.ContentTypeMapping,
+	}
+}
+
+// storageBlobDirectorySyncBlob is a blob within the container, including the Cache-Control which isn't part of
+// the manifest
+type storageBlobDirectorySyncBlob struct {
+	blobsync.File
+
+	CacheControl string
+}
+
+// listStorageBlobDirectorySyncBlobs returns the blobs within the container (and prefix) which match the filter,
+// keyed by the name of the blob
+func listStorageBlobDirectorySyncBlobs(ctx context.Context, containersClient shim.StorageContainerWrapper, model StorageBlobDirectorySyncModel) (map[string]storageBlobDirectorySyncBlob, error) {
+	input := containers.ListBlobsInput{}
+	if model.Prefix != "" {
+		input.Prefix = pointer.To(model.Prefix)
+	}
+	details, err := containersClient.ListBlobs(ctx, model.StorageContainerName, input)
+	if err != nil {
+		return nil, fmt.Errorf("listing the blobs within Container %q: %+v", model.StorageContainerName, err)
+	}
+
+	filter := blobsync.Filter{
+		Include: model.Include,
+		Exclude: model.Exclude,
+	}
+
+	out := make(map[string]storageBlobDirectorySyncBlob)
+	for _, blob := range details {
+		matches, err := filter.Matches(strings.TrimPrefix(blob.Name, model.Prefix))
+		if err != nil {
+			return nil, err
+		}
+		if !matches {
+			continue
+		}
+
+		item := storageBlobDirectorySyncBlob{
+			File: blobsync.File{
+				Name: blob.Name,
+			},
+		}
+		if props := blob.Properties; props != nil {
+			if v := pointer.From(props.ContentMD5); v != "" {
+				contentMD5, err := convertBase64ToHexEncoding(v)
+				if err != nil {
+					return nil, fmt.Errorf("parsing the Content-MD5 for %q: %+v", blob.Name, err)
+				}
+				item.ContentMD5 = contentMD5
+			}
+			item.ContentType = pointer.From(props.ContentType)
+			item.CacheControl = pointer.From(props.CacheControl)
+		}
+		out[blob.Name] = item
+	}
+
+	return out, nil
+}
+
+func deleteStorageBlobDirectorySyncBlobs(ctx context.Context, blobsClient *blobs.Client, model StorageBlobDirectorySyncModel, names []string) error {
+	return runInParallel(ctx, int(model.Parallelism), len(names), func(i int) error {
+		input := blobs.DeleteInput{
+			DeleteSnapshots: true,
+		}
+		if resp, err := blobsClient.Delete(ctx, model.StorageContainerName, names[i], input); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %q: %+v", names[i], err)
+		}
+		return nil
+	})
+}
+
+func flattenStorageBlobDirectorySyncFiles(input []blobsync.File) map[string]string {
+	out := make(map[string]string, len(input))
+	for _, file := range input {
+		out[file.Name] = file.ContentMD5
+	}
+	return out
+}
+
+// storageBlobDirectorySyncPrefix validates the prefix (virtual directory) within the container, which must end with
+// a `/` so that it can't match other directories starting with the same name
+func storageBlobDirectorySyncPrefix(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if strings.HasPrefix(input, "/") || !strings.HasSuffix(input, "/") || strings.Contains(input, "//") {
+		errors = append(errors, fmt.Errorf("%q must be a path within the container ending with a `/` (for example `site/`) without a leading `/`, got %q", k, input))
+	}
+	return
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type StorageBlobDirectorySyncResource struct{}
+
+func TestAccStorageBlobDirectorySync_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+	source := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blobs.%").HasValue("3"),
+				check.That(data.ResourceName).Key("manifest_hash").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobDirectorySync_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+	source := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blobs.%").HasValue("3"),
+			),
+		},
+		{
+			PreConfig: func() {
+				r.writeFile(t, filepath.Join(source, "index.html"), "<html><body>Updated</body></html>")
+				r.writeFile(t, filepath.Join(source, "docs", "guide.html"), "<html><body>Guide</body></html>")
+			},
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blobs.%").HasValue("4"),
+			),
+		},
+		{
+			Config: r.complete(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blobs.%").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobDirectorySync_deleteOrphanedBlobs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+	source := r.sourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			PreConfig: func() {
+				if err := os.Remove(filepath.Join(source, "index.html")); err != nil {
+					t.Fatalf("removing `index.html`: %+v", err)
+				}
+			},
+			Config: r.complete(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blobs.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (r StorageBlobDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	accountName := state.Attributes["storage_account_name"]
+	containerName := state.Attributes["storage_container_name"]
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for Container %q", accountName, containerName)
+	}
+	blobsClient, err := client.Storage.BlobsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	// every blob which has been synchronised must exist
+	for key := range state.Attributes {
+		if !strings.HasPrefix(key, "blobs.") || key == "blobs.%" {
+			continue
+		}
+		name := strings.TrimPrefix(key, "blobs.")
+
+		resp, err := blobsClient.GetProperties(ctx, containerName, name, blobs.GetPropertiesInput{})
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", name, containerName, accountName, err)
+		}
+	}
+	return utils.Bool(true), nil
+}
+
+// sourceDirectory returns a local directory containing the files which are synchronised
+func (r StorageBlobDirectorySyncResource) sourceDirectory(t *testing.T) string {
+	source := t.TempDir()
+	r.writeFile(t, filepath.Join(source, "index.html"), "<html><body>Hello World</body></html>")
+	r.writeFile(t, filepath.Join(source, "css", "site.css"), "body { color: red; }")
+	r.writeFile(t, filepath.Join(source, "config.conf"), "key=value")
+	return source
+}
+
+func (StorageBlobDirectorySyncResource) writeFile(t *testing.T, filePath, contents string) {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatalf("creating the directory for %q: %+v", filePath, err)
+	}
+	if err := os.WriteFile(filePath, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) basic(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source                 = %q
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectorySyncResource) complete(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  prefix                 = "site/"
+  source                 = %q
+  include                = ["**/*.html", "**/*.conf"]
+  exclude                = ["docs/**"]
+  cache_control          = "public, max-age=300"
+  delete_orphaned_blobs  = true
+  parallelism            = 4
+
+  content_type_mapping = {
+    ".conf" = "text/plain"
+  }
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (StorageBlobDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
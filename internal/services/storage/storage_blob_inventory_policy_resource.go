@@ -24,7 +24,7 @@ import (
 )
 
 func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStorageBlobInventoryPolicyCreateUpdate,
 		Read:   resourceStorageBlobInventoryPolicyRead,
 		Update: resourceStorageBlobInventoryPolicyCreateUpdate,
@@ -178,7 +178,7 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 
 			return nil
 		}),
-	}
+	}, &commonids.StorageAccountId{})
 }
 
 func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -161,6 +163,15 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.StorageBlobIndexTags,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 
 		CustomizeDiff: func(ctx context.Context, diff *pluginsdk.ResourceDiff, i interface{}) error {
@@ -335,6 +346,20 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updated MetaData for %s", id)
 	}
 
+	if d.HasChange("index_tags") {
+		log.Printf("[DEBUG] Updating Index Tags for %s...", id)
+		blobIndexClient, err := storageClient.BlobIndexDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return fmt.Errorf("building Blob Index Client: %v", err)
+		}
+
+		indexTags := expandStorageBlobIndexTags(d.Get("index_tags").(map[string]interface{}))
+		if _, err = blobIndexClient.SetTags(ctx, id.ContainerName, id.BlobName, indexTags); err != nil {
+			return fmt.Errorf("updating Index Tags for %s: %v", id, err)
+		}
+		log.Printf("[DEBUG] Updated Index Tags for %s", id)
+	}
+
 	if d.HasChange("access_tier") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for %s...", id)
@@ -409,12 +434,28 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("encryption_scope", props.EncryptionScope)
 
+	// the Required `type` is only unset when the Blob is being imported
+	importing := d.Get("type").(string) == ""
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
 	d.Set("url", d.Id())
 
 	if err = d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %v", err)
 	}
+
+	// retrieving the Index Tags requires an additional API call (and permission), so this is only done when they're
+	// configured or when the Blob is being imported
+	indexTagsConfigured := len(d.Get("index_tags").(map[string]interface{})) > 0
+	if indexTagsConfigured || importing {
+		indexTags, err := retrieveStorageBlobIndexTags(ctx, storageClient, *account, id.ContainerName, id.BlobName, indexTagsConfigured)
+		if err != nil {
+			return fmt.Errorf("retrieving Index Tags for %s: %v", id, err)
+		}
+		if err = d.Set("index_tags", indexTags); err != nil {
+			return fmt.Errorf("setting `index_tags`: %v", err)
+		}
+	}
+
 	// The CopySource is only returned if the blob hasn't been modified (e.g. metadata configured etc)
 	// as such, we need to conditionally set this to ensure it's trackable if possible
 	if props.CopySource != "" {
@@ -457,3 +498,39 @@ func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func expandStorageBlobIndexTags(input map[string]interface{}) map[string]string {
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+// retrieveStorageBlobIndexTags returns the Index Tags assigned to the specified Blob. Index Tags aren't supported
+// for every kind of Storage Account (e.g. those with a Hierarchical Namespace) and require an additional permission
+// when authenticating using Azure AD - as such when no Index Tags are expected, these errors are ignored.
+func retrieveStorageBlobIndexTags(ctx context.Context, storageClient *client.Client, account client.AccountDetails, containerName, blobName string, expected bool) (map[string]string, error) {
+	if account.IsHnsEnabled && !expected {
+		return map[string]string{}, nil
+	}
+
+	blobIndexClient, err := storageClient.BlobIndexDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Blob Index Client: %v", err)
+	}
+
+	resp, err := blobIndexClient.GetTags(ctx, containerName, blobName)
+	if err != nil {
+		if !expected && resp.HttpResponse != nil {
+			switch resp.HttpResponse.StatusCode {
+			case http.StatusBadRequest, http.StatusForbidden, http.StatusConflict:
+				log.Printf("[DEBUG] Unable to retrieve the Index Tags for Blob %q (Container %q) - ignoring since none are expected: %v", blobName, containerName, err)
+				return map[string]string{}, nil
+			}
+		}
+		return nil, err
+	}
+
+	return resp.Tags, nil
+}
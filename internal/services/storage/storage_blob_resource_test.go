@@ -528,6 +528,45 @@ func TestAccStorageBlob_update(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_indexTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.indexTags(data, `index_tags = {
+    project = "example"
+  }`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("index_tags.project").HasValue("example"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type", "source_content"),
+		{
+			Config: r.indexTags(data, `index_tags = {
+    project     = "updated"
+    cost-center = "1234"
+  }`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("2"),
+				check.That(data.ResourceName).Key("index_tags.project").HasValue("updated"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type", "source_content"),
+		{
+			Config: r.indexTags(data, ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("0"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type", "source_content"),
+	})
+}
+
 func TestAccStorageBlob_archive(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -1317,6 +1356,27 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) indexTags(data acceptance.TestData, indexTags string) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "rick.morty"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Wubba Lubba Dub Dub"
+
+  %s
+}
+`, template, indexTags)
+}
+
 func (r StorageBlobResource) cacheControl(data acceptance.TestData, cacheControl string) string {
 	template := r.template(data, "private")
 	return fmt.Sprintf(`
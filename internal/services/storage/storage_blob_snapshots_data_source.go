@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type storageBlobSnapshotsDataSource struct{}
+
+var _ sdk.DataSource = storageBlobSnapshotsDataSource{}
+
+type storageBlobSnapshotsDataSourceModel struct {
+	Name                 string                     `tfschema:"name"`
+	StorageAccountName   string                     `tfschema:"storage_account_name"`
+	StorageContainerName string                     `tfschema:"storage_container_name"`
+	Snapshots            []storageBlobSnapshotModel `tfschema:"snapshots"`
+}
+
+type storageBlobSnapshotModel struct {
+	Snapshot      string `tfschema:"snapshot"`
+	Url           string `tfschema:"url"`
+	AccessTier    string `tfschema:"access_tier"`
+	ContentLength int64  `tfschema:"content_length"`
+	ContentMD5    string `tfschema:"content_md5"`
+	ContentType   string `tfschema:"content_type"`
+	CreationTime  string `tfschema:"creation_time"`
+	LastModified  string `tfschema:"last_modified"`
+}
+
+func (r storageBlobSnapshotsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return storageBlobVersionsArguments()
+}
+
+func (r storageBlobSnapshotsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"snapshots": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"snapshot": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"access_tier": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"content_length": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"content_md5": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"content_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"creation_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"last_modified": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageBlobSnapshotsDataSource) ResourceType() string {
+	return "azurerm_storage_blob_snapshots"
+}
+
+func (r storageBlobSnapshotsDataSource) ModelObject() interface{} {
+	return &storageBlobSnapshotsDataSourceModel{}
+}
+
+func (r storageBlobSnapshotsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state storageBlobSnapshotsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, items, err := listStorageBlobVersions(ctx, metadata, state.StorageAccountName, state.StorageContainerName, state.Name, blobversions.ListBlobVersionsInput{
+				IncludeSnapshots: true,
+			})
+			if err != nil {
+				return err
+			}
+
+			state.Snapshots = make([]storageBlobSnapshotModel, 0)
+			for _, item := range items {
+				if item.Snapshot == "" {
+					continue
+				}
+
+				contentMD5, err := storageBlobVersionContentMD5(item)
+				if err != nil {
+					return fmt.Errorf("converting the Content MD5 for Snapshot %q of %s: %v", item.Snapshot, id, err)
+				}
+
+				state.Snapshots = append(state.Snapshots, storageBlobSnapshotModel{
+					Snapshot:      item.Snapshot,
+					Url:           fmt.Sprintf("%s?snapshot=%s", id.ID(), url.QueryEscape(item.Snapshot)),
+					AccessTier:    item.Properties.AccessTier,
+					ContentLength: item.Properties.ContentLength,
+					ContentMD5:    contentMD5,
+					ContentType:   item.Properties.ContentType,
+					CreationTime:  item.Properties.CreationTime,
+					LastModified:  item.Properties.LastModified,
+				})
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
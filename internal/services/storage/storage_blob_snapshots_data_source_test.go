@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type StorageBlobSnapshotsDataSource struct{}
+
+func TestAccDataSourceStorageBlobSnapshots_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_snapshots", "test")
+	r := StorageBlobSnapshotsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(r.createSnapshot, "azurerm_storage_blob.test"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("1"),
+				check.That(data.ResourceName).Key("snapshots.0.snapshot").IsSet(),
+				check.That(data.ResourceName).Key("snapshots.0.url").IsSet(),
+				check.That(data.ResourceName).Key("snapshots.0.content_length").HasValue("7"),
+				check.That(data.ResourceName).Key("snapshots.0.content_md5").IsSet(),
+			),
+		},
+	})
+}
+
+func (StorageBlobSnapshotsDataSource) createSnapshot(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+	accountName := state.Attributes["storage_account_name"]
+	containerName := state.Attributes["storage_container_name"]
+	name := state.Attributes["name"]
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, accountName)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Account %q for Blob %q (Container %q)", accountName, name, containerName)
+	}
+
+	blobsClient, err := client.Storage.BlobsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	if _, err := blobsClient.Snapshot(ctx, containerName, name, blobs.SnapshotInput{}); err != nil {
+		return fmt.Errorf("creating a Snapshot of Blob %q (Container %q / Account %q): %+v", name, containerName, accountName, err)
+	}
+
+	return nil
+}
+
+func (r StorageBlobSnapshotsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_snapshots" "test" {
+  name                   = azurerm_storage_blob.test.name
+  storage_account_name   = azurerm_storage_blob.test.storage_account_name
+  storage_container_name = azurerm_storage_blob.test.storage_container_name
+}
+`, r.template(data))
+}
+
+func (StorageBlobSnapshotsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "example"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
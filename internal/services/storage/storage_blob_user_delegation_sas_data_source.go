@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+var blobUserDelegationSasPermissions = []sasPermission{
+	{name: "read", letter: "r"},
+	{name: "add", letter: "a"},
+	{name: "create", letter: "c"},
+	{name: "write", letter: "w"},
+	{name: "delete", letter: "d"},
+	{name: "list", letter: "l"},
+}
+
+// userDelegationKeyMaximumValidity is the maximum lifetime of a User Delegation Key, which also limits the lifetime
+// of the Shared Access Signatures signed using it
+const userDelegationKeyMaximumValidity = 7 * 24 * time.Hour
+
+func dataSourceStorageBlobUserDelegationSharedAccessSignature() *pluginsdk.Resource {
+	s := sasSchema(blobUserDelegationSasPermissions)
+	for k, v := range sasResponseHeadersSchema() {
+		s[k] = v
+	}
+
+	// the User Delegation Key requires that the start and expiry are specified as a date and time
+	s["start"].ValidateFunc = validation.IsRFC3339Time
+	s["expiry"].ValidateFunc = validation.IsRFC3339Time
+
+	s["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: commonids.ValidateStorageAccountID,
+	}
+
+	s["container_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: storageValidate.StorageContainerName,
+	}
+
+	s["blob_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	s["encryption_scope"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: storageValidate.StorageEncryptionScopeName,
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageBlobUserDelegationSasRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceStorageBlobUserDelegationSasRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	start, err := time.Parse(time.RFC3339, d.Get("start").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `start`: %+v", err)
+	}
+	expiry, err := time.Parse(time.RFC3339, d.Get("expiry").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `expiry`: %+v", err)
+	}
+
+	// data sources are read during the plan, so these are checked prior to retrieving the User Delegation Key
+	if !expiry.After(start) {
+		return fmt.Errorf("`expiry` must be after `start`")
+	}
+	if expiry.Sub(start) > userDelegationKeyMaximumValidity {
+		return fmt.Errorf("`expiry` must be no more than 7 days after `start`, since a User Delegation Key can only be valid for up to 7 days")
+	}
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %v", id, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating %s", id)
+	}
+
+	blobServiceClient, err := storageClient.BlobServiceDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationRequiringAadAuth())
+	if err != nil {
+		return fmt.Errorf("building Blob Service Client: %v", err)
+	}
+
+	// the User Delegation Key must be valid for (at least) the lifetime of the Shared Access Signature, and both the key
+	// and the signed start/expiry of the Shared Access Signature are specified in UTC
+	const keyTimeFormat = "2006-01-02T15:04:05Z"
+	resp, err := blobServiceClient.GetUserDelegationKey(ctx, blobservice.GetUserDelegationKeyInput{
+		Start:  start.UTC().Format(keyTimeFormat),
+		Expiry: expiry.UTC().Format(keyTimeFormat),
+	})
+	if err != nil {
+		return fmt.Errorf("retrieving a User Delegation Key for %s: %v", id, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving a User Delegation Key for %s: model was nil", id)
+	}
+
+	input := sas.BlobInput{
+		AccountName:     id.StorageAccountName,
+		ContainerName:   d.Get("container_name").(string),
+		BlobName:        d.Get("blob_name").(string),
+		Permissions:     expandSasPermissions(d.Get("permissions").([]interface{}), blobUserDelegationSasPermissions),
+		Start:           start.UTC().Format(keyTimeFormat),
+		Expiry:          expiry.UTC().Format(keyTimeFormat),
+		IP:              d.Get("ip_address").(string),
+		Protocol:        expandSasProtocol(d.Get("https_only").(bool)),
+		EncryptionScope: d.Get("encryption_scope").(string),
+		ResponseHeaders: expandSasResponseHeaders(d),
+	}
+	key := sas.UserDelegationKey{
+		SignedOid:     resp.Model.SignedOid,
+		SignedTid:     resp.Model.SignedTid,
+		SignedStart:   resp.Model.SignedStart,
+		SignedExpiry:  resp.Model.SignedExpiry,
+		SignedService: resp.Model.SignedService,
+		SignedVersion: resp.Model.SignedVersion,
+		Value:         resp.Model.Value,
+	}
+
+	token, err := sas.BlobUserDelegationSAS(input, key)
+	if err != nil {
+		return fmt.Errorf("computing the User Delegation SAS: %+v", err)
+	}
+
+	setSasToken(d, token)
+
+	return nil
+}
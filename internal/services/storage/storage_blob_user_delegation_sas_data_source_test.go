@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageBlobUserDelegationSASDataSource struct{}
+
+func TestAccDataSourceStorageBlobUserDelegationSas_container(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_user_delegation_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobUserDelegationSASDataSource{}.container(data, startDate, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("https_only").HasValue("true"),
+				check.That(data.ResourceName).Key("start").HasValue(startDate),
+				check.That(data.ResourceName).Key("expiry").HasValue(endDate),
+				check.That(data.ResourceName).Key("permissions.0.read").HasValue("true"),
+				check.That(data.ResourceName).Key("permissions.0.list").HasValue("true"),
+				check.That(data.ResourceName).Key("sas").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobUserDelegationSas_blob(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_user_delegation_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobUserDelegationSASDataSource{}.blob(data, startDate, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blob_name").HasValue("example.txt"),
+				check.That(data.ResourceName).Key("content_type").HasValue("text/plain"),
+				check.That(data.ResourceName).Key("sas").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobUserDelegationSas_expiryTooLong(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_user_delegation_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24 * 8).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      StorageBlobUserDelegationSASDataSource{}.container(data, startDate, endDate),
+			ExpectError: regexp.MustCompile("`expiry` must be no more than 7 days after `start`"),
+		},
+	})
+}
+
+func (d StorageBlobUserDelegationSASDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsads%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sas-test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (d StorageBlobUserDelegationSASDataSource) container(data acceptance.TestData, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = false
+    create = false
+    write  = false
+    delete = false
+    list   = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, d.template(data), startDate, endDate)
+}
+
+func (d StorageBlobUserDelegationSASDataSource) blob(data acceptance.TestData, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Hello World"
+}
+
+data "azurerm_storage_blob_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+  blob_name          = azurerm_storage_blob.test.name
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    add    = false
+    create = false
+    write  = false
+    delete = false
+    list   = false
+  }
+
+  content_type = "text/plain"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, d.template(data), startDate, endDate)
+}
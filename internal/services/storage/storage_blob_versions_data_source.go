@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type storageBlobVersionsDataSource struct{}
+
+var _ sdk.DataSource = storageBlobVersionsDataSource{}
+
+type storageBlobVersionsDataSourceModel struct {
+	Name                 string                    `tfschema:"name"`
+	StorageAccountName   string                    `tfschema:"storage_account_name"`
+	StorageContainerName string                    `tfschema:"storage_container_name"`
+	Versions             []storageBlobVersionModel `tfschema:"versions"`
+}
+
+type storageBlobVersionModel struct {
+	VersionId        string `tfschema:"version_id"`
+	IsCurrentVersion bool   `tfschema:"is_current_version"`
+	Url              string `tfschema:"url"`
+	AccessTier       string `tfschema:"access_tier"`
+	ContentLength    int64  `tfschema:"content_length"`
+	ContentMD5       string `tfschema:"content_md5"`
+	ContentType      string `tfschema:"content_type"`
+	CreationTime     string `tfschema:"creation_time"`
+	LastModified     string `tfschema:"last_modified"`
+}
+
+func (r storageBlobVersionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return storageBlobVersionsArguments()
+}
+
+func (r storageBlobVersionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"versions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"version_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"is_current_version": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"access_tier": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"content_length": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+					"content_md5": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"content_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"creation_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"last_modified": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageBlobVersionsDataSource) ResourceType() string {
+	return "azurerm_storage_blob_versions"
+}
+
+func (r storageBlobVersionsDataSource) ModelObject() interface{} {
+	return &storageBlobVersionsDataSourceModel{}
+}
+
+func (r storageBlobVersionsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state storageBlobVersionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, items, err := listStorageBlobVersions(ctx, metadata, state.StorageAccountName, state.StorageContainerName, state.Name, blobversions.ListBlobVersionsInput{
+				IncludeVersions: true,
+			})
+			if err != nil {
+				return err
+			}
+
+			state.Versions = make([]storageBlobVersionModel, 0)
+			for _, item := range items {
+				if item.VersionId == "" {
+					continue
+				}
+
+				contentMD5, err := storageBlobVersionContentMD5(item)
+				if err != nil {
+					return fmt.Errorf("converting the Content MD5 for Version %q of %s: %v", item.VersionId, id, err)
+				}
+
+				state.Versions = append(state.Versions, storageBlobVersionModel{
+					VersionId:        item.VersionId,
+					IsCurrentVersion: item.IsCurrentVersion,
+					Url:              fmt.Sprintf("%s?versionid=%s", id.ID(), url.QueryEscape(item.VersionId)),
+					AccessTier:       item.Properties.AccessTier,
+					ContentLength:    item.Properties.ContentLength,
+					ContentMD5:       contentMD5,
+					ContentType:      item.Properties.ContentType,
+					CreationTime:     item.Properties.CreationTime,
+					LastModified:     item.Properties.LastModified,
+				})
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// storageBlobVersionsArguments returns the arguments used to identify the Blob whose Versions or Snapshots are listed.
+func storageBlobVersionsArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageAccountName,
+		},
+
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+	}
+}
+
+// listStorageBlobVersions returns the ID of the specified Blob, together with the Versions and/or Snapshots of it.
+func listStorageBlobVersions(ctx context.Context, metadata sdk.ResourceMetaData, accountName, containerName, name string, input blobversions.ListBlobVersionsInput) (*blobs.BlobId, []blobversions.BlobVersionDetails, error) {
+	storageClient := metadata.Client.Storage
+	subscriptionId := metadata.Client.Account.SubscriptionId
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, accountName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %v", accountName, name, containerName, err)
+	}
+	if account == nil {
+		return nil, nil, fmt.Errorf("locating Storage Account %q", accountName)
+	}
+
+	blobEndpoint, err := account.DataPlaneEndpoint(client.EndpointTypeBlob)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving the blob data plane endpoint: %v", err)
+	}
+
+	accountId, err := accounts.ParseAccountID(*blobEndpoint, storageClient.StorageDomainSuffix)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing Account ID: %v", err)
+	}
+
+	id := blobs.NewBlobID(*accountId, containerName, name)
+
+	blobIndexClient, err := storageClient.BlobVersionsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, nil, fmt.Errorf("building Blob Versions Client: %v", err)
+	}
+
+	items, err := blobIndexClient.ListBlobVersions(ctx, containerName, name, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing %s: %+v", id, err)
+	}
+
+	return &id, *items, nil
+}
+
+func storageBlobVersionContentMD5(input blobversions.BlobVersionDetails) (string, error) {
+	if input.Properties.ContentMD5 == "" {
+		return "", nil
+	}
+	return convertBase64ToHexEncoding(input.Properties.ContentMD5)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageBlobVersionsDataSource struct{}
+
+func TestAccDataSourceStorageBlobVersions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_versions", "test")
+	r := StorageBlobVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data, "first"),
+		},
+		{
+			// updating the metadata of the Blob creates a new Version
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("versions.0.version_id").IsSet(),
+				check.That(data.ResourceName).Key("versions.0.url").IsSet(),
+				check.That(data.ResourceName).Key("versions.0.content_length").HasValue("7"),
+				check.That(data.ResourceName).Key("versions.1.is_current_version").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (r StorageBlobVersionsDataSource) basic(data acceptance.TestData, revision string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_versions" "test" {
+  name                   = azurerm_storage_blob.test.name
+  storage_account_name   = azurerm_storage_blob.test.storage_account_name
+  storage_container_name = azurerm_storage_blob.test.storage_container_name
+}
+`, r.template(data, revision))
+}
+
+func (StorageBlobVersionsDataSource) template(data acceptance.TestData, revision string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = true
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "example"
+
+  metadata = {
+    revision = %[4]q
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, revision)
+}
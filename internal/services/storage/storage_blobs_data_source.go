@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/blobindex"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type storageBlobsDataSource struct{}
+
+var _ sdk.DataSource = storageBlobsDataSource{}
+
+type storageBlobsDataSourceModel struct {
+	StorageAccountId     string                  `tfschema:"storage_account_id"`
+	StorageContainerName string                  `tfschema:"storage_container_name"`
+	IndexTagFilter       string                  `tfschema:"index_tag_filter"`
+	Blobs                []storageBlobsBlobModel `tfschema:"blobs"`
+}
+
+type storageBlobsBlobModel struct {
+	Name                 string            `tfschema:"name"`
+	StorageContainerName string            `tfschema:"storage_container_name"`
+	Url                  string            `tfschema:"url"`
+	IndexTags            map[string]string `tfschema:"index_tags"`
+}
+
+func (r storageBlobsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+		"index_tag_filter": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.StorageContainerName,
+		},
+	}
+}
+
+func (r storageBlobsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"blobs": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"storage_container_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"index_tags": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageBlobsDataSource) ResourceType() string {
+	return "azurerm_storage_blobs"
+}
+
+func (r storageBlobsDataSource) ModelObject() interface{} {
+	return &storageBlobsDataSourceModel{}
+}
+
+func (r storageBlobsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var plan storageBlobsDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q: %v", id.StorageAccountName, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q", id.StorageAccountName)
+			}
+
+			// Determine the blob endpoint, so we can build a data plane ID for each Blob
+			endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeBlob)
+			if err != nil {
+				return fmt.Errorf("determining Blob endpoint: %v", err)
+			}
+
+			accountId, err := accounts.ParseAccountID(*endpoint, storageClient.StorageDomainSuffix)
+			if err != nil {
+				return fmt.Errorf("parsing Account ID: %v", err)
+			}
+
+			blobIndexClient, err := storageClient.BlobIndexDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blob Index Client: %v", err)
+			}
+
+			input := blobindex.FindBlobsByTagsInput{
+				Where: plan.IndexTagFilter,
+			}
+			if plan.StorageContainerName != "" {
+				input.ContainerName = pointer.To(plan.StorageContainerName)
+			}
+
+			result, err := blobIndexClient.FindAllBlobsByTags(ctx, input)
+			if err != nil {
+				return fmt.Errorf("finding Blobs within %s matching the Index Tag filter %q: %+v", id, plan.IndexTagFilter, err)
+			}
+
+			plan.Blobs = flattenStorageBlobsBlobs(result, *accountId)
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func flattenStorageBlobsBlobs(input *[]blobindex.FilteredBlobDetails, accountId accounts.AccountId) []storageBlobsBlobModel {
+	output := make([]storageBlobsBlobModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		output = append(output, storageBlobsBlobModel{
+			Name:                 item.Name,
+			StorageContainerName: item.ContainerName,
+			Url:                  blobs.NewBlobID(accountId, item.ContainerName, item.Name).ID(),
+			IndexTags:            item.Tags.ToMap(),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageBlobsDataSource struct{}
+
+func TestAccDataSourceStorageBlobs_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	r := StorageBlobsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("2"),
+				check.That(data.ResourceName).Key("blobs.0.storage_container_name").IsSet(),
+				check.That(data.ResourceName).Key("blobs.0.url").IsSet(),
+				check.That(data.ResourceName).Key("blobs.0.index_tags.project").HasValue(fmt.Sprintf("acctest%d", data.RandomInteger)),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_container(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+	r := StorageBlobsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.container(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("first.txt"),
+				check.That(data.ResourceName).Key("blobs.0.storage_container_name").HasValue("first"),
+			),
+		},
+	})
+}
+
+func (r StorageBlobsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  index_tag_filter   = "\"project\" = 'acctest%d'"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageBlobsDataSource) container(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_id     = azurerm_storage_account.test.id
+  storage_container_name = azurerm_storage_container.first.name
+  index_tag_filter       = "\"project\" = 'acctest%d'"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (StorageBlobsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "first" {
+  name                  = "first"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_container" "second" {
+  name                  = "second"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "first" {
+  name                   = "first.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.first.name
+  type                   = "Block"
+  source_content         = "first"
+
+  index_tags = {
+    project = "acctest%[1]d"
+  }
+}
+
+resource "azurerm_storage_blob" "second" {
+  name                   = "second.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.second.name
+  type                   = "Block"
+  source_content         = "second"
+
+  index_tags = {
+    project = "acctest%[1]d"
+  }
+}
+
+resource "azurerm_storage_blob" "other" {
+  name                   = "other.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.first.name
+  type                   = "Block"
+  source_content         = "other"
+
+  index_tags = {
+    project = "other"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
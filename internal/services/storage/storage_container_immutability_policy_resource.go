@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
@@ -40,6 +41,10 @@ func (r StorageContainerImmutabilityPolicyResource) IDValidationFunc() pluginsdk
 	return validate.StorageContainerImmutabilityPolicyID
 }
 
+func (r StorageContainerImmutabilityPolicyResource) Identity() resourceids.ResourceId {
+	return &parse.StorageContainerImmutabilityPolicyId{}
+}
+
 func (r StorageContainerImmutabilityPolicyResource) ModelObject() interface{} {
 	return &ContainerImmutabilityPolicyModel{}
 }
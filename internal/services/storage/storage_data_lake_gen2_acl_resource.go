@@ -0,0 +1,546 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/datalakeacl"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/blob/accounts"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/datalakestore/paths"
+	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
+)
+
+// storageDataLakeGen2AclMaxFailedEntries is the maximum number of failed paths which are included in an error
+const storageDataLakeGen2AclMaxFailedEntries = 10
+
+func resourceStorageDataLakeGen2Acl() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageDataLakeGen2AclCreate,
+		Read:   resourceStorageDataLakeGen2AclRead,
+		Update: resourceStorageDataLakeGen2AclUpdate,
+		Delete: resourceStorageDataLakeGen2AclDelete,
+
+		Importer: helpers.ImporterValidatingStorageResourceIdThen(func(id, storageDomainSuffix string) error {
+			_, err := paths.ParsePathID(id, storageDomainSuffix)
+			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+			ctx, cancel := context.WithTimeout(ctx, d.Timeout(pluginsdk.TimeoutRead))
+			defer cancel()
+
+			storageClient := meta.(*clients.Client).Storage
+
+			id, err := paths.ParsePathID(d.Id(), storageClient.StorageDomainSuffix)
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("parsing ID %q for import of Data Lake Gen2 ACL: %v", d.Id(), err)
+			}
+
+			account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving Account %q for Data Lake Gen2 ACL %q in File System %q: %s", id.AccountId.AccountName, id.Path, id.FileSystemName, err)
+			}
+			if account == nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("unable to locate Storage Account: %q", id.AccountId.AccountName)
+			}
+
+			d.Set("storage_account_id", account.StorageAccountId.ID())
+			d.Set("filesystem_name", id.FileSystemName)
+			// whether the ACL was applied recursively can't be determined, so when `recursive` is configured the ACL is
+			// applied to the children of the path during the next apply
+			d.Set("recursive", false)
+			d.Set("continue_on_failure", false)
+
+			return []*pluginsdk.ResourceData{d}, nil
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+			},
+
+			"filesystem_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+			},
+
+			// an empty path is the root directory of the File System
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("\\"),
+			},
+
+			"ace": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"scope": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"default", "access"}, false),
+							Default:      "access",
+						},
+						"type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+						},
+						"id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"permissions": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ADLSAccessControlPermissions,
+						},
+					},
+				},
+			},
+
+			"recursive": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"continue_on_failure": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"directories_successful": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"files_successful": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"failure_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"failed_entries": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageDataLakeGen2AclCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	filesystemName := d.Get("filesystem_name").(string)
+	path := d.Get("path").(string)
+
+	accountResourceManagerId, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, accountResourceManagerId.StorageAccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Data Lake Gen2 Filesystem %q: %v", accountResourceManagerId.StorageAccountName, filesystemName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", accountResourceManagerId.StorageAccountName)
+	}
+
+	endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeDfs)
+	if err != nil {
+		return fmt.Errorf("determining Data Lake Gen2 Filesystems endpoint: %v", err)
+	}
+
+	accountId, err := accounts.ParseAccountID(*endpoint, storageClient.StorageDomainSuffix)
+	if err != nil {
+		return fmt.Errorf("parsing Account ID: %v", err)
+	}
+
+	id := paths.NewPathID(*accountId, filesystemName, path)
+
+	dataPlaneFilesystemsClient, err := storageClient.DataLakeFilesystemsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Data Lake Gen2 Filesystems Client: %v", err)
+	}
+
+	if _, err = dataPlaneFilesystemsClient.GetProperties(ctx, filesystemName); err != nil {
+		return fmt.Errorf("retrieving File System %q for %s: %v", filesystemName, id, err)
+	}
+
+	// the ACL can only be managed for a path which already exists, unlike `azurerm_storage_data_lake_gen2_path`
+	// this resource doesn't create (or delete) the path
+	dataPlanePathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Data Lake Gen2 Paths Client: %v", err)
+	}
+
+	if path != "" {
+		if _, err = dataPlanePathsClient.GetProperties(ctx, filesystemName, path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetStatus}); err != nil {
+			return fmt.Errorf("retrieving %s: %v", id, err)
+		}
+	}
+
+	log.Printf("[INFO] Setting the ACL for %s...", id)
+	if err := setStorageDataLakeGen2Acl(ctx, d, storageClient, *account, id); err != nil {
+		// when the ACL could only be set for some of the children the ACL has still been set, so the ID is set to
+		// track (and taint) this resource
+		if d.Get("failure_count").(int) > 0 {
+			d.SetId(id.ID())
+		}
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageDataLakeGen2AclRead(d, meta)
+}
+
+func resourceStorageDataLakeGen2AclUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParsePathID(d.Id(), storageClient.StorageDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Data Lake Gen2 Filesystem %q: %v", id.AccountId.AccountName, id.FileSystemName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+	}
+
+	// changing `continue_on_failure` alone doesn't require the ACL to be set again
+	if d.HasChanges("ace", "recursive") {
+		log.Printf("[INFO] Updating the ACL for %s...", id)
+		if err := setStorageDataLakeGen2Acl(ctx, d, storageClient, *account, *id); err != nil {
+			// the ACL may only have been set for some of the children, so the prior `ace` and `recursive` values are
+			// kept in the state to ensure the ACL is set again during the next apply
+			d.Partial(true)
+			return err
+		}
+	}
+
+	return resourceStorageDataLakeGen2AclRead(d, meta)
+}
+
+func resourceStorageDataLakeGen2AclRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParsePathID(d.Id(), storageClient.StorageDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Data Lake Gen2 Filesystem %q: %v", id.AccountId.AccountName, id.FileSystemName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for %s - assuming removed & removing from state", id.AccountId.AccountName, id)
+		d.SetId("")
+		return nil
+	}
+
+	dataPlanePathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Data Lake Gen2 Paths Client: %v", err)
+	}
+
+	// only the ACL of the path itself is checked for drift, since retrieving the ACL of every child would
+	// require listing (and a request for) every file and directory beneath it
+	resp, err := dataPlanePathsClient.GetProperties(ctx, id.FileSystemName, storageDataLakeGen2AclApiPath(id.Path), paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] Path %q does not exist in File System %q in Storage Account %q - removing from state...", id.Path, id.FileSystemName, id.AccountId.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving ACLs for %s: %v", id, err)
+	}
+
+	acl, err := accesscontrol.ParseACL(resp.ACL)
+	if err != nil {
+		return fmt.Errorf("parsing response ACL %q: %v", resp.ACL, err)
+	}
+
+	d.Set("storage_account_id", account.StorageAccountId.ID())
+	d.Set("filesystem_name", id.FileSystemName)
+	d.Set("path", id.Path)
+	d.Set("ace", FlattenDataLakeGen2AceList(d, acl))
+
+	return nil
+}
+
+func resourceStorageDataLakeGen2AclDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := paths.ParsePathID(d.Id(), storageClient.StorageDomainSuffix)
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, subscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Data Lake Gen2 Filesystem %q: %v", id.AccountId.AccountName, id.FileSystemName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", id.AccountId.AccountName)
+	}
+
+	// the ACL of a path can't be removed, instead the entries for the named users and groups are removed -
+	// the entries for the owning user, owning group, mask and other are retained
+	acl, err := ExpandDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("parsing ace list: %v", err)
+	}
+	named := make([]accesscontrol.ACE, 0)
+	if acl != nil {
+		for _, v := range acl.Entries {
+			if v.TagQualifier != nil {
+				named = append(named, v)
+			}
+		}
+	}
+	if len(named) == 0 {
+		return nil
+	}
+
+	if d.Get("recursive").(bool) {
+		dataPlaneAclClient, err := storageClient.DataLakeAccessControlDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return fmt.Errorf("building Data Lake Gen2 Access Control Client: %v", err)
+		}
+
+		entries := make([]string, 0, len(named))
+		for _, v := range named {
+			entries = append(entries, storageDataLakeGen2AclRemovalEntry(v))
+		}
+		input := datalakeacl.SetAccessControlRecursiveInput{
+			Mode:      datalakeacl.SetAccessControlRecursiveModeRemove,
+			ACL:       strings.Join(entries, ","),
+			ForceFlag: d.Get("continue_on_failure").(bool),
+		}
+		result, err := dataPlaneAclClient.SetAccessControlRecursiveComplete(ctx, id.FileSystemName, storageDataLakeGen2AclApiPath(id.Path), input)
+		if err != nil {
+			return fmt.Errorf("removing the ACL entries for %s: %v", id, err)
+		}
+		if result.FailureCount > 0 {
+			return fmt.Errorf("removing the ACL entries for %s: %s", id, storageDataLakeGen2AclFailureSummary(*result))
+		}
+
+		return nil
+	}
+
+	dataPlanePathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return fmt.Errorf("building Data Lake Gen2 Paths Client: %v", err)
+	}
+
+	resp, err := dataPlanePathsClient.GetProperties(ctx, id.FileSystemName, storageDataLakeGen2AclApiPath(id.Path), paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving ACLs for %s: %v", id, err)
+	}
+
+	existing, err := accesscontrol.ParseACL(resp.ACL)
+	if err != nil {
+		return fmt.Errorf("parsing response ACL %q: %v", resp.ACL, err)
+	}
+
+	retained := accesscontrol.ACL{
+		Entries: make([]accesscontrol.ACE, 0),
+	}
+	for _, v := range existing.Entries {
+		if !storageDataLakeGen2AclContainsNamedEntry(named, v) {
+			retained.Entries = append(retained.Entries, v)
+		}
+	}
+
+	aclString := retained.String()
+	if _, err = dataPlanePathsClient.SetAccessControl(ctx, id.FileSystemName, storageDataLakeGen2AclApiPath(id.Path), paths.SetAccessControlInput{ACL: &aclString}); err != nil {
+		return fmt.Errorf("removing the ACL entries for %s: %v", id, err)
+	}
+
+	return nil
+}
+
+// setStorageDataLakeGen2Acl sets the ACL for the path - and when `recursive` is enabled, every existing child of the
+// path - populating the number of directories and files which were updated and the paths which couldn't be updated
+func setStorageDataLakeGen2Acl(ctx context.Context, d *pluginsdk.ResourceData, storageClient *client.Client, account client.AccountDetails, id paths.PathId) error {
+	acl, err := ExpandDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("parsing ace list: %v", err)
+	}
+	aclString := acl.String()
+
+	result := datalakeacl.SetAccessControlRecursiveResult{
+		FailedEntries: make([]datalakeacl.FailedEntry, 0),
+	}
+
+	if d.Get("recursive").(bool) {
+		dataPlaneAclClient, err := storageClient.DataLakeAccessControlDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return fmt.Errorf("building Data Lake Gen2 Access Control Client: %v", err)
+		}
+
+		input := datalakeacl.SetAccessControlRecursiveInput{
+			Mode:      datalakeacl.SetAccessControlRecursiveModeSet,
+			ACL:       aclString,
+			ForceFlag: d.Get("continue_on_failure").(bool),
+		}
+		resp, err := dataPlaneAclClient.SetAccessControlRecursiveComplete(ctx, id.FileSystemName, storageDataLakeGen2AclApiPath(id.Path), input)
+		if err != nil {
+			return fmt.Errorf("setting the ACL recursively for %s: %v", id, err)
+		}
+		result = *resp
+	} else {
+		dataPlanePathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return fmt.Errorf("building Data Lake Gen2 Paths Client: %v", err)
+		}
+
+		if _, err = dataPlanePathsClient.SetAccessControl(ctx, id.FileSystemName, storageDataLakeGen2AclApiPath(id.Path), paths.SetAccessControlInput{ACL: &aclString}); err != nil {
+			return fmt.Errorf("setting the ACL for %s: %v", id, err)
+		}
+	}
+
+	d.Set("directories_successful", int(result.DirectoriesSuccessful))
+	d.Set("files_successful", int(result.FilesSuccessful))
+	d.Set("failure_count", int(result.FailureCount))
+	d.Set("failed_entries", flattenStorageDataLakeGen2AclFailedEntries(result.FailedEntries))
+
+	if result.FailureCount > 0 {
+		if !d.Get("continue_on_failure").(bool) {
+			return fmt.Errorf("setting the ACL recursively for %s: %s", id, storageDataLakeGen2AclFailureSummary(result))
+		}
+		log.Printf("[WARN] setting the ACL recursively for %s: %s", id, storageDataLakeGen2AclFailureSummary(result))
+	}
+
+	return nil
+}
+
+// storageDataLakeGen2AclApiPath returns the path used in requests, where the root directory of a File System is `/`
+func storageDataLakeGen2AclApiPath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// storageDataLakeGen2AclRemovalEntry returns the form of the entry used when removing it, which omits the permissions
+func storageDataLakeGen2AclRemovalEntry(input accesscontrol.ACE) string {
+	prefix := ""
+	if input.IsDefault {
+		prefix = "default:"
+	}
+	return fmt.Sprintf("%s%s:%s", prefix, input.TagType, input.TagQualifier.String())
+}
+
+func storageDataLakeGen2AclContainsNamedEntry(named []accesscontrol.ACE, input accesscontrol.ACE) bool {
+	if input.TagQualifier == nil {
+		return false
+	}
+
+	for _, v := range named {
+		if v.TagType == input.TagType && v.IsDefault == input.IsDefault && *v.TagQualifier == *input.TagQualifier {
+			return true
+		}
+	}
+
+	return false
+}
+
+func storageDataLakeGen2AclFailureSummary(input datalakeacl.SetAccessControlRecursiveResult) string {
+	failures := make([]string, 0)
+	for i, v := range input.FailedEntries {
+		if i == storageDataLakeGen2AclMaxFailedEntries {
+			failures = append(failures, fmt.Sprintf("and %d more", len(input.FailedEntries)-i))
+			break
+		}
+		failures = append(failures, fmt.Sprintf("%s %q: %s", strings.ToLower(v.Type), v.Name, v.ErrorMessage))
+	}
+
+	return fmt.Sprintf("%d paths could not be updated (%d directories and %d files were updated):\n\n%s", input.FailureCount, input.DirectoriesSuccessful, input.FilesSuccessful, strings.Join(failures, "\n"))
+}
+
+func flattenStorageDataLakeGen2AclFailedEntries(input []datalakeacl.FailedEntry) []interface{} {
+	output := make([]interface{}, 0, len(input))
+	for _, v := range input {
+		output = append(output, map[string]interface{}{
+			"name":          v.Name,
+			"type":          strings.ToLower(v.Type),
+			"error_message": v.ErrorMessage,
+		})
+	}
+	return output
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2023-11-03/datalakestore/paths"
+)
+
+type StorageDataLakeGen2AclResource struct{}
+
+// the path outlives this resource, so the Check Destroyed step would always fail
+func TestAccStorageDataLakeGen2Acl_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_acl", "test")
+	r := StorageDataLakeGen2AclResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("4"),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries.#"),
+	})
+}
+
+func TestAccStorageDataLakeGen2Acl_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_acl", "test")
+	r := StorageDataLakeGen2AclResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries.#"),
+		{
+			Config: r.recursive(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("6"),
+				check.That(data.ResourceName).Key("directories_successful").HasValue("2"),
+				check.That(data.ResourceName).Key("failure_count").HasValue("0"),
+			),
+		},
+		data.ImportStep("recursive", "directories_successful", "files_successful", "failure_count", "failed_entries.#"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("4"),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries.#"),
+	})
+}
+
+func TestAccStorageDataLakeGen2Acl_rootPath(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_acl", "test")
+	r := StorageDataLakeGen2AclResource{}
+
+	data.ResourceTestSkipCheckDestroyed(t, []acceptance.TestStep{
+		{
+			Config: r.rootPath(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("path").HasValue(""),
+				check.That(data.ResourceName).Key("failure_count").HasValue("0"),
+			),
+		},
+		data.ImportStep("recursive", "directories_successful", "files_successful", "failure_count", "failed_entries.#"),
+	})
+}
+
+func (r StorageDataLakeGen2AclResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := paths.ParsePathID(state.ID, client.Storage.StorageDomainSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, client.Account.SubscriptionId, id.AccountId.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Path %q: %+v", id.AccountId.AccountName, id.Path, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account %q", id.AccountId.AccountName)
+	}
+
+	pathsClient, err := client.Storage.DataLakePathsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+	}
+
+	path := id.Path
+	if path == "" {
+		path = "/"
+	}
+	resp, err := pathsClient.GetProperties(ctx, id.FileSystemName, path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving the ACL for Path %q (File System %q / Account %q): %+v", id.Path, id.FileSystemName, id.AccountId.AccountName, err)
+	}
+
+	return utils.Bool(resp.ACL != ""), nil
+}
+
+func (r StorageDataLakeGen2AclResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2AclResource) recursive(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+  recursive          = true
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "rwx"
+  }
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "mask"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2AclResource) rootPath(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  recursive          = true
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "mask"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2AclResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestspa%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "fstest"
+  storage_account_id = azurerm_storage_account.test.id
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "parent" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "parent"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.parent.path}/child"
+  resource           = "directory"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
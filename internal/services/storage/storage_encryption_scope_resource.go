@@ -23,7 +23,7 @@ import (
 )
 
 func resourceStorageEncryptionScope() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStorageEncryptionScopeCreate,
 		Read:   resourceStorageEncryptionScopeRead,
 		Update: resourceStorageEncryptionScopeUpdate,
@@ -77,7 +77,7 @@ func resourceStorageEncryptionScope() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 		},
-	}
+	}, &encryptionscopes.EncryptionScopeId{})
 }
 
 func resourceStorageEncryptionScopeCreate(d *pluginsdk.ResourceData, meta interface{}) error {
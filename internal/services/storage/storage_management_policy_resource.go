@@ -23,7 +23,7 @@ import (
 )
 
 func resourceStorageManagementPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStorageManagementPolicyCreateOrUpdate,
 		Read:   resourceStorageManagementPolicyRead,
 		Update: resourceStorageManagementPolicyCreateOrUpdate,
@@ -302,7 +302,7 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.StorageAccountManagementPolicyId{})
 }
 
 func resourceStorageManagementPolicyCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var queueSasPermissions = []sasPermission{
+	{name: "read", letter: "r"},
+	{name: "add", letter: "a"},
+	{name: "update", letter: "u"},
+	{name: "process", letter: "p"},
+}
+
+func dataSourceStorageQueueSharedAccessSignature() *pluginsdk.Resource {
+	s := sasSchema(queueSasPermissions)
+
+	s["connection_string"] = sasConnectionStringSchema()
+
+	s["queue_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: storageValidate.StorageQueueName,
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageQueueSasRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceStorageQueueSasRead(d *pluginsdk.ResourceData, _ interface{}) error {
+	accountName, accountKey, err := parseSasConnectionString(d.Get("connection_string").(string))
+	if err != nil {
+		return err
+	}
+
+	token, err := sas.QueueServiceSAS(sas.QueueInput{
+		AccountName: accountName,
+		QueueName:   d.Get("queue_name").(string),
+		Permissions: expandSasPermissions(d.Get("permissions").([]interface{}), queueSasPermissions),
+		Start:       d.Get("start").(string),
+		Expiry:      d.Get("expiry").(string),
+		IP:          d.Get("ip_address").(string),
+		Protocol:    expandSasProtocol(d.Get("https_only").(bool)),
+	}, accountKey)
+	if err != nil {
+		return fmt.Errorf("computing the Shared Access Signature: %+v", err)
+	}
+
+	setSasToken(d, token)
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// sasPermission maps a field within the `permissions` block to the letter used within the Shared Access Signature
+type sasPermission struct {
+	name   string
+	letter string
+}
+
+// sasSchema returns the schema shared by the Shared Access Signature Data Sources, where the `permissions` block
+// contains the permissions specified (in the order they must appear within the Shared Access Signature)
+func sasSchema(permissions []sasPermission) map[string]*pluginsdk.Schema {
+	permissionsSchema := make(map[string]*pluginsdk.Schema, len(permissions))
+	for _, p := range permissions {
+		permissionsSchema[p.name] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"ip_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: storageValidate.SharedAccessSignatureIP,
+		},
+
+		"start": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ISO8601DateTime,
+		},
+
+		"expiry": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ISO8601DateTime,
+		},
+
+		"permissions": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: permissionsSchema,
+			},
+		},
+
+		"sas": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+// sasConnectionStringSchema returns the schema for the Connection String used to sign a Service SAS
+func sasConnectionStringSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+}
+
+// sasResponseHeadersSchema returns the schema for the response headers which can be overridden when accessing a Blob or File
+func sasResponseHeadersSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cache_control": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"content_disposition": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"content_encoding": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"content_language": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func expandSasPermissions(input []interface{}, permissions []sasPermission) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+	raw := input[0].(map[string]interface{})
+
+	output := ""
+	for _, p := range permissions {
+		if v, ok := raw[p.name].(bool); ok && v {
+			output += p.letter
+		}
+	}
+	return output
+}
+
+func expandSasProtocol(httpsOnly bool) string {
+	if httpsOnly {
+		return sas.ProtocolHttps
+	}
+	return sas.ProtocolHttpsAndHttp
+}
+
+func expandSasResponseHeaders(d *pluginsdk.ResourceData) sas.ResponseHeaders {
+	return sas.ResponseHeaders{
+		CacheControl:       d.Get("cache_control").(string),
+		ContentDisposition: d.Get("content_disposition").(string),
+		ContentEncoding:    d.Get("content_encoding").(string),
+		ContentLanguage:    d.Get("content_language").(string),
+		ContentType:        d.Get("content_type").(string),
+	}
+}
+
+// parseSasConnectionString returns the name and key of the Storage Account from the Connection String
+func parseSasConnectionString(input string) (accountName string, accountKey string, err error) {
+	kvp, err := storage.ParseAccountSASConnectionString(input)
+	if err != nil {
+		return "", "", err
+	}
+
+	accountName = kvp[connStringAccountNameKey]
+	if accountName == "" {
+		return "", "", fmt.Errorf("the Storage Account Name wasn't found in the connection string")
+	}
+
+	return accountName, kvp[connStringAccountKeyKey], nil
+}
+
+// setSasToken sets the Shared Access Signature, using a hash of it as the ID of the Data Source
+func setSasToken(d *pluginsdk.ResourceData, token string) {
+	d.Set("sas", token)
+	tokenHash := sha256.Sum256([]byte(token))
+	d.SetId(hex.EncodeToString(tokenHash[:]))
+}
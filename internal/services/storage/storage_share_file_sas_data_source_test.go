@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageShareFileSASDataSource struct{}
+
+func TestAccDataSourceStorageShareFileSas_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_file_sas", "test")
+	utcNow := time.Now().UTC()
+	startDate := utcNow.Format(time.RFC3339)
+	endDate := utcNow.Add(time.Hour * 24).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageShareFileSASDataSource{}.basic(data, startDate, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("https_only").HasValue("true"),
+				check.That(data.ResourceName).Key("start").HasValue(startDate),
+				check.That(data.ResourceName).Key("expiry").HasValue(endDate),
+				check.That(data.ResourceName).Key("file_path").HasValue("example/file.txt"),
+				check.That(data.ResourceName).Key("permissions.0.write").HasValue("true"),
+				check.That(data.ResourceName).Key("permissions.0.delete").HasValue("false"),
+				check.That(data.ResourceName).Key("sas").Exists(),
+			),
+		},
+	})
+}
+
+func (d StorageShareFileSASDataSource) basic(data acceptance.TestData, startDate string, endDate string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsads%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "sas-test"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 1
+}
+
+data "azurerm_storage_share_file_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  share_name        = azurerm_storage_share.test.name
+  file_path         = "example/file.txt"
+
+  start  = "%s"
+  expiry = "%s"
+
+  permissions {
+    read   = true
+    create = true
+    write  = true
+    delete = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, startDate, endDate)
+}
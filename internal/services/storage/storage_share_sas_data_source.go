@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var shareSasPermissions = []sasPermission{
+	{name: "read", letter: "r"},
+	{name: "create", letter: "c"},
+	{name: "write", letter: "w"},
+	{name: "delete", letter: "d"},
+	{name: "list", letter: "l"},
+}
+
+func dataSourceStorageShareSharedAccessSignature() *pluginsdk.Resource {
+	s := sasSchema(shareSasPermissions)
+	for k, v := range sasResponseHeadersSchema() {
+		s[k] = v
+	}
+
+	s["connection_string"] = sasConnectionStringSchema()
+
+	s["share_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: storageValidate.StorageShareName,
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageShareSasRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceStorageShareSasRead(d *pluginsdk.ResourceData, _ interface{}) error {
+	accountName, accountKey, err := parseSasConnectionString(d.Get("connection_string").(string))
+	if err != nil {
+		return err
+	}
+
+	token, err := sas.FileServiceSAS(sas.FileInput{
+		AccountName:     accountName,
+		ShareName:       d.Get("share_name").(string),
+		Permissions:     expandSasPermissions(d.Get("permissions").([]interface{}), shareSasPermissions),
+		Start:           d.Get("start").(string),
+		Expiry:          d.Get("expiry").(string),
+		IP:              d.Get("ip_address").(string),
+		Protocol:        expandSasProtocol(d.Get("https_only").(bool)),
+		ResponseHeaders: expandSasResponseHeaders(d),
+	}, accountKey)
+	if err != nil {
+		return fmt.Errorf("computing the Shared Access Signature: %+v", err)
+	}
+
+	setSasToken(d, token)
+
+	return nil
+}
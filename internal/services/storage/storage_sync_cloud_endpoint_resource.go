@@ -22,7 +22,7 @@ import (
 )
 
 func resourceStorageSyncCloudEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStorageSyncCloudEndpointCreate,
 		Read:   resourceStorageSyncCloudEndpointRead,
 		Delete: resourceStorageSyncCloudEndpointDelete,
@@ -75,7 +75,7 @@ func resourceStorageSyncCloudEndpoint() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &cloudendpointresource.CloudEndpointId{})
 }
 
 func resourceStorageSyncCloudEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
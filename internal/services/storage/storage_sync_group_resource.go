@@ -19,7 +19,7 @@ import (
 )
 
 func resourceStorageSyncGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStorageSyncGroupCreate,
 		Read:   resourceStorageSyncGroupRead,
 		Delete: resourceStorageSyncGroupDelete,
@@ -50,7 +50,7 @@ func resourceStorageSyncGroup() *pluginsdk.Resource {
 				ValidateFunc: storagesyncservicesresource.ValidateStorageSyncServiceID,
 			},
 		},
-	}
+	}, &syncgroupresource.SyncGroupId{})
 }
 
 func resourceStorageSyncGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceStorageSync() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceStorageSyncCreate,
 		Read:   resourceStorageSyncRead,
 		Update: resourceStorageSyncUpdate,
@@ -75,6 +75,10 @@ func resourceStorageSync() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &storagesyncservicesresource.StorageSyncServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &storagesyncservicesresource.StorageSyncServiceId{})
 }
 
 func resourceStorageSyncCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var tableSasPermissions = []sasPermission{
+	{name: "read", letter: "r"},
+	{name: "add", letter: "a"},
+	{name: "update", letter: "u"},
+	{name: "delete", letter: "d"},
+}
+
+func dataSourceStorageTableSharedAccessSignature() *pluginsdk.Resource {
+	s := sasSchema(tableSasPermissions)
+
+	s["connection_string"] = sasConnectionStringSchema()
+
+	s["table_name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: storageValidate.StorageTableName,
+	}
+
+	s["start_partition_key"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	s["start_row_key"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		RequiredWith: []string{"start_partition_key"},
+	}
+
+	s["end_partition_key"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	s["end_row_key"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		RequiredWith: []string{"end_partition_key"},
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageTableSasRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceStorageTableSasRead(d *pluginsdk.ResourceData, _ interface{}) error {
+	accountName, accountKey, err := parseSasConnectionString(d.Get("connection_string").(string))
+	if err != nil {
+		return err
+	}
+
+	token, err := sas.TableServiceSAS(sas.TableInput{
+		AccountName:       accountName,
+		TableName:         d.Get("table_name").(string),
+		Permissions:       expandSasPermissions(d.Get("permissions").([]interface{}), tableSasPermissions),
+		Start:             d.Get("start").(string),
+		Expiry:            d.Get("expiry").(string),
+		IP:                d.Get("ip_address").(string),
+		Protocol:          expandSasProtocol(d.Get("https_only").(bool)),
+		StartPartitionKey: d.Get("start_partition_key").(string),
+		StartRowKey:       d.Get("start_row_key").(string),
+		EndPartitionKey:   d.Get("end_partition_key").(string),
+		EndRowKey:         d.Get("end_row_key").(string),
+	}, accountKey)
+	if err != nil {
+		return fmt.Errorf("computing the Shared Access Signature: %+v", err)
+	}
+
+	setSasToken(d, token)
+
+	return nil
+}
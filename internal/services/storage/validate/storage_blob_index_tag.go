@@ -5,8 +5,11 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 )
 
+var storageBlobIndexTagCharacters = regexp.MustCompile(`^[a-zA-Z0-9 +\-./:=_]*$`)
+
 func StorageBlobIndexTagName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 	if len(value) == 0 || len(value) > 128 {
@@ -24,3 +27,38 @@ func StorageBlobIndexTagValue(v interface{}, k string) (warnings []string, error
 	}
 	return warnings, errors
 }
+
+// StorageBlobIndexTags validates the Blob Index Tags which can be assigned to a single Blob.
+func StorageBlobIndexTags(v interface{}, k string) (warnings []string, errors []error) {
+	tags, ok := v.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a map", k))
+		return warnings, errors
+	}
+
+	if len(tags) > 10 {
+		errors = append(errors, fmt.Errorf("a maximum of 10 Blob Index Tags can be specified for %q but got %d", k, len(tags)))
+	}
+
+	for name, raw := range tags {
+		value, ok := raw.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected the value of %q in %q to be a string", name, k))
+			continue
+		}
+
+		_, errs := StorageBlobIndexTagName(name, k)
+		errors = append(errors, errs...)
+		_, errs = StorageBlobIndexTagValue(value, k)
+		errors = append(errors, errs...)
+
+		if !storageBlobIndexTagCharacters.MatchString(name) {
+			errors = append(errors, fmt.Errorf("the Blob Index Tag name %q in %q can only contain alphanumeric characters, spaces and the characters `+-./:=_`", name, k))
+		}
+		if !storageBlobIndexTagCharacters.MatchString(value) {
+			errors = append(errors, fmt.Errorf("the value of the Blob Index Tag %q in %q can only contain alphanumeric characters, spaces and the characters `+-./:=_`", name, k))
+		}
+	}
+
+	return warnings, errors
+}
@@ -54,3 +54,57 @@ func TestStorageBlobIndexTagValue(t *testing.T) {
 		}
 	}
 }
+
+func TestStorageBlobIndexTags(t *testing.T) {
+	cases := []struct {
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"project":     "example",
+				"cost-center": "1234",
+				"path":        "/logs/2024-01-01",
+				"empty":       "",
+				"with space":  "a+b=c_d:e.f",
+			},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"project!": "example",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"project": "exa$mple",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				strings.Repeat("w", 129): "example",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"1": "a", "2": "b", "3": "c", "4": "d", "5": "e", "6": "f",
+				"7": "g", "8": "h", "9": "i", "10": "j", "11": "k",
+			},
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := StorageBlobIndexTags(tc.Input, "index_tags")
+		if valid := len(errors) == 0; valid != tc.Valid {
+			t.Fatalf("expected %+v to be valid: %t but got %+v", tc.Input, tc.Valid, errors)
+		}
+	}
+}
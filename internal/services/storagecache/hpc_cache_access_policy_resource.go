@@ -20,7 +20,7 @@ import (
 )
 
 func resourceHPCCacheAccessPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceHPCCacheAccessPolicyCreateUpdate,
 		Read:   resourceHPCCacheAccessPolicyRead,
 		Update: resourceHPCCacheAccessPolicyCreateUpdate,
@@ -117,7 +117,7 @@ func resourceHPCCacheAccessPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.CacheAccessPolicyId{})
 }
 
 func resourceHPCCacheAccessPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
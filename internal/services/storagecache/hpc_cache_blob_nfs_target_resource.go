@@ -23,7 +23,7 @@ import (
 )
 
 func resourceHPCCacheBlobNFSTarget() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceHPCCacheBlobNFSTargetCreateUpdate,
 		Read:   resourceHPCCacheBlobNFSTargetRead,
 		Update: resourceHPCCacheBlobNFSTargetCreateUpdate,
@@ -108,7 +108,7 @@ func resourceHPCCacheBlobNFSTarget() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(1, 31536000),
 			},
 		},
-	}
+	}, &storagetargets.StorageTargetId{})
 }
 
 func resourceHPCCacheBlobNFSTargetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceHPCCacheBlobTarget() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceHPCCacheBlobTargetCreateOrUpdate,
 		Update: resourceHPCCacheBlobTargetCreateOrUpdate,
 		Read:   resourceHPCCacheBlobTargetRead,
@@ -77,7 +77,7 @@ func resourceHPCCacheBlobTarget() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &storagetargets.StorageTargetId{})
 }
 
 func resourceHPCCacheBlobTargetCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
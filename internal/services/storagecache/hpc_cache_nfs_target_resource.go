@@ -22,7 +22,7 @@ import (
 )
 
 func resourceHPCCacheNFSTarget() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceHPCCacheNFSTargetCreateOrUpdate,
 		Update: resourceHPCCacheNFSTargetCreateOrUpdate,
 		Read:   resourceHPCCacheNFSTargetRead,
@@ -129,7 +129,7 @@ func resourceHPCCacheNFSTarget() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(1, 31536000),
 			},
 		},
-	}
+	}, &storagetargets.StorageTargetId{})
 }
 
 func resourceHPCCacheNFSTargetCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
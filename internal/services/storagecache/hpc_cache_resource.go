@@ -32,7 +32,7 @@ import (
 )
 
 func resourceHPCCache() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceHPCCacheCreateOrUpdate,
 		Update: resourceHPCCacheCreateOrUpdate,
 		Read:   resourceHPCCacheRead,
@@ -51,6 +51,10 @@ func resourceHPCCache() *pluginsdk.Resource {
 
 		Schema: resourceHPCCacheSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &caches.CacheId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &caches.CacheId{})
 }
 
 func resourceHPCCacheCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
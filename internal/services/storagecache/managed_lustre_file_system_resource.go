@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/amlfilesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -63,6 +64,7 @@ type ManagedLustreFileSystemResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagedLustreFileSystemResource{}
 var _ sdk.ResourceWithCustomizeDiff = ManagedLustreFileSystemResource{}
+var _ sdk.ResourceWithIdentity = ManagedLustreFileSystemResource{}
 
 func GetSkuPropertiesByName(skuName string) *SkuProperties {
 	for _, sku := range PossibleSkuProperties() {
@@ -117,6 +119,10 @@ func (r ManagedLustreFileSystemResource) IDValidationFunc() pluginsdk.SchemaVali
 	return amlfilesystems.ValidateAmlFilesystemID
 }
 
+func (r ManagedLustreFileSystemResource) Identity() resourceids.ResourceId {
+	return &amlfilesystems.AmlFilesystemId{}
+}
+
 func (r ManagedLustreFileSystemResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -43,6 +43,22 @@ func (id CacheAccessPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CacheName, id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this CacheAccessPolicy ID
+func (id CacheAccessPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStorageCache", "Microsoft.StorageCache", "Microsoft.StorageCache"),
+		resourceids.StaticSegment("staticCaches", "caches", "caches"),
+		resourceids.UserSpecifiedSegment("cacheName", "cache1"),
+		resourceids.StaticSegment("staticCacheAccessPolicies", "cacheAccessPolicies", "cacheAccessPolicies"),
+		resourceids.UserSpecifiedSegment("name", "policy1"),
+	}
+}
+
 // CacheAccessPolicyID parses a CacheAccessPolicy ID into an CacheAccessPolicyId struct
 func CacheAccessPolicyID(input string) (*CacheAccessPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func CacheAccessPolicyID(input string) (*CacheAccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the CacheAccessPolicyId using the ParseResult provided in 'input'
+func (id *CacheAccessPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.CacheName, ok = input.Parsed["cacheName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "cacheName", input)
+	}
+
+	if id.Name, ok = input.Parsed["name"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "name", input)
+	}
+
+	return nil
+}
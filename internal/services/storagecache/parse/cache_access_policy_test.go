@@ -21,6 +21,23 @@ func TestCacheAccessPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCacheAccessPolicyIDSegments(t *testing.T) {
+	expected := NewCacheAccessPolicyID("12345678-1234-9876-4563-123456789012", "group1", "cache1", "policy1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CacheAccessPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CacheAccessPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCacheAccessPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
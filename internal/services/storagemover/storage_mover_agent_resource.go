@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/agents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,6 +30,7 @@ type StorageMoverAgentResourceModel struct {
 type StorageMoverAgentResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverAgentResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverAgentResource{}
 
 func (r StorageMoverAgentResource) ResourceType() string {
 	return "azurerm_storage_mover_agent"
@@ -42,6 +44,10 @@ func (r StorageMoverAgentResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return agents.ValidateAgentID
 }
 
+func (r StorageMoverAgentResource) Identity() resourceids.ResourceId {
+	return &agents.AgentId{}
+}
+
 func (r StorageMoverAgentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/jobdefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -33,6 +34,7 @@ type StorageMoverJobDefinitionResourceModel struct {
 type StorageMoverJobDefinitionResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverJobDefinitionResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverJobDefinitionResource{}
 
 func (r StorageMoverJobDefinitionResource) ResourceType() string {
 	return "azurerm_storage_mover_job_definition"
@@ -46,6 +48,10 @@ func (r StorageMoverJobDefinitionResource) IDValidationFunc() pluginsdk.SchemaVa
 	return jobdefinitions.ValidateJobDefinitionID
 }
 
+func (r StorageMoverJobDefinitionResource) Identity() resourceids.ResourceId {
+	return &jobdefinitions.JobDefinitionId{}
+}
+
 func (r StorageMoverJobDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
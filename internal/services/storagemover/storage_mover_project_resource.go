@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/projects"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -27,6 +28,7 @@ type StorageMoverProjectResourceModel struct {
 type StorageMoverProjectResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverProjectResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverProjectResource{}
 
 func (r StorageMoverProjectResource) ResourceType() string {
 	return "azurerm_storage_mover_project"
@@ -40,6 +42,10 @@ func (r StorageMoverProjectResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return projects.ValidateProjectID
 }
 
+func (r StorageMoverProjectResource) Identity() resourceids.ResourceId {
+	return &projects.ProjectId{}
+}
+
 func (r StorageMoverProjectResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -28,6 +29,7 @@ type StorageMoverModel struct {
 type StorageMoverResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverResource{}
 
 func (r StorageMoverResource) ResourceType() string {
 	return "azurerm_storage_mover"
@@ -41,6 +43,10 @@ func (r StorageMoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return storagemovers.ValidateStorageMoverID
 }
 
+func (r StorageMoverResource) Identity() resourceids.ResourceId {
+	return &storagemovers.StorageMoverId{}
+}
+
 func (r StorageMoverResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
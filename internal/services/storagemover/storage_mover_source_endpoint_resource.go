@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/endpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -30,6 +31,7 @@ type StorageMoverSourceEndpointModel struct {
 type StorageMoverSourceEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverSourceEndpointResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverSourceEndpointResource{}
 
 func (r StorageMoverSourceEndpointResource) ResourceType() string {
 	return "azurerm_storage_mover_source_endpoint"
@@ -43,6 +45,10 @@ func (r StorageMoverSourceEndpointResource) IDValidationFunc() pluginsdk.SchemaV
 	return endpoints.ValidateEndpointID
 }
 
+func (r StorageMoverSourceEndpointResource) Identity() resourceids.ResourceId {
+	return &endpoints.EndpointId{}
+}
+
 func (r StorageMoverSourceEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/endpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -31,6 +32,7 @@ type StorageMoverTargetEndpointModel struct {
 type StorageMoverTargetEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = StorageMoverTargetEndpointResource{}
+var _ sdk.ResourceWithIdentity = StorageMoverTargetEndpointResource{}
 
 func (r StorageMoverTargetEndpointResource) ResourceType() string {
 	return "azurerm_storage_mover_target_endpoint"
@@ -44,6 +46,10 @@ func (r StorageMoverTargetEndpointResource) IDValidationFunc() pluginsdk.SchemaV
 	return endpoints.ValidateEndpointID
 }
 
+func (r StorageMoverTargetEndpointResource) Identity() resourceids.ResourceId {
+	return &endpoints.EndpointId{}
+}
+
 func (r StorageMoverTargetEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
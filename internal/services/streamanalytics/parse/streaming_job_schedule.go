@@ -43,6 +43,22 @@ func (id StreamingJobScheduleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingJobName, id.ScheduleName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StreamingJobSchedule ID
+func (id StreamingJobScheduleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftStreamAnalytics", "Microsoft.StreamAnalytics", "Microsoft.StreamAnalytics"),
+		resourceids.StaticSegment("staticStreamingJobs", "streamingJobs", "streamingJobs"),
+		resourceids.UserSpecifiedSegment("streamingJobName", "streamingJob1"),
+		resourceids.StaticSegment("staticSchedule", "schedule", "schedule"),
+		resourceids.UserSpecifiedSegment("scheduleName", "default"),
+	}
+}
+
 // StreamingJobScheduleID parses a StreamingJobSchedule ID into an StreamingJobScheduleId struct
 func StreamingJobScheduleID(input string) (*StreamingJobScheduleId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func StreamingJobScheduleIDInsensitively(input string) (*StreamingJobScheduleId,
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the StreamingJobScheduleId using the ParseResult provided in 'input'
+func (id *StreamingJobScheduleId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StreamingJobName, ok = input.Parsed["streamingJobName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "streamingJobName", input)
+	}
+
+	if id.ScheduleName, ok = input.Parsed["scheduleName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "scheduleName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestStreamingJobScheduleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStreamingJobScheduleIDSegments(t *testing.T) {
+	expected := NewStreamingJobScheduleID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StreamingJobScheduleId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StreamingJobScheduleId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStreamingJobScheduleID(t *testing.T) {
 	testData := []struct {
 		Input    string
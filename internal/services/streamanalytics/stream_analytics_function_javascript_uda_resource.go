@@ -22,7 +22,7 @@ import (
 )
 
 func resourceStreamAnalyticsFunctionUDA() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsFunctionUDACreate,
 		Read:   resourceStreamAnalyticsFunctionUDARead,
 		Update: resourceStreamAnalyticsFunctionUDAUpdate,
@@ -118,7 +118,7 @@ func resourceStreamAnalyticsFunctionUDA() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &functions.FunctionId{})
 }
 
 func resourceStreamAnalyticsFunctionUDACreate(d *pluginsdk.ResourceData, meta interface{}) error {
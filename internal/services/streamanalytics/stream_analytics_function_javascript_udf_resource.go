@@ -21,7 +21,7 @@ import (
 )
 
 func resourceStreamAnalyticsFunctionUDF() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsFunctionUDFCreateUpdate,
 		Read:   resourceStreamAnalyticsFunctionUDFRead,
 		Update: resourceStreamAnalyticsFunctionUDFCreateUpdate,
@@ -120,7 +120,7 @@ func resourceStreamAnalyticsFunctionUDF() *pluginsdk.Resource {
 				// TODO: JS diff suppress func?!
 			},
 		},
-	}
+	}, &functions.FunctionId{})
 }
 
 func resourceStreamAnalyticsFunctionUDFCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -219,7 +219,10 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 			ValidateFunc: validation.StringIsNotEmpty,
 		}
 	}
-	return resource
+
+	pluginsdk.EnableLocationValidation(resource, &streamingjobs.StreamingJobId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &streamingjobs.StreamingJobId{})
 }
 
 func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
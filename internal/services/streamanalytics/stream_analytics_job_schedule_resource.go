@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -83,6 +84,10 @@ func (r JobScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return streamAnalyticsValidate.StreamingJobScheduleID
 }
 
+func (r JobScheduleResource) Identity() resourceids.ResourceId {
+	return &parse.StreamingJobScheduleId{}
+}
+
 func (r JobScheduleResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/privateendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -30,6 +31,7 @@ type ManagedPrivateEndpointModel struct {
 }
 
 var _ sdk.ResourceWithStateMigration = ManagedPrivateEndpointResource{}
+var _ sdk.ResourceWithIdentity = ManagedPrivateEndpointResource{}
 
 func (r ManagedPrivateEndpointResource) ModelObject() interface{} {
 	return &ManagedPrivateEndpointModel{}
@@ -43,6 +45,10 @@ func (r ManagedPrivateEndpointResource) IDValidationFunc() pluginsdk.SchemaValid
 	return privateendpoints.ValidatePrivateEndpointID
 }
 
+func (r ManagedPrivateEndpointResource) Identity() resourceids.ResourceId {
+	return &privateendpoints.PrivateEndpointId{}
+}
+
 func (r ManagedPrivateEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
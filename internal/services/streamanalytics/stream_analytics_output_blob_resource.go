@@ -23,7 +23,7 @@ import (
 )
 
 func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputBlobCreateUpdate,
 		Read:   resourceStreamAnalyticsOutputBlobRead,
 		Update: resourceStreamAnalyticsOutputBlobCreateUpdate,
@@ -129,7 +129,7 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(outputs.PossibleValuesForBlobWriteMode(), false),
 			},
 		},
-	}
+	}, &outputs.OutputId{})
 }
 
 func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
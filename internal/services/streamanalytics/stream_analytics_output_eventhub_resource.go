@@ -22,7 +22,7 @@ import (
 )
 
 func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputEventHubCreateUpdate,
 		Read:   resourceStreamAnalyticsOutputEventHubRead,
 		Update: resourceStreamAnalyticsOutputEventHubCreateUpdate,
@@ -113,7 +113,7 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
 		},
-	}
+	}, &outputs.OutputId{})
 }
 
 func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
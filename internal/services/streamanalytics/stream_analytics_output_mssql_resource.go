@@ -20,7 +20,7 @@ import (
 )
 
 func resourceStreamAnalyticsOutputSql() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputSqlCreateUpdate,
 		Read:   resourceStreamAnalyticsOutputSqlRead,
 		Update: resourceStreamAnalyticsOutputSqlCreateUpdate,
@@ -124,7 +124,7 @@ func resourceStreamAnalyticsOutputSql() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &outputs.OutputId{})
 }
 
 func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
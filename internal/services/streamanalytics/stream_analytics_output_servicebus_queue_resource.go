@@ -22,7 +22,7 @@ import (
 )
 
 func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate,
 		Read:   resourceStreamAnalyticsOutputServiceBusQueueRead,
 		Update: resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate,
@@ -117,7 +117,7 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &outputs.OutputId{})
 }
 
 func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
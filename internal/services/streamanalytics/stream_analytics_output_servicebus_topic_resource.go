@@ -22,7 +22,7 @@ import (
 )
 
 func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate,
 		Read:   resourceStreamAnalyticsOutputServiceBusTopicRead,
 		Update: resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate,
@@ -117,7 +117,7 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &outputs.OutputId{})
 }
 
 func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
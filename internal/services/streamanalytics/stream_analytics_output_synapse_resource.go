@@ -20,7 +20,7 @@ import (
 )
 
 func resourceStreamAnalyticsOutputSynapse() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsOutputSynapseCreateUpdate,
 		Read:   resourceStreamAnalyticsOutputSynapseRead,
 		Update: resourceStreamAnalyticsOutputSynapseCreateUpdate,
@@ -100,7 +100,7 @@ func resourceStreamAnalyticsOutputSynapse() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &outputs.OutputId{})
 }
 
 func resourceStreamAnalyticsOutputSynapseCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -120,6 +121,10 @@ func (r OutputTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return outputs.ValidateOutputID
 }
 
+func (r OutputTableResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputTableResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceStreamAnalyticsReferenceInputBlob() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsReferenceInputBlobCreate,
 		Read:   resourceStreamAnalyticsReferenceInputBlobRead,
 		Update: resourceStreamAnalyticsReferenceInputBlobUpdate,
@@ -110,7 +110,7 @@ func resourceStreamAnalyticsReferenceInputBlob() *pluginsdk.Resource {
 				}, false),
 			},
 		},
-	}
+	}, &inputs.InputId{})
 }
 
 func resourceStreamAnalyticsReferenceInputBlobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceStreamAnalyticsReferenceMsSql() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsReferenceInputMsSqlCreateUpdate,
 		Read:   resourceStreamAnalyticsReferenceInputMsSqlRead,
 		Update: resourceStreamAnalyticsReferenceInputMsSqlCreateUpdate,
@@ -121,7 +121,7 @@ func resourceStreamAnalyticsReferenceMsSql() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &inputs.InputId{})
 }
 
 func resourceStreamAnalyticsReferenceInputMsSqlCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
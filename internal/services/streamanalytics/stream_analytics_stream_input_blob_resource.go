@@ -21,7 +21,7 @@ import (
 )
 
 func resourceStreamAnalyticsStreamInputBlob() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsStreamInputBlobCreateUpdate,
 		Read:   resourceStreamAnalyticsStreamInputBlobRead,
 		Update: resourceStreamAnalyticsStreamInputBlobCreateUpdate,
@@ -99,7 +99,7 @@ func resourceStreamAnalyticsStreamInputBlob() *pluginsdk.Resource {
 
 			"serialization": schemaStreamAnalyticsStreamInputSerialization(),
 		},
-	}
+	}, &inputs.InputId{})
 }
 
 func resourceStreamAnalyticsStreamInputBlobCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
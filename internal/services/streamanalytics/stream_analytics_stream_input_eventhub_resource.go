@@ -21,7 +21,7 @@ import (
 )
 
 func resourceStreamAnalyticsStreamInputEventHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsStreamInputEventHubCreateUpdate,
 		Read:   resourceStreamAnalyticsStreamInputEventHubRead,
 		Update: resourceStreamAnalyticsStreamInputEventHubCreateUpdate,
@@ -110,7 +110,7 @@ func resourceStreamAnalyticsStreamInputEventHub() *pluginsdk.Resource {
 
 			"serialization": schemaStreamAnalyticsStreamInputSerialization(),
 		},
-	}
+	}, &inputs.InputId{})
 }
 
 func resourceStreamAnalyticsStreamInputEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceStreamAnalyticsStreamInputIoTHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceStreamAnalyticsStreamInputIoTHubCreateUpdate,
 		Read:   resourceStreamAnalyticsStreamInputIoTHubRead,
 		Update: resourceStreamAnalyticsStreamInputIoTHubCreateUpdate,
@@ -95,7 +95,7 @@ func resourceStreamAnalyticsStreamInputIoTHub() *pluginsdk.Resource {
 
 			"serialization": schemaStreamAnalyticsStreamInputSerialization(),
 		},
-	}
+	}, &inputs.InputId{})
 }
 
 func resourceStreamAnalyticsStreamInputIoTHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -6,6 +6,8 @@ package parse
 import (
 	"fmt"
 	"regexp"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 )
 
 type SubscriptionAliasId struct {
@@ -18,10 +20,35 @@ func NewSubscriptionAliasId(name string) SubscriptionAliasId {
 	}
 }
 
+func (id SubscriptionAliasId) String() string {
+	return fmt.Sprintf("Subscription Alias: (Name %q)", id.Name)
+}
+
 func (id SubscriptionAliasId) ID() string {
 	return fmt.Sprintf("/providers/Microsoft.Subscription/aliases/%s", id.Name)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this Subscription Alias ID
+func (id SubscriptionAliasId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSubscription", "Microsoft.Subscription", "Microsoft.Subscription"),
+		resourceids.StaticSegment("staticAliases", "aliases", "aliases"),
+		resourceids.UserSpecifiedSegment("aliasName", "alias1"),
+	}
+}
+
+// FromParseResult populates the SubscriptionAliasId using the ParseResult provided in 'input'
+func (id *SubscriptionAliasId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.Name, ok = input.Parsed["aliasName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "aliasName", input)
+	}
+
+	return nil
+}
+
 func SubscriptionAliasID(input string) (*SubscriptionAliasId, error) {
 	groups := regexp.MustCompile(`^/providers/Microsoft.Subscription/aliases/([^/]+)$`).FindStringSubmatch(input)
 	if len(groups) != 2 {
@@ -33,7 +33,7 @@ import (
 var SubscriptionResourceName = "azurerm_subscription"
 
 func resourceSubscription() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSubscriptionCreate,
 		Update: resourceSubscriptionUpdate,
 		Read:   resourceSubscriptionRead,
@@ -119,7 +119,7 @@ func resourceSubscription() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
-	}
+	}, &parse.SubscriptionAliasId{})
 }
 
 func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
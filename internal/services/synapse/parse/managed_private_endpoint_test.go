@@ -21,6 +21,23 @@ func TestManagedPrivateEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedPrivateEndpointIDSegments(t *testing.T) {
+	expected := NewManagedPrivateEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "default", "endpoint1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedPrivateEndpointId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedPrivateEndpointId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedPrivateEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestPrivateLinkHubIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateLinkHubIDSegments(t *testing.T) {
+	expected := NewPrivateLinkHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "privateLinkHub1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PrivateLinkHubId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PrivateLinkHubId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPrivateLinkHubID(t *testing.T) {
 	testData := []struct {
 		Input    string
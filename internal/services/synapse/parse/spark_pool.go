@@ -43,6 +43,22 @@ func (id SparkPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.BigDataPoolName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SparkPool ID
+func (id SparkPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticBigDataPools", "bigDataPools", "bigDataPools"),
+		resourceids.UserSpecifiedSegment("bigDataPoolName", "bigDataPool1"),
+	}
+}
+
 // SparkPoolID parses a SparkPool ID into an SparkPoolId struct
 func SparkPoolID(input string) (*SparkPoolId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -132,3 +148,26 @@ func SparkPoolIDInsensitively(input string) (*SparkPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SparkPoolId using the ParseResult provided in 'input'
+func (id *SparkPoolId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.BigDataPoolName, ok = input.Parsed["bigDataPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "bigDataPoolName", input)
+	}
+
+	return nil
+}
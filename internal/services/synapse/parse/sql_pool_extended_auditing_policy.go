@@ -46,6 +46,24 @@ func (id SqlPoolExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, id.ExtendedAuditingSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SqlPoolExtendedAuditingPolicy ID
+func (id SqlPoolExtendedAuditingPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticSqlPools", "sqlPools", "sqlPools"),
+		resourceids.UserSpecifiedSegment("sqlPoolName", "sqlPool1"),
+		resourceids.StaticSegment("staticExtendedAuditingSettings", "extendedAuditingSettings", "extendedAuditingSettings"),
+		resourceids.UserSpecifiedSegment("extendedAuditingSettingName", "default"),
+	}
+}
+
 // SqlPoolExtendedAuditingPolicyID parses a SqlPoolExtendedAuditingPolicy ID into an SqlPoolExtendedAuditingPolicyId struct
 func SqlPoolExtendedAuditingPolicyID(input string) (*SqlPoolExtendedAuditingPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func SqlPoolExtendedAuditingPolicyID(input string) (*SqlPoolExtendedAuditingPoli
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SqlPoolExtendedAuditingPolicyId using the ParseResult provided in 'input'
+func (id *SqlPoolExtendedAuditingPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SqlPoolName, ok = input.Parsed["sqlPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "sqlPoolName", input)
+	}
+
+	if id.ExtendedAuditingSettingName, ok = input.Parsed["extendedAuditingSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "extendedAuditingSettingName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSqlPoolExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolExtendedAuditingPolicyIDSegments(t *testing.T) {
+	expected := NewSqlPoolExtendedAuditingPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "sqlPool1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlPoolExtendedAuditingPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlPoolExtendedAuditingPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlPoolExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSqlPoolRecoverableDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolRecoverableDatabaseIDSegments(t *testing.T) {
+	expected := NewSqlPoolRecoverableDatabaseID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "database")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlPoolRecoverableDatabaseId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlPoolRecoverableDatabaseId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlPoolRecoverableDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestSqlPoolVulnerabilityAssessmentBaselineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolVulnerabilityAssessmentBaselineIDSegments(t *testing.T) {
+	expected := NewSqlPoolVulnerabilityAssessmentBaselineID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "sqlPool1", "default", "rule1", "baseline1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlPoolVulnerabilityAssessmentBaselineId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlPoolVulnerabilityAssessmentBaselineId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlPoolVulnerabilityAssessmentBaselineID(t *testing.T) {
 	testData := []struct {
 		Input    string
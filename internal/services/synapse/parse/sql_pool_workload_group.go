@@ -46,6 +46,24 @@ func (id SqlPoolWorkloadGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SqlPoolName, id.WorkloadGroupName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SqlPoolWorkloadGroup ID
+func (id SqlPoolWorkloadGroupId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticSqlPools", "sqlPools", "sqlPools"),
+		resourceids.UserSpecifiedSegment("sqlPoolName", "sqlPool1"),
+		resourceids.StaticSegment("staticWorkloadGroups", "workloadGroups", "workloadGroups"),
+		resourceids.UserSpecifiedSegment("workloadGroupName", "workloadGroup1"),
+	}
+}
+
 // SqlPoolWorkloadGroupID parses a SqlPoolWorkloadGroup ID into an SqlPoolWorkloadGroupId struct
 func SqlPoolWorkloadGroupID(input string) (*SqlPoolWorkloadGroupId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func SqlPoolWorkloadGroupID(input string) (*SqlPoolWorkloadGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SqlPoolWorkloadGroupId using the ParseResult provided in 'input'
+func (id *SqlPoolWorkloadGroupId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SqlPoolName, ok = input.Parsed["sqlPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "sqlPoolName", input)
+	}
+
+	if id.WorkloadGroupName, ok = input.Parsed["workloadGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workloadGroupName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestSqlPoolWorkloadGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolWorkloadGroupIDSegments(t *testing.T) {
+	expected := NewSqlPoolWorkloadGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "sqlPool1", "workloadGroup1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SqlPoolWorkloadGroupId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SqlPoolWorkloadGroupId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSqlPoolWorkloadGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
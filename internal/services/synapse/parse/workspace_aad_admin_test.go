@@ -21,6 +21,23 @@ func TestWorkspaceAADAdminIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceAADAdminIDSegments(t *testing.T) {
+	expected := NewWorkspaceAADAdminID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "workspace1", "activeDirectory")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WorkspaceAADAdminId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WorkspaceAADAdminId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWorkspaceAADAdminID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id WorkspaceExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.ExtendedAuditingSettingName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this WorkspaceExtendedAuditingPolicy ID
+func (id WorkspaceExtendedAuditingPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticExtendedAuditingSettings", "extendedAuditingSettings", "extendedAuditingSettings"),
+		resourceids.UserSpecifiedSegment("extendedAuditingSettingName", "default"),
+	}
+}
+
 // WorkspaceExtendedAuditingPolicyID parses a WorkspaceExtendedAuditingPolicy ID into an WorkspaceExtendedAuditingPolicyId struct
 func WorkspaceExtendedAuditingPolicyID(input string) (*WorkspaceExtendedAuditingPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func WorkspaceExtendedAuditingPolicyID(input string) (*WorkspaceExtendedAuditing
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the WorkspaceExtendedAuditingPolicyId using the ParseResult provided in 'input'
+func (id *WorkspaceExtendedAuditingPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.ExtendedAuditingSettingName, ok = input.Parsed["extendedAuditingSettingName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "extendedAuditingSettingName", input)
+	}
+
+	return nil
+}
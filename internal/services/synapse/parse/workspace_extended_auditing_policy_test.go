@@ -21,6 +21,23 @@ func TestWorkspaceExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceExtendedAuditingPolicyIDSegments(t *testing.T) {
+	expected := NewWorkspaceExtendedAuditingPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WorkspaceExtendedAuditingPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WorkspaceExtendedAuditingPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWorkspaceExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
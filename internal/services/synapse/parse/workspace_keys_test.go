@@ -21,6 +21,23 @@ func TestWorkspaceKeysIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceKeysIDSegments(t *testing.T) {
+	expected := NewWorkspaceKeysID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "key1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WorkspaceKeysId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WorkspaceKeysId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWorkspaceKeysID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id WorkspaceSecurityAlertPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SecurityAlertPolicyName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this WorkspaceSecurityAlertPolicy ID
+func (id WorkspaceSecurityAlertPolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse", "Microsoft.Synapse"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspace1"),
+		resourceids.StaticSegment("staticSecurityAlertPolicies", "securityAlertPolicies", "securityAlertPolicies"),
+		resourceids.UserSpecifiedSegment("securityAlertPolicyName", "Default"),
+	}
+}
+
 // WorkspaceSecurityAlertPolicyID parses a WorkspaceSecurityAlertPolicy ID into an WorkspaceSecurityAlertPolicyId struct
 func WorkspaceSecurityAlertPolicyID(input string) (*WorkspaceSecurityAlertPolicyId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func WorkspaceSecurityAlertPolicyID(input string) (*WorkspaceSecurityAlertPolicy
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the WorkspaceSecurityAlertPolicyId using the ParseResult provided in 'input'
+func (id *WorkspaceSecurityAlertPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.WorkspaceName, ok = input.Parsed["workspaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "workspaceName", input)
+	}
+
+	if id.SecurityAlertPolicyName, ok = input.Parsed["securityAlertPolicyName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "securityAlertPolicyName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestWorkspaceSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceSecurityAlertPolicyIDSegments(t *testing.T) {
+	expected := NewWorkspaceSecurityAlertPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "Default")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WorkspaceSecurityAlertPolicyId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WorkspaceSecurityAlertPolicyId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWorkspaceSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestWorkspaceSqlAADAdminIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceSqlAADAdminIDSegments(t *testing.T) {
+	expected := NewWorkspaceSqlAADAdminID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "workspace1", "activeDirectory")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WorkspaceSqlAADAdminId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WorkspaceSqlAADAdminId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWorkspaceSqlAADAdminID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestWorkspaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceIDSegments(t *testing.T) {
+	expected := NewWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&WorkspaceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := WorkspaceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestWorkspaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
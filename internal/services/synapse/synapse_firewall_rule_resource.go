@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSynapseFirewallRule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseFirewallRuleCreateUpdate,
 		Read:   resourceSynapseFirewallRuleRead,
 		Update: resourceSynapseFirewallRuleCreateUpdate,
@@ -65,7 +65,7 @@ func resourceSynapseFirewallRule() *pluginsdk.Resource {
 				ValidateFunc: validation.IsIPv4Address,
 			},
 		},
-	}
+	}, &parse.FirewallRuleId{})
 }
 
 func resourceSynapseFirewallRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -23,7 +23,7 @@ import (
 )
 
 func resourceSynapseIntegrationRuntimeAzure() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSynapseIntegrationRuntimeAzureCreateUpdate,
 		Read:   resourceSynapseIntegrationRuntimeAzureRead,
 		Update: resourceSynapseIntegrationRuntimeAzureCreateUpdate,
@@ -109,6 +109,10 @@ func resourceSynapseIntegrationRuntimeAzure() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.IntegrationRuntimeId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.IntegrationRuntimeId{})
 }
 
 func resourceSynapseIntegrationRuntimeAzureCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSynapseIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseIntegrationRuntimeSelfHostedCreateUpdate,
 		Read:   resourceSynapseIntegrationRuntimeSelfHostedRead,
 		Update: resourceSynapseIntegrationRuntimeSelfHostedCreateUpdate,
@@ -78,7 +78,7 @@ func resourceSynapseIntegrationRuntimeSelfHosted() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.IntegrationRuntimeId{})
 }
 
 func resourceSynapseIntegrationRuntimeSelfHostedCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -24,7 +24,7 @@ import (
 )
 
 func resourceSynapseLinkedService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseLinkedServiceCreateUpdate,
 		Read:   resourceSynapseLinkedServiceRead,
 		Update: resourceSynapseLinkedServiceCreateUpdate,
@@ -230,7 +230,7 @@ func resourceSynapseLinkedService() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &parse.LinkedServiceId{})
 }
 
 func resourceSynapseLinkedServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
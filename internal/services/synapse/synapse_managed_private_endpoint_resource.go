@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSynapseManagedPrivateEndpoint() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseManagedPrivateEndpointCreate,
 		Read:   resourceSynapseManagedPrivateEndpointRead,
 		Delete: resourceSynapseManagedPrivateEndpointDelete,
@@ -67,7 +67,7 @@ func resourceSynapseManagedPrivateEndpoint() *pluginsdk.Resource {
 				ValidateFunc: networkValidate.PrivateLinkSubResourceName,
 			},
 		},
-	}
+	}, &parse.ManagedPrivateEndpointId{})
 }
 
 func resourceSynapseManagedPrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
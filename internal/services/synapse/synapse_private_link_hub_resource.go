@@ -23,7 +23,7 @@ import (
 )
 
 func resourceSynapsePrivateLinkHub() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceSynapsePrivateLinkHubCreate,
 		Read:   resourceSynapsePrivateLinkHubRead,
 		Update: resourceSynapsePrivateLinkHubUpdate,
@@ -56,6 +56,10 @@ func resourceSynapsePrivateLinkHub() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.PrivateLinkHubId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.PrivateLinkHubId{})
 }
 
 func resourceSynapsePrivateLinkHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSynapseSqlPoolExtendedAuditingPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolExtendedAuditingPolicyCreateUpdate,
 		Read:   resourceSynapseSqlPoolExtendedAuditingPolicyRead,
 		Update: resourceSynapseSqlPoolExtendedAuditingPolicyCreateUpdate,
@@ -78,7 +78,7 @@ func resourceSynapseSqlPoolExtendedAuditingPolicy() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &parse.SqlPoolExtendedAuditingPolicyId{})
 }
 
 func resourceSynapseSqlPoolExtendedAuditingPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -200,7 +200,7 @@ func resourceSynapseSqlPool() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &parse.SqlPoolId{})
 }
 
 func synapseSqlPoolCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
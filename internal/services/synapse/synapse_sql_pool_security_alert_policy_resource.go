@@ -19,7 +19,7 @@ import (
 )
 
 func resourceSynapseSqlPoolSecurityAlertPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolSecurityAlertPolicyCreateUpdate,
 		Read:   resourceSynapseSqlPoolSecurityAlertPolicyRead,
 		Update: resourceSynapseSqlPoolSecurityAlertPolicyCreateUpdate,
@@ -106,7 +106,7 @@ func resourceSynapseSqlPoolSecurityAlertPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.SqlPoolSecurityAlertPolicyId{})
 }
 
 func resourceSynapseSqlPoolSecurityAlertPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSynapseSqlPoolVulnerabilityAssessmentBaseline() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolVulnerabilityAssessmentBaselineCreateUpdate,
 		Read:   resourceSynapseSqlPoolVulnerabilityAssessmentBaselineRead,
 		Update: resourceSynapseSqlPoolVulnerabilityAssessmentBaselineCreateUpdate,
@@ -74,7 +74,7 @@ func resourceSynapseSqlPoolVulnerabilityAssessmentBaseline() *pluginsdk.Resource
 				},
 			},
 		},
-	}
+	}, &parse.SqlPoolVulnerabilityAssessmentBaselineId{})
 }
 
 func resourceSynapseSqlPoolVulnerabilityAssessmentBaselineCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
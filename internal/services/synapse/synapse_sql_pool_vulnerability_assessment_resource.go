@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSynapseSqlPoolVulnerabilityAssessment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseSqlPoolVulnerabilityAssessmentCreateUpdate,
 		Read:   resourceSynapseSqlPoolVulnerabilityAssessmentRead,
 		Update: resourceSynapseSqlPoolVulnerabilityAssessmentCreateUpdate,
@@ -99,7 +99,7 @@ func resourceSynapseSqlPoolVulnerabilityAssessment() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.SqlPoolVulnerabilityAssessmentId{})
 }
 
 func resourceSynapseSqlPoolVulnerabilityAssessmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
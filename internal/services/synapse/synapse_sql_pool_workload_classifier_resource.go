@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSynapseSQLPoolWorkloadClassifier() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseSQLPoolWorkloadClassifierCreateUpdate,
 		Read:   resourceSynapseSQLPoolWorkloadClassifierRead,
 		Update: resourceSynapseSQLPoolWorkloadClassifierCreateUpdate,
@@ -101,7 +101,7 @@ func resourceSynapseSQLPoolWorkloadClassifier() *pluginsdk.Resource {
 				),
 			},
 		},
-	}
+	}, &parse.SqlPoolWorkloadClassifierId{})
 }
 
 func resourceSynapseSQLPoolWorkloadClassifierCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
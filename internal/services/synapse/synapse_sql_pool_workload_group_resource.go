@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSynapseSQLPoolWorkloadGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseSQLPoolWorkloadGroupCreateUpdate,
 		Read:   resourceSynapseSQLPoolWorkloadGroupRead,
 		Update: resourceSynapseSQLPoolWorkloadGroupCreateUpdate,
@@ -90,7 +90,7 @@ func resourceSynapseSQLPoolWorkloadGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntAtLeast(0),
 			},
 		},
-	}
+	}, &parse.SqlPoolWorkloadGroupId{})
 }
 
 func resourceSynapseSQLPoolWorkloadGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
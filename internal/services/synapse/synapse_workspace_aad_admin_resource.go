@@ -18,7 +18,7 @@ import (
 )
 
 func resourceSynapseWorkspaceAADAdmin() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseWorkspaceAADAdminCreateUpdate,
 		Read:   resourceSynapseWorkspaceAADAdminRead,
 		Update: resourceSynapseWorkspaceAADAdminCreateUpdate,
@@ -61,7 +61,7 @@ func resourceSynapseWorkspaceAADAdmin() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.WorkspaceAADAdminId{})
 }
 
 func resourceSynapseWorkspaceAADAdminCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceSynapseWorkspaceExtendedAuditingPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseWorkspaceExtendedAuditingPolicyCreateUpdate,
 		Read:   resourceSynapseWorkspaceExtendedAuditingPolicyRead,
 		Update: resourceSynapseWorkspaceExtendedAuditingPolicyCreateUpdate,
@@ -79,7 +79,7 @@ func resourceSynapseWorkspaceExtendedAuditingPolicy() *pluginsdk.Resource {
 				Default:  true,
 			},
 		},
-	}
+	}, &parse.WorkspaceExtendedAuditingPolicyId{})
 }
 
 func resourceSynapseWorkspaceExtendedAuditingPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
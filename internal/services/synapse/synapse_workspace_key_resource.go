@@ -22,7 +22,7 @@ import (
 )
 
 func resourceSynapseWorkspaceKey() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseWorkspaceKeysCreateUpdate,
 		Read:   resourceSynapseWorkspaceKeyRead,
 		Update: resourceSynapseWorkspaceKeysCreateUpdate,
@@ -63,7 +63,7 @@ func resourceSynapseWorkspaceKey() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.WorkspaceKeysId{})
 }
 
 func resourceSynapseWorkspaceKeysCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -336,7 +336,9 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	pluginsdk.EnableLocationValidation(resource, &parse.WorkspaceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.WorkspaceId{})
 }
 
 func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,7 +19,7 @@ import (
 )
 
 func resourceSynapseWorkspaceSecurityAlertPolicy() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseWorkspaceSecurityAlertPolicyCreateUpdate,
 		Read:   resourceSynapseWorkspaceSecurityAlertPolicyRead,
 		Update: resourceSynapseWorkspaceSecurityAlertPolicyCreateUpdate,
@@ -106,7 +106,7 @@ func resourceSynapseWorkspaceSecurityAlertPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.WorkspaceSecurityAlertPolicyId{})
 }
 
 func resourceSynapseWorkspaceSecurityAlertPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
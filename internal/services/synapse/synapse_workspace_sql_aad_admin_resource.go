@@ -18,7 +18,7 @@ import (
 )
 
 func resourceSynapseWorkspaceSqlAADAdmin() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseWorkspaceSqlAADAdminCreateUpdate,
 		Read:   resourceSynapseWorkspaceSqlAADAdminRead,
 		Update: resourceSynapseWorkspaceSqlAADAdminCreateUpdate,
@@ -61,7 +61,7 @@ func resourceSynapseWorkspaceSqlAADAdmin() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 		},
-	}
+	}, &parse.WorkspaceSqlAADAdminId{})
 }
 
 func resourceSynapseWorkspaceSqlAADAdminCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceSynapseWorkspaceVulnerabilityAssessment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceSynapseWorkspaceVulnerabilityAssessmentCreateUpdate,
 		Read:   resourceSynapseWorkspaceVulnerabilityAssessmentRead,
 		Update: resourceSynapseWorkspaceVulnerabilityAssessmentCreateUpdate,
@@ -99,7 +99,7 @@ func resourceSynapseWorkspaceVulnerabilityAssessment() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
-	}
+	}, &parse.WorkspaceVulnerabilityAssessmentId{})
 }
 
 func resourceSynapseWorkspaceVulnerabilityAssessmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
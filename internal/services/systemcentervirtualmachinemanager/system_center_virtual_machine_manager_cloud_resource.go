@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/clouds"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
@@ -32,6 +33,7 @@ type SystemCenterVirtualMachineManagerCloudModel struct {
 
 var _ sdk.Resource = SystemCenterVirtualMachineManagerCloudResource{}
 var _ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerCloudResource{}
+var _ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerCloudResource{}
 
 type SystemCenterVirtualMachineManagerCloudResource struct{}
 
@@ -43,6 +45,10 @@ func (r SystemCenterVirtualMachineManagerCloudResource) IDValidationFunc() plugi
 	return clouds.ValidateCloudID
 }
 
+func (r SystemCenterVirtualMachineManagerCloudResource) Identity() resourceids.ResourceId {
+	return &clouds.CloudId{}
+}
+
 func (r SystemCenterVirtualMachineManagerCloudResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_cloud"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualmachinetemplates"
@@ -32,6 +33,7 @@ type SystemCenterVirtualMachineManagerVirtualMachineTemplateModel struct {
 
 var _ sdk.Resource = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
 var _ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
+var _ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
 
 type SystemCenterVirtualMachineManagerVirtualMachineTemplateResource struct{}
 
@@ -43,6 +45,10 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) IDValid
 	return virtualmachinetemplates.ValidateVirtualMachineTemplateID
 }
 
+func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) Identity() resourceids.ResourceId {
+	return &virtualmachinetemplates.VirtualMachineTemplateId{}
+}
+
 func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_virtual_machine_template"
 }
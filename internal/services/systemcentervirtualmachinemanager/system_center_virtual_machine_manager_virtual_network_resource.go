@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualnetworks"
@@ -32,6 +33,7 @@ type SystemCenterVirtualMachineManagerVirtualNetworkModel struct {
 
 var _ sdk.Resource = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
 var _ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
+var _ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
 
 type SystemCenterVirtualMachineManagerVirtualNetworkResource struct{}
 
@@ -43,6 +45,10 @@ func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) IDValidationFun
 	return virtualnetworks.ValidateVirtualNetworkID
 }
 
+func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) Identity() resourceids.ResourceId {
+	return &virtualnetworks.VirtualNetworkId{}
+}
+
 func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_virtual_network"
 }
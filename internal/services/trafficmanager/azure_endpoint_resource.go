@@ -163,7 +163,7 @@ func resourceAzureEndpoint() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &endpoints.EndpointTypeId{})
 }
 
 func resourceAzureEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
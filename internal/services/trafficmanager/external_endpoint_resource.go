@@ -171,7 +171,7 @@ func resourceExternalEndpoint() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &endpoints.EndpointTypeId{})
 }
 
 func resourceExternalEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -181,7 +181,7 @@ func resourceNestedEndpoint() *pluginsdk.Resource {
 		}
 	}
 
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &endpoints.EndpointTypeId{})
 }
 
 func resourceNestedEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -206,7 +206,7 @@ func resourceArmTrafficManagerProfile() *pluginsdk.Resource {
 			}, false),
 		}
 	}
-	return resource
+	return pluginsdk.WithResourceIdentity(resource, &profiles.TrafficManagerProfileId{})
 }
 
 func resourceArmTrafficManagerProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -21,7 +21,7 @@ import (
 )
 
 func resourceVideoAnalyzerEdgeModule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVideoAnalyzerEdgeModuleCreateUpdate,
 		Read:   resourceVideoAnalyzerEdgeModuleRead,
 		Delete: resourceVideoAnalyzerEdgeModuleDelete,
@@ -59,7 +59,7 @@ func resourceVideoAnalyzerEdgeModule() *pluginsdk.Resource {
 				ValidateFunc: validate.VideoAnalyzerName(),
 			},
 		},
-	}
+	}, &edgemodules.EdgeModuleId{})
 }
 
 func resourceVideoAnalyzerEdgeModuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
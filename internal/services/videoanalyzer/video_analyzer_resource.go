@@ -23,7 +23,7 @@ import (
 )
 
 func resourceVideoAnalyzer() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVideoAnalyzerCreateUpdate,
 		Read:   resourceVideoAnalyzerRead,
 		Update: resourceVideoAnalyzerCreateUpdate,
@@ -81,6 +81,10 @@ func resourceVideoAnalyzer() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &videoanalyzers.VideoAnalyzerId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &videoanalyzers.VideoAnalyzerId{})
 }
 
 func resourceVideoAnalyzerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceVmwareCluster() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVmwareClusterCreate,
 		Read:   resourceVmwareClusterRead,
 		Update: resourceVmwareClusterUpdate,
@@ -90,7 +90,7 @@ func resourceVmwareCluster() *pluginsdk.Resource {
 				},
 			},
 		},
-	}
+	}, &clusters.ClusterId{})
 }
 
 func resourceVmwareClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,7 +20,7 @@ import (
 )
 
 func resourceVmwareExpressRouteAuthorization() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceVmwareExpressRouteAuthorizationCreate,
 		Read:   resourceVmwareExpressRouteAuthorizationRead,
 		Delete: resourceVmwareExpressRouteAuthorizationDelete,
@@ -62,7 +62,7 @@ func resourceVmwareExpressRouteAuthorization() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 		},
-	}
+	}, &authorizations.AuthorizationId{})
 }
 
 func resourceVmwareExpressRouteAuthorizationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
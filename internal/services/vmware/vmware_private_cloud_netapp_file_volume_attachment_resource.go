@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -67,6 +68,10 @@ func (r NetappFileVolumeAttachmentResource) IDValidationFunc() pluginsdk.SchemaV
 	return datastores.ValidateDataStoreID
 }
 
+func (r NetappFileVolumeAttachmentResource) Identity() resourceids.ResourceId {
+	return &datastores.DataStoreId{}
+}
+
 func (r NetappFileVolumeAttachmentResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
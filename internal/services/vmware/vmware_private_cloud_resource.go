@@ -24,7 +24,7 @@ import (
 )
 
 func resourceVmwarePrivateCloud() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceVmwarePrivateCloudCreate,
 		Read:   resourceVmwarePrivateCloudRead,
 		Update: resourceVmwarePrivateCloudUpdate,
@@ -198,6 +198,10 @@ func resourceVmwarePrivateCloud() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &privateclouds.PrivateCloudId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &privateclouds.PrivateCloudId{})
 }
 
 func resourceVmwarePrivateCloudCreate(d *pluginsdk.ResourceData, meta interface{}) error {
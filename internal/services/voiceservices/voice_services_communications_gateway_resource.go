@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -54,6 +55,7 @@ type CommunicationsGatewayResource struct{}
 var _ sdk.ResourceWithUpdate = CommunicationsGatewayResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = CommunicationsGatewayResource{}
+var _ sdk.ResourceWithIdentity = CommunicationsGatewayResource{}
 
 func (r CommunicationsGatewayResource) ResourceType() string {
 	return "azurerm_voice_services_communications_gateway"
@@ -92,6 +94,10 @@ func (r CommunicationsGatewayResource) IDValidationFunc() pluginsdk.SchemaValida
 	return communicationsgateways.ValidateCommunicationsGatewayID
 }
 
+func (r CommunicationsGatewayResource) Identity() resourceids.ResourceId {
+	return &communicationsgateways.CommunicationsGatewayId{}
+}
+
 func (r CommunicationsGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/testlines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -31,6 +32,7 @@ type CommunicationsGatewayTestLineResourceModel struct {
 type CommunicationsGatewayTestLineResource struct{}
 
 var _ sdk.ResourceWithUpdate = CommunicationsGatewayTestLineResource{}
+var _ sdk.ResourceWithIdentity = CommunicationsGatewayTestLineResource{}
 
 func (r CommunicationsGatewayTestLineResource) ResourceType() string {
 	return "azurerm_voice_services_communications_gateway_test_line"
@@ -44,6 +46,10 @@ func (r CommunicationsGatewayTestLineResource) IDValidationFunc() pluginsdk.Sche
 	return testlines.ValidateTestLineID
 }
 
+func (r CommunicationsGatewayTestLineResource) Identity() resourceids.ResourceId {
+	return &testlines.TestLineId{}
+}
+
 func (r CommunicationsGatewayTestLineResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
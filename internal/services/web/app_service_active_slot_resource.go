@@ -18,7 +18,7 @@ import (
 )
 
 func resourceAppServiceActiveSlot() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAppServiceActiveSlotCreateUpdate,
 		Read:   resourceAppServiceActiveSlotRead,
 		Update: resourceAppServiceActiveSlotCreateUpdate,
@@ -51,7 +51,7 @@ func resourceAppServiceActiveSlot() *pluginsdk.Resource {
 				Required: true,
 			},
 		},
-	}
+	}, &parse.AppServiceId{})
 }
 
 func resourceAppServiceActiveSlotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
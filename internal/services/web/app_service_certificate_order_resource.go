@@ -23,7 +23,7 @@ import (
 )
 
 func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAppServiceCertificateOrderCreateUpdate,
 		Read:   resourceAppServiceCertificateOrderRead,
 		Update: resourceAppServiceCertificateOrderCreateUpdate,
@@ -175,6 +175,10 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.CertificateOrderId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.CertificateOrderId{})
 }
 
 func resourceAppServiceCertificateOrderCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
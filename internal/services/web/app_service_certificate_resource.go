@@ -27,7 +27,7 @@ import (
 )
 
 func resourceAppServiceCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAppServiceCertificateCreateUpdate,
 		Read:   resourceAppServiceCertificateRead,
 		Update: resourceAppServiceCertificateCreateUpdate,
@@ -46,6 +46,10 @@ func resourceAppServiceCertificate() *pluginsdk.Resource {
 
 		Schema: resourceAppServiceCertificateSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.CertificateId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.CertificateId{})
 }
 
 func resourceAppServiceCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
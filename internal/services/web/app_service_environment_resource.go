@@ -34,7 +34,7 @@ const (
 )
 
 func resourceAppServiceEnvironment() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		DeprecationMessage: "This resource is deprecated due to the [retirement of v1 and v2 App Service Environments](https://azure.microsoft.com/en-gb/updates/app-service-environment-v1-and-v2-retirement-announcement/) and will be removed inv4.0 of the provider. Please use `azurerm_app_service_environment_v3` instead.",
 		Create:             resourceAppServiceEnvironmentCreate,
 		Read:               resourceAppServiceEnvironmentRead,
@@ -55,6 +55,10 @@ func resourceAppServiceEnvironment() *pluginsdk.Resource {
 
 		Schema: resourceAppServiceEnvironmentSchema(),
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.AppServiceEnvironmentId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.AppServiceEnvironmentId{})
 }
 
 func resourceAppServiceEnvironmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceAppServiceHybridConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAppServiceHybridConnectionCreateUpdate,
 		Read:   resourceAppServiceHybridConnectionRead,
 		Update: resourceAppServiceHybridConnectionCreateUpdate,
@@ -109,7 +109,7 @@ func resourceAppServiceHybridConnection() *pluginsdk.Resource {
 				Computed:  true,
 			},
 		},
-	}
+	}, &parse.HybridConnectionId{})
 }
 
 func resourceAppServiceHybridConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,7 +22,7 @@ import (
 )
 
 func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAppServiceManagedCertificateCreateUpdate,
 		Read:   resourceAppServiceManagedCertificateRead,
 		Update: resourceAppServiceManagedCertificateCreateUpdate,
@@ -92,7 +92,7 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
-	}
+	}, &parse.ManagedCertificateId{})
 }
 
 func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
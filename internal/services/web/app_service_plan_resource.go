@@ -26,7 +26,7 @@ import (
 )
 
 func resourceAppServicePlan() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAppServicePlanCreateUpdate,
 		Read:   resourceAppServicePlanRead,
 		Update: resourceAppServicePlanCreateUpdate,
@@ -149,6 +149,10 @@ func resourceAppServicePlan() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.AppServicePlanId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.AppServicePlanId{})
 }
 
 func resourceAppServicePlanCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
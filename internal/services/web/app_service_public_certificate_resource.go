@@ -21,7 +21,7 @@ import (
 )
 
 func resourceAppServicePublicCertificate() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAppServicePublicCertificateCreate,
 		Read:   resourceAppServicePublicCertificateRead,
 		Delete: resourceAppServicePublicCertificateDelete,
@@ -70,7 +70,7 @@ func resourceAppServicePublicCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &webapps.PublicCertificateId{})
 }
 
 func resourceAppServicePublicCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -27,7 +27,7 @@ import (
 )
 
 func resourceAppService() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAppServiceCreate,
 		Read:   resourceAppServiceRead,
 		Update: resourceAppServiceUpdate,
@@ -225,6 +225,10 @@ func resourceAppService() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.AppServiceId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.AppServiceId{})
 }
 
 func resourceAppServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
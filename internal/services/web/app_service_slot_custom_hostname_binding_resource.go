@@ -23,7 +23,7 @@ import (
 var appServiceSlotCustomHostnameBindingResourceName = "azurerm_app_service_slot_custom_hostname_binding"
 
 func resourceAppServiceSlotCustomHostnameBinding() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAppServiceSlotCustomHostnameBindingCreate,
 		Read:   resourceAppServiceSlotCustomHostnameBindingRead,
 		Delete: resourceAppServiceSlotCustomHostnameBindingDelete,
@@ -76,7 +76,7 @@ func resourceAppServiceSlotCustomHostnameBinding() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
-	}
+	}, &parse.AppServiceSlotCustomHostnameBindingId{})
 }
 
 func resourceAppServiceSlotCustomHostnameBindingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
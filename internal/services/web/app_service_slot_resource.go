@@ -26,7 +26,7 @@ import (
 )
 
 func resourceAppServiceSlot() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceAppServiceSlotCreateUpdate,
 		Read:   resourceAppServiceSlotRead,
 		Update: resourceAppServiceSlotCreateUpdate,
@@ -178,6 +178,10 @@ func resourceAppServiceSlot() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.AppServiceSlotId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.AppServiceSlotId{})
 }
 
 func resourceAppServiceSlotCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
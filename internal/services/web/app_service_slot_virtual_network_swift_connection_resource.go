@@ -23,7 +23,7 @@ import (
 )
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnection() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		Create: resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate,
 		Read:   resourceAppServiceSlotVirtualNetworkSwiftConnectionRead,
 		Update: resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate,
@@ -60,7 +60,7 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 				ValidateFunc: validate.AppServiceName,
 			},
 		},
-	}
+	}, &parse.SlotVirtualNetworkSwiftConnectionId{})
 }
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
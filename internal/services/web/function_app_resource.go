@@ -31,7 +31,7 @@ import (
 // Azure Function App shares the same infrastructure with Azure App Service.
 // So this resource will reuse most of the App Service code, but remove the configurations which are not applicable for Function App.
 func resourceFunctionApp() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFunctionAppCreate,
 		Read:   resourceFunctionAppRead,
 		Update: resourceFunctionAppUpdate,
@@ -241,6 +241,10 @@ func resourceFunctionApp() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.FunctionAppId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.FunctionAppId{})
 }
 
 func resourceFunctionAppCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -30,7 +30,7 @@ import (
 )
 
 func resourceFunctionAppSlot() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFunctionAppSlotCreate,
 		Read:   resourceFunctionAppSlotRead,
 		Update: resourceFunctionAppSlotUpdate,
@@ -218,6 +218,10 @@ func resourceFunctionAppSlot() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.FunctionAppSlotId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.FunctionAppSlotId{})
 }
 
 func resourceFunctionAppSlotCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -40,6 +40,20 @@ func (id AppServiceEnvironmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostingEnvironmentName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AppServiceEnvironment ID
+func (id AppServiceEnvironmentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticHostingEnvironments", "hostingEnvironments", "hostingEnvironments"),
+		resourceids.UserSpecifiedSegment("hostingEnvironmentName", "hostingEnvironment1"),
+	}
+}
+
 // AppServiceEnvironmentID parses a AppServiceEnvironment ID into an AppServiceEnvironmentId struct
 func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AppServiceEnvironmentId using the ParseResult provided in 'input'
+func (id *AppServiceEnvironmentId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.HostingEnvironmentName, ok = input.Parsed["hostingEnvironmentName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "hostingEnvironmentName", input)
+	}
+
+	return nil
+}
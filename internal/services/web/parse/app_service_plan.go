@@ -40,6 +40,20 @@ func (id AppServicePlanId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerFarmName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AppServicePlan ID
+func (id AppServicePlanId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticServerFarms", "serverFarms", "serverFarms"),
+		resourceids.UserSpecifiedSegment("serverFarmName", "farm1"),
+	}
+}
+
 // AppServicePlanID parses a AppServicePlan ID into an AppServicePlanId struct
 func AppServicePlanID(input string) (*AppServicePlanId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func AppServicePlanID(input string) (*AppServicePlanId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AppServicePlanId using the ParseResult provided in 'input'
+func (id *AppServicePlanId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.ServerFarmName, ok = input.Parsed["serverFarmName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "serverFarmName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestAppServicePlanIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServicePlanIDSegments(t *testing.T) {
+	expected := NewAppServicePlanID("12345678-1234-9876-4563-123456789012", "resGroup1", "farm1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AppServicePlanId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AppServicePlanId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAppServicePlanID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -43,6 +43,22 @@ func (id AppServiceSlotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this AppServiceSlot ID
+func (id AppServiceSlotId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "site1"),
+		resourceids.StaticSegment("staticSlots", "slots", "slots"),
+		resourceids.UserSpecifiedSegment("slotName", "slot1"),
+	}
+}
+
 // AppServiceSlotID parses a AppServiceSlot ID into an AppServiceSlotId struct
 func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the AppServiceSlotId using the ParseResult provided in 'input'
+func (id *AppServiceSlotId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SiteName, ok = input.Parsed["siteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "siteName", input)
+	}
+
+	if id.SlotName, ok = input.Parsed["slotName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "slotName", input)
+	}
+
+	return nil
+}
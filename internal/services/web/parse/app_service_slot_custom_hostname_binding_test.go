@@ -21,6 +21,23 @@ func TestAppServiceSlotCustomHostnameBindingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServiceSlotCustomHostnameBindingIDSegments(t *testing.T) {
+	expected := NewAppServiceSlotCustomHostnameBindingID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1", "binding1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AppServiceSlotCustomHostnameBindingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AppServiceSlotCustomHostnameBindingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAppServiceSlotCustomHostnameBindingID(t *testing.T) {
 	testData := []struct {
 		Input    string
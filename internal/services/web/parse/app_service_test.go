@@ -21,6 +21,23 @@ func TestAppServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServiceIDSegments(t *testing.T) {
+	expected := NewAppServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&AppServiceId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := AppServiceId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestAppServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
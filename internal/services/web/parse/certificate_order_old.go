@@ -40,6 +40,20 @@ func (id CertificateOrderOldId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CertificateOrderName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this CertificateOrderOld ID
+func (id CertificateOrderOldId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticCertificateOrders", "certificateOrders", "certificateOrders"),
+		resourceids.UserSpecifiedSegment("certificateOrderName", "order1"),
+	}
+}
+
 // CertificateOrderOldID parses a CertificateOrderOld ID into an CertificateOrderOldId struct
 func CertificateOrderOldID(input string) (*CertificateOrderOldId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -70,3 +84,22 @@ func CertificateOrderOldID(input string) (*CertificateOrderOldId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the CertificateOrderOldId using the ParseResult provided in 'input'
+func (id *CertificateOrderOldId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.CertificateOrderName, ok = input.Parsed["certificateOrderName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "certificateOrderName", input)
+	}
+
+	return nil
+}
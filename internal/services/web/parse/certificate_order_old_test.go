@@ -21,6 +21,23 @@ func TestCertificateOrderOldIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateOrderOldIDSegments(t *testing.T) {
+	expected := NewCertificateOrderOldID("12345678-1234-9876-4563-123456789012", "resGroup1", "order1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CertificateOrderOldId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CertificateOrderOldId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCertificateOrderOldID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestCertificateOrderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateOrderIDSegments(t *testing.T) {
+	expected := NewCertificateOrderID("12345678-1234-9876-4563-123456789012", "resGroup1", "order1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&CertificateOrderId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := CertificateOrderId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestCertificateOrderID(t *testing.T) {
 	testData := []struct {
 		Input    string
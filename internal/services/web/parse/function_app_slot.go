@@ -43,6 +43,22 @@ func (id FunctionAppSlotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this FunctionAppSlot ID
+func (id FunctionAppSlotId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "site1"),
+		resourceids.StaticSegment("staticSlots", "slots", "slots"),
+		resourceids.UserSpecifiedSegment("slotName", "slot1"),
+	}
+}
+
 // FunctionAppSlotID parses a FunctionAppSlot ID into an FunctionAppSlotId struct
 func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the FunctionAppSlotId using the ParseResult provided in 'input'
+func (id *FunctionAppSlotId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SiteName, ok = input.Parsed["siteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "siteName", input)
+	}
+
+	if id.SlotName, ok = input.Parsed["slotName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "slotName", input)
+	}
+
+	return nil
+}
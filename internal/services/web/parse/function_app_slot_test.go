@@ -21,6 +21,23 @@ func TestFunctionAppSlotIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFunctionAppSlotIDSegments(t *testing.T) {
+	expected := NewFunctionAppSlotID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FunctionAppSlotId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FunctionAppSlotId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFunctionAppSlotID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestFunctionAppIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFunctionAppIDSegments(t *testing.T) {
+	expected := NewFunctionAppID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&FunctionAppId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := FunctionAppId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestFunctionAppID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestHostnameBindingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostnameBindingIDSegments(t *testing.T) {
+	expected := NewHostnameBindingID("12345678-1234-9876-4563-123456789012", "mygroup1", "site1", "binding1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HostnameBindingId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HostnameBindingId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHostnameBindingID(t *testing.T) {
 	testData := []struct {
 		Input    string
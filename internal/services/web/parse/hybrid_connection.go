@@ -46,6 +46,24 @@ func (id HybridConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this HybridConnection ID
+func (id HybridConnectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "site1"),
+		resourceids.StaticSegment("staticHybridConnectionNamespaces", "hybridConnectionNamespaces", "hybridConnectionNamespaces"),
+		resourceids.UserSpecifiedSegment("hybridConnectionNamespaceName", "hybridConnectionNamespace1"),
+		resourceids.StaticSegment("staticRelays", "relays", "relays"),
+		resourceids.UserSpecifiedSegment("relayName", "relay1"),
+	}
+}
+
 // HybridConnectionID parses a HybridConnection ID into an HybridConnectionId struct
 func HybridConnectionID(input string) (*HybridConnectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func HybridConnectionID(input string) (*HybridConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the HybridConnectionId using the ParseResult provided in 'input'
+func (id *HybridConnectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SiteName, ok = input.Parsed["siteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "siteName", input)
+	}
+
+	if id.HybridConnectionNamespaceName, ok = input.Parsed["hybridConnectionNamespaceName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "hybridConnectionNamespaceName", input)
+	}
+
+	if id.RelayName, ok = input.Parsed["relayName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "relayName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestHybridConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHybridConnectionIDSegments(t *testing.T) {
+	expected := NewHybridConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "hybridConnectionNamespace1", "relay1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&HybridConnectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := HybridConnectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestHybridConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
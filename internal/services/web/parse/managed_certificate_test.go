@@ -21,6 +21,23 @@ func TestManagedCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedCertificateIDSegments(t *testing.T) {
+	expected := NewManagedCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "customhost.contoso.com")
+	parsed, err := resourceids.NewParserFromResourceIdType(&ManagedCertificateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := ManagedCertificateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestManagedCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -21,6 +21,23 @@ func TestPublicCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPublicCertificateIDSegments(t *testing.T) {
+	expected := NewPublicCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "publicCertificate1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&PublicCertificateId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := PublicCertificateId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestPublicCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
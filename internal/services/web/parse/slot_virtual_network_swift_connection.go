@@ -46,6 +46,24 @@ func (id SlotVirtualNetworkSwiftConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.ConfigName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this SlotVirtualNetworkSwiftConnection ID
+func (id SlotVirtualNetworkSwiftConnectionId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "resGroup1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticSites", "sites", "sites"),
+		resourceids.UserSpecifiedSegment("siteName", "site1"),
+		resourceids.StaticSegment("staticSlots", "slots", "slots"),
+		resourceids.UserSpecifiedSegment("slotName", "slot1"),
+		resourceids.StaticSegment("staticConfig", "config", "config"),
+		resourceids.UserSpecifiedSegment("configName", "virtualNetwork"),
+	}
+}
+
 // SlotVirtualNetworkSwiftConnectionID parses a SlotVirtualNetworkSwiftConnection ID into an SlotVirtualNetworkSwiftConnectionId struct
 func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwiftConnectionId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -82,3 +100,30 @@ func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwift
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the SlotVirtualNetworkSwiftConnectionId using the ParseResult provided in 'input'
+func (id *SlotVirtualNetworkSwiftConnectionId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.SiteName, ok = input.Parsed["siteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "siteName", input)
+	}
+
+	if id.SlotName, ok = input.Parsed["slotName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "slotName", input)
+	}
+
+	if id.ConfigName, ok = input.Parsed["configName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "configName", input)
+	}
+
+	return nil
+}
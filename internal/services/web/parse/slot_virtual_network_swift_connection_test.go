@@ -21,6 +21,23 @@ func TestSlotVirtualNetworkSwiftConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSlotVirtualNetworkSwiftConnectionIDSegments(t *testing.T) {
+	expected := NewSlotVirtualNetworkSwiftConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1", "virtualNetwork")
+	parsed, err := resourceids.NewParserFromResourceIdType(&SlotVirtualNetworkSwiftConnectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := SlotVirtualNetworkSwiftConnectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestSlotVirtualNetworkSwiftConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
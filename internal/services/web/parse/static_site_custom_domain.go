@@ -43,6 +43,22 @@ func (id StaticSiteCustomDomainId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
 }
 
+// Segments returns a slice of Resource ID Segments which comprise this StaticSiteCustomDomain ID
+func (id StaticSiteCustomDomainId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "group1"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web", "Microsoft.Web"),
+		resourceids.StaticSegment("staticStaticSites", "staticSites", "staticSites"),
+		resourceids.UserSpecifiedSegment("staticSiteName", "my-static-site1"),
+		resourceids.StaticSegment("staticCustomDomains", "customDomains", "customDomains"),
+		resourceids.UserSpecifiedSegment("customDomainName", "name.contoso.com"),
+	}
+}
+
 // StaticSiteCustomDomainID parses a StaticSiteCustomDomain ID into an StaticSiteCustomDomainId struct
 func StaticSiteCustomDomainID(input string) (*StaticSiteCustomDomainId, error) {
 	id, err := resourceids.ParseAzureResourceID(input)
@@ -76,3 +92,26 @@ func StaticSiteCustomDomainID(input string) (*StaticSiteCustomDomainId, error) {
 
 	return &resourceId, nil
 }
+
+// FromParseResult populates the StaticSiteCustomDomainId using the ParseResult provided in 'input'
+func (id *StaticSiteCustomDomainId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroup, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StaticSiteName, ok = input.Parsed["staticSiteName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "staticSiteName", input)
+	}
+
+	if id.CustomDomainName, ok = input.Parsed["customDomainName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "customDomainName", input)
+	}
+
+	return nil
+}
@@ -21,6 +21,23 @@ func TestStaticSiteCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStaticSiteCustomDomainIDSegments(t *testing.T) {
+	expected := NewStaticSiteCustomDomainID("12345678-1234-9876-4563-123456789012", "group1", "my-static-site1", "name.contoso.com")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StaticSiteCustomDomainId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StaticSiteCustomDomainId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStaticSiteCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
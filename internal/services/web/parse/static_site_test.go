@@ -21,6 +21,23 @@ func TestStaticSiteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStaticSiteIDSegments(t *testing.T) {
+	expected := NewStaticSiteID("12345678-1234-9876-4563-123456789012", "group1", "my-static-site1")
+	parsed, err := resourceids.NewParserFromResourceIdType(&StaticSiteId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := StaticSiteId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestStaticSiteID(t *testing.T) {
 	testData := []struct {
 		Input    string
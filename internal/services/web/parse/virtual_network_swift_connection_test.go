@@ -21,6 +21,23 @@ func TestVirtualNetworkSwiftConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkSwiftConnectionIDSegments(t *testing.T) {
+	expected := NewVirtualNetworkSwiftConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "virtualNetwork")
+	parsed, err := resourceids.NewParserFromResourceIdType(&VirtualNetworkSwiftConnectionId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %q using the Segments: %+v", expected.ID(), err)
+	}
+
+	actual := VirtualNetworkSwiftConnectionId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
 func TestVirtualNetworkSwiftConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -26,7 +26,7 @@ const (
 )
 
 func resourceStaticSiteCustomDomain() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	return pluginsdk.WithResourceIdentity(&pluginsdk.Resource{
 		DeprecationMessage: "This resource has been deprecated in favour of `azurerm_static_web_app_custom_domain` and will be removed in a future release.",
 		Create:             resourceStaticSiteCustomDomainCreate,
 		Read:               resourceStaticSiteCustomDomainRead,
@@ -73,7 +73,7 @@ func resourceStaticSiteCustomDomain() *pluginsdk.Resource {
 				Computed:  true,
 			},
 		},
-	}
+	}, &parse.StaticSiteCustomDomainId{})
 }
 
 func resourceStaticSiteCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
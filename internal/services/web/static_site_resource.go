@@ -25,7 +25,7 @@ import (
 )
 
 func resourceStaticSite() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		DeprecationMessage: "This resource has been deprecated in favour of `azurerm_static_web_app` and will be removed in a future release.",
 		Create:             resourceStaticSiteCreateOrUpdate,
 		Read:               resourceStaticSiteRead,
@@ -99,6 +99,10 @@ func resourceStaticSite() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 	}
+
+	pluginsdk.EnableLocationValidation(resource, &parse.StaticSiteId{})
+
+	return pluginsdk.WithResourceIdentity(resource, &parse.StaticSiteId{})
 }
 
 func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapvirtualinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -90,6 +91,7 @@ type SingleServerDataDisk struct {
 type WorkloadsSAPSingleNodeVirtualInstanceResource struct{}
 
 var _ sdk.ResourceWithUpdate = WorkloadsSAPSingleNodeVirtualInstanceResource{}
+var _ sdk.ResourceWithIdentity = WorkloadsSAPSingleNodeVirtualInstanceResource{}
 
 func (r WorkloadsSAPSingleNodeVirtualInstanceResource) ResourceType() string {
 	return "azurerm_workloads_sap_single_node_virtual_instance"
@@ -103,6 +105,10 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) IDValidationFunc() plugin
 	return sapvirtualinstances.ValidateSapVirtualInstanceID
 }
 
+func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Identity() resourceids.ResourceId {
+	return &sapvirtualinstances.SapVirtualInstanceId{}
+}
+
 func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
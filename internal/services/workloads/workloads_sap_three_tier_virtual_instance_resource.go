@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapvirtualinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -154,6 +155,7 @@ type SharedStorage struct {
 type WorkloadsSAPThreeTierVirtualInstanceResource struct{}
 
 var _ sdk.ResourceWithUpdate = WorkloadsSAPThreeTierVirtualInstanceResource{}
+var _ sdk.ResourceWithIdentity = WorkloadsSAPThreeTierVirtualInstanceResource{}
 
 func (r WorkloadsSAPThreeTierVirtualInstanceResource) ResourceType() string {
 	return "azurerm_workloads_sap_three_tier_virtual_instance"
@@ -167,6 +169,10 @@ func (r WorkloadsSAPThreeTierVirtualInstanceResource) IDValidationFunc() plugins
 	return sapvirtualinstances.ValidateSapVirtualInstanceID
 }
 
+func (r WorkloadsSAPThreeTierVirtualInstanceResource) Identity() resourceids.ResourceId {
+	return &sapvirtualinstances.SapVirtualInstanceId{}
+}
+
 func (r WorkloadsSAPThreeTierVirtualInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
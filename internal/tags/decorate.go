@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// hookFunc is called before the decorated function and returns the function which should be called once the
+// decorated function has completed successfully - which can be nil when there's nothing further to do
+type hookFunc func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (func(d *pluginsdk.ResourceData) error, error)
+
+// decorateCreate wraps the Create function of the specified resource using the hook `f`
+func decorateCreate(resource *pluginsdk.Resource, f hookFunc) {
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	decorateFunc(&resource.Create, f) //nolint:staticcheck
+	decorateContextFunc(&resource.CreateContext, f)
+	decorateContextFunc(&resource.CreateWithoutTimeout, f)
+}
+
+// decorateRead wraps the Read function of the specified resource using the hook `f`
+func decorateRead(resource *pluginsdk.Resource, f hookFunc) {
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	decorateFunc(&resource.Read, f) //nolint:staticcheck
+	decorateContextFunc(&resource.ReadContext, f)
+	decorateContextFunc(&resource.ReadWithoutTimeout, f)
+}
+
+// decorateUpdate wraps the Update function of the specified resource using the hook `f`
+func decorateUpdate(resource *pluginsdk.Resource, f hookFunc) {
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	decorateFunc(&resource.Update, f) //nolint:staticcheck
+	decorateContextFunc(&resource.UpdateContext, f)
+	decorateContextFunc(&resource.UpdateWithoutTimeout, f)
+}
+
+func decorateFunc[T ~func(*pluginsdk.ResourceData, interface{}) error](fn *T, f hookFunc) {
+	inner := *fn
+	if inner == nil {
+		return
+	}
+
+	*fn = func(d *pluginsdk.ResourceData, meta interface{}) error {
+		after, err := f(context.Background(), d, meta)
+		if err != nil {
+			return err
+		}
+
+		if err := inner(d, meta); err != nil {
+			return err
+		}
+
+		if after == nil {
+			return nil
+		}
+		return after(d)
+	}
+}
+
+func decorateContextFunc[T ~func(context.Context, *pluginsdk.ResourceData, interface{}) diag.Diagnostics](fn *T, f hookFunc) {
+	inner := *fn
+	if inner == nil {
+		return
+	}
+
+	*fn = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		after, err := f(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := inner(ctx, d, meta)
+		if diags.HasError() || after == nil {
+			return diags
+		}
+
+		if err := after(d); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ProviderConfig contains the tags configuration defined within the Provider block. This is held on the client
+// for each instance (alias) of the Provider, so that it only applies to the resources managed by that instance.
+type ProviderConfig struct {
+	// DefaultTags contains the tags defined in the `default_tags` block of the Provider, which are merged
+	// into the tags of every taggable resource - this can be (validly) nil when no defaults are configured
+	DefaultTags map[string]string
+
+	// IgnoredTagKeys and IgnoredTagKeyPrefixes contain the values defined in the `ignore_tags` block of the Provider,
+	// these tags are managed outside of Terraform (e.g. by Azure Policy) and are omitted from the `tags` field
+	IgnoredTagKeys        []string
+	IgnoredTagKeyPrefixes []string
+}
+
+// ProviderConfigFunc returns the tags configuration for the instance of the Provider which owns `meta`
+type ProviderConfigFunc func(meta interface{}) *ProviderConfig
+
+// NewProviderConfig returns the tags configuration for an instance of the Provider
+func NewProviderConfig(defaultTags map[string]string, ignoredTagKeys []string, ignoredTagKeyPrefixes []string) *ProviderConfig {
+	config := &ProviderConfig{}
+
+	if len(defaultTags) > 0 {
+		config.DefaultTags = make(map[string]string, len(defaultTags))
+		for k, v := range defaultTags {
+			config.DefaultTags[k] = v
+		}
+	}
+
+	for _, key := range ignoredTagKeys {
+		if len(key) > 0 {
+			config.IgnoredTagKeys = append(config.IgnoredTagKeys, key)
+		}
+	}
+	for _, prefix := range ignoredTagKeyPrefixes {
+		if len(prefix) > 0 {
+			config.IgnoredTagKeyPrefixes = append(config.IgnoredTagKeyPrefixes, strings.ToLower(prefix))
+		}
+	}
+
+	return config
+}
+
+// MergeWithDefaults returns the combination of the Provider's default tags and the specified tags,
+// where the tags defined on the resource take precedence over the default tags
+func (c *ProviderConfig) MergeWithDefaults(input map[string]string) map[string]string {
+	output := make(map[string]string)
+	if c != nil {
+		for k, v := range c.DefaultTags {
+			output[k] = v
+		}
+	}
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
+}
+
+func (c *ProviderConfig) hasDefaultTags() bool {
+	return c != nil && len(c.DefaultTags) > 0
+}
+
+// isInheritedDefaultTag returns whether the specified key/value pair has been inherited from the Provider's
+// default tags, in which case it shouldn't be surfaced in the `tags` field of the resource. Tags which are
+// defined on the resource (`explicit`) are never considered inherited, even when the value matches the default.
+func (c *ProviderConfig) isInheritedDefaultTag(key, value string, explicit map[string]interface{}) bool {
+	if !c.hasDefaultTags() {
+		return false
+	}
+
+	if _, ok := explicit[key]; ok {
+		return false
+	}
+
+	v, ok := c.DefaultTags[key]
+	return ok && v == value
+}
+
+func (c *ProviderConfig) hasIgnoredTags() bool {
+	return c != nil && (len(c.IgnoredTagKeys) > 0 || len(c.IgnoredTagKeyPrefixes) > 0)
+}
+
+// isIgnoredTag returns whether the specified tag key (case insensitive) matches the keys or key prefixes defined
+// within the `ignore_tags` block of the Provider
+func (c *ProviderConfig) isIgnoredTag(key string) bool {
+	if !c.hasIgnoredTags() {
+		return false
+	}
+
+	if filtered := Filter(&map[string]string{key: ""}, c.IgnoredTagKeys...); len(*filtered) == 0 {
+		return true
+	}
+
+	key = strings.ToLower(key)
+	for _, prefix := range c.IgnoredTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// supportsDefaultTags returns whether the specified resource exposes a configurable `tags` field, and so should
+// inherit the Provider's default tags. This is determined from the structure of the field, rather than how it's
+// defined, since this includes Typed Resources (e.g. using `commonschema.Tags()`) and tags which are ForceNew.
+func supportsDefaultTags(resource *pluginsdk.Resource) bool {
+	if resource == nil || resource.Schema == nil {
+		return false
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != pluginsdk.TypeMap {
+		return false
+	}
+
+	return v.Optional || v.Required
+}
+
+// EnableDefaultTags merges the default tags of the Provider instance returned from `config` into the `tags`
+// sent to the API when the specified resource is created or updated, and omits the inherited default tags
+// from the `tags` field once the resource has been read.
+func EnableDefaultTags(resource *pluginsdk.Resource, config ProviderConfigFunc) {
+	if !supportsDefaultTags(resource) {
+		return
+	}
+
+	// the configured tags are both sent to the API and retained by the resource, even when they match a default
+	mergeDefaults := func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) (func(d *pluginsdk.ResourceData) error, error) {
+		c := config(meta)
+		if !c.hasDefaultTags() {
+			return nil, nil
+		}
+
+		configured := d.Get("tags").(map[string]interface{})
+		configuredTags, err := toStringMap(configured)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("tags", c.MergeWithDefaults(configuredTags)); err != nil {
+			return nil, fmt.Errorf("setting `tags`: %+v", err)
+		}
+
+		return func(d *pluginsdk.ResourceData) error {
+			return removeInheritedDefaultTags(d, c, configured)
+		}, nil
+	}
+	decorateCreate(resource, mergeDefaults)
+	decorateUpdate(resource, mergeDefaults)
+
+	// when refreshing, the tags in the existing state are those defined on the resource
+	decorateRead(resource, func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) (func(d *pluginsdk.ResourceData) error, error) {
+		c := config(meta)
+		if !c.hasDefaultTags() {
+			return nil, nil
+		}
+
+		existing := d.Get("tags").(map[string]interface{})
+		return func(d *pluginsdk.ResourceData) error {
+			return removeInheritedDefaultTags(d, c, existing)
+		}, nil
+	})
+}
+
+func removeInheritedDefaultTags(d *pluginsdk.ResourceData, config *ProviderConfig, explicit map[string]interface{}) error {
+	// the resource has been removed from state, so there's nothing to set
+	if d.Id() == "" {
+		return nil
+	}
+
+	raw, ok := d.Get("tags").(map[string]interface{})
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+
+	filtered := make(map[string]interface{}, len(raw))
+	for k, v := range raw {
+		value, err := TagValueToString(v)
+		if err != nil {
+			return err
+		}
+		if !config.isInheritedDefaultTag(k, value, explicit) {
+			filtered[k] = v
+		}
+	}
+
+	if len(filtered) == len(raw) {
+		return nil
+	}
+
+	if err := d.Set("tags", filtered); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestMergeWithDefaults(t *testing.T) {
+	config := NewProviderConfig(map[string]string{
+		"env": "prod",
+	}, nil, nil)
+
+	actual := config.MergeWithDefaults(map[string]string{
+		"hello": "there",
+	})
+	expected := map[string]string{
+		"env":   "prod",
+		"hello": "there",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	var unconfigured *ProviderConfig
+	if actual := unconfigured.MergeWithDefaults(map[string]string{"hello": "there"}); len(actual) != 1 {
+		t.Fatalf("Expected no default tags to be merged but got %+v", actual)
+	}
+}
+
+func TestEnableDefaultTags(t *testing.T) {
+	var sentToApi map[string]interface{}
+	remote := map[string]interface{}{}
+
+	read := func(d *pluginsdk.ResourceData, meta interface{}) error {
+		return d.Set("tags", remote)
+	}
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": Schema(),
+		},
+		//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+		Create: func(d *pluginsdk.ResourceData, meta interface{}) error { //nolint:staticcheck
+			sentToApi = d.Get("tags").(map[string]interface{})
+			remote = sentToApi
+			d.SetId("example")
+			return read(d, meta)
+		},
+		Read: read, //nolint:staticcheck
+	}
+
+	config := map[string]*ProviderConfig{
+		"first": NewProviderConfig(map[string]string{
+			"env":   "prod",
+			"owner": "platform",
+		}, nil, nil),
+		"second": NewProviderConfig(nil, nil, nil),
+	}
+	EnableDefaultTags(resource, func(meta interface{}) *ProviderConfig {
+		return config[meta.(string)]
+	})
+
+	d := resource.TestResourceData()
+	if err := d.Set("tags", map[string]interface{}{"env": "prod", "hello": "there"}); err != nil {
+		t.Fatalf("setting `tags`: %+v", err)
+	}
+	if err := resource.Create(d, "first"); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expected := map[string]interface{}{"env": "prod", "hello": "there", "owner": "platform"}
+	if !reflect.DeepEqual(sentToApi, expected) {
+		t.Fatalf("Expected %+v to be sent to the API but got %+v", expected, sentToApi)
+	}
+
+	// `env` is defined on the resource so must be retained, even though it matches the default
+	expected = map[string]interface{}{"env": "prod", "hello": "there"}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the tags to be %+v after creation but got %+v", expected, actual)
+	}
+	if err := resource.Read(d, "first"); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the tags to be %+v after reading but got %+v", expected, actual)
+	}
+
+	// a Provider instance without default tags doesn't omit any tags
+	imported := resource.TestResourceData()
+	imported.SetId("example")
+	if err := resource.Read(imported, "second"); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+	expected = map[string]interface{}{"env": "prod", "hello": "there", "owner": "platform"}
+	if actual := imported.Get("tags"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the tags to be %+v when read by another Provider instance but got %+v", expected, actual)
+	}
+}
+
+func TestSupportsDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Schema   map[string]*pluginsdk.Schema
+		Expected bool
+	}{
+		{
+			Name:     "No Tags",
+			Schema:   map[string]*pluginsdk.Schema{},
+			Expected: false,
+		},
+		{
+			Name: "Tags Schema",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": Schema(),
+			},
+			Expected: true,
+		},
+		{
+			Name: "ForceNew Tags Schema",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": ForceNewSchema(),
+			},
+			Expected: true,
+		},
+		{
+			Name: "Common Schema Tags",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": commonschema.Tags(),
+			},
+			Expected: true,
+		},
+		{
+			Name: "Common Schema ForceNew Tags",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": commonschema.TagsForceNew(),
+			},
+			Expected: true,
+		},
+		{
+			Name: "Computed Tags",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": commonschema.TagsDataSource(),
+			},
+			Expected: false,
+		},
+		{
+			Name: "Tags as a List",
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+		if actual := supportsDefaultTags(&pluginsdk.Resource{Schema: v.Schema}); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -3,7 +3,11 @@
 
 package tags
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
 
 func Filter(tagsMap *map[string]string, tagNames ...string) *map[string]string {
 	if len(tagNames) == 0 || tagsMap == nil {
@@ -28,3 +32,57 @@ func Filter(tagsMap *map[string]string, tagNames ...string) *map[string]string {
 
 	return &tagsRet
 }
+
+// EnableIgnoredTags suppresses the diff for tags matching the `ignore_tags` block of the Provider instance returned
+// from `config`, so that tags managed outside of Terraform (e.g. by Azure Policy) never show as a diff.
+//
+// These tags are retained in the state (as flattened from the API) and since their removal is suppressed they remain
+// in the planned `tags` - as such these are expanded and sent to the API when the resource is updated, rather than
+// being removed, without the existing tags needing to be retrieved again.
+func EnableIgnoredTags(resource *pluginsdk.Resource, config func() *ProviderConfig) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != pluginsdk.TypeMap {
+		return
+	}
+
+	existing := v.DiffSuppressFunc
+	v.DiffSuppressFunc = func(k, old, new string, d *pluginsdk.ResourceData) bool {
+		if existing != nil && existing(k, old, new, d) {
+			return true
+		}
+
+		return suppressIgnoredTagsDiff(k, d, config())
+	}
+}
+
+// suppressIgnoredTagsDiff returns whether the diff for the key `k` within the `tags` field only results from tags
+// matching the `ignore_tags` block. Ignored tags defined in the configuration are sent when the resource is created.
+func suppressIgnoredTagsDiff(k string, d *pluginsdk.ResourceData, config *ProviderConfig) bool {
+	if !config.hasIgnoredTags() || d.Id() == "" {
+		return false
+	}
+
+	if k == "tags.%" {
+		// the number of tags changes when an ignored tag is omitted from the configuration, which is suppressed when
+		// the number of tags which aren't ignored hasn't changed - any other changes have their own diff
+		old, new := d.GetChange("tags")
+		return len(config.withoutIgnoredTags(old.(map[string]interface{}))) == len(config.withoutIgnoredTags(new.(map[string]interface{})))
+	}
+
+	return config.isIgnoredTag(strings.TrimPrefix(k, "tags."))
+}
+
+// withoutIgnoredTags returns the tags which don't match the `ignore_tags` block
+func (c *ProviderConfig) withoutIgnoredTags(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		if !c.isIgnoredTag(k) {
+			output[k] = v
+		}
+	}
+	return output
+}
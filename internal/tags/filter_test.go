@@ -4,7 +4,13 @@
 package tags
 
 import (
+	"context"
+	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func TestFilter(t *testing.T) {
@@ -25,3 +31,102 @@ func TestFilter(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], (*filtered)["key2"])
 	}
 }
+
+func TestEnableIgnoredTags(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": Schema(),
+		},
+	}
+
+	config := NewProviderConfig(nil, []string{"createdby"}, []string{"policy-"})
+	EnableIgnoredTags(resource, func() *ProviderConfig {
+		return config
+	})
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                 "example",
+			"tags.%":             "3",
+			"tags.CreatedBy":     "policy",
+			"tags.Policy-Source": "inherited",
+			"tags.key1":          "value1",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:   "Ignored Tags Omitted",
+			Config: map[string]interface{}{"key1": "value1"},
+		},
+		{
+			Name:   "Ignored Tags Retained When Updating",
+			Config: map[string]interface{}{"key2": "value2"},
+			Expected: map[string]interface{}{
+				"CreatedBy":     "policy",
+				"Policy-Source": "inherited",
+				"key2":          "value2",
+			},
+		},
+		{
+			Name:   "All Other Tags Removed",
+			Config: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"CreatedBy":     "policy",
+				"Policy-Source": "inherited",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+
+		diff, err := resource.Diff(context.TODO(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"tags": v.Config}), nil)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+
+		if v.Expected == nil {
+			if diff != nil && len(diff.Attributes) > 0 {
+				t.Fatalf("Expected no diff but got %+v", diff.Attributes)
+			}
+			continue
+		}
+
+		// the tags which are sent to the API when updating the resource
+		d, err := schema.InternalMap(resource.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("building the ResourceData: %+v", err)
+		}
+		if actual := d.Get("tags"); !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected the tags %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestEnableIgnoredTagsWhenCreating(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": Schema(),
+		},
+	}
+
+	config := NewProviderConfig(nil, []string{"createdby"}, nil)
+	EnableIgnoredTags(resource, func() *ProviderConfig {
+		return config
+	})
+
+	// ignored tags defined in the configuration are sent when creating the resource
+	diff, err := resource.Diff(context.TODO(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"tags": map[string]interface{}{"CreatedBy": "terraform"}}), nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if v, ok := diff.Attributes["tags.CreatedBy"]; !ok || v.New != "terraform" {
+		t.Fatalf("Expected a diff for `tags.CreatedBy` but got %+v", diff.Attributes)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// SchemaTagsAll returns the Schema used for the `tags_all` field, which contains the combination of
+// the tags defined on the resource and the Provider's default tags
+func SchemaTagsAll() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+// SupportsTagsAll returns whether the specified resource exposes a configurable `tags` field which can be
+// updated in-place, and so can surface the Provider's default tags via `tags_all`
+func SupportsTagsAll(resource *pluginsdk.Resource) bool {
+	if !supportsDefaultTags(resource) || resource.Schema["tags"].ForceNew {
+		return false
+	}
+
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	return resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil //nolint:staticcheck
+}
+
+// EnableTagsAll adds the computed `tags_all` field to the specified resource, which is populated
+// during both the plan (via CustomizeDiff) and after the resource has been read
+func EnableTagsAll(resource *pluginsdk.Resource, config ProviderConfigFunc) {
+	if !SupportsTagsAll(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = SchemaTagsAll()
+
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, customizeDiffTagsAll(config))
+	} else {
+		resource.CustomizeDiff = customizeDiffTagsAll(config)
+	}
+
+	decorateRead(resource, func(_ context.Context, _ *pluginsdk.ResourceData, meta interface{}) (func(d *pluginsdk.ResourceData) error, error) {
+		return func(d *pluginsdk.ResourceData) error {
+			return setTagsAll(d, config(meta))
+		}, nil
+	})
+}
+
+func setTagsAll(d *pluginsdk.ResourceData, config *ProviderConfig) error {
+	// the resource has been removed from state, so there's nothing to set
+	if d.Id() == "" {
+		return nil
+	}
+
+	// tags matching the `ignore_tags` block are retained in `tags` but aren't surfaced in `tags_all`
+	configured, err := toStringMap(config.withoutIgnoredTags(d.Get("tags").(map[string]interface{})))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("tags_all", config.MergeWithDefaults(configured)); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
+
+func customizeDiffTagsAll(config ProviderConfigFunc) pluginsdk.CustomizeDiffFunc {
+	return func(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		c := config(meta)
+		configured, err := toStringMap(c.withoutIgnoredTags(d.Get("tags").(map[string]interface{})))
+		if err != nil {
+			return err
+		}
+
+		merged := c.MergeWithDefaults(configured)
+
+		existing, err := toStringMap(d.Get("tags_all").(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		if d.Id() != "" && reflect.DeepEqual(existing, merged) {
+			return nil
+		}
+
+		return d.SetNew("tags_all", merged)
+	}
+}
+
+func toStringMap(input map[string]interface{}) (map[string]string, error) {
+	output := make(map[string]string, len(input))
+	for k, v := range input {
+		value, err := TagValueToString(v)
+		if err != nil {
+			return nil, err
+		}
+		output[k] = value
+	}
+	return output, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestSupportsTagsAll(t *testing.T) {
+	update := func(d *pluginsdk.ResourceData, meta interface{}) error {
+		return nil
+	}
+
+	testData := []struct {
+		Name     string
+		Input    *pluginsdk.Resource
+		Expected bool
+	}{
+		{
+			Name: "No Tags",
+			Input: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{},
+				Update: update,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Tags",
+			Input: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tags": Schema(),
+				},
+				Update: update,
+			},
+			Expected: true,
+		},
+		{
+			Name: "Tags without Update",
+			Input: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tags": Schema(),
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Force New Tags",
+			Input: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tags": ForceNewSchema(),
+				},
+				Update: update,
+			},
+			Expected: false,
+		},
+		{
+			Name: "Tags with a different Validation Function",
+			Input: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tags": SchemaEnforceLowerCaseKeys(),
+				},
+				Update: update,
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test %q", v.Name)
+		if actual := SupportsTagsAll(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type (
+	ResourceIdentity = schema.ResourceIdentity
+	IdentityData     = schema.IdentityData
+)
+
+// identityAttribute maps a user-specified segment of a Resource ID to an attribute within the Resource Identity
+type identityAttribute struct {
+	name        string
+	segmentName string
+	segmentType resourceids.SegmentType
+}
+
+// identityAttributes returns the attributes of the Resource Identity for the Resource ID type, which are:
+//
+// * `subscription_id` for the Subscription ID segment
+// * `resource_group_name` for the Resource Group segment (or `name` when the resource is the Resource Group)
+// * `scope` for the Scope segment
+// * `name` for the last user-specified segment (the name of the resource)
+// * the snake_cased segment name for any other user-specified or constant segments (e.g. the names of parent resources)
+func identityAttributes(id resourceids.ResourceId) []identityAttribute {
+	segments := id.Segments()
+
+	lastUserSpecified := -1
+	for i, segment := range segments {
+		if segment.Type == resourceids.UserSpecifiedSegmentType {
+			lastUserSpecified = i
+		}
+	}
+
+	attributes := make([]identityAttribute, 0)
+	for i, segment := range segments {
+		var name string
+		switch segment.Type {
+		case resourceids.SubscriptionIdSegmentType:
+			name = "subscription_id"
+		case resourceids.ResourceGroupSegmentType:
+			name = "resource_group_name"
+			if lastUserSpecified == -1 {
+				// the Resource Group is the resource, e.g. `azurerm_resource_group`
+				name = "name"
+			}
+		case resourceids.ScopeSegmentType:
+			name = "scope"
+		case resourceids.UserSpecifiedSegmentType:
+			name = snakeCase(segment.Name)
+			if i == lastUserSpecified {
+				name = "name"
+			}
+		case resourceids.ConstantSegmentType:
+			name = snakeCase(segment.Name)
+		default:
+			continue
+		}
+
+		attributes = append(attributes, identityAttribute{
+			name:        name,
+			segmentName: segment.Name,
+			segmentType: segment.Type,
+		})
+	}
+
+	return attributes
+}
+
+// ResourceIdentityFromResourceId returns the Resource Identity for a Resource using the Resource ID type, where
+// each of the user-specified segments within the Resource ID is an attribute of the Resource Identity.
+func ResourceIdentityFromResourceId(id resourceids.ResourceId) *ResourceIdentity {
+	return &ResourceIdentity{
+		Version: 0,
+		SchemaFunc: func() map[string]*Schema {
+			out := make(map[string]*Schema)
+			for _, attribute := range identityAttributes(id) {
+				out[attribute.name] = &Schema{
+					Type: TypeString,
+					// the Subscription ID can be omitted, in which case the Subscription configured in the Provider is used
+					OptionalForImport: attribute.segmentType == resourceids.SubscriptionIdSegmentType,
+					RequiredForImport: attribute.segmentType != resourceids.SubscriptionIdSegmentType,
+				}
+			}
+			return out
+		},
+	}
+}
+
+// ResourceIdentityValues returns the values of the Resource Identity attributes for the Resource ID
+func ResourceIdentityValues(id resourceids.ResourceId) (map[string]string, error) {
+	return resourceIdentityValuesFromString(id, id.ID(), false)
+}
+
+// resourceIdentityValuesFromString parses the Resource ID `input` using the Resource ID type `id` and returns
+// the values of the Resource Identity attributes
+func resourceIdentityValuesFromString(id resourceids.ResourceId, input string, insensitively bool) (map[string]string, error) {
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(input, insensitively)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	out := make(map[string]string)
+	for _, attribute := range identityAttributes(id) {
+		out[attribute.name] = parsed.Parsed[attribute.segmentName]
+	}
+	return out, nil
+}
+
+// resourceIdFromIdentityValues builds the Resource ID for the Resource ID type `id` from the values of the
+// Resource Identity attributes
+func resourceIdFromIdentityValues(id resourceids.ResourceId, values map[string]string) (string, error) {
+	attributes := identityAttributes(id)
+	for _, attribute := range attributes {
+		if v := values[attribute.name]; v == "" {
+			return "", fmt.Errorf("the Resource Identity attribute %q must be specified", attribute.name)
+		}
+	}
+
+	segmentValues := make(map[string]string)
+	for _, attribute := range attributes {
+		segmentValues[attribute.segmentName] = values[attribute.name]
+	}
+
+	components := make([]string, 0)
+	for _, segment := range id.Segments() {
+		switch segment.Type {
+		case resourceids.ResourceProviderSegmentType, resourceids.StaticSegmentType:
+			if segment.FixedValue != nil {
+				components = append(components, *segment.FixedValue)
+			}
+		case resourceids.ScopeSegmentType:
+			components = append(components, strings.TrimPrefix(segmentValues[segment.Name], "/"))
+		default:
+			components = append(components, segmentValues[segment.Name])
+		}
+	}
+
+	return "/" + strings.Join(components, "/"), nil
+}
+
+// SetResourceIdentityData sets the Resource Identity for the Resource using the Resource ID
+func SetResourceIdentityData(d *ResourceData, id resourceids.ResourceId) error {
+	values, err := ResourceIdentityValues(id)
+	if err != nil {
+		return fmt.Errorf("building Resource Identity: %+v", err)
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("retrieving Resource Identity: %+v", err)
+	}
+
+	for k, v := range values {
+		if err := identity.Set(k, v); err != nil {
+			return fmt.Errorf("setting Resource Identity attribute %q: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// snakeCase converts the camelCased segment name into snake_case, e.g. `virtualNetworkName` -> `virtual_network_name`
+func snakeCase(input string) string {
+	runes := []rune(input)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				sb.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
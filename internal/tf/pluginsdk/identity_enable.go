@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// defaultSubscriptionIdProvider is implemented by the Provider's client, returning the Subscription ID which is
+// configured in the Provider
+type defaultSubscriptionIdProvider interface {
+	DefaultSubscriptionId() string
+}
+
+// WithResourceIdentity defines a Resource Identity for the resource using the Resource ID type `id`, which is
+// populated each time the resource is created, updated or read - and allows the resource to be imported using
+// the attributes of the Resource Identity, rather than the Resource ID.
+//
+// Since the Resource Identity can't change once it's been set, when the Resource ID has been re-cased within
+// Azure (for example the Resource Group `example` is returned as `Example`) the existing values are retained
+// and a warning is returned when the re-cased Resource ID is first seen.
+//
+// When a resource is imported without specifying the `subscription_id` attribute, the Subscription ID which is
+// configured in the Provider is used.
+func WithResourceIdentity(resource *Resource, id resourceids.ResourceId) *Resource {
+	resource.Identity = ResourceIdentityFromResourceId(id)
+
+	// the (deprecated) functions without a context are unable to return warnings, so are replaced by their
+	// context-aware equivalents
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if create := resource.Create; create != nil { //nolint:staticcheck
+		resource.Create = nil //nolint:staticcheck
+		resource.CreateContext = contextAwareFunc(create)
+	}
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if read := resource.Read; read != nil { //nolint:staticcheck
+		resource.Read = nil //nolint:staticcheck
+		resource.ReadContext = contextAwareFunc(read)
+	}
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
+	if update := resource.Update; update != nil { //nolint:staticcheck
+		resource.Update = nil //nolint:staticcheck
+		resource.UpdateContext = contextAwareFunc(update)
+	}
+
+	if create := resource.CreateContext; create != nil {
+		resource.CreateContext = func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			existingId := d.Id()
+			return afterOperationSetResourceIdentity(create(ctx, d, meta), d, id, existingId)
+		}
+	}
+	if create := resource.CreateWithoutTimeout; create != nil {
+		resource.CreateWithoutTimeout = func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			existingId := d.Id()
+			return afterOperationSetResourceIdentity(create(ctx, d, meta), d, id, existingId)
+		}
+	}
+	if read := resource.ReadContext; read != nil {
+		resource.ReadContext = func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			existingId := d.Id()
+			return afterOperationSetResourceIdentity(read(ctx, d, meta), d, id, existingId)
+		}
+	}
+	if read := resource.ReadWithoutTimeout; read != nil {
+		resource.ReadWithoutTimeout = func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			existingId := d.Id()
+			return afterOperationSetResourceIdentity(read(ctx, d, meta), d, id, existingId)
+		}
+	}
+	if update := resource.UpdateContext; update != nil {
+		resource.UpdateContext = func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			existingId := d.Id()
+			return afterOperationSetResourceIdentity(update(ctx, d, meta), d, id, existingId)
+		}
+	}
+	if update := resource.UpdateWithoutTimeout; update != nil {
+		resource.UpdateWithoutTimeout = func(ctx context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+			existingId := d.Id()
+			return afterOperationSetResourceIdentity(update(ctx, d, meta), d, id, existingId)
+		}
+	}
+
+	if importer := resource.Importer; importer != nil {
+		stateContext := importer.StateContext
+		state := importer.State //nolint:staticcheck
+		importer.State = nil    //nolint:staticcheck
+		importer.StateContext = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			// when importing using the Resource Identity there's no Resource ID, so this is built from the identity
+			if d.Id() == "" {
+				defaultSubscriptionId := ""
+				if v, ok := meta.(defaultSubscriptionIdProvider); ok {
+					defaultSubscriptionId = v.DefaultSubscriptionId()
+				}
+				if err := setIdFromResourceIdentity(d, id, defaultSubscriptionId); err != nil {
+					return nil, err
+				}
+			}
+
+			if stateContext != nil {
+				return stateContext(ctx, d, meta)
+			}
+			if state != nil {
+				return state(d, meta)
+			}
+			return []*ResourceData{d}, nil
+		}
+	}
+
+	return resource
+}
+
+func contextAwareFunc(f func(*ResourceData, interface{}) error) func(context.Context, *ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *ResourceData, meta interface{}) diag.Diagnostics {
+		return diag.FromErr(f(d, meta))
+	}
+}
+
+func afterOperationSetResourceIdentity(diags diag.Diagnostics, d *ResourceData, id resourceids.ResourceId, existingId string) diag.Diagnostics {
+	// the resource either failed or has been removed from the state, so there's nothing to set
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return append(diags, setResourceIdentityFromId(d, id, existingId)...)
+}
+
+// setResourceIdentityFromId sets the Resource Identity using the Resource ID for the resource, retaining any
+// existing values which only differ in casing from the Resource ID. A warning is returned only when the Resource
+// ID differs from `existingId` (the Resource ID prior to the operation), so that the re-cased Resource ID isn't
+// reported on every subsequent refresh.
+func setResourceIdentityFromId(d *ResourceData, id resourceids.ResourceId, existingId string) diag.Diagnostics {
+	values, err := resourceIdentityValuesFromString(id, d.Id(), false)
+	if err != nil {
+		insensitiveValues, insensitiveErr := resourceIdentityValuesFromString(id, d.Id(), true)
+		if insensitiveErr != nil {
+			// the ID isn't in the format of the Resource ID type (e.g. it's a legacy ID which hasn't been migrated yet),
+			// so there's no Resource Identity to set
+			log.Printf("[DEBUG] Unable to build the Resource Identity for %q: %+v", d.Id(), err)
+			return nil
+		}
+
+		log.Printf("[DEBUG] The Resource ID %q doesn't match the casing of the Resource ID type %q", d.Id(), id.ID())
+		values = insensitiveValues
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return diag.Errorf("retrieving Resource Identity: %+v", err)
+	}
+
+	var diags diag.Diagnostics
+	if recased := retainRecasedIdentityValues(identity, values); len(recased) > 0 {
+		if d.Id() == existingId {
+			log.Printf("[DEBUG] Retaining the existing casing of the Resource Identity attributes %s for %q", strings.Join(recased, ", "), d.Id())
+		} else {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The Resource ID has been re-cased",
+				Detail: fmt.Sprintf(`The casing of the Resource ID %q differs from the existing Resource Identity for the attributes: %s.
+
+Since the Resource Identity can't be changed, the existing values have been retained. To use the casing of the Resource ID
+remove this resource from the State and then import it again.`, d.Id(), strings.Join(recased, ", ")),
+			})
+		}
+	}
+
+	for k, v := range values {
+		if err := identity.Set(k, v); err != nil {
+			return append(diags, diag.Errorf("setting Resource Identity attribute %q: %+v", k, err)...)
+		}
+	}
+
+	return diags
+}
+
+// retainRecasedIdentityValues replaces any of the `values` which differ only in casing from the existing Resource
+// Identity with the existing value, returning the names of the attributes which have been re-cased
+func retainRecasedIdentityValues(identity *IdentityData, values map[string]string) []string {
+	recased := make([]string, 0)
+	for k, v := range values {
+		raw, ok := identity.GetOk(k)
+		if !ok {
+			continue
+		}
+
+		existing, ok := raw.(string)
+		if ok && existing != v && strings.EqualFold(existing, v) {
+			values[k] = existing
+			recased = append(recased, fmt.Sprintf("`%s`", k))
+		}
+	}
+	sort.Strings(recased)
+
+	return recased
+}
+
+// setIdFromResourceIdentity sets the Resource ID for the resource using the attributes of the Resource Identity,
+// defaulting the `subscription_id` attribute (when it's not specified) to `defaultSubscriptionId`
+func setIdFromResourceIdentity(d *ResourceData, id resourceids.ResourceId, defaultSubscriptionId string) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("retrieving Resource Identity: %+v", err)
+	}
+
+	values := make(map[string]string)
+	for _, attribute := range identityAttributes(id) {
+		if v, ok := identity.GetOk(attribute.name); ok {
+			values[attribute.name] = v.(string)
+		}
+	}
+	if values["subscription_id"] == "" {
+		values["subscription_id"] = defaultSubscriptionId
+	}
+
+	resourceId, err := resourceIdFromIdentityValues(id, values)
+	if err != nil {
+		return fmt.Errorf("building Resource ID from the Resource Identity: %+v", err)
+	}
+
+	log.Printf("[DEBUG] Importing Resource - built the Resource ID %q from the Resource Identity", resourceId)
+	d.SetId(resourceId)
+
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestResourceIdentityValues(t *testing.T) {
+	testCases := []struct {
+		id       resourceids.ResourceId
+		expected map[string]string
+	}{
+		{
+			id: pointer.To(commonids.NewResourceGroupID("00000000-0000-0000-0000-000000000000", "example")),
+			expected: map[string]string{
+				"subscription_id": "00000000-0000-0000-0000-000000000000",
+				"name":            "example",
+			},
+		},
+		{
+			id: pointer.To(commonids.NewSubnetID("00000000-0000-0000-0000-000000000000", "example", "example-network", "internal")),
+			expected: map[string]string{
+				"subscription_id":      "00000000-0000-0000-0000-000000000000",
+				"resource_group_name":  "example",
+				"virtual_network_name": "example-network",
+				"name":                 "internal",
+			},
+		},
+		{
+			id: pointer.To(commonids.NewScopeID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example")),
+			expected: map[string]string{
+				"scope": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.id.ID())
+
+		actual, err := ResourceIdentityValues(testCase.id)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if !reflect.DeepEqual(testCase.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", testCase.expected, actual)
+		}
+
+		identitySchema := ResourceIdentityFromResourceId(testCase.id).SchemaFunc()
+		if len(identitySchema) != len(testCase.expected) {
+			t.Fatalf("expected %d attributes in the identity schema but got %d", len(testCase.expected), len(identitySchema))
+		}
+		for k := range testCase.expected {
+			if _, ok := identitySchema[k]; !ok {
+				t.Fatalf("expected the attribute %q in the identity schema", k)
+			}
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"virtualNetworkName": "virtual_network_name",
+		"vaultName":          "vault_name",
+		"name":               "name",
+		"slot2Name":          "slot2_name",
+	}
+
+	for input, expected := range testCases {
+		if actual := snakeCase(input); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
+
+func TestResourceIdFromIdentityValues(t *testing.T) {
+	testCases := []struct {
+		id       resourceids.ResourceId
+		values   map[string]string
+		expected string
+		error    bool
+	}{
+		{
+			id: &commonids.ResourceGroupId{},
+			values: map[string]string{
+				"subscription_id": "00000000-0000-0000-0000-000000000000",
+				"name":            "example",
+			},
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		},
+		{
+			id: &commonids.SubnetId{},
+			values: map[string]string{
+				"subscription_id":      "00000000-0000-0000-0000-000000000000",
+				"resource_group_name":  "example",
+				"virtual_network_name": "example-network",
+				"name":                 "internal",
+			},
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example-network/subnets/internal",
+		},
+		{
+			id: &commonids.ScopeId{},
+			values: map[string]string{
+				"scope": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			},
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		},
+		{
+			// the name of the parent resource is missing
+			id: &commonids.SubnetId{},
+			values: map[string]string{
+				"subscription_id":     "00000000-0000-0000-0000-000000000000",
+				"resource_group_name": "example",
+				"name":                "internal",
+			},
+			error: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %+v..", testCase.values)
+
+		actual, err := resourceIdFromIdentityValues(testCase.id, testCase.values)
+		if err != nil {
+			if testCase.error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if testCase.error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+		if actual != testCase.expected {
+			t.Fatalf("expected %q but got %q", testCase.expected, actual)
+		}
+
+		// the Resource ID should be parseable using the Resource ID type
+		if _, err := resourceIdentityValuesFromString(testCase.id, actual, false); err != nil {
+			t.Fatalf("parsing %q: %+v", actual, err)
+		}
+	}
+}
+
+func TestWithResourceIdentityRecasedResourceId(t *testing.T) {
+	recasedId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Example"
+	resource := WithResourceIdentity(&Resource{
+		Schema: map[string]*Schema{},
+		ReadContext: func(_ context.Context, d *ResourceData, _ interface{}) diag.Diagnostics {
+			d.SetId(recasedId)
+			return nil
+		},
+	}, &commonids.ResourceGroupId{})
+
+	d := resource.TestResourceData()
+	existing := commonids.NewResourceGroupID("00000000-0000-0000-0000-000000000000", "example")
+	d.SetId(existing.ID())
+	if err := SetResourceIdentityData(d, &existing); err != nil {
+		t.Fatalf("setting Resource Identity: %+v", err)
+	}
+
+	// the warning is returned when the re-cased Resource ID is first seen..
+	if diags := resource.ReadContext(context.Background(), d, nil); len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning but got %+v", diags)
+	}
+
+	// .. but not on subsequent reads
+	if diags := resource.ReadContext(context.Background(), d, nil); len(diags) != 0 {
+		t.Fatalf("expected no diagnostics but got %+v", diags)
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		t.Fatalf("retrieving Resource Identity: %+v", err)
+	}
+	if v := identity.Get("name").(string); v != "example" {
+		t.Fatalf("expected the existing value %q to be retained but got %q", "example", v)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// locationValidator is implemented by the Provider's client, validating the location against the locations where
+// the Resource Manager Resource Type is available
+type locationValidator interface {
+	ValidateLocationForResourceType(resourceType, location string) error
+}
+
+// resourceManagerResourceType returns the Resource Manager Resource Type (e.g. `Microsoft.Network/virtualNetworks/subnets`)
+// for the Resource ID type, which is made up of the last Resource Provider segment and the static segments which follow it
+func resourceManagerResourceType(id resourceids.ResourceId) (string, bool) {
+	components := make([]string, 0)
+	for _, segment := range id.Segments() {
+		switch segment.Type {
+		case resourceids.ResourceProviderSegmentType:
+			if segment.FixedValue == nil {
+				return "", false
+			}
+			components = []string{*segment.FixedValue}
+		case resourceids.StaticSegmentType:
+			if len(components) > 0 && segment.FixedValue != nil {
+				components = append(components, *segment.FixedValue)
+			}
+		}
+	}
+
+	// Resource IDs without a Resource Provider (e.g. Resource Groups) or a Resource Type aren't validated
+	if len(components) < 2 {
+		return "", false
+	}
+
+	return strings.Join(components, "/"), true
+}
+
+// EnableLocationValidation validates the `location` of the resource during the plan against the locations where the
+// Resource Manager Resource Type for the Resource ID type `id` is available, which is independent of whether the
+// resource exposes a Resource Identity. Resources without a `location` field, or whose Resource ID type doesn't
+// identify a Resource Type (e.g. Resource Groups and scoped resources) aren't validated.
+func EnableLocationValidation(resource *Resource, id resourceids.ResourceId) {
+	if v, ok := resource.Schema["location"]; !ok || v.Type != TypeString {
+		return
+	}
+
+	resourceType, ok := resourceManagerResourceType(id)
+	if !ok {
+		return
+	}
+
+	customizeDiff := func(_ context.Context, d *ResourceDiff, meta interface{}) error {
+		validator, ok := meta.(locationValidator)
+		if !ok {
+			return nil
+		}
+
+		// existing resources are only validated when the location changes, since the locations where a Resource Type
+		// is available can change over time
+		if d.Id() != "" && !d.HasChange("location") {
+			return nil
+		}
+		if !d.NewValueKnown("location") {
+			return nil
+		}
+
+		return validator.ValidateLocationForResourceType(resourceType, d.Get("location").(string))
+	}
+
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = CustomDiffInSequence(resource.CustomizeDiff, customizeDiff)
+	} else {
+		resource.CustomizeDiff = customizeDiff
+	}
+}
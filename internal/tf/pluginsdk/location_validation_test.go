@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func TestResourceManagerResourceType(t *testing.T) {
+	testCases := []struct {
+		id       resourceids.ResourceId
+		expected string
+	}{
+		{
+			// Resource Groups aren't part of a Resource Provider
+			id:       &commonids.ResourceGroupId{},
+			expected: "",
+		},
+		{
+			id:       &commonids.VirtualNetworkId{},
+			expected: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			id:       &commonids.SubnetId{},
+			expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			id:       &commonids.ScopeId{},
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %T..", testCase.id)
+
+		actual, ok := resourceManagerResourceType(testCase.id)
+		if ok != (testCase.expected != "") {
+			t.Fatalf("expected %t but got %t", testCase.expected != "", ok)
+		}
+		if actual != testCase.expected {
+			t.Fatalf("expected %q but got %q", testCase.expected, actual)
+		}
+	}
+}
+
+func TestEnableLocationValidation(t *testing.T) {
+	testCases := []struct {
+		schema   map[string]*Schema
+		id       resourceids.ResourceId
+		expected bool
+	}{
+		{
+			schema: map[string]*Schema{
+				"location": {
+					Type:     TypeString,
+					Required: true,
+				},
+			},
+			id:       &commonids.VirtualNetworkId{},
+			expected: true,
+		},
+		{
+			// Resource Groups aren't part of a Resource Provider
+			schema: map[string]*Schema{
+				"location": {
+					Type:     TypeString,
+					Required: true,
+				},
+			},
+			id:       &commonids.ResourceGroupId{},
+			expected: false,
+		},
+		{
+			schema: map[string]*Schema{
+				"name": {
+					Type:     TypeString,
+					Required: true,
+				},
+			},
+			id:       &commonids.VirtualNetworkId{},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %T..", testCase.id)
+
+		resource := &Resource{
+			Schema: testCase.schema,
+		}
+		EnableLocationValidation(resource, testCase.id)
+
+		if actual := resource.CustomizeDiff != nil; actual != testCase.expected {
+			t.Fatalf("expected %t but got %t", testCase.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// rawConfigRetriever is implemented by both ResourceData and ResourceDiff
+type rawConfigRetriever interface {
+	GetRawConfig() cty.Value
+}
+
+// GetWriteOnly returns the value of the top-level write-only argument `key` from the raw config, or
+// a null value when no config is available (for example, during Read or Delete).
+//
+// Write-only arguments are never persisted into the plan or the state, meaning that Get/GetOk will
+// always return the zero value for these - as such they must be retrieved from the config instead.
+func GetWriteOnly(d rawConfigRetriever, key string) (cty.Value, error) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+
+	if !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		return cty.NilVal, fmt.Errorf("the write-only argument %q was not found in the config", key)
+	}
+
+	return config.GetAttr(key), nil
+}
+
+// GetWriteOnlyString returns the value of the top-level write-only string argument `key`, or nil
+// when this hasn't been specified in the config
+func GetWriteOnlyString(d rawConfigRetriever, key string) (*string, error) {
+	value, err := GetWriteOnly(d, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return nil, nil
+	}
+
+	if !value.Type().Equals(cty.String) {
+		return nil, fmt.Errorf("expected the write-only argument %q to be a string but got %s", key, value.Type().FriendlyName())
+	}
+
+	output := value.AsString()
+	return &output, nil
+}
@@ -357,7 +357,9 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForSegments(), id.codeForParser(), id.codeForParserInsensitive(), id.codeForFromParseResult())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -448,6 +450,93 @@ func (id %[1]sId) ID() string {
 `, id.TypeName, id.IDFmt, formatKeysString)
 }
 
+// segmentName returns the name of the Resource ID Segment for this segment, which is used as the name of the
+// attribute within the Resource Identity
+func (id ResourceIdGenerator) segmentName(segment ResourceIdSegment) string {
+	switch segment.FieldName {
+	case "SubscriptionId":
+		return "subscriptionId"
+	case "ResourceGroup":
+		return "resourceGroupName"
+	}
+
+	// the last segment is the name of the resource, so when this resource has child segments (e.g. a Version)
+	// the segment is named after the Resource Type
+	last := id.Segments[len(id.Segments)-1]
+	if segment.ArgumentName == "name" && segment.FieldName != last.FieldName {
+		return fmt.Sprintf("%sName", strings.ToLower(id.TypeName[:1])+id.TypeName[1:])
+	}
+
+	return segment.ArgumentName
+}
+
+func (id ResourceIdGenerator) codeForSegments() string {
+	segments := make([]string, 0)
+	staticNames := make(map[string]int)
+	staticSegment := func(segmentType, value string) string {
+		name := fmt.Sprintf("static%s", strings.ReplaceAll(azure.TitleCase(value), ".", ""))
+		// the names of the segments must be unique, e.g. the second 'subscriptions' segment in a ServiceBus Subscription
+		staticNames[name]++
+		if count := staticNames[name]; count > 1 {
+			name = fmt.Sprintf("%s%d", name, count)
+		}
+		return fmt.Sprintf("\t\tresourceids.%s(%q, %q, %q),", segmentType, name, value, value)
+	}
+
+	split := strings.Split(strings.TrimPrefix(id.IDRaw, "/"), "/")
+	userSpecified := id.Segments
+	for i := 0; i < len(split); i += 2 {
+		key := split[i]
+		value := split[i+1]
+
+		segments = append(segments, staticSegment("StaticSegment", key))
+		if key == "providers" {
+			segments = append(segments, staticSegment("ResourceProviderSegment", value))
+			continue
+		}
+
+		segment := userSpecified[0]
+		userSpecified = userSpecified[1:]
+		segmentType := "UserSpecifiedSegment"
+		switch {
+		case segment.FieldName == "SubscriptionId" && id.HasSubscriptionId:
+			segmentType = "SubscriptionIdSegment"
+		case segment.FieldName == "ResourceGroup" && id.HasResourceGroup:
+			segmentType = "ResourceGroupSegment"
+		}
+		segments = append(segments, fmt.Sprintf("\t\tresourceids.%s(%q, %q),", segmentType, id.segmentName(segment), value))
+	}
+
+	return fmt.Sprintf(`
+// Segments returns a slice of Resource ID Segments which comprise this %[1]s ID
+func (id %[1]sId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+%[2]s
+	}
+}
+`, id.TypeName, strings.Join(segments, "\n"))
+}
+
+func (id ResourceIdGenerator) codeForFromParseResult() string {
+	assignments := make([]string, 0)
+	for _, segment := range id.Segments {
+		assignments = append(assignments, fmt.Sprintf(`
+	if id.%[1]s, ok = input.Parsed[%[2]q]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, %[2]q, input)
+	}
+`, segment.FieldName, id.segmentName(segment)))
+	}
+
+	return fmt.Sprintf(`
+// FromParseResult populates the %[1]sId using the ParseResult provided in 'input'
+func (id *%[1]sId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+%[2]s
+	return nil
+}
+`, id.TypeName, strings.Join(assignments, ""))
+}
+
 func (id ResourceIdGenerator) codeForParser() string {
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
@@ -596,7 +685,39 @@ import (
 %s
 %s
 %s
-`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForSegments(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+}
+
+func (id ResourceIdGenerator) testCodeForSegments() string {
+	arguments := make([]string, 0)
+	for _, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
+	}
+	argumentsStr := strings.Join(arguments, ", ")
+	packagePrefix := ""
+	if id.TestPackageSuffix != "" {
+		packagePrefix = "parse."
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDSegments(t *testing.T) {
+	expected := %[3]sNew%[1]sID(%[2]s)
+	parsed, err := resourceids.NewParserFromResourceIdType(&%[3]s%[1]sId{}).Parse(expected.ID(), false)
+	if err != nil {
+		t.Fatalf("parsing %%q using the Segments: %%+v", expected.ID(), err)
+	}
+
+	actual := %[3]s%[1]sId{}
+	if err := actual.FromParseResult(*parsed); err != nil {
+		t.Fatalf("populating from the Parse Result: %%+v", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("Expected %%+v but got %%+v", expected, actual)
+	}
+}
+`, id.TypeName, argumentsStr, packagePrefix)
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {